@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/spf13/cobra"
+
+	"github.com/arwoosa/form/internal/dao/mongodb"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/service"
+)
+
+var repairIntegrity bool
+
+var integrityCmd = &cobra.Command{
+	Use:   "integrity",
+	Short: "Check referential integrity of forms",
+	Long: `Scan for forms referencing templates that no longer exist and print the report as JSON.
+Use --repair to remove the dangling references.`,
+	Run: runIntegrity,
+}
+
+func init() {
+	integrityCmd.Flags().BoolVar(&repairIntegrity, "repair", false, "remove dangling references that were found")
+	rootCmd.AddCommand(integrityCmd)
+}
+
+func runIntegrity(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	appConfig := GetAppConfig()
+
+	mongoClient, err := mongodb.InitMongoDB(ctx, appConfig.MongodbConfig)
+	if err != nil {
+		log.Fatal("Failed to initialize MongoDB", log.Err(err))
+	}
+
+	mongoRepo := repository.NewMongoRepository(mongoClient, appConfig.MongodbConfig.DB)
+	consistencyService := service.NewConsistencyService(repository.NewFormRepository(mongoRepo), appConfig)
+
+	report, err := consistencyService.ScanReferentialIntegrity(ctx, repairIntegrity)
+	if err != nil {
+		log.Fatal("Referential integrity check failed", log.Err(err))
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal("Failed to write report", log.Err(err))
+	}
+	if !report.Healthy {
+		os.Exit(1)
+	}
+}
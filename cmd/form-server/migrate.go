@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/spf13/cobra"
+
+	"github.com/arwoosa/form/internal/dao/mongodb"
+	"github.com/arwoosa/form/internal/migrations"
+)
+
+var migrationStatusOnly bool
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending database migrations",
+	Long: `Apply the pending database migrations and print the status of every migration as JSON.
+Use --status to print the status without applying anything.`,
+	Run: runMigrate,
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&migrationStatusOnly, "status", false, "only print the migration status")
+	rootCmd.AddCommand(migrateCmd)
+}
+
+func runMigrate(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// Migrations are applied here rather than on connecting
+	cfg := *GetAppConfig().MongodbConfig
+	cfg.SkipMigrations = true
+	mongoClient, err := mongodb.InitMongoDB(ctx, &cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize MongoDB", log.Err(err))
+	}
+
+	var statuses []migrations.Status
+	if migrationStatusOnly {
+		statuses, err = mongodb.MigrationStatus(ctx, mongoClient, &cfg)
+	} else {
+		statuses, err = mongodb.Migrate(ctx, mongoClient, &cfg)
+	}
+	if err != nil {
+		log.Fatal("Migration failed", log.Err(err))
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(statuses); err != nil {
+		log.Fatal("Failed to write migration status", log.Err(err))
+	}
+}
@@ -7,11 +7,13 @@ import (
 
 	"github.com/arwoosa/vulpes/ezgrpc"
 	"github.com/arwoosa/vulpes/log"
-	"github.com/arwoosa/vulpes/relation"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/spf13/cobra"
 
-	"github.com/arwoosa/form/internal/dao/mongodb"
-	"github.com/arwoosa/form/internal/service"
+	"github.com/arwoosa/form/internal/app"
+	"github.com/arwoosa/form/internal/etag"
+	"github.com/arwoosa/form/internal/idempotency"
+	"github.com/arwoosa/form/internal/metrics"
 )
 
 var serverCmd = &cobra.Command{
@@ -31,6 +33,10 @@ func runServer(cmd *cobra.Command, args []string) {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Dependencies and the server outlive the signal so in-flight work can finish during shutdown
+	appCtx, cancelApp := context.WithCancel(context.Background())
+	defer cancelApp()
+
 	appConfig := GetAppConfig()
 
 	log.Info("Starting form service server",
@@ -38,36 +44,25 @@ func runServer(cmd *cobra.Command, args []string) {
 		log.String("mode", appConfig.Mode),
 		log.Int("port", appConfig.Port))
 
-	// Initialize MongoDB singleton first - Form service requires database
-	if _, err := mongodb.InitMongoDB(ctx, appConfig.MongodbConfig); err != nil {
-		log.Error("Failed to initialize MongoDB", log.Err(err))
-		log.Fatal("Form service requires MongoDB connection - cannot start without database")
+	// Connect to the database and Keto and build the services
+	formApp, err := app.New(appCtx, appConfig)
+	if err != nil {
+		log.Fatal("Failed to initialize form service", log.Err(err))
 	}
 
-	// Initialize Keto relation client
-	if appConfig.KetoConfig != nil {
-		log.Info("Initializing Keto relation client",
-			log.String("write_addr", appConfig.KetoConfig.WriteAddr),
-			log.String("read_addr", appConfig.KetoConfig.ReadAddr))
-
-		relation.Initialize(
-			relation.WithWriteAddr(appConfig.KetoConfig.WriteAddr),
-			relation.WithReadAddr(appConfig.KetoConfig.ReadAddr),
-		)
-
-		// Ensure relation connection is closed when server shuts down
-		defer relation.Close()
-		log.Info("Keto relation client initialized successfully")
-	} else {
-		log.Warn("Keto configuration not found - authorization features may not work")
-	}
+	// Register services; RPC latency histograms are exposed on /metrics with the request counters
+	metrics.EnableRPCLatency()
+	ezgrpc.InjectGrpcService(formApp.RegisterGRPC)
+	ezgrpc.RegisterHandlerFromEndpoint(formApp.RegisterGateway)
 
-	// Register services
-	service.RegisterFormServices(appConfig)
+	// Refresh readiness and start background jobs
+	formApp.Start(appCtx)
 
 	ezgrpc.SetServeMuxOpts(
 		ezgrpc.DefaultHeaderMatcher,
 		ezgrpc.OutgoingHeaderMatcher,
+		runtime.WithMetadata(idempotency.GatewayMetadata),
+		runtime.WithForwardResponseOption(etag.GatewayResponseOption),
 	)
 
 	// Channel to listen for server errors
@@ -75,7 +70,7 @@ func runServer(cmd *cobra.Command, args []string) {
 
 	// Run the gRPC + Gateway server in a goroutine
 	go func() {
-		if err := ezgrpc.RunGrpcGateway(ctx, appConfig.Port); err != nil {
+		if err := ezgrpc.RunGrpcGateway(appCtx, appConfig.Port); err != nil {
 			errChan <- err
 		}
 	}()
@@ -88,5 +83,6 @@ func runServer(cmd *cobra.Command, args []string) {
 		log.Fatal("failed to run form server", log.Err(err))
 	}
 
+	formApp.Shutdown()
 	log.Info("Server shut down gracefully")
 }
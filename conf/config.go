@@ -10,17 +10,45 @@ import (
 
 // AppConfig holds the application configuration.
 type AppConfig struct {
-	Mode                 string `mapstructure:"mode"`
-	Port                 int    `mapstructure:"port"`
-	Name                 string `mapstructure:"name"`
-	Version              string `mapstructure:"version"`
-	TimeZone             string `mapstructure:"time_zone"`
-	*LogConfig           `mapstructure:"log"`
-	*MongodbConfig       `mapstructure:"mongodb"`
-	*KetoConfig          `mapstructure:"keto"`
-	*ExternalConfig      `mapstructure:"external"`
-	*PaginationConfig    `mapstructure:"pagination"`
-	*BusinessRulesConfig `mapstructure:"business_rules"`
+	Mode                   string `mapstructure:"mode"`
+	Port                   int    `mapstructure:"port"`
+	Name                   string `mapstructure:"name"`
+	Version                string `mapstructure:"version"`
+	TimeZone               string `mapstructure:"time_zone"`
+	*LogConfig             `mapstructure:"log"`
+	*DatabaseConfig        `mapstructure:"database"`
+	*MongodbConfig         `mapstructure:"mongodb"`
+	*KetoConfig            `mapstructure:"keto"`
+	*ExternalConfig        `mapstructure:"external"`
+	*PaginationConfig      `mapstructure:"pagination"`
+	*BusinessRulesConfig   `mapstructure:"business_rules"`
+	*UISchemaConfig        `mapstructure:"ui_schema"`
+	*SchemaLintConfig      `mapstructure:"schema_lint"`
+	*ContentRulesConfig    `mapstructure:"content_rules"`
+	*TemplatePackageConfig `mapstructure:"template_package"`
+	*SubmissionIndexConfig `mapstructure:"submission_index"`
+	*AdminConfig           `mapstructure:"admin"`
+	*JobsConfig            `mapstructure:"jobs"`
+	*InvitationConfig      `mapstructure:"invitation"`
+	*CheckInConfig         `mapstructure:"check_in"`
+	*RedisConfig           `mapstructure:"redis"`
+	*RateLimitConfig       `mapstructure:"rate_limit"`
+	*IdempotencyConfig     `mapstructure:"idempotency"`
+	*AuthorizationConfig   `mapstructure:"authorization"`
+	*UsageConfig           `mapstructure:"usage"`
+	*StorageConfig         `mapstructure:"storage"`
+	*EncryptionConfig      `mapstructure:"encryption"`
+	*SpamConfig            `mapstructure:"spam"`
+	*CacheConfig           `mapstructure:"cache"`
+	*EventBusConfig        `mapstructure:"event_bus"`
+	*HealthConfig          `mapstructure:"health"`
+	*ShutdownConfig        `mapstructure:"shutdown"`
+}
+
+// DatabaseConfig selects the storage backend.
+type DatabaseConfig struct {
+	// Driver is "mongodb" (default) or "memory", which keeps all data in the process and loses it on exit
+	Driver string `mapstructure:"driver"`
 }
 
 // MongodbConfig holds the MongoDB configuration.
@@ -30,6 +58,12 @@ type MongodbConfig struct {
 	User     string `mapstructure:"user"`
 	Password string `mapstructure:"password"`
 	DB       string `mapstructure:"db"`
+	// SkipMigrations leaves the migrations to the migrate command instead of applying them at startup
+	SkipMigrations bool `mapstructure:"skip_migrations"`
+	// QueryTimeout bounds each repository operation, 0 for no limit
+	QueryTimeout time.Duration `mapstructure:"query_timeout"`
+	// SlowQueryThreshold logs repository operations taking longer, with the shape of their filter, 0 to disable
+	SlowQueryThreshold time.Duration `mapstructure:"slow_query_threshold"`
 }
 
 // KetoConfig holds the Ory Keto authorization configuration.
@@ -61,14 +95,249 @@ type ServiceConfig struct {
 
 // PaginationConfig holds pagination configuration.
 type PaginationConfig struct {
-	DefaultPageSize       int `mapstructure:"default_page_size"`
-	MaxPageSize           int `mapstructure:"max_page_size"`
-	DefaultLocationRadius int `mapstructure:"default_location_radius"`
+	DefaultPageSize       int    `mapstructure:"default_page_size"`
+	MaxPageSize           int    `mapstructure:"max_page_size"`
+	DefaultLocationRadius int    `mapstructure:"default_location_radius"`
+	StreamBatchSize       int    `mapstructure:"stream_batch_size"` // Documents fetched per cursor round trip when streaming
+	CountMode             string `mapstructure:"count_mode"`        // How form and template lists count their totals: "exact" (default) or "facet"
 }
 
 // BusinessRulesConfig holds business rule configuration.
 type BusinessRulesConfig struct {
-	MaxTemplatesPerMerchant int `mapstructure:"max_templates_per_merchant"`
+	MaxTemplatesPerMerchant int           `mapstructure:"max_templates_per_merchant"`
+	MaxImportBatchSize      int           `mapstructure:"max_import_batch_size"`
+	MaxFormsPerEvent        int           `mapstructure:"max_forms_per_event"`       // 0 means unlimited
+	LimitsCacheTTL          time.Duration `mapstructure:"merchant_limits_cache_ttl"` // How long per-merchant overrides are cached
+	TemplateDeletePolicy    string        `mapstructure:"template_delete_policy"`    // "block" (default), "detach" or "archive" for templates used by forms
+}
+
+// UISchemaConfig holds default UI Schema generation configuration.
+type UISchemaConfig struct {
+	// FormatWidgets overrides the widget used for a JSON Schema format; an empty widget disables it
+	FormatWidgets map[string]string `mapstructure:"format_widgets"`
+}
+
+// SchemaLintConfig holds the thresholds of the best-practice warnings for form schemas.
+type SchemaLintConfig struct {
+	// MaxNestingDepth is the number of object levels before nesting is reported, 0 for the default of 3
+	MaxNestingDepth int `mapstructure:"max_nesting_depth"`
+	// MaxRequiredFields is the number of required fields before they are reported, 0 for the default of 20
+	MaxRequiredFields int `mapstructure:"max_required_fields"`
+}
+
+// ContentRulesConfig holds the platform rules for the field titles and descriptions of forms and
+// templates. Platform admins can replace them per merchant.
+type ContentRulesConfig struct {
+	Action       string   `mapstructure:"action"`        // "reject" (default) or "strip" labels breaking the rules
+	BlockURLs    bool     `mapstructure:"block_urls"`    // Links in labels break the rules
+	BlockedTerms []string `mapstructure:"blocked_terms"` // Terms labels may not contain, matched case-insensitively
+}
+
+// TemplatePackageConfig holds the signing of exported template packages. Environments
+// exchanging packages must share the secret.
+type TemplatePackageConfig struct {
+	SigningSecret string `mapstructure:"signing_secret"` // HMAC key signing template packages; export and import are unavailable without it
+}
+
+// SubmissionIndexConfig holds configuration for indexing frequently filtered answer fields.
+type SubmissionIndexConfig struct {
+	MinFilterUsage int64 `mapstructure:"min_filter_usage"` // Filter count before a field is recommended for indexing
+	AutoCreate     bool  `mapstructure:"auto_create"`      // Create recommended indexes when the report is generated
+	MaxAutoIndexes int   `mapstructure:"max_auto_indexes"` // Cap on automatically created indexes
+}
+
+// AdminConfig holds platform administrator configuration.
+type AdminConfig struct {
+	UserIDs []string `mapstructure:"user_ids"`
+	// Platform admins for support access may also be granted in Keto, as the relation on
+	// KetoNamespace:KetoObject (e.g. Platform:form#admin); an empty namespace disables the check
+	KetoNamespace string `mapstructure:"keto_namespace"`
+	KetoObject    string `mapstructure:"keto_object"`
+	KetoRelation  string `mapstructure:"keto_relation"`
+}
+
+// JobsConfig holds background job configuration.
+type JobsConfig struct {
+	FormCloseInterval time.Duration      `mapstructure:"form_close_interval"` // How often forms past their close_at are closed
+	Queue             JobQueueConfig     `mapstructure:"queue"`
+	Purge             PurgeJobConfig     `mapstructure:"purge"`
+	Retention         RetentionJobConfig `mapstructure:"retention"`
+}
+
+// JobQueueConfig holds the MongoDB backed queue of asynchronous jobs. Zero values use the defaults.
+type JobQueueConfig struct {
+	Workers           int           `mapstructure:"workers"`            // Jobs processed concurrently by each instance
+	PollInterval      time.Duration `mapstructure:"poll_interval"`      // Wait between polls when no job is due
+	VisibilityTimeout time.Duration `mapstructure:"visibility_timeout"` // Lease duration of a running job
+	MaxAttempts       int           `mapstructure:"max_attempts"`
+	RetryBackoff      time.Duration `mapstructure:"retry_backoff"`     // Delay before the first retry, doubled for each further attempt
+	MaxRetryBackoff   time.Duration `mapstructure:"max_retry_backoff"` // Cap on the retry delay
+	Retention         time.Duration `mapstructure:"retention"`         // How long finished jobs are kept
+}
+
+// PurgeJobConfig holds the merchant data purge job. Zero values use the defaults.
+type PurgeJobConfig struct {
+	BatchSize int           `mapstructure:"batch_size"` // Documents deleted per batch
+	RunTime   time.Duration `mapstructure:"run_time"`   // Work done per job run before the rest is queued; keep below the visibility timeout
+}
+
+// RetentionJobConfig holds the cleanup of responses past their form's retention period. Zero values use the defaults.
+type RetentionJobConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`   // How often expired responses are cleaned up
+	BatchSize int           `mapstructure:"batch_size"` // Responses deleted per batch
+}
+
+// InvitationConfig holds configuration for invite-only form invitations.
+type InvitationConfig struct {
+	Secret      string        `mapstructure:"secret"`        // HMAC key used to sign invitation tokens; invitations are unavailable without it
+	TTL         time.Duration `mapstructure:"ttl"`           // Default invitation lifetime
+	LinkBaseURL string        `mapstructure:"link_base_url"` // Base URL of invitation links; links are omitted when empty
+}
+
+// CheckInConfig holds configuration for the QR code tokens of session check-ins.
+type CheckInConfig struct {
+	Secret string        `mapstructure:"secret"` // HMAC key used to sign check-in tokens; QR check-in is unavailable without it
+	TTL    time.Duration `mapstructure:"ttl"`    // Default check-in token lifetime
+}
+
+// RedisConfig holds the Redis connection configuration.
+type RedisConfig struct {
+	Addr     string `mapstructure:"addr"`
+	Password string `mapstructure:"password"`
+	DB       int    `mapstructure:"db"`
+}
+
+// RateLimitConfig holds rate limiting configuration for public endpoints.
+type RateLimitConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	Store       string        `mapstructure:"store"` // "memory" (per instance) or "redis" (shared, uses the redis section)
+	PerIP       RateLimitRule `mapstructure:"per_ip"`
+	PerMerchant RateLimitRule `mapstructure:"per_merchant"`
+	Methods     []string      `mapstructure:"methods"` // Full gRPC method names to limit
+	// TrustedProxies is the number of proxies in front of the gateway appending to X-Forwarded-For.
+	// It also applies to the client addresses used by spam protection.
+	TrustedProxies int `mapstructure:"trusted_proxies"`
+}
+
+// RateLimitRule holds a token bucket: rate tokens per second up to burst tokens. A zero rate disables it.
+type RateLimitRule struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
+// IdempotencyConfig holds configuration for Idempotency-Key support.
+type IdempotencyConfig struct {
+	TTL     time.Duration `mapstructure:"ttl"`     // How long a key and its stored response are kept
+	Methods []string      `mapstructure:"methods"` // Full gRPC method names honoring idempotency keys
+}
+
+// AuthorizationConfig holds the server-side Keto permission checks.
+type AuthorizationConfig struct {
+	Enabled bool                `mapstructure:"enabled"`
+	Rules   []AuthorizationRule `mapstructure:"rules"`
+}
+
+// AuthorizationRule requires the caller to hold a relation on the object whose ID is in a request field.
+type AuthorizationRule struct {
+	Method    string `mapstructure:"method"`    // Full gRPC method name
+	Namespace string `mapstructure:"namespace"` // Keto namespace, e.g. "Form" or "FormTemplate"
+	Relation  string `mapstructure:"relation"`  // "owner", "editor" or "viewer"
+	IDField   string `mapstructure:"id_field"`  // Request field holding the object ID
+}
+
+// UsageConfig holds configuration for merchant usage reporting.
+type UsageConfig struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"` // How long a merchant's usage counts are cached
+}
+
+// StorageConfig holds the object storage used for file uploads.
+type StorageConfig struct {
+	Provider            string        `mapstructure:"provider"` // "s3" for S3 compatible stores, including Google Cloud Storage; empty disables uploads
+	Endpoint            string        `mapstructure:"endpoint"`
+	Region              string        `mapstructure:"region"`
+	Bucket              string        `mapstructure:"bucket"`
+	AccessKeyID         string        `mapstructure:"access_key_id"`
+	SecretAccessKey     string        `mapstructure:"secret_access_key"`
+	PathStyle           bool          `mapstructure:"path_style"`
+	UploadURLTTL        time.Duration `mapstructure:"upload_url_ttl"`        // Lifetime of pre-signed upload URLs
+	PendingFileTTL      time.Duration `mapstructure:"pending_file_ttl"`      // How long uploads are kept before being attached to a submission
+	MaxFileSize         int64         `mapstructure:"max_file_size"`         // Platform maximum in bytes; 0 leaves it to the file fields
+	AllowedContentTypes []string      `mapstructure:"allowed_content_types"` // Default MIME types for file fields without "accept"
+}
+
+// EncryptionConfig holds the envelope encryption of answers to PII fields.
+type EncryptionConfig struct {
+	Provider  string `mapstructure:"provider"`   // "local" for a master key held in the configuration; empty refuses answers to PII fields
+	KeyID     string `mapstructure:"key_id"`     // Identifies the master key; stored with every data key it wraps
+	MasterKey string `mapstructure:"master_key"` // Base64 encoded 32 byte master key of the local provider
+}
+
+// SpamConfig holds the deployment side of form spam protection; forms choose their defenses.
+type SpamConfig struct {
+	TokenSecret string        `mapstructure:"token_secret"`  // HMAC key signing submission tokens; forms cannot set a minimum fill time without it
+	TokenMaxAge time.Duration `mapstructure:"token_max_age"` // How long after loading a form its submission token is accepted; defaults to 24h
+	Store       string        `mapstructure:"store"`         // "memory" (per instance) or "redis" (shared, uses the redis section) for per-address counts and used tokens
+	Captcha     CaptchaConfig `mapstructure:"captcha"`
+}
+
+// CaptchaConfig holds the secrets of the CAPTCHA providers forms may use; a provider without a secret is unavailable.
+type CaptchaConfig struct {
+	HCaptchaSecret  string        `mapstructure:"hcaptcha_secret"`
+	TurnstileSecret string        `mapstructure:"turnstile_secret"`
+	Timeout         time.Duration `mapstructure:"timeout"` // Bounds each verification call; the response is rejected when the provider is unavailable
+}
+
+// CacheConfig holds the shared Redis cache of hot public reads.
+type CacheConfig struct {
+	PublicFormTTL time.Duration `mapstructure:"public_form_ttl"` // How long published forms are cached for public reads; 0 disables the cache
+}
+
+// EventBusConfig holds the message bus domain events are published to.
+type EventBusConfig struct {
+	Provider string         `mapstructure:"provider"` // "nats" or "kafka"; empty disables publishing
+	Timeout  time.Duration  `mapstructure:"timeout"`  // Maximum time to hand an event to the bus
+	NATS     NATSConfig     `mapstructure:"nats"`
+	Kafka    KafkaBusConfig `mapstructure:"kafka"`
+	Outbox   OutboxConfig   `mapstructure:"outbox"`
+}
+
+// NATSConfig holds the NATS server domain events are published to.
+type NATSConfig struct {
+	URL           string `mapstructure:"url"`
+	Token         string `mapstructure:"token"`
+	User          string `mapstructure:"user"`
+	Password      string `mapstructure:"password"`
+	SubjectPrefix string `mapstructure:"subject_prefix"` // Events are published to "<prefix>.<event type>"
+}
+
+// KafkaBusConfig holds the Kafka REST Proxy domain events are produced through.
+type KafkaBusConfig struct {
+	Endpoint string `mapstructure:"endpoint"`
+	Topic    string `mapstructure:"topic"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+// OutboxConfig holds the relay publishing the domain events stored in the outbox. Zero values use the defaults.
+type OutboxConfig struct {
+	PollInterval    time.Duration `mapstructure:"poll_interval"`     // Wait between polls when no event is due
+	LeaseTimeout    time.Duration `mapstructure:"lease_timeout"`     // An event still being published after this is handed to another replica
+	RetryBackoff    time.Duration `mapstructure:"retry_backoff"`     // Delay before the first retry, doubled for each further attempt
+	MaxRetryBackoff time.Duration `mapstructure:"max_retry_backoff"` // Cap on the retry delay
+	Retention       time.Duration `mapstructure:"retention"`         // How long published events are kept
+}
+
+// HealthConfig holds the dependency checks behind the readiness probes.
+type HealthConfig struct {
+	CheckTimeout        time.Duration `mapstructure:"check_timeout"`         // Maximum time for a round of checks
+	CheckInterval       time.Duration `mapstructure:"check_interval"`        // How often the grpc.health.v1 status is refreshed
+	RequireOrderService bool          `mapstructure:"require_order_service"` // Unready while the order service is unreachable; otherwise it is only reported
+}
+
+// ShutdownConfig holds the graceful shutdown settings.
+type ShutdownConfig struct {
+	DrainDelay time.Duration `mapstructure:"drain_delay"` // Time between failing readiness and rejecting new calls, for load balancers to notice
+	Timeout    time.Duration `mapstructure:"timeout"`     // Deadline for in-flight calls, background jobs and the outbox after the drain delay
 }
 
 // NewConfig loads the application configuration from a file.
@@ -65,6 +65,8 @@ func (m *Pagination) validate(all bool) error {
 
 	// no validation rules for TotalPages
 
+	// no validation rules for HasMore
+
 	if len(errors) > 0 {
 		return PaginationMultiError(errors)
 	}
@@ -183,6 +183,37 @@ func (m *FormTemplate) validate(all bool) error {
 
 	// no validation rules for UpdatedBy
 
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetArchivedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormTemplateValidationError{
+					field:  "ArchivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormTemplateValidationError{
+					field:  "ArchivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArchivedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormTemplateValidationError{
+				field:  "ArchivedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return FormTemplateMultiError(errors)
 	}
@@ -333,17 +364,6 @@ func (m *CreateFormTemplateRequest) validate(all bool) error {
 		}
 	}
 
-	if m.GetUischema() == nil {
-		err := CreateFormTemplateRequestValidationError{
-			field:  "Uischema",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
 		switch v := interface{}(m.GetUischema()).(type) {
 		case interface{ ValidateAll() error }:
@@ -632,6 +652,19 @@ func (m *ListFormTemplatesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for SkipCount
+
+	if _, ok := _ListFormTemplatesRequest_View_InLookup[m.GetView()]; !ok {
+		err := ListFormTemplatesRequestValidationError{
+			field:  "View",
+			reason: "value must be in list [ full summary]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListFormTemplatesRequestMultiError(errors)
 	}
@@ -725,6 +758,12 @@ var _ListFormTemplatesRequest_SortOrder_InLookup = map[string]struct{}{
 	"desc": {},
 }
 
+var _ListFormTemplatesRequest_View_InLookup = map[string]struct{}{
+	"":        {},
+	"full":    {},
+	"summary": {},
+}
+
 // Validate checks the field values on ListFormTemplatesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1340,44 +1379,44 @@ var _ interface {
 	ErrorName() string
 } = DuplicateFormTemplateResponseValidationError{}
 
-// Validate checks the field values on ConfigResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ConfigResponse) Validate() error {
+// Validate checks the field values on ExportTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTemplateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConfigResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ConfigResponseMultiError,
-// or nil if none found.
-func (m *ConfigResponse) ValidateAll() error {
+// ValidateAll checks the field values on ExportTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTemplateResponseMultiError, or nil if none found.
+func (m *ExportTemplateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConfigResponse) validate(all bool) error {
+func (m *ExportTemplateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for MaxTemplatesPerMerchant
+	// no validation rules for Package
 
 	if len(errors) > 0 {
-		return ConfigResponseMultiError(errors)
+		return ExportTemplateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConfigResponseMultiError is an error wrapping multiple validation errors
-// returned by ConfigResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ConfigResponseMultiError []error
+// ExportTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportTemplateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConfigResponseMultiError) Error() string {
+func (m ExportTemplateResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1386,11 +1425,11 @@ func (m ConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConfigResponseMultiError) AllErrors() []error { return m }
+func (m ExportTemplateResponseMultiError) AllErrors() []error { return m }
 
-// ConfigResponseValidationError is the validation error returned by
-// ConfigResponse.Validate if the designated constraints aren't met.
-type ConfigResponseValidationError struct {
+// ExportTemplateResponseValidationError is the validation error returned by
+// ExportTemplateResponse.Validate if the designated constraints aren't met.
+type ExportTemplateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1398,22 +1437,24 @@ type ConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConfigResponseValidationError) Field() string { return e.field }
+func (e ExportTemplateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConfigResponseValidationError) Reason() string { return e.reason }
+func (e ExportTemplateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConfigResponseValidationError) Cause() error { return e.cause }
+func (e ExportTemplateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConfigResponseValidationError) Key() bool { return e.key }
+func (e ExportTemplateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConfigResponseValidationError) ErrorName() string { return "ConfigResponseValidationError" }
+func (e ExportTemplateResponseValidationError) ErrorName() string {
+	return "ExportTemplateResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConfigResponseValidationError) Error() string {
+func (e ExportTemplateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1425,14 +1466,14 @@ func (e ConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConfigResponse.%s: %s%s",
+		"invalid %sExportTemplateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConfigResponseValidationError{}
+var _ error = ExportTemplateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1440,4 +1481,13102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConfigResponseValidationError{}
+} = ExportTemplateResponseValidationError{}
+
+// Validate checks the field values on ImportTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportTemplateRequestMultiError, or nil if none found.
+func (m *ImportTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPackage()) < 1 {
+		err := ImportTemplateRequestValidationError{
+			field:  "Package",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return ImportTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportTemplateRequestMultiError) AllErrors() []error { return m }
+
+// ImportTemplateRequestValidationError is the validation error returned by
+// ImportTemplateRequest.Validate if the designated constraints aren't met.
+type ImportTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportTemplateRequestValidationError) ErrorName() string {
+	return "ImportTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportTemplateRequestValidationError{}
+
+// Validate checks the field values on ImportTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportTemplateResponseMultiError, or nil if none found.
+func (m *ImportTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportTemplateResponseMultiError) AllErrors() []error { return m }
+
+// ImportTemplateResponseValidationError is the validation error returned by
+// ImportTemplateResponse.Validate if the designated constraints aren't met.
+type ImportTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportTemplateResponseValidationError) ErrorName() string {
+	return "ImportTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportTemplateResponseValidationError{}
+
+// Validate checks the field values on ImportFormRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportFormRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportFormRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportFormRequestMultiError, or nil if none found.
+func (m *ImportFormRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportFormRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormat()) < 1 {
+		err := ImportFormRequestValidationError{
+			field:  "Format",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExport() == nil {
+		err := ImportFormRequestValidationError{
+			field:  "Export",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportFormRequestValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportFormRequestValidationError{
+					field:  "Export",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportFormRequestValidationError{
+				field:  "Export",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportFormRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportFormRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportFormRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportFormRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportFormRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportFormRequestMultiError) AllErrors() []error { return m }
+
+// ImportFormRequestValidationError is the validation error returned by
+// ImportFormRequest.Validate if the designated constraints aren't met.
+type ImportFormRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportFormRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportFormRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportFormRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportFormRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportFormRequestValidationError) ErrorName() string {
+	return "ImportFormRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportFormRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportFormRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportFormRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportFormRequestValidationError{}
+
+// Validate checks the field values on UnsupportedQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsupportedQuestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsupportedQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsupportedQuestionMultiError, or nil if none found.
+func (m *UnsupportedQuestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsupportedQuestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return UnsupportedQuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsupportedQuestionMultiError is an error wrapping multiple validation
+// errors returned by UnsupportedQuestion.ValidateAll() if the designated
+// constraints aren't met.
+type UnsupportedQuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsupportedQuestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsupportedQuestionMultiError) AllErrors() []error { return m }
+
+// UnsupportedQuestionValidationError is the validation error returned by
+// UnsupportedQuestion.Validate if the designated constraints aren't met.
+type UnsupportedQuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsupportedQuestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsupportedQuestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsupportedQuestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsupportedQuestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsupportedQuestionValidationError) ErrorName() string {
+	return "UnsupportedQuestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsupportedQuestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsupportedQuestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsupportedQuestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsupportedQuestionValidationError{}
+
+// Validate checks the field values on ImportFormResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportFormResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportFormResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportFormResponseMultiError, or nil if none found.
+func (m *ImportFormResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportFormResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportFormResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportFormResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportFormResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportFormResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportFormResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportFormResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUischema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportFormResponseValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportFormResponseValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUischema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportFormResponseValidationError{
+				field:  "Uischema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetUnsupported() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportFormResponseValidationError{
+						field:  fmt.Sprintf("Unsupported[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportFormResponseValidationError{
+						field:  fmt.Sprintf("Unsupported[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportFormResponseValidationError{
+					field:  fmt.Sprintf("Unsupported[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportFormResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportFormResponseMultiError is an error wrapping multiple validation errors
+// returned by ImportFormResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImportFormResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportFormResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportFormResponseMultiError) AllErrors() []error { return m }
+
+// ImportFormResponseValidationError is the validation error returned by
+// ImportFormResponse.Validate if the designated constraints aren't met.
+type ImportFormResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportFormResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportFormResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportFormResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportFormResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportFormResponseValidationError) ErrorName() string {
+	return "ImportFormResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportFormResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportFormResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportFormResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportFormResponseValidationError{}
+
+// Validate checks the field values on FieldBlock with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldBlock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldBlock with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldBlockMultiError, or
+// nil if none found.
+func (m *FieldBlock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldBlock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MerchantId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldBlockValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldBlockValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldBlockValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldBlockValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldBlockValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldBlockValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldBlockValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldBlockValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldBlockValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	if len(errors) > 0 {
+		return FieldBlockMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldBlockMultiError is an error wrapping multiple validation errors
+// returned by FieldBlock.ValidateAll() if the designated constraints aren't met.
+type FieldBlockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldBlockMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldBlockMultiError) AllErrors() []error { return m }
+
+// FieldBlockValidationError is the validation error returned by
+// FieldBlock.Validate if the designated constraints aren't met.
+type FieldBlockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldBlockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldBlockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldBlockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldBlockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldBlockValidationError) ErrorName() string { return "FieldBlockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldBlockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldBlock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldBlockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldBlockValidationError{}
+
+// Validate checks the field values on CreateFieldBlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFieldBlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFieldBlockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFieldBlockRequestMultiError, or nil if none found.
+func (m *CreateFieldBlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFieldBlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateFieldBlockRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := CreateFieldBlockRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSchema() == nil {
+		err := CreateFieldBlockRequestValidationError{
+			field:  "Schema",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateFieldBlockRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateFieldBlockRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateFieldBlockRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateFieldBlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFieldBlockRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateFieldBlockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateFieldBlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFieldBlockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFieldBlockRequestMultiError) AllErrors() []error { return m }
+
+// CreateFieldBlockRequestValidationError is the validation error returned by
+// CreateFieldBlockRequest.Validate if the designated constraints aren't met.
+type CreateFieldBlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFieldBlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFieldBlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFieldBlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFieldBlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFieldBlockRequestValidationError) ErrorName() string {
+	return "CreateFieldBlockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFieldBlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFieldBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFieldBlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFieldBlockRequestValidationError{}
+
+// Validate checks the field values on ListFieldBlocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFieldBlocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFieldBlocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFieldBlocksRequestMultiError, or nil if none found.
+func (m *ListFieldBlocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFieldBlocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if _, ok := _ListFieldBlocksRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := ListFieldBlocksRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ name created_at updated_at]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListFieldBlocksRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := ListFieldBlocksRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListFieldBlocksRequest_View_InLookup[m.GetView()]; !ok {
+		err := ListFieldBlocksRequestValidationError{
+			field:  "View",
+			reason: "value must be in list [ full summary]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFieldBlocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFieldBlocksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFieldBlocksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFieldBlocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFieldBlocksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFieldBlocksRequestMultiError) AllErrors() []error { return m }
+
+// ListFieldBlocksRequestValidationError is the validation error returned by
+// ListFieldBlocksRequest.Validate if the designated constraints aren't met.
+type ListFieldBlocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFieldBlocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFieldBlocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFieldBlocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFieldBlocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFieldBlocksRequestValidationError) ErrorName() string {
+	return "ListFieldBlocksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFieldBlocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFieldBlocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFieldBlocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFieldBlocksRequestValidationError{}
+
+var _ListFieldBlocksRequest_SortBy_InLookup = map[string]struct{}{
+	"":           {},
+	"name":       {},
+	"created_at": {},
+	"updated_at": {},
+}
+
+var _ListFieldBlocksRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+var _ListFieldBlocksRequest_View_InLookup = map[string]struct{}{
+	"":        {},
+	"full":    {},
+	"summary": {},
+}
+
+// Validate checks the field values on ListFieldBlocksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFieldBlocksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFieldBlocksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFieldBlocksResponseMultiError, or nil if none found.
+func (m *ListFieldBlocksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFieldBlocksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBlocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFieldBlocksResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFieldBlocksResponseValidationError{
+						field:  fmt.Sprintf("Blocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFieldBlocksResponseValidationError{
+					field:  fmt.Sprintf("Blocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListFieldBlocksResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListFieldBlocksResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListFieldBlocksResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListFieldBlocksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFieldBlocksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFieldBlocksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFieldBlocksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFieldBlocksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFieldBlocksResponseMultiError) AllErrors() []error { return m }
+
+// ListFieldBlocksResponseValidationError is the validation error returned by
+// ListFieldBlocksResponse.Validate if the designated constraints aren't met.
+type ListFieldBlocksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFieldBlocksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFieldBlocksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFieldBlocksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFieldBlocksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFieldBlocksResponseValidationError) ErrorName() string {
+	return "ListFieldBlocksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFieldBlocksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFieldBlocksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFieldBlocksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFieldBlocksResponseValidationError{}
+
+// Validate checks the field values on UpdateFieldBlockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFieldBlockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFieldBlockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFieldBlockRequestMultiError, or nil if none found.
+func (m *UpdateFieldBlockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFieldBlockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UpdateFieldBlockRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdateFieldBlockRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := UpdateFieldBlockRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSchema() == nil {
+		err := UpdateFieldBlockRequestValidationError{
+			field:  "Schema",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFieldBlockRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFieldBlockRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFieldBlockRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateFieldBlockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFieldBlockRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateFieldBlockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFieldBlockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFieldBlockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFieldBlockRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFieldBlockRequestValidationError is the validation error returned by
+// UpdateFieldBlockRequest.Validate if the designated constraints aren't met.
+type UpdateFieldBlockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFieldBlockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFieldBlockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFieldBlockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFieldBlockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFieldBlockRequestValidationError) ErrorName() string {
+	return "UpdateFieldBlockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFieldBlockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFieldBlockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFieldBlockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFieldBlockRequestValidationError{}
+
+// Validate checks the field values on ConfigResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigResponseMultiError,
+// or nil if none found.
+func (m *ConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxTemplatesPerMerchant
+
+	if len(errors) > 0 {
+		return ConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigResponseMultiError is an error wrapping multiple validation errors
+// returned by ConfigResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigResponseMultiError) AllErrors() []error { return m }
+
+// ConfigResponseValidationError is the validation error returned by
+// ConfigResponse.Validate if the designated constraints aren't met.
+type ConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigResponseValidationError) ErrorName() string { return "ConfigResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigResponseValidationError{}
+
+// Validate checks the field values on SubmissionRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubmissionRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmissionRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmissionRecordMultiError, or nil if none found.
+func (m *SubmissionRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmissionRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAnswers() == nil {
+		err := SubmissionRecordValidationError{
+			field:  "Answers",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswers()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmissionRecordValidationError{
+					field:  "Answers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmissionRecordValidationError{
+					field:  "Answers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswers()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmissionRecordValidationError{
+				field:  "Answers",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSubmittedAt() == nil {
+		err := SubmissionRecordValidationError{
+			field:  "SubmittedAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SubmittedBy
+
+	// no validation rules for ExternalId
+
+	if len(errors) > 0 {
+		return SubmissionRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmissionRecordMultiError is an error wrapping multiple validation errors
+// returned by SubmissionRecord.ValidateAll() if the designated constraints
+// aren't met.
+type SubmissionRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmissionRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmissionRecordMultiError) AllErrors() []error { return m }
+
+// SubmissionRecordValidationError is the validation error returned by
+// SubmissionRecord.Validate if the designated constraints aren't met.
+type SubmissionRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmissionRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmissionRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmissionRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmissionRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmissionRecordValidationError) ErrorName() string { return "SubmissionRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubmissionRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmissionRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmissionRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmissionRecordValidationError{}
+
+// Validate checks the field values on ImportSubmissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportSubmissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportSubmissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportSubmissionsRequestMultiError, or nil if none found.
+func (m *ImportSubmissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportSubmissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := ImportSubmissionsRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSchemaVersion() < 0 {
+		err := ImportSubmissionsRequestValidationError{
+			field:  "SchemaVersion",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubmissions()) < 1 {
+		err := ImportSubmissionsRequestValidationError{
+			field:  "Submissions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSubmissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportSubmissionsRequestValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportSubmissionsRequestValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportSubmissionsRequestValidationError{
+					field:  fmt.Sprintf("Submissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportSubmissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportSubmissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportSubmissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportSubmissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportSubmissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportSubmissionsRequestMultiError) AllErrors() []error { return m }
+
+// ImportSubmissionsRequestValidationError is the validation error returned by
+// ImportSubmissionsRequest.Validate if the designated constraints aren't met.
+type ImportSubmissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportSubmissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportSubmissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportSubmissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportSubmissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportSubmissionsRequestValidationError) ErrorName() string {
+	return "ImportSubmissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportSubmissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportSubmissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportSubmissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportSubmissionsRequestValidationError{}
+
+// Validate checks the field values on RejectedSubmission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectedSubmission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectedSubmission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectedSubmissionMultiError, or nil if none found.
+func (m *RejectedSubmission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectedSubmission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	if len(errors) > 0 {
+		return RejectedSubmissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectedSubmissionMultiError is an error wrapping multiple validation errors
+// returned by RejectedSubmission.ValidateAll() if the designated constraints
+// aren't met.
+type RejectedSubmissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectedSubmissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectedSubmissionMultiError) AllErrors() []error { return m }
+
+// RejectedSubmissionValidationError is the validation error returned by
+// RejectedSubmission.Validate if the designated constraints aren't met.
+type RejectedSubmissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectedSubmissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectedSubmissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectedSubmissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectedSubmissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectedSubmissionValidationError) ErrorName() string {
+	return "RejectedSubmissionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectedSubmissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectedSubmission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectedSubmissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectedSubmissionValidationError{}
+
+// Validate checks the field values on ImportSubmissionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportSubmissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportSubmissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportSubmissionsResponseMultiError, or nil if none found.
+func (m *ImportSubmissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportSubmissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for ImportedCount
+
+	for idx, item := range m.GetRejected() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportSubmissionsResponseValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportSubmissionsResponseValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportSubmissionsResponseValidationError{
+					field:  fmt.Sprintf("Rejected[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportSubmissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportSubmissionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportSubmissionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ImportSubmissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportSubmissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportSubmissionsResponseMultiError) AllErrors() []error { return m }
+
+// ImportSubmissionsResponseValidationError is the validation error returned by
+// ImportSubmissionsResponse.Validate if the designated constraints aren't met.
+type ImportSubmissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportSubmissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportSubmissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportSubmissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportSubmissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportSubmissionsResponseValidationError) ErrorName() string {
+	return "ImportSubmissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportSubmissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportSubmissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportSubmissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportSubmissionsResponseValidationError{}
+
+// Validate checks the field values on FormSubmission with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FormSubmission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormSubmission with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FormSubmissionMultiError,
+// or nil if none found.
+func (m *FormSubmission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormSubmission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FormId
+
+	// no validation rules for SchemaVersion
+
+	if all {
+		switch v := interface{}(m.GetAnswers()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormSubmissionValidationError{
+					field:  "Answers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormSubmissionValidationError{
+					field:  "Answers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswers()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormSubmissionValidationError{
+				field:  "Answers",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	// no validation rules for ExternalId
+
+	// no validation rules for SubmittedBy
+
+	if all {
+		switch v := interface{}(m.GetSubmittedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormSubmissionValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormSubmissionValidationError{
+					field:  "SubmittedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubmittedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormSubmissionValidationError{
+				field:  "SubmittedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormSubmissionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormSubmissionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormSubmissionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FormSubmissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormSubmissionMultiError is an error wrapping multiple validation errors
+// returned by FormSubmission.ValidateAll() if the designated constraints
+// aren't met.
+type FormSubmissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormSubmissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormSubmissionMultiError) AllErrors() []error { return m }
+
+// FormSubmissionValidationError is the validation error returned by
+// FormSubmission.Validate if the designated constraints aren't met.
+type FormSubmissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormSubmissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormSubmissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormSubmissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormSubmissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormSubmissionValidationError) ErrorName() string { return "FormSubmissionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FormSubmissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormSubmission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormSubmissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormSubmissionValidationError{}
+
+// Validate checks the field values on SubmitFormResponseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitFormResponseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitFormResponseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitFormResponseRequestMultiError, or nil if none found.
+func (m *SubmitFormResponseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitFormResponseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := SubmitFormResponseRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAnswers() == nil {
+		err := SubmitFormResponseRequestValidationError{
+			field:  "Answers",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnswers()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitFormResponseRequestValidationError{
+					field:  "Answers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitFormResponseRequestValidationError{
+					field:  "Answers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnswers()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitFormResponseRequestValidationError{
+				field:  "Answers",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InvitationToken
+
+	// no validation rules for SubmissionToken
+
+	// no validation rules for CaptchaToken
+
+	if len(errors) > 0 {
+		return SubmitFormResponseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitFormResponseRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitFormResponseRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SubmitFormResponseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitFormResponseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitFormResponseRequestMultiError) AllErrors() []error { return m }
+
+// SubmitFormResponseRequestValidationError is the validation error returned by
+// SubmitFormResponseRequest.Validate if the designated constraints aren't met.
+type SubmitFormResponseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitFormResponseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitFormResponseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitFormResponseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitFormResponseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitFormResponseRequestValidationError) ErrorName() string {
+	return "SubmitFormResponseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitFormResponseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitFormResponseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitFormResponseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitFormResponseRequestValidationError{}
+
+// Validate checks the field values on RequestUploadURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestUploadURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestUploadURLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestUploadURLRequestMultiError, or nil if none found.
+func (m *RequestUploadURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestUploadURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := RequestUploadURLRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetField()) < 1 {
+		err := RequestUploadURLRequestValidationError{
+			field:  "Field",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetFileName()); l < 1 || l > 255 {
+		err := RequestUploadURLRequestValidationError{
+			field:  "FileName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContentType()) < 1 {
+		err := RequestUploadURLRequestValidationError{
+			field:  "ContentType",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := RequestUploadURLRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestUploadURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestUploadURLRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestUploadURLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestUploadURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestUploadURLRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestUploadURLRequestMultiError) AllErrors() []error { return m }
+
+// RequestUploadURLRequestValidationError is the validation error returned by
+// RequestUploadURLRequest.Validate if the designated constraints aren't met.
+type RequestUploadURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestUploadURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestUploadURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestUploadURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestUploadURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestUploadURLRequestValidationError) ErrorName() string {
+	return "RequestUploadURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestUploadURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestUploadURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestUploadURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestUploadURLRequestValidationError{}
+
+// Validate checks the field values on UploadURL with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadURL) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadURL with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadURLMultiError, or nil
+// if none found.
+func (m *UploadURL) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadURL) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for UploadUrl
+
+	// no validation rules for Method
+
+	// no validation rules for Headers
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadURLValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadURLValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadURLValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadURLMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadURLMultiError is an error wrapping multiple validation errors returned
+// by UploadURL.ValidateAll() if the designated constraints aren't met.
+type UploadURLMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadURLMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadURLMultiError) AllErrors() []error { return m }
+
+// UploadURLValidationError is the validation error returned by
+// UploadURL.Validate if the designated constraints aren't met.
+type UploadURLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadURLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadURLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadURLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadURLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadURLValidationError) ErrorName() string { return "UploadURLValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadURLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadURL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadURLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadURLValidationError{}
+
+// Validate checks the field values on ListSubmissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubmissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubmissionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubmissionsRequestMultiError, or nil if none found.
+func (m *ListSubmissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubmissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := ListSubmissionsRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if _, ok := _ListSubmissionsRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := ListSubmissionsRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSubmissionsRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSubmissionsRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSubmissionsRequestValidationError{
+				field:  "Filters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListSubmissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubmissionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSubmissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubmissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubmissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubmissionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSubmissionsRequestValidationError is the validation error returned by
+// ListSubmissionsRequest.Validate if the designated constraints aren't met.
+type ListSubmissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubmissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubmissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubmissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubmissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubmissionsRequestValidationError) ErrorName() string {
+	return "ListSubmissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubmissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubmissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubmissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubmissionsRequestValidationError{}
+
+var _ListSubmissionsRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on ListSubmissionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubmissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubmissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubmissionsResponseMultiError, or nil if none found.
+func (m *ListSubmissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubmissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubmissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSubmissionsResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSubmissionsResponseValidationError{
+						field:  fmt.Sprintf("Submissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSubmissionsResponseValidationError{
+					field:  fmt.Sprintf("Submissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSubmissionsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSubmissionsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSubmissionsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListSubmissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubmissionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSubmissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubmissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubmissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubmissionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSubmissionsResponseValidationError is the validation error returned by
+// ListSubmissionsResponse.Validate if the designated constraints aren't met.
+type ListSubmissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubmissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubmissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubmissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubmissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubmissionsResponseValidationError) ErrorName() string {
+	return "ListSubmissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubmissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubmissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubmissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubmissionsResponseValidationError{}
+
+// Validate checks the field values on StreamFormResponsesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamFormResponsesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamFormResponsesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamFormResponsesRequestMultiError, or nil if none found.
+func (m *StreamFormResponsesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamFormResponsesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := StreamFormResponsesRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _StreamFormResponsesRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := StreamFormResponsesRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamFormResponsesRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamFormResponsesRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamFormResponsesRequestValidationError{
+				field:  "Filters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamFormResponsesRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamFormResponsesRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamFormResponsesRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamFormResponsesRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamFormResponsesRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamFormResponsesRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamFormResponsesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamFormResponsesRequestMultiError is an error wrapping multiple
+// validation errors returned by StreamFormResponsesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type StreamFormResponsesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamFormResponsesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamFormResponsesRequestMultiError) AllErrors() []error { return m }
+
+// StreamFormResponsesRequestValidationError is the validation error returned
+// by StreamFormResponsesRequest.Validate if the designated constraints aren't met.
+type StreamFormResponsesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamFormResponsesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamFormResponsesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamFormResponsesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamFormResponsesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamFormResponsesRequestValidationError) ErrorName() string {
+	return "StreamFormResponsesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamFormResponsesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamFormResponsesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamFormResponsesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamFormResponsesRequestValidationError{}
+
+var _StreamFormResponsesRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on SubmissionIndexRecommendation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmissionIndexRecommendation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmissionIndexRecommendation with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubmissionIndexRecommendationMultiError, or nil if none found.
+func (m *SubmissionIndexRecommendation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmissionIndexRecommendation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for UsageCount
+
+	// no validation rules for MerchantCount
+
+	// no validation rules for Indexed
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return SubmissionIndexRecommendationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmissionIndexRecommendationMultiError is an error wrapping multiple
+// validation errors returned by SubmissionIndexRecommendation.ValidateAll()
+// if the designated constraints aren't met.
+type SubmissionIndexRecommendationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmissionIndexRecommendationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmissionIndexRecommendationMultiError) AllErrors() []error { return m }
+
+// SubmissionIndexRecommendationValidationError is the validation error
+// returned by SubmissionIndexRecommendation.Validate if the designated
+// constraints aren't met.
+type SubmissionIndexRecommendationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmissionIndexRecommendationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmissionIndexRecommendationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmissionIndexRecommendationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmissionIndexRecommendationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmissionIndexRecommendationValidationError) ErrorName() string {
+	return "SubmissionIndexRecommendationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmissionIndexRecommendationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmissionIndexRecommendation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmissionIndexRecommendationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmissionIndexRecommendationValidationError{}
+
+// Validate checks the field values on SubmissionIndexReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmissionIndexReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmissionIndexReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmissionIndexReportMultiError, or nil if none found.
+func (m *SubmissionIndexReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmissionIndexReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinFilterUsage
+
+	// no validation rules for AutoCreate
+
+	// no validation rules for MaxAutoIndexes
+
+	// no validation rules for AutoIndexCount
+
+	for idx, item := range m.GetRecommendations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmissionIndexReportValidationError{
+						field:  fmt.Sprintf("Recommendations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmissionIndexReportValidationError{
+						field:  fmt.Sprintf("Recommendations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmissionIndexReportValidationError{
+					field:  fmt.Sprintf("Recommendations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubmissionIndexReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmissionIndexReportMultiError is an error wrapping multiple validation
+// errors returned by SubmissionIndexReport.ValidateAll() if the designated
+// constraints aren't met.
+type SubmissionIndexReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmissionIndexReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmissionIndexReportMultiError) AllErrors() []error { return m }
+
+// SubmissionIndexReportValidationError is the validation error returned by
+// SubmissionIndexReport.Validate if the designated constraints aren't met.
+type SubmissionIndexReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmissionIndexReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmissionIndexReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmissionIndexReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmissionIndexReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmissionIndexReportValidationError) ErrorName() string {
+	return "SubmissionIndexReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmissionIndexReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmissionIndexReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmissionIndexReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmissionIndexReportValidationError{}
+
+// Validate checks the field values on GetFormResponseStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFormResponseStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFormResponseStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFormResponseStatsRequestMultiError, or nil if none found.
+func (m *GetFormResponseStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFormResponseStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := GetFormResponseStatsRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFormResponseStatsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFormResponseStatsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFormResponseStatsRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetFormResponseStatsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetFormResponseStatsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetFormResponseStatsRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetFormResponseStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFormResponseStatsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetFormResponseStatsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetFormResponseStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFormResponseStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFormResponseStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetFormResponseStatsRequestValidationError is the validation error returned
+// by GetFormResponseStatsRequest.Validate if the designated constraints
+// aren't met.
+type GetFormResponseStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFormResponseStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFormResponseStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFormResponseStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFormResponseStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFormResponseStatsRequestValidationError) ErrorName() string {
+	return "GetFormResponseStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFormResponseStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFormResponseStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFormResponseStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFormResponseStatsRequestValidationError{}
+
+// Validate checks the field values on ChoiceCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChoiceCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChoiceCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChoiceCountMultiError, or
+// nil if none found.
+func (m *ChoiceCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChoiceCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ChoiceCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChoiceCountMultiError is an error wrapping multiple validation errors
+// returned by ChoiceCount.ValidateAll() if the designated constraints aren't met.
+type ChoiceCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChoiceCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChoiceCountMultiError) AllErrors() []error { return m }
+
+// ChoiceCountValidationError is the validation error returned by
+// ChoiceCount.Validate if the designated constraints aren't met.
+type ChoiceCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChoiceCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChoiceCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChoiceCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChoiceCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChoiceCountValidationError) ErrorName() string { return "ChoiceCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChoiceCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChoiceCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChoiceCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChoiceCountValidationError{}
+
+// Validate checks the field values on ChoiceFieldStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChoiceFieldStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChoiceFieldStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChoiceFieldStatsMultiError, or nil if none found.
+func (m *ChoiceFieldStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChoiceFieldStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	for idx, item := range m.GetChoices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChoiceFieldStatsValidationError{
+						field:  fmt.Sprintf("Choices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChoiceFieldStatsValidationError{
+						field:  fmt.Sprintf("Choices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChoiceFieldStatsValidationError{
+					field:  fmt.Sprintf("Choices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ChoiceFieldStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChoiceFieldStatsMultiError is an error wrapping multiple validation errors
+// returned by ChoiceFieldStats.ValidateAll() if the designated constraints
+// aren't met.
+type ChoiceFieldStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChoiceFieldStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChoiceFieldStatsMultiError) AllErrors() []error { return m }
+
+// ChoiceFieldStatsValidationError is the validation error returned by
+// ChoiceFieldStats.Validate if the designated constraints aren't met.
+type ChoiceFieldStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChoiceFieldStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChoiceFieldStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChoiceFieldStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChoiceFieldStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChoiceFieldStatsValidationError) ErrorName() string { return "ChoiceFieldStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChoiceFieldStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChoiceFieldStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChoiceFieldStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChoiceFieldStatsValidationError{}
+
+// Validate checks the field values on NumericFieldStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NumericFieldStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NumericFieldStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NumericFieldStatsMultiError, or nil if none found.
+func (m *NumericFieldStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NumericFieldStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Count
+
+	// no validation rules for Average
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	if len(errors) > 0 {
+		return NumericFieldStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NumericFieldStatsMultiError is an error wrapping multiple validation errors
+// returned by NumericFieldStats.ValidateAll() if the designated constraints
+// aren't met.
+type NumericFieldStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NumericFieldStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NumericFieldStatsMultiError) AllErrors() []error { return m }
+
+// NumericFieldStatsValidationError is the validation error returned by
+// NumericFieldStats.Validate if the designated constraints aren't met.
+type NumericFieldStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NumericFieldStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NumericFieldStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NumericFieldStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NumericFieldStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NumericFieldStatsValidationError) ErrorName() string {
+	return "NumericFieldStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NumericFieldStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNumericFieldStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NumericFieldStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NumericFieldStatsValidationError{}
+
+// Validate checks the field values on DailyResponseCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DailyResponseCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyResponseCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DailyResponseCountMultiError, or nil if none found.
+func (m *DailyResponseCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyResponseCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return DailyResponseCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyResponseCountMultiError is an error wrapping multiple validation errors
+// returned by DailyResponseCount.ValidateAll() if the designated constraints
+// aren't met.
+type DailyResponseCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyResponseCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyResponseCountMultiError) AllErrors() []error { return m }
+
+// DailyResponseCountValidationError is the validation error returned by
+// DailyResponseCount.Validate if the designated constraints aren't met.
+type DailyResponseCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyResponseCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyResponseCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyResponseCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyResponseCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyResponseCountValidationError) ErrorName() string {
+	return "DailyResponseCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DailyResponseCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyResponseCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyResponseCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyResponseCountValidationError{}
+
+// Validate checks the field values on FormResponseStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FormResponseStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormResponseStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormResponseStatsMultiError, or nil if none found.
+func (m *FormResponseStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormResponseStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormId
+
+	// no validation rules for TotalResponses
+
+	// no validation rules for CompleteResponses
+
+	// no validation rules for CompletionRate
+
+	for idx, item := range m.GetChoiceFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FormResponseStatsValidationError{
+						field:  fmt.Sprintf("ChoiceFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FormResponseStatsValidationError{
+						field:  fmt.Sprintf("ChoiceFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FormResponseStatsValidationError{
+					field:  fmt.Sprintf("ChoiceFields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetNumericFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FormResponseStatsValidationError{
+						field:  fmt.Sprintf("NumericFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FormResponseStatsValidationError{
+						field:  fmt.Sprintf("NumericFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FormResponseStatsValidationError{
+					field:  fmt.Sprintf("NumericFields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDailyCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FormResponseStatsValidationError{
+						field:  fmt.Sprintf("DailyCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FormResponseStatsValidationError{
+						field:  fmt.Sprintf("DailyCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FormResponseStatsValidationError{
+					field:  fmt.Sprintf("DailyCounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FormResponseStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormResponseStatsMultiError is an error wrapping multiple validation errors
+// returned by FormResponseStats.ValidateAll() if the designated constraints
+// aren't met.
+type FormResponseStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormResponseStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormResponseStatsMultiError) AllErrors() []error { return m }
+
+// FormResponseStatsValidationError is the validation error returned by
+// FormResponseStats.Validate if the designated constraints aren't met.
+type FormResponseStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormResponseStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormResponseStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormResponseStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormResponseStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormResponseStatsValidationError) ErrorName() string {
+	return "FormResponseStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FormResponseStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormResponseStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormResponseStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormResponseStatsValidationError{}
+
+// Validate checks the field values on CheckEventConsistencyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckEventConsistencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckEventConsistencyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckEventConsistencyRequestMultiError, or nil if none found.
+func (m *CheckEventConsistencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckEventConsistencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEventId()) < 1 {
+		err := CheckEventConsistencyRequestValidationError{
+			field:  "EventId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMerchantId()) < 1 {
+		err := CheckEventConsistencyRequestValidationError{
+			field:  "MerchantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckEventConsistencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckEventConsistencyRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckEventConsistencyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CheckEventConsistencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckEventConsistencyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckEventConsistencyRequestMultiError) AllErrors() []error { return m }
+
+// CheckEventConsistencyRequestValidationError is the validation error returned
+// by CheckEventConsistencyRequest.Validate if the designated constraints
+// aren't met.
+type CheckEventConsistencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckEventConsistencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckEventConsistencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckEventConsistencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckEventConsistencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckEventConsistencyRequestValidationError) ErrorName() string {
+	return "CheckEventConsistencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckEventConsistencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckEventConsistencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckEventConsistencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckEventConsistencyRequestValidationError{}
+
+// Validate checks the field values on ConsistencyCheck with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyCheck with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyCheckMultiError, or nil if none found.
+func (m *ConsistencyCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for ResourceId
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ConsistencyCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyCheckMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyCheck.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyCheckMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyCheckMultiError) AllErrors() []error { return m }
+
+// ConsistencyCheckValidationError is the validation error returned by
+// ConsistencyCheck.Validate if the designated constraints aren't met.
+type ConsistencyCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyCheckValidationError) ErrorName() string { return "ConsistencyCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsistencyCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyCheckValidationError{}
+
+// Validate checks the field values on EventConsistencyReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EventConsistencyReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventConsistencyReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventConsistencyReportMultiError, or nil if none found.
+func (m *EventConsistencyReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventConsistencyReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for MerchantId
+
+	// no validation rules for FormCount
+
+	// no validation rules for Healthy
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventConsistencyReportValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventConsistencyReportValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventConsistencyReportValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EventConsistencyReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventConsistencyReportMultiError is an error wrapping multiple validation
+// errors returned by EventConsistencyReport.ValidateAll() if the designated
+// constraints aren't met.
+type EventConsistencyReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventConsistencyReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventConsistencyReportMultiError) AllErrors() []error { return m }
+
+// EventConsistencyReportValidationError is the validation error returned by
+// EventConsistencyReport.Validate if the designated constraints aren't met.
+type EventConsistencyReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventConsistencyReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventConsistencyReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventConsistencyReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventConsistencyReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventConsistencyReportValidationError) ErrorName() string {
+	return "EventConsistencyReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventConsistencyReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventConsistencyReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventConsistencyReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventConsistencyReportValidationError{}
+
+// Validate checks the field values on CheckReferentialIntegrityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckReferentialIntegrityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckReferentialIntegrityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckReferentialIntegrityRequestMultiError, or nil if none found.
+func (m *CheckReferentialIntegrityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckReferentialIntegrityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repair
+
+	if len(errors) > 0 {
+		return CheckReferentialIntegrityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckReferentialIntegrityRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckReferentialIntegrityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckReferentialIntegrityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckReferentialIntegrityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckReferentialIntegrityRequestMultiError) AllErrors() []error { return m }
+
+// CheckReferentialIntegrityRequestValidationError is the validation error
+// returned by CheckReferentialIntegrityRequest.Validate if the designated
+// constraints aren't met.
+type CheckReferentialIntegrityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckReferentialIntegrityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckReferentialIntegrityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckReferentialIntegrityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckReferentialIntegrityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckReferentialIntegrityRequestValidationError) ErrorName() string {
+	return "CheckReferentialIntegrityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckReferentialIntegrityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckReferentialIntegrityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckReferentialIntegrityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckReferentialIntegrityRequestValidationError{}
+
+// Validate checks the field values on IntegrityReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IntegrityReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntegrityReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntegrityReportMultiError, or nil if none found.
+func (m *IntegrityReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntegrityReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Found
+
+	// no validation rules for Repaired
+
+	// no validation rules for Healthy
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IntegrityReportValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IntegrityReportValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IntegrityReportValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IntegrityReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntegrityReportMultiError is an error wrapping multiple validation errors
+// returned by IntegrityReport.ValidateAll() if the designated constraints
+// aren't met.
+type IntegrityReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntegrityReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntegrityReportMultiError) AllErrors() []error { return m }
+
+// IntegrityReportValidationError is the validation error returned by
+// IntegrityReport.Validate if the designated constraints aren't met.
+type IntegrityReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntegrityReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntegrityReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntegrityReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntegrityReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntegrityReportValidationError) ErrorName() string { return "IntegrityReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IntegrityReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntegrityReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntegrityReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntegrityReportValidationError{}
+
+// Validate checks the field values on MerchantLimits with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MerchantLimits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantLimits with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MerchantLimitsMultiError,
+// or nil if none found.
+func (m *MerchantLimits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantLimits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MerchantId
+
+	// no validation rules for MaxTemplates
+
+	// no validation rules for MaxFormsPerEvent
+
+	// no validation rules for MaxTemplatesOverride
+
+	// no validation rules for MaxFormsPerEventOverride
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantLimitsValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantLimitsValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantLimitsValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	if len(errors) > 0 {
+		return MerchantLimitsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantLimitsMultiError is an error wrapping multiple validation errors
+// returned by MerchantLimits.ValidateAll() if the designated constraints
+// aren't met.
+type MerchantLimitsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantLimitsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantLimitsMultiError) AllErrors() []error { return m }
+
+// MerchantLimitsValidationError is the validation error returned by
+// MerchantLimits.Validate if the designated constraints aren't met.
+type MerchantLimitsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantLimitsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantLimitsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantLimitsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantLimitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantLimitsValidationError) ErrorName() string { return "MerchantLimitsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MerchantLimitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantLimits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantLimitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantLimitsValidationError{}
+
+// Validate checks the field values on MerchantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MerchantUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MerchantUsageMultiError, or
+// nil if none found.
+func (m *MerchantUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MerchantId
+
+	// no validation rules for Templates
+
+	// no validation rules for Forms
+
+	// no validation rules for FormsByStatus
+
+	// no validation rules for Responses
+
+	if all {
+		switch v := interface{}(m.GetLimits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantUsageValidationError{
+					field:  "Limits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantUsageValidationError{
+					field:  "Limits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantUsageValidationError{
+				field:  "Limits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantUsageValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantUsageValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantUsageValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MerchantUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantUsageMultiError is an error wrapping multiple validation errors
+// returned by MerchantUsage.ValidateAll() if the designated constraints
+// aren't met.
+type MerchantUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantUsageMultiError) AllErrors() []error { return m }
+
+// MerchantUsageValidationError is the validation error returned by
+// MerchantUsage.Validate if the designated constraints aren't met.
+type MerchantUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantUsageValidationError) ErrorName() string { return "MerchantUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MerchantUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantUsageValidationError{}
+
+// Validate checks the field values on GetMerchantLimitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMerchantLimitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMerchantLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMerchantLimitsRequestMultiError, or nil if none found.
+func (m *GetMerchantLimitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMerchantLimitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMerchantId()) < 1 {
+		err := GetMerchantLimitsRequestValidationError{
+			field:  "MerchantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMerchantLimitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMerchantLimitsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMerchantLimitsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMerchantLimitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMerchantLimitsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMerchantLimitsRequestMultiError) AllErrors() []error { return m }
+
+// GetMerchantLimitsRequestValidationError is the validation error returned by
+// GetMerchantLimitsRequest.Validate if the designated constraints aren't met.
+type GetMerchantLimitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMerchantLimitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMerchantLimitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMerchantLimitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMerchantLimitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMerchantLimitsRequestValidationError) ErrorName() string {
+	return "GetMerchantLimitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMerchantLimitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMerchantLimitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMerchantLimitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMerchantLimitsRequestValidationError{}
+
+// Validate checks the field values on SetMerchantLimitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMerchantLimitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMerchantLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMerchantLimitsRequestMultiError, or nil if none found.
+func (m *SetMerchantLimitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMerchantLimitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMerchantId()) < 1 {
+		err := SetMerchantLimitsRequestValidationError{
+			field:  "MerchantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxTemplates() < 0 {
+		err := SetMerchantLimitsRequestValidationError{
+			field:  "MaxTemplates",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxFormsPerEvent() < 0 {
+		err := SetMerchantLimitsRequestValidationError{
+			field:  "MaxFormsPerEvent",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetMerchantLimitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMerchantLimitsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMerchantLimitsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMerchantLimitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMerchantLimitsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMerchantLimitsRequestMultiError) AllErrors() []error { return m }
+
+// SetMerchantLimitsRequestValidationError is the validation error returned by
+// SetMerchantLimitsRequest.Validate if the designated constraints aren't met.
+type SetMerchantLimitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMerchantLimitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMerchantLimitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMerchantLimitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMerchantLimitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMerchantLimitsRequestValidationError) ErrorName() string {
+	return "SetMerchantLimitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMerchantLimitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMerchantLimitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMerchantLimitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMerchantLimitsRequestValidationError{}
+
+// Validate checks the field values on MerchantContentRules with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MerchantContentRules) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantContentRules with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MerchantContentRulesMultiError, or nil if none found.
+func (m *MerchantContentRules) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantContentRules) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MerchantId
+
+	// no validation rules for Action
+
+	// no validation rules for BlockUrls
+
+	// no validation rules for PlatformRules
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantContentRulesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantContentRulesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantContentRulesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	if len(errors) > 0 {
+		return MerchantContentRulesMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantContentRulesMultiError is an error wrapping multiple validation
+// errors returned by MerchantContentRules.ValidateAll() if the designated
+// constraints aren't met.
+type MerchantContentRulesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantContentRulesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantContentRulesMultiError) AllErrors() []error { return m }
+
+// MerchantContentRulesValidationError is the validation error returned by
+// MerchantContentRules.Validate if the designated constraints aren't met.
+type MerchantContentRulesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantContentRulesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantContentRulesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantContentRulesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantContentRulesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantContentRulesValidationError) ErrorName() string {
+	return "MerchantContentRulesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MerchantContentRulesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantContentRules.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantContentRulesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantContentRulesValidationError{}
+
+// Validate checks the field values on SetMerchantContentRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMerchantContentRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMerchantContentRulesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetMerchantContentRulesRequestMultiError, or nil if none found.
+func (m *SetMerchantContentRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMerchantContentRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMerchantId()) < 1 {
+		err := SetMerchantContentRulesRequestValidationError{
+			field:  "MerchantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetMerchantContentRulesRequest_Action_InLookup[m.GetAction()]; !ok {
+		err := SetMerchantContentRulesRequestValidationError{
+			field:  "Action",
+			reason: "value must be in list [reject strip]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BlockUrls
+
+	if len(m.GetBlockedTerms()) > 500 {
+		err := SetMerchantContentRulesRequestValidationError{
+			field:  "BlockedTerms",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetMerchantContentRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMerchantContentRulesRequestMultiError is an error wrapping multiple
+// validation errors returned by SetMerchantContentRulesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetMerchantContentRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMerchantContentRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMerchantContentRulesRequestMultiError) AllErrors() []error { return m }
+
+// SetMerchantContentRulesRequestValidationError is the validation error
+// returned by SetMerchantContentRulesRequest.Validate if the designated
+// constraints aren't met.
+type SetMerchantContentRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMerchantContentRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMerchantContentRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMerchantContentRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMerchantContentRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMerchantContentRulesRequestValidationError) ErrorName() string {
+	return "SetMerchantContentRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMerchantContentRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMerchantContentRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMerchantContentRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMerchantContentRulesRequestValidationError{}
+
+var _SetMerchantContentRulesRequest_Action_InLookup = map[string]struct{}{
+	"reject": {},
+	"strip":  {},
+}
+
+// Validate checks the field values on ListMerchantLimitsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMerchantLimitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMerchantLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMerchantLimitsRequestMultiError, or nil if none found.
+func (m *ListMerchantLimitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMerchantLimitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListMerchantLimitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMerchantLimitsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMerchantLimitsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListMerchantLimitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMerchantLimitsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMerchantLimitsRequestMultiError) AllErrors() []error { return m }
+
+// ListMerchantLimitsRequestValidationError is the validation error returned by
+// ListMerchantLimitsRequest.Validate if the designated constraints aren't met.
+type ListMerchantLimitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMerchantLimitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMerchantLimitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMerchantLimitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMerchantLimitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMerchantLimitsRequestValidationError) ErrorName() string {
+	return "ListMerchantLimitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMerchantLimitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMerchantLimitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMerchantLimitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMerchantLimitsRequestValidationError{}
+
+// Validate checks the field values on ListMerchantLimitsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMerchantLimitsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMerchantLimitsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMerchantLimitsResponseMultiError, or nil if none found.
+func (m *ListMerchantLimitsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMerchantLimitsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLimits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMerchantLimitsResponseValidationError{
+						field:  fmt.Sprintf("Limits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMerchantLimitsResponseValidationError{
+						field:  fmt.Sprintf("Limits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMerchantLimitsResponseValidationError{
+					field:  fmt.Sprintf("Limits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListMerchantLimitsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListMerchantLimitsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListMerchantLimitsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListMerchantLimitsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMerchantLimitsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMerchantLimitsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMerchantLimitsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMerchantLimitsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMerchantLimitsResponseMultiError) AllErrors() []error { return m }
+
+// ListMerchantLimitsResponseValidationError is the validation error returned
+// by ListMerchantLimitsResponse.Validate if the designated constraints aren't met.
+type ListMerchantLimitsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMerchantLimitsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMerchantLimitsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMerchantLimitsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMerchantLimitsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMerchantLimitsResponseValidationError) ErrorName() string {
+	return "ListMerchantLimitsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMerchantLimitsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMerchantLimitsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMerchantLimitsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMerchantLimitsResponseValidationError{}
+
+// Validate checks the field values on GenerateUISchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUISchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateUISchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateUISchemaRequestMultiError, or nil if none found.
+func (m *GenerateUISchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateUISchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchema() == nil {
+		err := GenerateUISchemaRequestValidationError{
+			field:  "Schema",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateUISchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateUISchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateUISchemaRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateUISchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateUISchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateUISchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateUISchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateUISchemaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateUISchemaRequestMultiError) AllErrors() []error { return m }
+
+// GenerateUISchemaRequestValidationError is the validation error returned by
+// GenerateUISchemaRequest.Validate if the designated constraints aren't met.
+type GenerateUISchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUISchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUISchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUISchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUISchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUISchemaRequestValidationError) ErrorName() string {
+	return "GenerateUISchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateUISchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUISchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUISchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUISchemaRequestValidationError{}
+
+// Validate checks the field values on GenerateUISchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateUISchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateUISchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateUISchemaResponseMultiError, or nil if none found.
+func (m *GenerateUISchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateUISchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUischema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateUISchemaResponseValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateUISchemaResponseValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUischema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateUISchemaResponseValidationError{
+				field:  "Uischema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateUISchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateUISchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateUISchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateUISchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateUISchemaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateUISchemaResponseMultiError) AllErrors() []error { return m }
+
+// GenerateUISchemaResponseValidationError is the validation error returned by
+// GenerateUISchemaResponse.Validate if the designated constraints aren't met.
+type GenerateUISchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateUISchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateUISchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateUISchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateUISchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateUISchemaResponseValidationError) ErrorName() string {
+	return "GenerateUISchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateUISchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateUISchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateUISchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateUISchemaResponseValidationError{}
+
+// Validate checks the field values on ValidateFormSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateFormSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateFormSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateFormSchemaRequestMultiError, or nil if none found.
+func (m *ValidateFormSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateFormSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSchema() == nil {
+		err := ValidateFormSchemaRequestValidationError{
+			field:  "Schema",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateFormSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateFormSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateFormSchemaRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUischema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateFormSchemaRequestValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateFormSchemaRequestValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUischema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateFormSchemaRequestValidationError{
+				field:  "Uischema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ValidateFormSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateFormSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateFormSchemaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ValidateFormSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateFormSchemaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateFormSchemaRequestMultiError) AllErrors() []error { return m }
+
+// ValidateFormSchemaRequestValidationError is the validation error returned by
+// ValidateFormSchemaRequest.Validate if the designated constraints aren't met.
+type ValidateFormSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateFormSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateFormSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateFormSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateFormSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateFormSchemaRequestValidationError) ErrorName() string {
+	return "ValidateFormSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateFormSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateFormSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateFormSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateFormSchemaRequestValidationError{}
+
+// Validate checks the field values on SchemaIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaIssue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaIssueMultiError, or
+// nil if none found.
+func (m *SchemaIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return SchemaIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaIssueMultiError is an error wrapping multiple validation errors
+// returned by SchemaIssue.ValidateAll() if the designated constraints aren't met.
+type SchemaIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaIssueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaIssueMultiError) AllErrors() []error { return m }
+
+// SchemaIssueValidationError is the validation error returned by
+// SchemaIssue.Validate if the designated constraints aren't met.
+type SchemaIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaIssueValidationError) ErrorName() string { return "SchemaIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaIssueValidationError{}
+
+// Validate checks the field values on ValidateFormSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateFormSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateFormSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateFormSchemaResponseMultiError, or nil if none found.
+func (m *ValidateFormSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateFormSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateFormSchemaResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateFormSchemaResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateFormSchemaResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateFormSchemaResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateFormSchemaResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateFormSchemaResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidateFormSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateFormSchemaResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateFormSchemaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateFormSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateFormSchemaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateFormSchemaResponseMultiError) AllErrors() []error { return m }
+
+// ValidateFormSchemaResponseValidationError is the validation error returned
+// by ValidateFormSchemaResponse.Validate if the designated constraints aren't met.
+type ValidateFormSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateFormSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateFormSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateFormSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateFormSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateFormSchemaResponseValidationError) ErrorName() string {
+	return "ValidateFormSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateFormSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateFormSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateFormSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateFormSchemaResponseValidationError{}
+
+// Validate checks the field values on SchemaFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaFieldChangeMultiError, or nil if none found.
+func (m *SchemaFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for ChangeType
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaFieldChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaFieldChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaFieldChangeValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaFieldChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaFieldChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaFieldChangeValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequiredBefore
+
+	// no validation rules for RequiredAfter
+
+	// no validation rules for TypeChanged
+
+	// no validation rules for RequiredChanged
+
+	if len(errors) > 0 {
+		return SchemaFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaFieldChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaFieldChangeMultiError) AllErrors() []error { return m }
+
+// SchemaFieldChangeValidationError is the validation error returned by
+// SchemaFieldChange.Validate if the designated constraints aren't met.
+type SchemaFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaFieldChangeValidationError) ErrorName() string {
+	return "SchemaFieldChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaFieldChangeValidationError{}
+
+// Validate checks the field values on FormTemplateComparison with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FormTemplateComparison) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormTemplateComparison with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormTemplateComparisonMultiError, or nil if none found.
+func (m *FormTemplateComparison) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormTemplateComparison) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormId
+
+	// no validation rules for TemplateId
+
+	// no validation rules for FormTemplateVersion
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for VersionsBehind
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FormTemplateComparisonValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FormTemplateComparisonValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FormTemplateComparisonValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FormTemplateComparisonMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormTemplateComparisonMultiError is an error wrapping multiple validation
+// errors returned by FormTemplateComparison.ValidateAll() if the designated
+// constraints aren't met.
+type FormTemplateComparisonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormTemplateComparisonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormTemplateComparisonMultiError) AllErrors() []error { return m }
+
+// FormTemplateComparisonValidationError is the validation error returned by
+// FormTemplateComparison.Validate if the designated constraints aren't met.
+type FormTemplateComparisonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormTemplateComparisonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormTemplateComparisonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormTemplateComparisonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormTemplateComparisonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormTemplateComparisonValidationError) ErrorName() string {
+	return "FormTemplateComparisonValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FormTemplateComparisonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormTemplateComparison.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormTemplateComparisonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormTemplateComparisonValidationError{}
+
+// Validate checks the field values on SchemaSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaSourceMultiError, or
+// nil if none found.
+func (m *SchemaSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormId
+
+	// no validation rules for TemplateId
+
+	if m.GetVersion() < 0 {
+		err := SchemaSourceValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaSourceMultiError is an error wrapping multiple validation errors
+// returned by SchemaSource.ValidateAll() if the designated constraints aren't met.
+type SchemaSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaSourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaSourceMultiError) AllErrors() []error { return m }
+
+// SchemaSourceValidationError is the validation error returned by
+// SchemaSource.Validate if the designated constraints aren't met.
+type SchemaSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaSourceValidationError) ErrorName() string { return "SchemaSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaSourceValidationError{}
+
+// Validate checks the field values on CompareFormSchemasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareFormSchemasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareFormSchemasRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompareFormSchemasRequestMultiError, or nil if none found.
+func (m *CompareFormSchemasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareFormSchemasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFrom() == nil {
+		err := CompareFormSchemasRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareFormSchemasRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareFormSchemasRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareFormSchemasRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTo() == nil {
+		err := CompareFormSchemasRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareFormSchemasRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareFormSchemasRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareFormSchemasRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompareFormSchemasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareFormSchemasRequestMultiError is an error wrapping multiple validation
+// errors returned by CompareFormSchemasRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CompareFormSchemasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareFormSchemasRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareFormSchemasRequestMultiError) AllErrors() []error { return m }
+
+// CompareFormSchemasRequestValidationError is the validation error returned by
+// CompareFormSchemasRequest.Validate if the designated constraints aren't met.
+type CompareFormSchemasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareFormSchemasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareFormSchemasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareFormSchemasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareFormSchemasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareFormSchemasRequestValidationError) ErrorName() string {
+	return "CompareFormSchemasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareFormSchemasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareFormSchemasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareFormSchemasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareFormSchemasRequestValidationError{}
+
+// Validate checks the field values on SchemaComparison with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaComparison) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaComparison with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaComparisonMultiError, or nil if none found.
+func (m *SchemaComparison) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaComparison) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaComparisonValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaComparisonValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaComparisonValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaComparisonValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaComparisonValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaComparisonValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaComparisonValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaComparisonValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaComparisonValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Added
+
+	// no validation rules for Removed
+
+	// no validation rules for Modified
+
+	if len(errors) > 0 {
+		return SchemaComparisonMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaComparisonMultiError is an error wrapping multiple validation errors
+// returned by SchemaComparison.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaComparisonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaComparisonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaComparisonMultiError) AllErrors() []error { return m }
+
+// SchemaComparisonValidationError is the validation error returned by
+// SchemaComparison.Validate if the designated constraints aren't met.
+type SchemaComparisonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaComparisonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaComparisonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaComparisonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaComparisonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaComparisonValidationError) ErrorName() string { return "SchemaComparisonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaComparisonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaComparison.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaComparisonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaComparisonValidationError{}
+
+// Validate checks the field values on Form with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Form) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Form with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FormMultiError, or nil if none found.
+func (m *Form) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Form) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EventId
+
+	// no validation rules for MerchantId
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUischema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUischema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "Uischema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	// no validation rules for Status
+
+	// no validation rules for TemplateId
+
+	// no validation rules for SchemaVersion
+
+	if all {
+		switch v := interface{}(m.GetOpenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "OpenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "OpenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "OpenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "CloseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "CloseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "CloseAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxResponses
+
+	// no validation rules for MaxResponsesPerUser
+
+	// no validation rules for SubmissionMode
+
+	// no validation rules for RetentionDays
+
+	// no validation rules for RetentionAction
+
+	if all {
+		switch v := interface{}(m.GetSpamProtection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "SpamProtection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormValidationError{
+					field:  "SpamProtection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpamProtection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormValidationError{
+				field:  "SpamProtection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FormMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormMultiError is an error wrapping multiple validation errors returned by
+// Form.ValidateAll() if the designated constraints aren't met.
+type FormMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormMultiError) AllErrors() []error { return m }
+
+// FormValidationError is the validation error returned by Form.Validate if the
+// designated constraints aren't met.
+type FormValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormValidationError) ErrorName() string { return "FormValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FormValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormValidationError{}
+
+// Validate checks the field values on SpamProtection with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SpamProtection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpamProtection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SpamProtectionMultiError,
+// or nil if none found.
+func (m *SpamProtection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpamProtection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HoneypotField
+
+	// no validation rules for MinFillSeconds
+
+	// no validation rules for MaxPerIpPerHour
+
+	// no validation rules for CaptchaProvider
+
+	// no validation rules for CaptchaSiteKey
+
+	if len(errors) > 0 {
+		return SpamProtectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpamProtectionMultiError is an error wrapping multiple validation errors
+// returned by SpamProtection.ValidateAll() if the designated constraints
+// aren't met.
+type SpamProtectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpamProtectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpamProtectionMultiError) AllErrors() []error { return m }
+
+// SpamProtectionValidationError is the validation error returned by
+// SpamProtection.Validate if the designated constraints aren't met.
+type SpamProtectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpamProtectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpamProtectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpamProtectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpamProtectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpamProtectionValidationError) ErrorName() string { return "SpamProtectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SpamProtectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpamProtection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpamProtectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpamProtectionValidationError{}
+
+// Validate checks the field values on PublicForm with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PublicForm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicForm with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PublicFormMultiError, or
+// nil if none found.
+func (m *PublicForm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicForm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EventId
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublicFormValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUischema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "Uischema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUischema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublicFormValidationError{
+				field:  "Uischema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SubmissionMode
+
+	if all {
+		switch v := interface{}(m.GetOpenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "OpenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "OpenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublicFormValidationError{
+				field:  "OpenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "CloseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublicFormValidationError{
+					field:  "CloseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublicFormValidationError{
+				field:  "CloseAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AcceptingSubmissions
+
+	// no validation rules for HoneypotField
+
+	// no validation rules for CaptchaProvider
+
+	// no validation rules for CaptchaSiteKey
+
+	// no validation rules for SubmissionToken
+
+	if len(errors) > 0 {
+		return PublicFormMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicFormMultiError is an error wrapping multiple validation errors
+// returned by PublicForm.ValidateAll() if the designated constraints aren't met.
+type PublicFormMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicFormMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicFormMultiError) AllErrors() []error { return m }
+
+// PublicFormValidationError is the validation error returned by
+// PublicForm.Validate if the designated constraints aren't met.
+type PublicFormValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicFormValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicFormValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicFormValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicFormValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicFormValidationError) ErrorName() string { return "PublicFormValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PublicFormValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicForm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicFormValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicFormValidationError{}
+
+// Validate checks the field values on GetFormsByIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFormsByIDsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFormsByIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFormsByIDsRequestMultiError, or nil if none found.
+func (m *GetFormsByIDsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFormsByIDsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := GetFormsByIDsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetFormsByIDsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFormsByIDsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFormsByIDsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFormsByIDsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFormsByIDsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFormsByIDsRequestMultiError) AllErrors() []error { return m }
+
+// GetFormsByIDsRequestValidationError is the validation error returned by
+// GetFormsByIDsRequest.Validate if the designated constraints aren't met.
+type GetFormsByIDsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFormsByIDsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFormsByIDsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFormsByIDsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFormsByIDsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFormsByIDsRequestValidationError) ErrorName() string {
+	return "GetFormsByIDsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFormsByIDsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFormsByIDsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFormsByIDsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFormsByIDsRequestValidationError{}
+
+// Validate checks the field values on FormLookup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FormLookup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormLookup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FormLookupMultiError, or
+// nil if none found.
+func (m *FormLookup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormLookup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Found
+
+	if all {
+		switch v := interface{}(m.GetForm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormLookupValidationError{
+					field:  "Form",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormLookupValidationError{
+					field:  "Form",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormLookupValidationError{
+				field:  "Form",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FormLookupMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormLookupMultiError is an error wrapping multiple validation errors
+// returned by FormLookup.ValidateAll() if the designated constraints aren't met.
+type FormLookupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormLookupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormLookupMultiError) AllErrors() []error { return m }
+
+// FormLookupValidationError is the validation error returned by
+// FormLookup.Validate if the designated constraints aren't met.
+type FormLookupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormLookupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormLookupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormLookupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormLookupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormLookupValidationError) ErrorName() string { return "FormLookupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FormLookupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormLookup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormLookupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormLookupValidationError{}
+
+// Validate checks the field values on GetFormsByIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFormsByIDsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFormsByIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFormsByIDsResponseMultiError, or nil if none found.
+func (m *GetFormsByIDsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFormsByIDsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFormsByIDsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFormsByIDsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFormsByIDsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFormsByIDsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFormsByIDsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFormsByIDsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFormsByIDsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFormsByIDsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFormsByIDsResponseMultiError) AllErrors() []error { return m }
+
+// GetFormsByIDsResponseValidationError is the validation error returned by
+// GetFormsByIDsResponse.Validate if the designated constraints aren't met.
+type GetFormsByIDsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFormsByIDsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFormsByIDsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFormsByIDsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFormsByIDsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFormsByIDsResponseValidationError) ErrorName() string {
+	return "GetFormsByIDsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFormsByIDsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFormsByIDsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFormsByIDsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFormsByIDsResponseValidationError{}
+
+// Validate checks the field values on AdminListFormsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminListFormsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListFormsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListFormsRequestMultiError, or nil if none found.
+func (m *AdminListFormsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListFormsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMerchantId()) < 1 {
+		err := AdminListFormsRequestValidationError{
+			field:  "MerchantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for EventId
+
+	if _, ok := _AdminListFormsRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := AdminListFormsRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ created_at updated_at]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdminListFormsRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := AdminListFormsRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminListFormsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListFormsRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminListFormsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminListFormsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListFormsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListFormsRequestMultiError) AllErrors() []error { return m }
+
+// AdminListFormsRequestValidationError is the validation error returned by
+// AdminListFormsRequest.Validate if the designated constraints aren't met.
+type AdminListFormsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListFormsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListFormsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListFormsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListFormsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListFormsRequestValidationError) ErrorName() string {
+	return "AdminListFormsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminListFormsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListFormsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListFormsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListFormsRequestValidationError{}
+
+var _AdminListFormsRequest_SortBy_InLookup = map[string]struct{}{
+	"":           {},
+	"created_at": {},
+	"updated_at": {},
+}
+
+var _AdminListFormsRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on AdminListFormsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminListFormsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListFormsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListFormsResponseMultiError, or nil if none found.
+func (m *AdminListFormsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListFormsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetForms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminListFormsResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminListFormsResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminListFormsResponseValidationError{
+					field:  fmt.Sprintf("Forms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminListFormsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminListFormsResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminListFormsResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminListFormsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListFormsResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminListFormsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminListFormsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListFormsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListFormsResponseMultiError) AllErrors() []error { return m }
+
+// AdminListFormsResponseValidationError is the validation error returned by
+// AdminListFormsResponse.Validate if the designated constraints aren't met.
+type AdminListFormsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListFormsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListFormsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListFormsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListFormsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListFormsResponseValidationError) ErrorName() string {
+	return "AdminListFormsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminListFormsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListFormsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListFormsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListFormsResponseValidationError{}
+
+// Validate checks the field values on PurgeMerchantDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeMerchantDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeMerchantDataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeMerchantDataRequestMultiError, or nil if none found.
+func (m *PurgeMerchantDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeMerchantDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMerchantId()) < 1 {
+		err := PurgeMerchantDataRequestValidationError{
+			field:  "MerchantId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurgeMerchantDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurgeMerchantDataRequestMultiError is an error wrapping multiple validation
+// errors returned by PurgeMerchantDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PurgeMerchantDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeMerchantDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeMerchantDataRequestMultiError) AllErrors() []error { return m }
+
+// PurgeMerchantDataRequestValidationError is the validation error returned by
+// PurgeMerchantDataRequest.Validate if the designated constraints aren't met.
+type PurgeMerchantDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeMerchantDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeMerchantDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeMerchantDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeMerchantDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeMerchantDataRequestValidationError) ErrorName() string {
+	return "PurgeMerchantDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PurgeMerchantDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeMerchantDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeMerchantDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeMerchantDataRequestValidationError{}
+
+// Validate checks the field values on MerchantPurgeStep with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MerchantPurgeStep) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantPurgeStep with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MerchantPurgeStepMultiError, or nil if none found.
+func (m *MerchantPurgeStep) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantPurgeStep) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Resource
+
+	// no validation rules for Deleted
+
+	// no validation rules for Done
+
+	if len(errors) > 0 {
+		return MerchantPurgeStepMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantPurgeStepMultiError is an error wrapping multiple validation errors
+// returned by MerchantPurgeStep.ValidateAll() if the designated constraints
+// aren't met.
+type MerchantPurgeStepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantPurgeStepMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantPurgeStepMultiError) AllErrors() []error { return m }
+
+// MerchantPurgeStepValidationError is the validation error returned by
+// MerchantPurgeStep.Validate if the designated constraints aren't met.
+type MerchantPurgeStepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantPurgeStepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantPurgeStepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantPurgeStepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantPurgeStepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantPurgeStepValidationError) ErrorName() string {
+	return "MerchantPurgeStepValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MerchantPurgeStepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantPurgeStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantPurgeStepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantPurgeStepValidationError{}
+
+// Validate checks the field values on MerchantPurge with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MerchantPurge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantPurge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MerchantPurgeMultiError, or
+// nil if none found.
+func (m *MerchantPurge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantPurge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MerchantId
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MerchantPurgeValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MerchantPurgeValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MerchantPurgeValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RequestedBy
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantPurgeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantPurgeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantPurgeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantPurgeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantPurgeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantPurgeValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantPurgeValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantPurgeValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantPurgeValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MerchantPurgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantPurgeMultiError is an error wrapping multiple validation errors
+// returned by MerchantPurge.ValidateAll() if the designated constraints
+// aren't met.
+type MerchantPurgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantPurgeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantPurgeMultiError) AllErrors() []error { return m }
+
+// MerchantPurgeValidationError is the validation error returned by
+// MerchantPurge.Validate if the designated constraints aren't met.
+type MerchantPurgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantPurgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantPurgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantPurgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantPurgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantPurgeValidationError) ErrorName() string { return "MerchantPurgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MerchantPurgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantPurge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantPurgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantPurgeValidationError{}
+
+// Validate checks the field values on UserDataRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDataRequestMultiError, or nil if none found.
+func (m *UserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := UserDataRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataRequestMultiError is an error wrapping multiple validation errors
+// returned by UserDataRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataRequestMultiError) AllErrors() []error { return m }
+
+// UserDataRequestValidationError is the validation error returned by
+// UserDataRequest.Validate if the designated constraints aren't met.
+type UserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataRequestValidationError) ErrorName() string { return "UserDataRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataRequestValidationError{}
+
+// Validate checks the field values on UserDataExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDataExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDataExportMultiError,
+// or nil if none found.
+func (m *UserDataExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetExportedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserDataExportValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserDataExportValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserDataExportValidationError{
+				field:  "ExportedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCollections()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserDataExportValidationError{
+					field:  "Collections",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserDataExportValidationError{
+					field:  "Collections",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollections()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserDataExportValidationError{
+				field:  "Collections",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserDataExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataExportMultiError is an error wrapping multiple validation errors
+// returned by UserDataExport.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataExportMultiError) AllErrors() []error { return m }
+
+// UserDataExportValidationError is the validation error returned by
+// UserDataExport.Validate if the designated constraints aren't met.
+type UserDataExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataExportValidationError) ErrorName() string { return "UserDataExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataExportValidationError{}
+
+// Validate checks the field values on EraseUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserDataRequestMultiError, or nil if none found.
+func (m *EraseUserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := EraseUserDataRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeleteResponses
+
+	if len(errors) > 0 {
+		return EraseUserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUserDataRequestMultiError is an error wrapping multiple validation
+// errors returned by EraseUserDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EraseUserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserDataRequestMultiError) AllErrors() []error { return m }
+
+// EraseUserDataRequestValidationError is the validation error returned by
+// EraseUserDataRequest.Validate if the designated constraints aren't met.
+type EraseUserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserDataRequestValidationError) ErrorName() string {
+	return "EraseUserDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserDataRequestValidationError{}
+
+// Validate checks the field values on ErasedCollection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ErasedCollection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasedCollection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasedCollectionMultiError, or nil if none found.
+func (m *ErasedCollection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasedCollection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Collection
+
+	// no validation rules for Pseudonymized
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return ErasedCollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasedCollectionMultiError is an error wrapping multiple validation errors
+// returned by ErasedCollection.ValidateAll() if the designated constraints
+// aren't met.
+type ErasedCollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasedCollectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasedCollectionMultiError) AllErrors() []error { return m }
+
+// ErasedCollectionValidationError is the validation error returned by
+// ErasedCollection.Validate if the designated constraints aren't met.
+type ErasedCollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasedCollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasedCollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasedCollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasedCollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasedCollectionValidationError) ErrorName() string { return "ErasedCollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasedCollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasedCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasedCollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasedCollectionValidationError{}
+
+// Validate checks the field values on UserDataErasure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserDataErasure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataErasure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDataErasureMultiError, or nil if none found.
+func (m *UserDataErasure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataErasure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Pseudonym
+
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataErasureValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataErasureValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataErasureValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserDataErasureMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataErasureMultiError is an error wrapping multiple validation errors
+// returned by UserDataErasure.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataErasureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataErasureMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataErasureMultiError) AllErrors() []error { return m }
+
+// UserDataErasureValidationError is the validation error returned by
+// UserDataErasure.Validate if the designated constraints aren't met.
+type UserDataErasureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataErasureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataErasureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataErasureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataErasureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataErasureValidationError) ErrorName() string { return "UserDataErasureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataErasureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataErasure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataErasureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataErasureValidationError{}
+
+// Validate checks the field values on GetPublicFormRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPublicFormRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPublicFormRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPublicFormRequestMultiError, or nil if none found.
+func (m *GetPublicFormRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPublicFormRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := GetPublicFormRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPublicFormRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPublicFormRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPublicFormRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicFormRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPublicFormRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPublicFormRequestMultiError) AllErrors() []error { return m }
+
+// GetPublicFormRequestValidationError is the validation error returned by
+// GetPublicFormRequest.Validate if the designated constraints aren't met.
+type GetPublicFormRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPublicFormRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPublicFormRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPublicFormRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPublicFormRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPublicFormRequestValidationError) ErrorName() string {
+	return "GetPublicFormRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPublicFormRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPublicFormRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPublicFormRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPublicFormRequestValidationError{}
+
+// Validate checks the field values on SetFormSpamProtectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFormSpamProtectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFormSpamProtectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFormSpamProtectionRequestMultiError, or nil if none found.
+func (m *SetFormSpamProtectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFormSpamProtectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SetFormSpamProtectionRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHoneypotField()) > 64 {
+		err := SetFormSpamProtectionRequestValidationError{
+			field:  "HoneypotField",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMinFillSeconds(); val < 0 || val > 3600 {
+		err := SetFormSpamProtectionRequestValidationError{
+			field:  "MinFillSeconds",
+			reason: "value must be inside range [0, 3600]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxPerIpPerHour() < 0 {
+		err := SetFormSpamProtectionRequestValidationError{
+			field:  "MaxPerIpPerHour",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetFormSpamProtectionRequest_CaptchaProvider_InLookup[m.GetCaptchaProvider()]; !ok {
+		err := SetFormSpamProtectionRequestValidationError{
+			field:  "CaptchaProvider",
+			reason: "value must be in list [ hcaptcha turnstile]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CaptchaSiteKey
+
+	if len(errors) > 0 {
+		return SetFormSpamProtectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFormSpamProtectionRequestMultiError is an error wrapping multiple
+// validation errors returned by SetFormSpamProtectionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetFormSpamProtectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFormSpamProtectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFormSpamProtectionRequestMultiError) AllErrors() []error { return m }
+
+// SetFormSpamProtectionRequestValidationError is the validation error returned
+// by SetFormSpamProtectionRequest.Validate if the designated constraints
+// aren't met.
+type SetFormSpamProtectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFormSpamProtectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFormSpamProtectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFormSpamProtectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFormSpamProtectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFormSpamProtectionRequestValidationError) ErrorName() string {
+	return "SetFormSpamProtectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFormSpamProtectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFormSpamProtectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFormSpamProtectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFormSpamProtectionRequestValidationError{}
+
+var _SetFormSpamProtectionRequest_CaptchaProvider_InLookup = map[string]struct{}{
+	"":          {},
+	"hcaptcha":  {},
+	"turnstile": {},
+}
+
+// Validate checks the field values on SetFormSubmissionModeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFormSubmissionModeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFormSubmissionModeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFormSubmissionModeRequestMultiError, or nil if none found.
+func (m *SetFormSubmissionModeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFormSubmissionModeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SetFormSubmissionModeRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetFormSubmissionModeRequest_SubmissionMode_InLookup[m.GetSubmissionMode()]; !ok {
+		err := SetFormSubmissionModeRequestValidationError{
+			field:  "SubmissionMode",
+			reason: "value must be in list [anonymous authenticated invite_only]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetFormSubmissionModeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFormSubmissionModeRequestMultiError is an error wrapping multiple
+// validation errors returned by SetFormSubmissionModeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetFormSubmissionModeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFormSubmissionModeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFormSubmissionModeRequestMultiError) AllErrors() []error { return m }
+
+// SetFormSubmissionModeRequestValidationError is the validation error returned
+// by SetFormSubmissionModeRequest.Validate if the designated constraints
+// aren't met.
+type SetFormSubmissionModeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFormSubmissionModeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFormSubmissionModeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFormSubmissionModeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFormSubmissionModeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFormSubmissionModeRequestValidationError) ErrorName() string {
+	return "SetFormSubmissionModeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFormSubmissionModeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFormSubmissionModeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFormSubmissionModeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFormSubmissionModeRequestValidationError{}
+
+var _SetFormSubmissionModeRequest_SubmissionMode_InLookup = map[string]struct{}{
+	"anonymous":     {},
+	"authenticated": {},
+	"invite_only":   {},
+}
+
+// Validate checks the field values on CreateFormInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFormInvitationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFormInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateFormInvitationsRequestMultiError, or nil if none found.
+func (m *CreateFormInvitationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFormInvitationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := CreateFormInvitationsRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCount(); val < 1 || val > 100 {
+		err := CreateFormInvitationsRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTtlHours() < 0 {
+		err := CreateFormInvitationsRequestValidationError{
+			field:  "TtlHours",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateFormInvitationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFormInvitationsRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateFormInvitationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateFormInvitationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFormInvitationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFormInvitationsRequestMultiError) AllErrors() []error { return m }
+
+// CreateFormInvitationsRequestValidationError is the validation error returned
+// by CreateFormInvitationsRequest.Validate if the designated constraints
+// aren't met.
+type CreateFormInvitationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFormInvitationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFormInvitationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFormInvitationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFormInvitationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFormInvitationsRequestValidationError) ErrorName() string {
+	return "CreateFormInvitationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFormInvitationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFormInvitationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFormInvitationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFormInvitationsRequestValidationError{}
+
+// Validate checks the field values on FormInvitation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FormInvitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormInvitation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FormInvitationMultiError,
+// or nil if none found.
+func (m *FormInvitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormInvitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FormId
+
+	// no validation rules for Token
+
+	// no validation rules for Link
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FormInvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FormInvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FormInvitationValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FormInvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormInvitationMultiError is an error wrapping multiple validation errors
+// returned by FormInvitation.ValidateAll() if the designated constraints
+// aren't met.
+type FormInvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormInvitationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormInvitationMultiError) AllErrors() []error { return m }
+
+// FormInvitationValidationError is the validation error returned by
+// FormInvitation.Validate if the designated constraints aren't met.
+type FormInvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormInvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormInvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormInvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormInvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormInvitationValidationError) ErrorName() string { return "FormInvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FormInvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormInvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormInvitationValidationError{}
+
+// Validate checks the field values on CreateFormInvitationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateFormInvitationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateFormInvitationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateFormInvitationsResponseMultiError, or nil if none found.
+func (m *CreateFormInvitationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateFormInvitationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvitations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateFormInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateFormInvitationsResponseValidationError{
+						field:  fmt.Sprintf("Invitations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateFormInvitationsResponseValidationError{
+					field:  fmt.Sprintf("Invitations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateFormInvitationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateFormInvitationsResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateFormInvitationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateFormInvitationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateFormInvitationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateFormInvitationsResponseMultiError) AllErrors() []error { return m }
+
+// CreateFormInvitationsResponseValidationError is the validation error
+// returned by CreateFormInvitationsResponse.Validate if the designated
+// constraints aren't met.
+type CreateFormInvitationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateFormInvitationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateFormInvitationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateFormInvitationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateFormInvitationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateFormInvitationsResponseValidationError) ErrorName() string {
+	return "CreateFormInvitationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateFormInvitationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateFormInvitationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateFormInvitationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateFormInvitationsResponseValidationError{}
+
+// Validate checks the field values on CheckInAttendeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckInAttendeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckInAttendeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckInAttendeeRequestMultiError, or nil if none found.
+func (m *CheckInAttendeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckInAttendeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := CheckInAttendeeRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSessionId()); l < 1 || l > 100 {
+		err := CheckInAttendeeRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubmissionId()) < 1 {
+		err := CheckInAttendeeRequestValidationError{
+			field:  "SubmissionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckInAttendeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckInAttendeeRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckInAttendeeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckInAttendeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckInAttendeeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckInAttendeeRequestMultiError) AllErrors() []error { return m }
+
+// CheckInAttendeeRequestValidationError is the validation error returned by
+// CheckInAttendeeRequest.Validate if the designated constraints aren't met.
+type CheckInAttendeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckInAttendeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckInAttendeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckInAttendeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckInAttendeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckInAttendeeRequestValidationError) ErrorName() string {
+	return "CheckInAttendeeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckInAttendeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckInAttendeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckInAttendeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckInAttendeeRequestValidationError{}
+
+// Validate checks the field values on Attendance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attendance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attendance with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttendanceMultiError, or
+// nil if none found.
+func (m *Attendance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attendance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FormId
+
+	// no validation rules for EventId
+
+	// no validation rules for SessionId
+
+	// no validation rules for SubmissionId
+
+	if all {
+		switch v := interface{}(m.GetCheckedInAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttendanceValidationError{
+					field:  "CheckedInAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttendanceValidationError{
+					field:  "CheckedInAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckedInAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttendanceValidationError{
+				field:  "CheckedInAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CheckedInBy
+
+	if len(errors) > 0 {
+		return AttendanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceMultiError is an error wrapping multiple validation errors
+// returned by Attendance.ValidateAll() if the designated constraints aren't met.
+type AttendanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceMultiError) AllErrors() []error { return m }
+
+// AttendanceValidationError is the validation error returned by
+// Attendance.Validate if the designated constraints aren't met.
+type AttendanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceValidationError) ErrorName() string { return "AttendanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttendanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceValidationError{}
+
+// Validate checks the field values on CheckInAttendeeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckInAttendeeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckInAttendeeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckInAttendeeResponseMultiError, or nil if none found.
+func (m *CheckInAttendeeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckInAttendeeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttendance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckInAttendeeResponseValidationError{
+					field:  "Attendance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckInAttendeeResponseValidationError{
+					field:  "Attendance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttendance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckInAttendeeResponseValidationError{
+				field:  "Attendance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AlreadyCheckedIn
+
+	if len(errors) > 0 {
+		return CheckInAttendeeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckInAttendeeResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckInAttendeeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckInAttendeeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckInAttendeeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckInAttendeeResponseMultiError) AllErrors() []error { return m }
+
+// CheckInAttendeeResponseValidationError is the validation error returned by
+// CheckInAttendeeResponse.Validate if the designated constraints aren't met.
+type CheckInAttendeeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckInAttendeeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckInAttendeeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckInAttendeeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckInAttendeeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckInAttendeeResponseValidationError) ErrorName() string {
+	return "CheckInAttendeeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckInAttendeeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckInAttendeeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckInAttendeeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckInAttendeeResponseValidationError{}
+
+// Validate checks the field values on IssueCheckInTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueCheckInTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueCheckInTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueCheckInTokenRequestMultiError, or nil if none found.
+func (m *IssueCheckInTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueCheckInTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := IssueCheckInTokenRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSessionId()); l < 1 || l > 100 {
+		err := IssueCheckInTokenRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubmissionId()) < 1 {
+		err := IssueCheckInTokenRequestValidationError{
+			field:  "SubmissionId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTtlHours() < 0 {
+		err := IssueCheckInTokenRequestValidationError{
+			field:  "TtlHours",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IssueCheckInTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueCheckInTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by IssueCheckInTokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IssueCheckInTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueCheckInTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueCheckInTokenRequestMultiError) AllErrors() []error { return m }
+
+// IssueCheckInTokenRequestValidationError is the validation error returned by
+// IssueCheckInTokenRequest.Validate if the designated constraints aren't met.
+type IssueCheckInTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueCheckInTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueCheckInTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueCheckInTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueCheckInTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueCheckInTokenRequestValidationError) ErrorName() string {
+	return "IssueCheckInTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueCheckInTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueCheckInTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueCheckInTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueCheckInTokenRequestValidationError{}
+
+// Validate checks the field values on CheckInToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckInToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckInToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckInTokenMultiError, or
+// nil if none found.
+func (m *CheckInToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckInToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for SubmissionId
+
+	// no validation rules for SessionId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckInTokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckInTokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckInTokenValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CheckInTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckInTokenMultiError is an error wrapping multiple validation errors
+// returned by CheckInToken.ValidateAll() if the designated constraints aren't met.
+type CheckInTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckInTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckInTokenMultiError) AllErrors() []error { return m }
+
+// CheckInTokenValidationError is the validation error returned by
+// CheckInToken.Validate if the designated constraints aren't met.
+type CheckInTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckInTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckInTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckInTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckInTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckInTokenValidationError) ErrorName() string { return "CheckInTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckInTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckInToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckInTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckInTokenValidationError{}
+
+// Validate checks the field values on RedeemCheckInTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemCheckInTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemCheckInTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemCheckInTokenRequestMultiError, or nil if none found.
+func (m *RedeemCheckInTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemCheckInTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := RedeemCheckInTokenRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSessionId()) > 100 {
+		err := RedeemCheckInTokenRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RedeemCheckInTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemCheckInTokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RedeemCheckInTokenRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RedeemCheckInTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemCheckInTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemCheckInTokenRequestMultiError) AllErrors() []error { return m }
+
+// RedeemCheckInTokenRequestValidationError is the validation error returned by
+// RedeemCheckInTokenRequest.Validate if the designated constraints aren't met.
+type RedeemCheckInTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemCheckInTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemCheckInTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemCheckInTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemCheckInTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemCheckInTokenRequestValidationError) ErrorName() string {
+	return "RedeemCheckInTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemCheckInTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemCheckInTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemCheckInTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemCheckInTokenRequestValidationError{}
+
+// Validate checks the field values on GetSessionAttendanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSessionAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSessionAttendanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSessionAttendanceRequestMultiError, or nil if none found.
+func (m *GetSessionAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSessionAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetFormId()) < 1 {
+		err := GetSessionAttendanceRequestValidationError{
+			field:  "FormId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSessionId()); l < 1 || l > 100 {
+		err := GetSessionAttendanceRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSessionAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSessionAttendanceRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSessionAttendanceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSessionAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSessionAttendanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSessionAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// GetSessionAttendanceRequestValidationError is the validation error returned
+// by GetSessionAttendanceRequest.Validate if the designated constraints
+// aren't met.
+type GetSessionAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSessionAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSessionAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSessionAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSessionAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSessionAttendanceRequestValidationError) ErrorName() string {
+	return "GetSessionAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSessionAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSessionAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSessionAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSessionAttendanceRequestValidationError{}
+
+// Validate checks the field values on SessionAttendance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SessionAttendance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionAttendance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SessionAttendanceMultiError, or nil if none found.
+func (m *SessionAttendance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionAttendance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormId
+
+	// no validation rules for SessionId
+
+	// no validation rules for CheckedIn
+
+	// no validation rules for FormResponses
+
+	// no validation rules for FormMaxResponses
+
+	if len(errors) > 0 {
+		return SessionAttendanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionAttendanceMultiError is an error wrapping multiple validation errors
+// returned by SessionAttendance.ValidateAll() if the designated constraints
+// aren't met.
+type SessionAttendanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionAttendanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionAttendanceMultiError) AllErrors() []error { return m }
+
+// SessionAttendanceValidationError is the validation error returned by
+// SessionAttendance.Validate if the designated constraints aren't met.
+type SessionAttendanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionAttendanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionAttendanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionAttendanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionAttendanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionAttendanceValidationError) ErrorName() string {
+	return "SessionAttendanceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SessionAttendanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionAttendance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionAttendanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionAttendanceValidationError{}
+
+// Validate checks the field values on ShareFormRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShareFormRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareFormRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareFormRequestMultiError, or nil if none found.
+func (m *ShareFormRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareFormRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ShareFormRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ShareFormRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ShareFormRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := ShareFormRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [editor viewer]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ShareFormRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareFormRequestMultiError is an error wrapping multiple validation errors
+// returned by ShareFormRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ShareFormRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareFormRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareFormRequestMultiError) AllErrors() []error { return m }
+
+// ShareFormRequestValidationError is the validation error returned by
+// ShareFormRequest.Validate if the designated constraints aren't met.
+type ShareFormRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareFormRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareFormRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareFormRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareFormRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareFormRequestValidationError) ErrorName() string { return "ShareFormRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShareFormRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareFormRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareFormRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareFormRequestValidationError{}
+
+var _ShareFormRequest_Role_InLookup = map[string]struct{}{
+	"editor": {},
+	"viewer": {},
+}
+
+// Validate checks the field values on UnshareFormRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnshareFormRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnshareFormRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnshareFormRequestMultiError, or nil if none found.
+func (m *UnshareFormRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnshareFormRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UnshareFormRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := UnshareFormRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnshareFormRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnshareFormRequestMultiError is an error wrapping multiple validation errors
+// returned by UnshareFormRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnshareFormRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnshareFormRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnshareFormRequestMultiError) AllErrors() []error { return m }
+
+// UnshareFormRequestValidationError is the validation error returned by
+// UnshareFormRequest.Validate if the designated constraints aren't met.
+type UnshareFormRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnshareFormRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnshareFormRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnshareFormRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnshareFormRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnshareFormRequestValidationError) ErrorName() string {
+	return "UnshareFormRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnshareFormRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnshareFormRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnshareFormRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnshareFormRequestValidationError{}
+
+// Validate checks the field values on FormCollaborator with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FormCollaborator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormCollaborator with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormCollaboratorMultiError, or nil if none found.
+func (m *FormCollaborator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormCollaborator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return FormCollaboratorMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormCollaboratorMultiError is an error wrapping multiple validation errors
+// returned by FormCollaborator.ValidateAll() if the designated constraints
+// aren't met.
+type FormCollaboratorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormCollaboratorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormCollaboratorMultiError) AllErrors() []error { return m }
+
+// FormCollaboratorValidationError is the validation error returned by
+// FormCollaborator.Validate if the designated constraints aren't met.
+type FormCollaboratorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormCollaboratorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormCollaboratorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormCollaboratorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormCollaboratorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormCollaboratorValidationError) ErrorName() string { return "FormCollaboratorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FormCollaboratorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormCollaborator.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormCollaboratorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormCollaboratorValidationError{}
+
+// Validate checks the field values on FormCollaborators with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FormCollaborators) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormCollaborators with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FormCollaboratorsMultiError, or nil if none found.
+func (m *FormCollaborators) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormCollaborators) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormId
+
+	for idx, item := range m.GetCollaborators() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FormCollaboratorsValidationError{
+						field:  fmt.Sprintf("Collaborators[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FormCollaboratorsValidationError{
+						field:  fmt.Sprintf("Collaborators[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FormCollaboratorsValidationError{
+					field:  fmt.Sprintf("Collaborators[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FormCollaboratorsMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormCollaboratorsMultiError is an error wrapping multiple validation errors
+// returned by FormCollaborators.ValidateAll() if the designated constraints
+// aren't met.
+type FormCollaboratorsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormCollaboratorsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormCollaboratorsMultiError) AllErrors() []error { return m }
+
+// FormCollaboratorsValidationError is the validation error returned by
+// FormCollaborators.Validate if the designated constraints aren't met.
+type FormCollaboratorsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormCollaboratorsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormCollaboratorsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormCollaboratorsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormCollaboratorsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormCollaboratorsValidationError) ErrorName() string {
+	return "FormCollaboratorsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FormCollaboratorsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormCollaborators.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormCollaboratorsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormCollaboratorsValidationError{}
+
+// Validate checks the field values on SetFormQuotasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFormQuotasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFormQuotasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFormQuotasRequestMultiError, or nil if none found.
+func (m *SetFormQuotasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFormQuotasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SetFormQuotasRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxResponses() < 0 {
+		err := SetFormQuotasRequestValidationError{
+			field:  "MaxResponses",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxResponsesPerUser() < 0 {
+		err := SetFormQuotasRequestValidationError{
+			field:  "MaxResponsesPerUser",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetFormQuotasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFormQuotasRequestMultiError is an error wrapping multiple validation
+// errors returned by SetFormQuotasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetFormQuotasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFormQuotasRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFormQuotasRequestMultiError) AllErrors() []error { return m }
+
+// SetFormQuotasRequestValidationError is the validation error returned by
+// SetFormQuotasRequest.Validate if the designated constraints aren't met.
+type SetFormQuotasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFormQuotasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFormQuotasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFormQuotasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFormQuotasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFormQuotasRequestValidationError) ErrorName() string {
+	return "SetFormQuotasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFormQuotasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFormQuotasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFormQuotasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFormQuotasRequestValidationError{}
+
+// Validate checks the field values on SetFormRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFormRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFormRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFormRetentionRequestMultiError, or nil if none found.
+func (m *SetFormRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFormRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SetFormRetentionRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRetentionDays() < 0 {
+		err := SetFormRetentionRequestValidationError{
+			field:  "RetentionDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetFormRetentionRequest_RetentionAction_InLookup[m.GetRetentionAction()]; !ok {
+		err := SetFormRetentionRequestValidationError{
+			field:  "RetentionAction",
+			reason: "value must be in list [ delete anonymize]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetFormRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFormRetentionRequestMultiError is an error wrapping multiple validation
+// errors returned by SetFormRetentionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetFormRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFormRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFormRetentionRequestMultiError) AllErrors() []error { return m }
+
+// SetFormRetentionRequestValidationError is the validation error returned by
+// SetFormRetentionRequest.Validate if the designated constraints aren't met.
+type SetFormRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFormRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFormRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFormRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFormRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFormRetentionRequestValidationError) ErrorName() string {
+	return "SetFormRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFormRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFormRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFormRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFormRetentionRequestValidationError{}
+
+var _SetFormRetentionRequest_RetentionAction_InLookup = map[string]struct{}{
+	"":          {},
+	"delete":    {},
+	"anonymize": {},
+}
+
+// Validate checks the field values on SetFormScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetFormScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFormScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFormScheduleRequestMultiError, or nil if none found.
+func (m *SetFormScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFormScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SetFormScheduleRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOpenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFormScheduleRequestValidationError{
+					field:  "OpenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFormScheduleRequestValidationError{
+					field:  "OpenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFormScheduleRequestValidationError{
+				field:  "OpenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetFormScheduleRequestValidationError{
+					field:  "CloseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetFormScheduleRequestValidationError{
+					field:  "CloseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetFormScheduleRequestValidationError{
+				field:  "CloseAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetFormScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFormScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetFormScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetFormScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFormScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFormScheduleRequestMultiError) AllErrors() []error { return m }
+
+// SetFormScheduleRequestValidationError is the validation error returned by
+// SetFormScheduleRequest.Validate if the designated constraints aren't met.
+type SetFormScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFormScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFormScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFormScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFormScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFormScheduleRequestValidationError) ErrorName() string {
+	return "SetFormScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFormScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFormScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFormScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFormScheduleRequestValidationError{}
@@ -21,13 +21,63 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FormService_CreateFormTemplate_FullMethodName    = "/form.service.FormService/CreateFormTemplate"
-	FormService_ListFormTemplates_FullMethodName     = "/form.service.FormService/ListFormTemplates"
-	FormService_GetFormTemplate_FullMethodName       = "/form.service.FormService/GetFormTemplate"
-	FormService_UpdateFormTemplate_FullMethodName    = "/form.service.FormService/UpdateFormTemplate"
-	FormService_DeleteFormTemplate_FullMethodName    = "/form.service.FormService/DeleteFormTemplate"
-	FormService_DuplicateFormTemplate_FullMethodName = "/form.service.FormService/DuplicateFormTemplate"
-	FormService_GetConfig_FullMethodName             = "/form.service.FormService/GetConfig"
+	FormService_CreateFormTemplate_FullMethodName        = "/form.service.FormService/CreateFormTemplate"
+	FormService_ListFormTemplates_FullMethodName         = "/form.service.FormService/ListFormTemplates"
+	FormService_GetFormTemplate_FullMethodName           = "/form.service.FormService/GetFormTemplate"
+	FormService_UpdateFormTemplate_FullMethodName        = "/form.service.FormService/UpdateFormTemplate"
+	FormService_DeleteFormTemplate_FullMethodName        = "/form.service.FormService/DeleteFormTemplate"
+	FormService_DuplicateFormTemplate_FullMethodName     = "/form.service.FormService/DuplicateFormTemplate"
+	FormService_ExportTemplate_FullMethodName            = "/form.service.FormService/ExportTemplate"
+	FormService_ImportTemplate_FullMethodName            = "/form.service.FormService/ImportTemplate"
+	FormService_ImportForm_FullMethodName                = "/form.service.FormService/ImportForm"
+	FormService_CreateFieldBlock_FullMethodName          = "/form.service.FormService/CreateFieldBlock"
+	FormService_ListFieldBlocks_FullMethodName           = "/form.service.FormService/ListFieldBlocks"
+	FormService_GetFieldBlock_FullMethodName             = "/form.service.FormService/GetFieldBlock"
+	FormService_UpdateFieldBlock_FullMethodName          = "/form.service.FormService/UpdateFieldBlock"
+	FormService_DeleteFieldBlock_FullMethodName          = "/form.service.FormService/DeleteFieldBlock"
+	FormService_GetConfig_FullMethodName                 = "/form.service.FormService/GetConfig"
+	FormService_RequestUploadURL_FullMethodName          = "/form.service.FormService/RequestUploadURL"
+	FormService_SubmitFormResponse_FullMethodName        = "/form.service.FormService/SubmitFormResponse"
+	FormService_ImportSubmissions_FullMethodName         = "/form.service.FormService/ImportSubmissions"
+	FormService_ListSubmissions_FullMethodName           = "/form.service.FormService/ListSubmissions"
+	FormService_StreamFormResponses_FullMethodName       = "/form.service.FormService/StreamFormResponses"
+	FormService_GetFormResponseStats_FullMethodName      = "/form.service.FormService/GetFormResponseStats"
+	FormService_PublishForm_FullMethodName               = "/form.service.FormService/PublishForm"
+	FormService_CloseForm_FullMethodName                 = "/form.service.FormService/CloseForm"
+	FormService_SetFormSchedule_FullMethodName           = "/form.service.FormService/SetFormSchedule"
+	FormService_SetFormQuotas_FullMethodName             = "/form.service.FormService/SetFormQuotas"
+	FormService_SetFormRetention_FullMethodName          = "/form.service.FormService/SetFormRetention"
+	FormService_SetFormSpamProtection_FullMethodName     = "/form.service.FormService/SetFormSpamProtection"
+	FormService_SetFormSubmissionMode_FullMethodName     = "/form.service.FormService/SetFormSubmissionMode"
+	FormService_CreateFormInvitations_FullMethodName     = "/form.service.FormService/CreateFormInvitations"
+	FormService_CheckInAttendee_FullMethodName           = "/form.service.FormService/CheckInAttendee"
+	FormService_IssueCheckInToken_FullMethodName         = "/form.service.FormService/IssueCheckInToken"
+	FormService_RedeemCheckInToken_FullMethodName        = "/form.service.FormService/RedeemCheckInToken"
+	FormService_GetSessionAttendance_FullMethodName      = "/form.service.FormService/GetSessionAttendance"
+	FormService_ShareForm_FullMethodName                 = "/form.service.FormService/ShareForm"
+	FormService_UnshareForm_FullMethodName               = "/form.service.FormService/UnshareForm"
+	FormService_ListFormCollaborators_FullMethodName     = "/form.service.FormService/ListFormCollaborators"
+	FormService_CompareFormToTemplate_FullMethodName     = "/form.service.FormService/CompareFormToTemplate"
+	FormService_CompareFormSchemas_FullMethodName        = "/form.service.FormService/CompareFormSchemas"
+	FormService_GetSubmissionIndexReport_FullMethodName  = "/form.service.FormService/GetSubmissionIndexReport"
+	FormService_CheckEventConsistency_FullMethodName     = "/form.service.FormService/CheckEventConsistency"
+	FormService_CheckReferentialIntegrity_FullMethodName = "/form.service.FormService/CheckReferentialIntegrity"
+	FormService_GetMerchantUsage_FullMethodName          = "/form.service.FormService/GetMerchantUsage"
+	FormService_ListMerchantLimits_FullMethodName        = "/form.service.FormService/ListMerchantLimits"
+	FormService_GetMerchantLimits_FullMethodName         = "/form.service.FormService/GetMerchantLimits"
+	FormService_SetMerchantLimits_FullMethodName         = "/form.service.FormService/SetMerchantLimits"
+	FormService_DeleteMerchantLimits_FullMethodName      = "/form.service.FormService/DeleteMerchantLimits"
+	FormService_GetMerchantContentRules_FullMethodName   = "/form.service.FormService/GetMerchantContentRules"
+	FormService_SetMerchantContentRules_FullMethodName   = "/form.service.FormService/SetMerchantContentRules"
+	FormService_AdminListForms_FullMethodName            = "/form.service.FormService/AdminListForms"
+	FormService_AdminGetForm_FullMethodName              = "/form.service.FormService/AdminGetForm"
+	FormService_PurgeMerchantData_FullMethodName         = "/form.service.FormService/PurgeMerchantData"
+	FormService_GetMerchantPurge_FullMethodName          = "/form.service.FormService/GetMerchantPurge"
+	FormService_ExportUserData_FullMethodName            = "/form.service.FormService/ExportUserData"
+	FormService_EraseUserData_FullMethodName             = "/form.service.FormService/EraseUserData"
+	FormService_GenerateUISchema_FullMethodName          = "/form.service.FormService/GenerateUISchema"
+	FormService_ValidateFormSchema_FullMethodName        = "/form.service.FormService/ValidateFormSchema"
+	FormService_GetFormsByIDs_FullMethodName             = "/form.service.FormService/GetFormsByIDs"
 )
 
 // FormServiceClient is the client API for FormService service.
@@ -46,8 +96,116 @@ type FormServiceClient interface {
 	DeleteFormTemplate(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Duplicates an existing form template
 	DuplicateFormTemplate(ctx context.Context, in *DuplicateFormTemplateRequest, opts ...grpc.CallOption) (*DuplicateFormTemplateResponse, error)
+	// Exports a form template as a signed package that can be imported in another environment
+	// or by another merchant
+	ExportTemplate(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*ExportTemplateResponse, error)
+	// Creates a form template from a package made by ExportTemplate
+	ImportTemplate(ctx context.Context, in *ImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplateResponse, error)
+	// Imports a form exported from Google Forms or Typeform as a template, reporting the
+	// questions that could not be converted
+	ImportForm(ctx context.Context, in *ImportFormRequest, opts ...grpc.CallOption) (*ImportFormResponse, error)
+	// Saves a reusable group of questions to the merchant's field block library
+	CreateFieldBlock(ctx context.Context, in *CreateFieldBlockRequest, opts ...grpc.CallOption) (*FieldBlock, error)
+	// Lists the merchant's field blocks with pagination
+	ListFieldBlocks(ctx context.Context, in *ListFieldBlocksRequest, opts ...grpc.CallOption) (*ListFieldBlocksResponse, error)
+	// Gets a single field block by ID
+	GetFieldBlock(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*FieldBlock, error)
+	// Updates a field block; forms and templates already using it are not changed
+	UpdateFieldBlock(ctx context.Context, in *UpdateFieldBlockRequest, opts ...grpc.CallOption) (*FieldBlock, error)
+	// Deletes a field block by ID; forms and templates already using it keep its questions
+	DeleteFieldBlock(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets configuration settings for the frontend
 	GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// Issues a pre-signed URL for uploading a file to a form's file field
+	RequestUploadURL(ctx context.Context, in *RequestUploadURLRequest, opts ...grpc.CallOption) (*UploadURL, error)
+	// Submits a response to a published form within its access window
+	SubmitFormResponse(ctx context.Context, in *SubmitFormResponseRequest, opts ...grpc.CallOption) (*FormSubmission, error)
+	// Imports historical submissions into a form, validated against a selected schema version
+	ImportSubmissions(ctx context.Context, in *ImportSubmissionsRequest, opts ...grpc.CallOption) (*ImportSubmissionsResponse, error)
+	// Lists a form's submissions, optionally filtered by answer values
+	ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
+	// Streams all of a form's responses for large exports, without the page size limit of ListSubmissions
+	StreamFormResponses(ctx context.Context, in *StreamFormResponsesRequest, opts ...grpc.CallOption) (FormService_StreamFormResponsesClient, error)
+	// Gets aggregated statistics over a form's responses for dashboards
+	GetFormResponseStats(ctx context.Context, in *GetFormResponseStatsRequest, opts ...grpc.CallOption) (*FormResponseStats, error)
+	// Publishes a draft or closed form, locking its schema and accepting submissions
+	PublishForm(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*Form, error)
+	// Closes a published form so it stops accepting submissions
+	CloseForm(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*Form, error)
+	// Sets or clears the time window in which a form accepts submissions
+	SetFormSchedule(ctx context.Context, in *SetFormScheduleRequest, opts ...grpc.CallOption) (*Form, error)
+	// Sets the maximum number of responses a form accepts in total and per user
+	SetFormQuotas(ctx context.Context, in *SetFormQuotasRequest, opts ...grpc.CallOption) (*Form, error)
+	// Sets how long a form keeps its responses and whether expired ones are deleted or anonymized
+	SetFormRetention(ctx context.Context, in *SetFormRetentionRequest, opts ...grpc.CallOption) (*Form, error)
+	// Sets the honeypot field, minimum fill time, per-address throttling and CAPTCHA a form
+	// applies to submitted responses
+	SetFormSpamProtection(ctx context.Context, in *SetFormSpamProtectionRequest, opts ...grpc.CallOption) (*Form, error)
+	// Sets who may submit responses to a form: anonymous, authenticated or invite_only
+	SetFormSubmissionMode(ctx context.Context, in *SetFormSubmissionModeRequest, opts ...grpc.CallOption) (*Form, error)
+	// Issues signed one-time invitation links for an invite-only form
+	CreateFormInvitations(ctx context.Context, in *CreateFormInvitationsRequest, opts ...grpc.CallOption) (*CreateFormInvitationsResponse, error)
+	// Checks in the respondent of a registration to an event session. Checking in again
+	// returns the first check-in, marked as a duplicate.
+	CheckInAttendee(ctx context.Context, in *CheckInAttendeeRequest, opts ...grpc.CallOption) (*CheckInAttendeeResponse, error)
+	// Issues the signed check-in token of a registration for a session, to be shown as a QR code
+	IssueCheckInToken(ctx context.Context, in *IssueCheckInTokenRequest, opts ...grpc.CallOption) (*CheckInToken, error)
+	// Checks in the registration of a scanned check-in token
+	RedeemCheckInToken(ctx context.Context, in *RedeemCheckInTokenRequest, opts ...grpc.CallOption) (*CheckInAttendeeResponse, error)
+	// Counts the attendees checked in to a session against the responses of the form
+	GetSessionAttendance(ctx context.Context, in *GetSessionAttendanceRequest, opts ...grpc.CallOption) (*SessionAttendance, error)
+	// Grants another user the editor or viewer role on a form (form owner only)
+	ShareForm(ctx context.Context, in *ShareFormRequest, opts ...grpc.CallOption) (*FormCollaborators, error)
+	// Removes the editor or viewer role a form is shared with a user (form owner only)
+	UnshareForm(ctx context.Context, in *UnshareFormRequest, opts ...grpc.CallOption) (*FormCollaborators, error)
+	// Lists the users holding the owner, editor or viewer role on a form
+	ListFormCollaborators(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*FormCollaborators, error)
+	// Compares a form's schema with the latest version of its source template
+	CompareFormToTemplate(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*FormTemplateComparison, error)
+	// Compares the schemas of two forms, a form and a template, or two versions of a form or template
+	CompareFormSchemas(ctx context.Context, in *CompareFormSchemasRequest, opts ...grpc.CallOption) (*SchemaComparison, error)
+	// Reports frequently filtered answer fields with index recommendations (admin only)
+	GetSubmissionIndexReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SubmissionIndexReport, error)
+	// Verifies that the forms attached to an event are consistent across resources (admin only)
+	CheckEventConsistency(ctx context.Context, in *CheckEventConsistencyRequest, opts ...grpc.CallOption) (*EventConsistencyReport, error)
+	// Admin scan for dangling references across merchants, optionally repairing them
+	CheckReferentialIntegrity(ctx context.Context, in *CheckReferentialIntegrityRequest, opts ...grpc.CallOption) (*IntegrityReport, error)
+	// Gets the caller's merchant usage of templates, forms and responses with its effective limits
+	GetMerchantUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MerchantUsage, error)
+	// Lists merchants with limit overrides and their effective limits (admin only)
+	ListMerchantLimits(ctx context.Context, in *ListMerchantLimitsRequest, opts ...grpc.CallOption) (*ListMerchantLimitsResponse, error)
+	// Gets a merchant's effective limits and overrides (admin only)
+	GetMerchantLimits(ctx context.Context, in *GetMerchantLimitsRequest, opts ...grpc.CallOption) (*MerchantLimits, error)
+	// Sets a merchant's overrides of the platform limits (admin only)
+	SetMerchantLimits(ctx context.Context, in *SetMerchantLimitsRequest, opts ...grpc.CallOption) (*MerchantLimits, error)
+	// Removes a merchant's overrides so the platform defaults apply (admin only)
+	DeleteMerchantLimits(ctx context.Context, in *GetMerchantLimitsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the content rules applied to a merchant's field titles and descriptions (admin only)
+	GetMerchantContentRules(ctx context.Context, in *GetMerchantLimitsRequest, opts ...grpc.CallOption) (*MerchantContentRules, error)
+	// Replaces the platform content rules for a merchant (admin only)
+	SetMerchantContentRules(ctx context.Context, in *SetMerchantContentRulesRequest, opts ...grpc.CallOption) (*MerchantContentRules, error)
+	// Lists a merchant's forms for support staff (platform admin only, audited)
+	AdminListForms(ctx context.Context, in *AdminListFormsRequest, opts ...grpc.CallOption) (*AdminListFormsResponse, error)
+	// Gets a form of any merchant for support staff (platform admin only, audited)
+	AdminGetForm(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*Form, error)
+	// Deletes all forms, templates, responses, files and Keto tuples of a merchant in resumable
+	// background batches (platform admin only, audited). Returns the purge to poll for progress.
+	PurgeMerchantData(ctx context.Context, in *PurgeMerchantDataRequest, opts ...grpc.CallOption) (*MerchantPurge, error)
+	// Reports the progress of a merchant data purge (platform admin only)
+	GetMerchantPurge(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*MerchantPurge, error)
+	// Exports every document in which a user is the creator, last editor or respondent, for a
+	// GDPR access request (the user or a platform admin, audited)
+	ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	// Erases a user by replacing their ID with a pseudonym everywhere; responses keep their answers
+	// for statistics unless delete_responses is set (the user or a platform admin, audited)
+	EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*UserDataErasure, error)
+	// Generates a default UI Schema for a JSON Schema
+	GenerateUISchema(ctx context.Context, in *GenerateUISchemaRequest, opts ...grpc.CallOption) (*GenerateUISchemaResponse, error)
+	// Checks a JSON Schema and its UI Schema, returning errors that prevent saving and
+	// best-practice warnings for the form builder
+	ValidateFormSchema(ctx context.Context, in *ValidateFormSchemaRequest, opts ...grpc.CallOption) (*ValidateFormSchemaResponse, error)
+	// Gets several forms by ID in one call, in the requested order
+	GetFormsByIDs(ctx context.Context, in *GetFormsByIDsRequest, opts ...grpc.CallOption) (*GetFormsByIDsResponse, error)
 }
 
 type formServiceClient struct {
@@ -112,6 +270,78 @@ func (c *formServiceClient) DuplicateFormTemplate(ctx context.Context, in *Dupli
 	return out, nil
 }
 
+func (c *formServiceClient) ExportTemplate(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*ExportTemplateResponse, error) {
+	out := new(ExportTemplateResponse)
+	err := c.cc.Invoke(ctx, FormService_ExportTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ImportTemplate(ctx context.Context, in *ImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplateResponse, error) {
+	out := new(ImportTemplateResponse)
+	err := c.cc.Invoke(ctx, FormService_ImportTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ImportForm(ctx context.Context, in *ImportFormRequest, opts ...grpc.CallOption) (*ImportFormResponse, error) {
+	out := new(ImportFormResponse)
+	err := c.cc.Invoke(ctx, FormService_ImportForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CreateFieldBlock(ctx context.Context, in *CreateFieldBlockRequest, opts ...grpc.CallOption) (*FieldBlock, error) {
+	out := new(FieldBlock)
+	err := c.cc.Invoke(ctx, FormService_CreateFieldBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ListFieldBlocks(ctx context.Context, in *ListFieldBlocksRequest, opts ...grpc.CallOption) (*ListFieldBlocksResponse, error) {
+	out := new(ListFieldBlocksResponse)
+	err := c.cc.Invoke(ctx, FormService_ListFieldBlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetFieldBlock(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*FieldBlock, error) {
+	out := new(FieldBlock)
+	err := c.cc.Invoke(ctx, FormService_GetFieldBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) UpdateFieldBlock(ctx context.Context, in *UpdateFieldBlockRequest, opts ...grpc.CallOption) (*FieldBlock, error) {
+	out := new(FieldBlock)
+	err := c.cc.Invoke(ctx, FormService_UpdateFieldBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) DeleteFieldBlock(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FormService_DeleteFieldBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *formServiceClient) GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigResponse, error) {
 	out := new(ConfigResponse)
 	err := c.cc.Invoke(ctx, FormService_GetConfig_FullMethodName, in, out, opts...)
@@ -121,187 +351,1749 @@ func (c *formServiceClient) GetConfig(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
-// FormServiceServer is the server API for FormService service.
-// All implementations must embed UnimplementedFormServiceServer
-// for forward compatibility
-type FormServiceServer interface {
-	// Creates a new form template
-	CreateFormTemplate(context.Context, *CreateFormTemplateRequest) (*CreateFormTemplateResponse, error)
-	// Lists form templates with pagination
-	ListFormTemplates(context.Context, *ListFormTemplatesRequest) (*ListFormTemplatesResponse, error)
-	// Gets a single form template by ID
-	GetFormTemplate(context.Context, *common.ID) (*FormTemplate, error)
-	// Updates an existing form template
-	UpdateFormTemplate(context.Context, *UpdateFormTemplateRequest) (*FormTemplate, error)
-	// Deletes a form template by ID
-	DeleteFormTemplate(context.Context, *common.ID) (*emptypb.Empty, error)
-	// Duplicates an existing form template
-	DuplicateFormTemplate(context.Context, *DuplicateFormTemplateRequest) (*DuplicateFormTemplateResponse, error)
-	// Gets configuration settings for the frontend
-	GetConfig(context.Context, *emptypb.Empty) (*ConfigResponse, error)
-	mustEmbedUnimplementedFormServiceServer()
+func (c *formServiceClient) RequestUploadURL(ctx context.Context, in *RequestUploadURLRequest, opts ...grpc.CallOption) (*UploadURL, error) {
+	out := new(UploadURL)
+	err := c.cc.Invoke(ctx, FormService_RequestUploadURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedFormServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedFormServiceServer struct {
+func (c *formServiceClient) SubmitFormResponse(ctx context.Context, in *SubmitFormResponseRequest, opts ...grpc.CallOption) (*FormSubmission, error) {
+	out := new(FormSubmission)
+	err := c.cc.Invoke(ctx, FormService_SubmitFormResponse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedFormServiceServer) CreateFormTemplate(context.Context, *CreateFormTemplateRequest) (*CreateFormTemplateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFormTemplate not implemented")
+func (c *formServiceClient) ImportSubmissions(ctx context.Context, in *ImportSubmissionsRequest, opts ...grpc.CallOption) (*ImportSubmissionsResponse, error) {
+	out := new(ImportSubmissionsResponse)
+	err := c.cc.Invoke(ctx, FormService_ImportSubmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFormServiceServer) ListFormTemplates(context.Context, *ListFormTemplatesRequest) (*ListFormTemplatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListFormTemplates not implemented")
+
+func (c *formServiceClient) ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error) {
+	out := new(ListSubmissionsResponse)
+	err := c.cc.Invoke(ctx, FormService_ListSubmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedFormServiceServer) GetFormTemplate(context.Context, *common.ID) (*FormTemplate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFormTemplate not implemented")
+
+func (c *formServiceClient) StreamFormResponses(ctx context.Context, in *StreamFormResponsesRequest, opts ...grpc.CallOption) (FormService_StreamFormResponsesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FormService_ServiceDesc.Streams[0], FormService_StreamFormResponses_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &formServiceStreamFormResponsesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedFormServiceServer) UpdateFormTemplate(context.Context, *UpdateFormTemplateRequest) (*FormTemplate, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFormTemplate not implemented")
+
+type FormService_StreamFormResponsesClient interface {
+	Recv() (*FormSubmission, error)
+	grpc.ClientStream
 }
-func (UnimplementedFormServiceServer) DeleteFormTemplate(context.Context, *common.ID) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFormTemplate not implemented")
+
+type formServiceStreamFormResponsesClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedFormServiceServer) DuplicateFormTemplate(context.Context, *DuplicateFormTemplateRequest) (*DuplicateFormTemplateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DuplicateFormTemplate not implemented")
+
+func (x *formServiceStreamFormResponsesClient) Recv() (*FormSubmission, error) {
+	m := new(FormSubmission)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedFormServiceServer) GetConfig(context.Context, *emptypb.Empty) (*ConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+
+func (c *formServiceClient) GetFormResponseStats(ctx context.Context, in *GetFormResponseStatsRequest, opts ...grpc.CallOption) (*FormResponseStats, error) {
+	out := new(FormResponseStats)
+	err := c.cc.Invoke(ctx, FormService_GetFormResponseStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) PublishForm(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_PublishForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CloseForm(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_CloseForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetFormSchedule(ctx context.Context, in *SetFormScheduleRequest, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_SetFormSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetFormQuotas(ctx context.Context, in *SetFormQuotasRequest, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_SetFormQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetFormRetention(ctx context.Context, in *SetFormRetentionRequest, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_SetFormRetention_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetFormSpamProtection(ctx context.Context, in *SetFormSpamProtectionRequest, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_SetFormSpamProtection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetFormSubmissionMode(ctx context.Context, in *SetFormSubmissionModeRequest, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_SetFormSubmissionMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CreateFormInvitations(ctx context.Context, in *CreateFormInvitationsRequest, opts ...grpc.CallOption) (*CreateFormInvitationsResponse, error) {
+	out := new(CreateFormInvitationsResponse)
+	err := c.cc.Invoke(ctx, FormService_CreateFormInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CheckInAttendee(ctx context.Context, in *CheckInAttendeeRequest, opts ...grpc.CallOption) (*CheckInAttendeeResponse, error) {
+	out := new(CheckInAttendeeResponse)
+	err := c.cc.Invoke(ctx, FormService_CheckInAttendee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) IssueCheckInToken(ctx context.Context, in *IssueCheckInTokenRequest, opts ...grpc.CallOption) (*CheckInToken, error) {
+	out := new(CheckInToken)
+	err := c.cc.Invoke(ctx, FormService_IssueCheckInToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) RedeemCheckInToken(ctx context.Context, in *RedeemCheckInTokenRequest, opts ...grpc.CallOption) (*CheckInAttendeeResponse, error) {
+	out := new(CheckInAttendeeResponse)
+	err := c.cc.Invoke(ctx, FormService_RedeemCheckInToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetSessionAttendance(ctx context.Context, in *GetSessionAttendanceRequest, opts ...grpc.CallOption) (*SessionAttendance, error) {
+	out := new(SessionAttendance)
+	err := c.cc.Invoke(ctx, FormService_GetSessionAttendance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ShareForm(ctx context.Context, in *ShareFormRequest, opts ...grpc.CallOption) (*FormCollaborators, error) {
+	out := new(FormCollaborators)
+	err := c.cc.Invoke(ctx, FormService_ShareForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) UnshareForm(ctx context.Context, in *UnshareFormRequest, opts ...grpc.CallOption) (*FormCollaborators, error) {
+	out := new(FormCollaborators)
+	err := c.cc.Invoke(ctx, FormService_UnshareForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ListFormCollaborators(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*FormCollaborators, error) {
+	out := new(FormCollaborators)
+	err := c.cc.Invoke(ctx, FormService_ListFormCollaborators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CompareFormToTemplate(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*FormTemplateComparison, error) {
+	out := new(FormTemplateComparison)
+	err := c.cc.Invoke(ctx, FormService_CompareFormToTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CompareFormSchemas(ctx context.Context, in *CompareFormSchemasRequest, opts ...grpc.CallOption) (*SchemaComparison, error) {
+	out := new(SchemaComparison)
+	err := c.cc.Invoke(ctx, FormService_CompareFormSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetSubmissionIndexReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SubmissionIndexReport, error) {
+	out := new(SubmissionIndexReport)
+	err := c.cc.Invoke(ctx, FormService_GetSubmissionIndexReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CheckEventConsistency(ctx context.Context, in *CheckEventConsistencyRequest, opts ...grpc.CallOption) (*EventConsistencyReport, error) {
+	out := new(EventConsistencyReport)
+	err := c.cc.Invoke(ctx, FormService_CheckEventConsistency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) CheckReferentialIntegrity(ctx context.Context, in *CheckReferentialIntegrityRequest, opts ...grpc.CallOption) (*IntegrityReport, error) {
+	out := new(IntegrityReport)
+	err := c.cc.Invoke(ctx, FormService_CheckReferentialIntegrity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetMerchantUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MerchantUsage, error) {
+	out := new(MerchantUsage)
+	err := c.cc.Invoke(ctx, FormService_GetMerchantUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ListMerchantLimits(ctx context.Context, in *ListMerchantLimitsRequest, opts ...grpc.CallOption) (*ListMerchantLimitsResponse, error) {
+	out := new(ListMerchantLimitsResponse)
+	err := c.cc.Invoke(ctx, FormService_ListMerchantLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetMerchantLimits(ctx context.Context, in *GetMerchantLimitsRequest, opts ...grpc.CallOption) (*MerchantLimits, error) {
+	out := new(MerchantLimits)
+	err := c.cc.Invoke(ctx, FormService_GetMerchantLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetMerchantLimits(ctx context.Context, in *SetMerchantLimitsRequest, opts ...grpc.CallOption) (*MerchantLimits, error) {
+	out := new(MerchantLimits)
+	err := c.cc.Invoke(ctx, FormService_SetMerchantLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) DeleteMerchantLimits(ctx context.Context, in *GetMerchantLimitsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FormService_DeleteMerchantLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetMerchantContentRules(ctx context.Context, in *GetMerchantLimitsRequest, opts ...grpc.CallOption) (*MerchantContentRules, error) {
+	out := new(MerchantContentRules)
+	err := c.cc.Invoke(ctx, FormService_GetMerchantContentRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetMerchantContentRules(ctx context.Context, in *SetMerchantContentRulesRequest, opts ...grpc.CallOption) (*MerchantContentRules, error) {
+	out := new(MerchantContentRules)
+	err := c.cc.Invoke(ctx, FormService_SetMerchantContentRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) AdminListForms(ctx context.Context, in *AdminListFormsRequest, opts ...grpc.CallOption) (*AdminListFormsResponse, error) {
+	out := new(AdminListFormsResponse)
+	err := c.cc.Invoke(ctx, FormService_AdminListForms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) AdminGetForm(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*Form, error) {
+	out := new(Form)
+	err := c.cc.Invoke(ctx, FormService_AdminGetForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) PurgeMerchantData(ctx context.Context, in *PurgeMerchantDataRequest, opts ...grpc.CallOption) (*MerchantPurge, error) {
+	out := new(MerchantPurge)
+	err := c.cc.Invoke(ctx, FormService_PurgeMerchantData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetMerchantPurge(ctx context.Context, in *common.ID, opts ...grpc.CallOption) (*MerchantPurge, error) {
+	out := new(MerchantPurge)
+	err := c.cc.Invoke(ctx, FormService_GetMerchantPurge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, FormService_ExportUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*UserDataErasure, error) {
+	out := new(UserDataErasure)
+	err := c.cc.Invoke(ctx, FormService_EraseUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GenerateUISchema(ctx context.Context, in *GenerateUISchemaRequest, opts ...grpc.CallOption) (*GenerateUISchemaResponse, error) {
+	out := new(GenerateUISchemaResponse)
+	err := c.cc.Invoke(ctx, FormService_GenerateUISchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ValidateFormSchema(ctx context.Context, in *ValidateFormSchemaRequest, opts ...grpc.CallOption) (*ValidateFormSchemaResponse, error) {
+	out := new(ValidateFormSchemaResponse)
+	err := c.cc.Invoke(ctx, FormService_ValidateFormSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) GetFormsByIDs(ctx context.Context, in *GetFormsByIDsRequest, opts ...grpc.CallOption) (*GetFormsByIDsResponse, error) {
+	out := new(GetFormsByIDsResponse)
+	err := c.cc.Invoke(ctx, FormService_GetFormsByIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FormServiceServer is the server API for FormService service.
+// All implementations must embed UnimplementedFormServiceServer
+// for forward compatibility
+type FormServiceServer interface {
+	// Creates a new form template
+	CreateFormTemplate(context.Context, *CreateFormTemplateRequest) (*CreateFormTemplateResponse, error)
+	// Lists form templates with pagination
+	ListFormTemplates(context.Context, *ListFormTemplatesRequest) (*ListFormTemplatesResponse, error)
+	// Gets a single form template by ID
+	GetFormTemplate(context.Context, *common.ID) (*FormTemplate, error)
+	// Updates an existing form template
+	UpdateFormTemplate(context.Context, *UpdateFormTemplateRequest) (*FormTemplate, error)
+	// Deletes a form template by ID
+	DeleteFormTemplate(context.Context, *common.ID) (*emptypb.Empty, error)
+	// Duplicates an existing form template
+	DuplicateFormTemplate(context.Context, *DuplicateFormTemplateRequest) (*DuplicateFormTemplateResponse, error)
+	// Exports a form template as a signed package that can be imported in another environment
+	// or by another merchant
+	ExportTemplate(context.Context, *common.ID) (*ExportTemplateResponse, error)
+	// Creates a form template from a package made by ExportTemplate
+	ImportTemplate(context.Context, *ImportTemplateRequest) (*ImportTemplateResponse, error)
+	// Imports a form exported from Google Forms or Typeform as a template, reporting the
+	// questions that could not be converted
+	ImportForm(context.Context, *ImportFormRequest) (*ImportFormResponse, error)
+	// Saves a reusable group of questions to the merchant's field block library
+	CreateFieldBlock(context.Context, *CreateFieldBlockRequest) (*FieldBlock, error)
+	// Lists the merchant's field blocks with pagination
+	ListFieldBlocks(context.Context, *ListFieldBlocksRequest) (*ListFieldBlocksResponse, error)
+	// Gets a single field block by ID
+	GetFieldBlock(context.Context, *common.ID) (*FieldBlock, error)
+	// Updates a field block; forms and templates already using it are not changed
+	UpdateFieldBlock(context.Context, *UpdateFieldBlockRequest) (*FieldBlock, error)
+	// Deletes a field block by ID; forms and templates already using it keep its questions
+	DeleteFieldBlock(context.Context, *common.ID) (*emptypb.Empty, error)
+	// Gets configuration settings for the frontend
+	GetConfig(context.Context, *emptypb.Empty) (*ConfigResponse, error)
+	// Issues a pre-signed URL for uploading a file to a form's file field
+	RequestUploadURL(context.Context, *RequestUploadURLRequest) (*UploadURL, error)
+	// Submits a response to a published form within its access window
+	SubmitFormResponse(context.Context, *SubmitFormResponseRequest) (*FormSubmission, error)
+	// Imports historical submissions into a form, validated against a selected schema version
+	ImportSubmissions(context.Context, *ImportSubmissionsRequest) (*ImportSubmissionsResponse, error)
+	// Lists a form's submissions, optionally filtered by answer values
+	ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error)
+	// Streams all of a form's responses for large exports, without the page size limit of ListSubmissions
+	StreamFormResponses(*StreamFormResponsesRequest, FormService_StreamFormResponsesServer) error
+	// Gets aggregated statistics over a form's responses for dashboards
+	GetFormResponseStats(context.Context, *GetFormResponseStatsRequest) (*FormResponseStats, error)
+	// Publishes a draft or closed form, locking its schema and accepting submissions
+	PublishForm(context.Context, *common.ID) (*Form, error)
+	// Closes a published form so it stops accepting submissions
+	CloseForm(context.Context, *common.ID) (*Form, error)
+	// Sets or clears the time window in which a form accepts submissions
+	SetFormSchedule(context.Context, *SetFormScheduleRequest) (*Form, error)
+	// Sets the maximum number of responses a form accepts in total and per user
+	SetFormQuotas(context.Context, *SetFormQuotasRequest) (*Form, error)
+	// Sets how long a form keeps its responses and whether expired ones are deleted or anonymized
+	SetFormRetention(context.Context, *SetFormRetentionRequest) (*Form, error)
+	// Sets the honeypot field, minimum fill time, per-address throttling and CAPTCHA a form
+	// applies to submitted responses
+	SetFormSpamProtection(context.Context, *SetFormSpamProtectionRequest) (*Form, error)
+	// Sets who may submit responses to a form: anonymous, authenticated or invite_only
+	SetFormSubmissionMode(context.Context, *SetFormSubmissionModeRequest) (*Form, error)
+	// Issues signed one-time invitation links for an invite-only form
+	CreateFormInvitations(context.Context, *CreateFormInvitationsRequest) (*CreateFormInvitationsResponse, error)
+	// Checks in the respondent of a registration to an event session. Checking in again
+	// returns the first check-in, marked as a duplicate.
+	CheckInAttendee(context.Context, *CheckInAttendeeRequest) (*CheckInAttendeeResponse, error)
+	// Issues the signed check-in token of a registration for a session, to be shown as a QR code
+	IssueCheckInToken(context.Context, *IssueCheckInTokenRequest) (*CheckInToken, error)
+	// Checks in the registration of a scanned check-in token
+	RedeemCheckInToken(context.Context, *RedeemCheckInTokenRequest) (*CheckInAttendeeResponse, error)
+	// Counts the attendees checked in to a session against the responses of the form
+	GetSessionAttendance(context.Context, *GetSessionAttendanceRequest) (*SessionAttendance, error)
+	// Grants another user the editor or viewer role on a form (form owner only)
+	ShareForm(context.Context, *ShareFormRequest) (*FormCollaborators, error)
+	// Removes the editor or viewer role a form is shared with a user (form owner only)
+	UnshareForm(context.Context, *UnshareFormRequest) (*FormCollaborators, error)
+	// Lists the users holding the owner, editor or viewer role on a form
+	ListFormCollaborators(context.Context, *common.ID) (*FormCollaborators, error)
+	// Compares a form's schema with the latest version of its source template
+	CompareFormToTemplate(context.Context, *common.ID) (*FormTemplateComparison, error)
+	// Compares the schemas of two forms, a form and a template, or two versions of a form or template
+	CompareFormSchemas(context.Context, *CompareFormSchemasRequest) (*SchemaComparison, error)
+	// Reports frequently filtered answer fields with index recommendations (admin only)
+	GetSubmissionIndexReport(context.Context, *emptypb.Empty) (*SubmissionIndexReport, error)
+	// Verifies that the forms attached to an event are consistent across resources (admin only)
+	CheckEventConsistency(context.Context, *CheckEventConsistencyRequest) (*EventConsistencyReport, error)
+	// Admin scan for dangling references across merchants, optionally repairing them
+	CheckReferentialIntegrity(context.Context, *CheckReferentialIntegrityRequest) (*IntegrityReport, error)
+	// Gets the caller's merchant usage of templates, forms and responses with its effective limits
+	GetMerchantUsage(context.Context, *emptypb.Empty) (*MerchantUsage, error)
+	// Lists merchants with limit overrides and their effective limits (admin only)
+	ListMerchantLimits(context.Context, *ListMerchantLimitsRequest) (*ListMerchantLimitsResponse, error)
+	// Gets a merchant's effective limits and overrides (admin only)
+	GetMerchantLimits(context.Context, *GetMerchantLimitsRequest) (*MerchantLimits, error)
+	// Sets a merchant's overrides of the platform limits (admin only)
+	SetMerchantLimits(context.Context, *SetMerchantLimitsRequest) (*MerchantLimits, error)
+	// Removes a merchant's overrides so the platform defaults apply (admin only)
+	DeleteMerchantLimits(context.Context, *GetMerchantLimitsRequest) (*emptypb.Empty, error)
+	// Gets the content rules applied to a merchant's field titles and descriptions (admin only)
+	GetMerchantContentRules(context.Context, *GetMerchantLimitsRequest) (*MerchantContentRules, error)
+	// Replaces the platform content rules for a merchant (admin only)
+	SetMerchantContentRules(context.Context, *SetMerchantContentRulesRequest) (*MerchantContentRules, error)
+	// Lists a merchant's forms for support staff (platform admin only, audited)
+	AdminListForms(context.Context, *AdminListFormsRequest) (*AdminListFormsResponse, error)
+	// Gets a form of any merchant for support staff (platform admin only, audited)
+	AdminGetForm(context.Context, *common.ID) (*Form, error)
+	// Deletes all forms, templates, responses, files and Keto tuples of a merchant in resumable
+	// background batches (platform admin only, audited). Returns the purge to poll for progress.
+	PurgeMerchantData(context.Context, *PurgeMerchantDataRequest) (*MerchantPurge, error)
+	// Reports the progress of a merchant data purge (platform admin only)
+	GetMerchantPurge(context.Context, *common.ID) (*MerchantPurge, error)
+	// Exports every document in which a user is the creator, last editor or respondent, for a
+	// GDPR access request (the user or a platform admin, audited)
+	ExportUserData(context.Context, *UserDataRequest) (*UserDataExport, error)
+	// Erases a user by replacing their ID with a pseudonym everywhere; responses keep their answers
+	// for statistics unless delete_responses is set (the user or a platform admin, audited)
+	EraseUserData(context.Context, *EraseUserDataRequest) (*UserDataErasure, error)
+	// Generates a default UI Schema for a JSON Schema
+	GenerateUISchema(context.Context, *GenerateUISchemaRequest) (*GenerateUISchemaResponse, error)
+	// Checks a JSON Schema and its UI Schema, returning errors that prevent saving and
+	// best-practice warnings for the form builder
+	ValidateFormSchema(context.Context, *ValidateFormSchemaRequest) (*ValidateFormSchemaResponse, error)
+	// Gets several forms by ID in one call, in the requested order
+	GetFormsByIDs(context.Context, *GetFormsByIDsRequest) (*GetFormsByIDsResponse, error)
+	mustEmbedUnimplementedFormServiceServer()
+}
+
+// UnimplementedFormServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFormServiceServer struct {
+}
+
+func (UnimplementedFormServiceServer) CreateFormTemplate(context.Context, *CreateFormTemplateRequest) (*CreateFormTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFormTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) ListFormTemplates(context.Context, *ListFormTemplatesRequest) (*ListFormTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFormTemplates not implemented")
+}
+func (UnimplementedFormServiceServer) GetFormTemplate(context.Context, *common.ID) (*FormTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) UpdateFormTemplate(context.Context, *UpdateFormTemplateRequest) (*FormTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFormTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) DeleteFormTemplate(context.Context, *common.ID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFormTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) DuplicateFormTemplate(context.Context, *DuplicateFormTemplateRequest) (*DuplicateFormTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateFormTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) ExportTemplate(context.Context, *common.ID) (*ExportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) ImportTemplate(context.Context, *ImportTemplateRequest) (*ImportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) ImportForm(context.Context, *ImportFormRequest) (*ImportFormResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportForm not implemented")
+}
+func (UnimplementedFormServiceServer) CreateFieldBlock(context.Context, *CreateFieldBlockRequest) (*FieldBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFieldBlock not implemented")
+}
+func (UnimplementedFormServiceServer) ListFieldBlocks(context.Context, *ListFieldBlocksRequest) (*ListFieldBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFieldBlocks not implemented")
+}
+func (UnimplementedFormServiceServer) GetFieldBlock(context.Context, *common.ID) (*FieldBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFieldBlock not implemented")
+}
+func (UnimplementedFormServiceServer) UpdateFieldBlock(context.Context, *UpdateFieldBlockRequest) (*FieldBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFieldBlock not implemented")
+}
+func (UnimplementedFormServiceServer) DeleteFieldBlock(context.Context, *common.ID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFieldBlock not implemented")
+}
+func (UnimplementedFormServiceServer) GetConfig(context.Context, *emptypb.Empty) (*ConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedFormServiceServer) RequestUploadURL(context.Context, *RequestUploadURLRequest) (*UploadURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUploadURL not implemented")
+}
+func (UnimplementedFormServiceServer) SubmitFormResponse(context.Context, *SubmitFormResponseRequest) (*FormSubmission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFormResponse not implemented")
+}
+func (UnimplementedFormServiceServer) ImportSubmissions(context.Context, *ImportSubmissionsRequest) (*ImportSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSubmissions not implemented")
+}
+func (UnimplementedFormServiceServer) ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissions not implemented")
+}
+func (UnimplementedFormServiceServer) StreamFormResponses(*StreamFormResponsesRequest, FormService_StreamFormResponsesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFormResponses not implemented")
+}
+func (UnimplementedFormServiceServer) GetFormResponseStats(context.Context, *GetFormResponseStatsRequest) (*FormResponseStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormResponseStats not implemented")
+}
+func (UnimplementedFormServiceServer) PublishForm(context.Context, *common.ID) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishForm not implemented")
+}
+func (UnimplementedFormServiceServer) CloseForm(context.Context, *common.ID) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseForm not implemented")
+}
+func (UnimplementedFormServiceServer) SetFormSchedule(context.Context, *SetFormScheduleRequest) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFormSchedule not implemented")
+}
+func (UnimplementedFormServiceServer) SetFormQuotas(context.Context, *SetFormQuotasRequest) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFormQuotas not implemented")
+}
+func (UnimplementedFormServiceServer) SetFormRetention(context.Context, *SetFormRetentionRequest) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFormRetention not implemented")
+}
+func (UnimplementedFormServiceServer) SetFormSpamProtection(context.Context, *SetFormSpamProtectionRequest) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFormSpamProtection not implemented")
+}
+func (UnimplementedFormServiceServer) SetFormSubmissionMode(context.Context, *SetFormSubmissionModeRequest) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFormSubmissionMode not implemented")
+}
+func (UnimplementedFormServiceServer) CreateFormInvitations(context.Context, *CreateFormInvitationsRequest) (*CreateFormInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFormInvitations not implemented")
+}
+func (UnimplementedFormServiceServer) CheckInAttendee(context.Context, *CheckInAttendeeRequest) (*CheckInAttendeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInAttendee not implemented")
+}
+func (UnimplementedFormServiceServer) IssueCheckInToken(context.Context, *IssueCheckInTokenRequest) (*CheckInToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCheckInToken not implemented")
+}
+func (UnimplementedFormServiceServer) RedeemCheckInToken(context.Context, *RedeemCheckInTokenRequest) (*CheckInAttendeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCheckInToken not implemented")
+}
+func (UnimplementedFormServiceServer) GetSessionAttendance(context.Context, *GetSessionAttendanceRequest) (*SessionAttendance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionAttendance not implemented")
+}
+func (UnimplementedFormServiceServer) ShareForm(context.Context, *ShareFormRequest) (*FormCollaborators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareForm not implemented")
+}
+func (UnimplementedFormServiceServer) UnshareForm(context.Context, *UnshareFormRequest) (*FormCollaborators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareForm not implemented")
+}
+func (UnimplementedFormServiceServer) ListFormCollaborators(context.Context, *common.ID) (*FormCollaborators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFormCollaborators not implemented")
+}
+func (UnimplementedFormServiceServer) CompareFormToTemplate(context.Context, *common.ID) (*FormTemplateComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareFormToTemplate not implemented")
+}
+func (UnimplementedFormServiceServer) CompareFormSchemas(context.Context, *CompareFormSchemasRequest) (*SchemaComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareFormSchemas not implemented")
+}
+func (UnimplementedFormServiceServer) GetSubmissionIndexReport(context.Context, *emptypb.Empty) (*SubmissionIndexReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionIndexReport not implemented")
+}
+func (UnimplementedFormServiceServer) CheckEventConsistency(context.Context, *CheckEventConsistencyRequest) (*EventConsistencyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEventConsistency not implemented")
+}
+func (UnimplementedFormServiceServer) CheckReferentialIntegrity(context.Context, *CheckReferentialIntegrityRequest) (*IntegrityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReferentialIntegrity not implemented")
+}
+func (UnimplementedFormServiceServer) GetMerchantUsage(context.Context, *emptypb.Empty) (*MerchantUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantUsage not implemented")
+}
+func (UnimplementedFormServiceServer) ListMerchantLimits(context.Context, *ListMerchantLimitsRequest) (*ListMerchantLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchantLimits not implemented")
+}
+func (UnimplementedFormServiceServer) GetMerchantLimits(context.Context, *GetMerchantLimitsRequest) (*MerchantLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantLimits not implemented")
+}
+func (UnimplementedFormServiceServer) SetMerchantLimits(context.Context, *SetMerchantLimitsRequest) (*MerchantLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMerchantLimits not implemented")
+}
+func (UnimplementedFormServiceServer) DeleteMerchantLimits(context.Context, *GetMerchantLimitsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMerchantLimits not implemented")
+}
+func (UnimplementedFormServiceServer) GetMerchantContentRules(context.Context, *GetMerchantLimitsRequest) (*MerchantContentRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantContentRules not implemented")
+}
+func (UnimplementedFormServiceServer) SetMerchantContentRules(context.Context, *SetMerchantContentRulesRequest) (*MerchantContentRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMerchantContentRules not implemented")
+}
+func (UnimplementedFormServiceServer) AdminListForms(context.Context, *AdminListFormsRequest) (*AdminListFormsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminListForms not implemented")
+}
+func (UnimplementedFormServiceServer) AdminGetForm(context.Context, *common.ID) (*Form, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetForm not implemented")
+}
+func (UnimplementedFormServiceServer) PurgeMerchantData(context.Context, *PurgeMerchantDataRequest) (*MerchantPurge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMerchantData not implemented")
+}
+func (UnimplementedFormServiceServer) GetMerchantPurge(context.Context, *common.ID) (*MerchantPurge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantPurge not implemented")
+}
+func (UnimplementedFormServiceServer) ExportUserData(context.Context, *UserDataRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedFormServiceServer) EraseUserData(context.Context, *EraseUserDataRequest) (*UserDataErasure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUserData not implemented")
+}
+func (UnimplementedFormServiceServer) GenerateUISchema(context.Context, *GenerateUISchemaRequest) (*GenerateUISchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateUISchema not implemented")
+}
+func (UnimplementedFormServiceServer) ValidateFormSchema(context.Context, *ValidateFormSchemaRequest) (*ValidateFormSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFormSchema not implemented")
+}
+func (UnimplementedFormServiceServer) GetFormsByIDs(context.Context, *GetFormsByIDsRequest) (*GetFormsByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormsByIDs not implemented")
+}
+func (UnimplementedFormServiceServer) mustEmbedUnimplementedFormServiceServer() {}
+
+// UnsafeFormServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FormServiceServer will
+// result in compilation errors.
+type UnsafeFormServiceServer interface {
+	mustEmbedUnimplementedFormServiceServer()
+}
+
+func RegisterFormServiceServer(s grpc.ServiceRegistrar, srv FormServiceServer) {
+	s.RegisterService(&FormService_ServiceDesc, srv)
+}
+
+func _FormService_CreateFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFormTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CreateFormTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CreateFormTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CreateFormTemplate(ctx, req.(*CreateFormTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ListFormTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFormTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ListFormTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ListFormTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ListFormTemplates(ctx, req.(*ListFormTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetFormTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetFormTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetFormTemplate(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_UpdateFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFormTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).UpdateFormTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_UpdateFormTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).UpdateFormTemplate(ctx, req.(*UpdateFormTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_DeleteFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).DeleteFormTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_DeleteFormTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).DeleteFormTemplate(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_DuplicateFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateFormTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).DuplicateFormTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_DuplicateFormTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).DuplicateFormTemplate(ctx, req.(*DuplicateFormTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ExportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ExportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ExportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ExportTemplate(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ImportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ImportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ImportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ImportTemplate(ctx, req.(*ImportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ImportForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ImportForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ImportForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ImportForm(ctx, req.(*ImportFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CreateFieldBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFieldBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CreateFieldBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CreateFieldBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CreateFieldBlock(ctx, req.(*CreateFieldBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ListFieldBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFieldBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ListFieldBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ListFieldBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ListFieldBlocks(ctx, req.(*ListFieldBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetFieldBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetFieldBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetFieldBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetFieldBlock(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_UpdateFieldBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFieldBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).UpdateFieldBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_UpdateFieldBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).UpdateFieldBlock(ctx, req.(*UpdateFieldBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_DeleteFieldBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).DeleteFieldBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_DeleteFieldBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).DeleteFieldBlock(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetConfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_RequestUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).RequestUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_RequestUploadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).RequestUploadURL(ctx, req.(*RequestUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SubmitFormResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFormResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SubmitFormResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SubmitFormResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SubmitFormResponse(ctx, req.(*SubmitFormResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ImportSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ImportSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ImportSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ImportSubmissions(ctx, req.(*ImportSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ListSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ListSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ListSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ListSubmissions(ctx, req.(*ListSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_StreamFormResponses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFormResponsesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FormServiceServer).StreamFormResponses(m, &formServiceStreamFormResponsesServer{stream})
+}
+
+type FormService_StreamFormResponsesServer interface {
+	Send(*FormSubmission) error
+	grpc.ServerStream
+}
+
+type formServiceStreamFormResponsesServer struct {
+	grpc.ServerStream
+}
+
+func (x *formServiceStreamFormResponsesServer) Send(m *FormSubmission) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FormService_GetFormResponseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFormResponseStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetFormResponseStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetFormResponseStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetFormResponseStats(ctx, req.(*GetFormResponseStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_PublishForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).PublishForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_PublishForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).PublishForm(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CloseForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CloseForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CloseForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CloseForm(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetFormSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFormScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetFormSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetFormSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetFormSchedule(ctx, req.(*SetFormScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetFormQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFormQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetFormQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetFormQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetFormQuotas(ctx, req.(*SetFormQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetFormRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFormRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetFormRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetFormRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetFormRetention(ctx, req.(*SetFormRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetFormSpamProtection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFormSpamProtectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetFormSpamProtection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetFormSpamProtection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetFormSpamProtection(ctx, req.(*SetFormSpamProtectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetFormSubmissionMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFormSubmissionModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetFormSubmissionMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetFormSubmissionMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetFormSubmissionMode(ctx, req.(*SetFormSubmissionModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CreateFormInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFormInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CreateFormInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CreateFormInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CreateFormInvitations(ctx, req.(*CreateFormInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CheckInAttendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInAttendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CheckInAttendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CheckInAttendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CheckInAttendee(ctx, req.(*CheckInAttendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_IssueCheckInToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCheckInTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).IssueCheckInToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_IssueCheckInToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).IssueCheckInToken(ctx, req.(*IssueCheckInTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_RedeemCheckInToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCheckInTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).RedeemCheckInToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_RedeemCheckInToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).RedeemCheckInToken(ctx, req.(*RedeemCheckInTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetSessionAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetSessionAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetSessionAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetSessionAttendance(ctx, req.(*GetSessionAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ShareForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ShareForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ShareForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ShareForm(ctx, req.(*ShareFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_UnshareForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).UnshareForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_UnshareForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).UnshareForm(ctx, req.(*UnshareFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ListFormCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ListFormCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ListFormCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ListFormCollaborators(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CompareFormToTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CompareFormToTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CompareFormToTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CompareFormToTemplate(ctx, req.(*common.ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CompareFormSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareFormSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CompareFormSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CompareFormSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CompareFormSchemas(ctx, req.(*CompareFormSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetSubmissionIndexReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetSubmissionIndexReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetSubmissionIndexReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetSubmissionIndexReport(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CheckEventConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEventConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CheckEventConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CheckEventConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CheckEventConsistency(ctx, req.(*CheckEventConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_CheckReferentialIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReferentialIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).CheckReferentialIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_CheckReferentialIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).CheckReferentialIntegrity(ctx, req.(*CheckReferentialIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetMerchantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetMerchantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetMerchantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetMerchantUsage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ListMerchantLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchantLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ListMerchantLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ListMerchantLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ListMerchantLimits(ctx, req.(*ListMerchantLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetMerchantLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetMerchantLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetMerchantLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetMerchantLimits(ctx, req.(*GetMerchantLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetMerchantLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMerchantLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetMerchantLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetMerchantLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetMerchantLimits(ctx, req.(*SetMerchantLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFormServiceServer) mustEmbedUnimplementedFormServiceServer() {}
 
-// UnsafeFormServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FormServiceServer will
-// result in compilation errors.
-type UnsafeFormServiceServer interface {
-	mustEmbedUnimplementedFormServiceServer()
+func _FormService_DeleteMerchantLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).DeleteMerchantLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_DeleteMerchantLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).DeleteMerchantLimits(ctx, req.(*GetMerchantLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFormServiceServer(s grpc.ServiceRegistrar, srv FormServiceServer) {
-	s.RegisterService(&FormService_ServiceDesc, srv)
+func _FormService_GetMerchantContentRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetMerchantContentRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetMerchantContentRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetMerchantContentRules(ctx, req.(*GetMerchantLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_CreateFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFormTemplateRequest)
+func _FormService_SetMerchantContentRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMerchantContentRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).CreateFormTemplate(ctx, in)
+		return srv.(FormServiceServer).SetMerchantContentRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_CreateFormTemplate_FullMethodName,
+		FullMethod: FormService_SetMerchantContentRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).CreateFormTemplate(ctx, req.(*CreateFormTemplateRequest))
+		return srv.(FormServiceServer).SetMerchantContentRules(ctx, req.(*SetMerchantContentRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_ListFormTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFormTemplatesRequest)
+func _FormService_AdminListForms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListFormsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).ListFormTemplates(ctx, in)
+		return srv.(FormServiceServer).AdminListForms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_ListFormTemplates_FullMethodName,
+		FullMethod: FormService_AdminListForms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).ListFormTemplates(ctx, req.(*ListFormTemplatesRequest))
+		return srv.(FormServiceServer).AdminListForms(ctx, req.(*AdminListFormsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_GetFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _FormService_AdminGetForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).GetFormTemplate(ctx, in)
+		return srv.(FormServiceServer).AdminGetForm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_GetFormTemplate_FullMethodName,
+		FullMethod: FormService_AdminGetForm_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).GetFormTemplate(ctx, req.(*common.ID))
+		return srv.(FormServiceServer).AdminGetForm(ctx, req.(*common.ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_UpdateFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFormTemplateRequest)
+func _FormService_PurgeMerchantData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMerchantDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).UpdateFormTemplate(ctx, in)
+		return srv.(FormServiceServer).PurgeMerchantData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_UpdateFormTemplate_FullMethodName,
+		FullMethod: FormService_PurgeMerchantData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).UpdateFormTemplate(ctx, req.(*UpdateFormTemplateRequest))
+		return srv.(FormServiceServer).PurgeMerchantData(ctx, req.(*PurgeMerchantDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_DeleteFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _FormService_GetMerchantPurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(common.ID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).DeleteFormTemplate(ctx, in)
+		return srv.(FormServiceServer).GetMerchantPurge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_DeleteFormTemplate_FullMethodName,
+		FullMethod: FormService_GetMerchantPurge_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).DeleteFormTemplate(ctx, req.(*common.ID))
+		return srv.(FormServiceServer).GetMerchantPurge(ctx, req.(*common.ID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_DuplicateFormTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DuplicateFormTemplateRequest)
+func _FormService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).DuplicateFormTemplate(ctx, in)
+		return srv.(FormServiceServer).ExportUserData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_DuplicateFormTemplate_FullMethodName,
+		FullMethod: FormService_ExportUserData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).DuplicateFormTemplate(ctx, req.(*DuplicateFormTemplateRequest))
+		return srv.(FormServiceServer).ExportUserData(ctx, req.(*UserDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FormService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _FormService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FormServiceServer).GetConfig(ctx, in)
+		return srv.(FormServiceServer).EraseUserData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FormService_GetConfig_FullMethodName,
+		FullMethod: FormService_EraseUserData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FormServiceServer).GetConfig(ctx, req.(*emptypb.Empty))
+		return srv.(FormServiceServer).EraseUserData(ctx, req.(*EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GenerateUISchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUISchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GenerateUISchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GenerateUISchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GenerateUISchema(ctx, req.(*GenerateUISchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ValidateFormSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFormSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ValidateFormSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ValidateFormSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ValidateFormSchema(ctx, req.(*ValidateFormSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_GetFormsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFormsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).GetFormsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_GetFormsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).GetFormsByIDs(ctx, req.(*GetFormsByIDsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -337,10 +2129,304 @@ var FormService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DuplicateFormTemplate",
 			Handler:    _FormService_DuplicateFormTemplate_Handler,
 		},
+		{
+			MethodName: "ExportTemplate",
+			Handler:    _FormService_ExportTemplate_Handler,
+		},
+		{
+			MethodName: "ImportTemplate",
+			Handler:    _FormService_ImportTemplate_Handler,
+		},
+		{
+			MethodName: "ImportForm",
+			Handler:    _FormService_ImportForm_Handler,
+		},
+		{
+			MethodName: "CreateFieldBlock",
+			Handler:    _FormService_CreateFieldBlock_Handler,
+		},
+		{
+			MethodName: "ListFieldBlocks",
+			Handler:    _FormService_ListFieldBlocks_Handler,
+		},
+		{
+			MethodName: "GetFieldBlock",
+			Handler:    _FormService_GetFieldBlock_Handler,
+		},
+		{
+			MethodName: "UpdateFieldBlock",
+			Handler:    _FormService_UpdateFieldBlock_Handler,
+		},
+		{
+			MethodName: "DeleteFieldBlock",
+			Handler:    _FormService_DeleteFieldBlock_Handler,
+		},
 		{
 			MethodName: "GetConfig",
 			Handler:    _FormService_GetConfig_Handler,
 		},
+		{
+			MethodName: "RequestUploadURL",
+			Handler:    _FormService_RequestUploadURL_Handler,
+		},
+		{
+			MethodName: "SubmitFormResponse",
+			Handler:    _FormService_SubmitFormResponse_Handler,
+		},
+		{
+			MethodName: "ImportSubmissions",
+			Handler:    _FormService_ImportSubmissions_Handler,
+		},
+		{
+			MethodName: "ListSubmissions",
+			Handler:    _FormService_ListSubmissions_Handler,
+		},
+		{
+			MethodName: "GetFormResponseStats",
+			Handler:    _FormService_GetFormResponseStats_Handler,
+		},
+		{
+			MethodName: "PublishForm",
+			Handler:    _FormService_PublishForm_Handler,
+		},
+		{
+			MethodName: "CloseForm",
+			Handler:    _FormService_CloseForm_Handler,
+		},
+		{
+			MethodName: "SetFormSchedule",
+			Handler:    _FormService_SetFormSchedule_Handler,
+		},
+		{
+			MethodName: "SetFormQuotas",
+			Handler:    _FormService_SetFormQuotas_Handler,
+		},
+		{
+			MethodName: "SetFormRetention",
+			Handler:    _FormService_SetFormRetention_Handler,
+		},
+		{
+			MethodName: "SetFormSpamProtection",
+			Handler:    _FormService_SetFormSpamProtection_Handler,
+		},
+		{
+			MethodName: "SetFormSubmissionMode",
+			Handler:    _FormService_SetFormSubmissionMode_Handler,
+		},
+		{
+			MethodName: "CreateFormInvitations",
+			Handler:    _FormService_CreateFormInvitations_Handler,
+		},
+		{
+			MethodName: "CheckInAttendee",
+			Handler:    _FormService_CheckInAttendee_Handler,
+		},
+		{
+			MethodName: "IssueCheckInToken",
+			Handler:    _FormService_IssueCheckInToken_Handler,
+		},
+		{
+			MethodName: "RedeemCheckInToken",
+			Handler:    _FormService_RedeemCheckInToken_Handler,
+		},
+		{
+			MethodName: "GetSessionAttendance",
+			Handler:    _FormService_GetSessionAttendance_Handler,
+		},
+		{
+			MethodName: "ShareForm",
+			Handler:    _FormService_ShareForm_Handler,
+		},
+		{
+			MethodName: "UnshareForm",
+			Handler:    _FormService_UnshareForm_Handler,
+		},
+		{
+			MethodName: "ListFormCollaborators",
+			Handler:    _FormService_ListFormCollaborators_Handler,
+		},
+		{
+			MethodName: "CompareFormToTemplate",
+			Handler:    _FormService_CompareFormToTemplate_Handler,
+		},
+		{
+			MethodName: "CompareFormSchemas",
+			Handler:    _FormService_CompareFormSchemas_Handler,
+		},
+		{
+			MethodName: "GetSubmissionIndexReport",
+			Handler:    _FormService_GetSubmissionIndexReport_Handler,
+		},
+		{
+			MethodName: "CheckEventConsistency",
+			Handler:    _FormService_CheckEventConsistency_Handler,
+		},
+		{
+			MethodName: "CheckReferentialIntegrity",
+			Handler:    _FormService_CheckReferentialIntegrity_Handler,
+		},
+		{
+			MethodName: "GetMerchantUsage",
+			Handler:    _FormService_GetMerchantUsage_Handler,
+		},
+		{
+			MethodName: "ListMerchantLimits",
+			Handler:    _FormService_ListMerchantLimits_Handler,
+		},
+		{
+			MethodName: "GetMerchantLimits",
+			Handler:    _FormService_GetMerchantLimits_Handler,
+		},
+		{
+			MethodName: "SetMerchantLimits",
+			Handler:    _FormService_SetMerchantLimits_Handler,
+		},
+		{
+			MethodName: "DeleteMerchantLimits",
+			Handler:    _FormService_DeleteMerchantLimits_Handler,
+		},
+		{
+			MethodName: "GetMerchantContentRules",
+			Handler:    _FormService_GetMerchantContentRules_Handler,
+		},
+		{
+			MethodName: "SetMerchantContentRules",
+			Handler:    _FormService_SetMerchantContentRules_Handler,
+		},
+		{
+			MethodName: "AdminListForms",
+			Handler:    _FormService_AdminListForms_Handler,
+		},
+		{
+			MethodName: "AdminGetForm",
+			Handler:    _FormService_AdminGetForm_Handler,
+		},
+		{
+			MethodName: "PurgeMerchantData",
+			Handler:    _FormService_PurgeMerchantData_Handler,
+		},
+		{
+			MethodName: "GetMerchantPurge",
+			Handler:    _FormService_GetMerchantPurge_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _FormService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _FormService_EraseUserData_Handler,
+		},
+		{
+			MethodName: "GenerateUISchema",
+			Handler:    _FormService_GenerateUISchema_Handler,
+		},
+		{
+			MethodName: "ValidateFormSchema",
+			Handler:    _FormService_ValidateFormSchema_Handler,
+		},
+		{
+			MethodName: "GetFormsByIDs",
+			Handler:    _FormService_GetFormsByIDs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFormResponses",
+			Handler:       _FormService_StreamFormResponses_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/form_service.proto",
+}
+
+const (
+	PublicFormService_GetPublicForm_FullMethodName = "/form.service.PublicFormService/GetPublicForm"
+)
+
+// PublicFormServiceClient is the client API for PublicFormService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PublicFormServiceClient interface {
+	// Gets the schema and UI schema of a published event form for rendering
+	GetPublicForm(ctx context.Context, in *GetPublicFormRequest, opts ...grpc.CallOption) (*PublicForm, error)
+}
+
+type publicFormServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPublicFormServiceClient(cc grpc.ClientConnInterface) PublicFormServiceClient {
+	return &publicFormServiceClient{cc}
+}
+
+func (c *publicFormServiceClient) GetPublicForm(ctx context.Context, in *GetPublicFormRequest, opts ...grpc.CallOption) (*PublicForm, error) {
+	out := new(PublicForm)
+	err := c.cc.Invoke(ctx, PublicFormService_GetPublicForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PublicFormServiceServer is the server API for PublicFormService service.
+// All implementations must embed UnimplementedPublicFormServiceServer
+// for forward compatibility
+type PublicFormServiceServer interface {
+	// Gets the schema and UI schema of a published event form for rendering
+	GetPublicForm(context.Context, *GetPublicFormRequest) (*PublicForm, error)
+	mustEmbedUnimplementedPublicFormServiceServer()
+}
+
+// UnimplementedPublicFormServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPublicFormServiceServer struct {
+}
+
+func (UnimplementedPublicFormServiceServer) GetPublicForm(context.Context, *GetPublicFormRequest) (*PublicForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicForm not implemented")
+}
+func (UnimplementedPublicFormServiceServer) mustEmbedUnimplementedPublicFormServiceServer() {}
+
+// UnsafePublicFormServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PublicFormServiceServer will
+// result in compilation errors.
+type UnsafePublicFormServiceServer interface {
+	mustEmbedUnimplementedPublicFormServiceServer()
+}
+
+func RegisterPublicFormServiceServer(s grpc.ServiceRegistrar, srv PublicFormServiceServer) {
+	s.RegisterService(&PublicFormService_ServiceDesc, srv)
+}
+
+func _PublicFormService_GetPublicForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicFormRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicFormServiceServer).GetPublicForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PublicFormService_GetPublicForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicFormServiceServer).GetPublicForm(ctx, req.(*GetPublicFormRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PublicFormService_ServiceDesc is the grpc.ServiceDesc for PublicFormService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PublicFormService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "form.service.PublicFormService",
+	HandlerType: (*PublicFormServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPublicForm",
+			Handler:    _PublicFormService_GetPublicForm_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/form_service.proto",
@@ -0,0 +1,197 @@
+// Package app composes the form service from its configuration: the database, repositories,
+// services, gRPC servers, health checks and background jobs. The server command runs it with
+// Vulpes; other binaries can embed it by registering it on their own gRPC server and gateway.
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/arwoosa/form/conf"
+	pb "github.com/arwoosa/form/gen/pb/form"
+	"github.com/arwoosa/form/internal/dao/mongodb"
+	"github.com/arwoosa/form/internal/health"
+	"github.com/arwoosa/form/internal/importer"
+	"github.com/arwoosa/form/internal/interceptor"
+	"github.com/arwoosa/form/internal/job"
+	"github.com/arwoosa/form/internal/service"
+	"github.com/arwoosa/form/internal/storage"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+)
+
+// App is the composed form service. Create it with New, register it on a gRPC server and
+// gateway, then call Start; Shutdown releases everything New and Start acquired.
+type App struct {
+	config       *conf.AppConfig
+	repos        *repositories
+	formServer   pb.FormServiceServer
+	publicServer pb.PublicFormServiceServer
+	interceptors []grpc.UnaryServerInterceptor
+	drainer      *interceptor.Drainer
+	checker      *health.Checker
+	queue        *job.Queue
+	storage      storage.Storage // nil when file uploads are not configured
+	jobs         *backgroundJobs
+	keto         bool
+}
+
+// New connects to the database and Keto and builds the services from the configuration
+func New(ctx context.Context, appConfig *conf.AppConfig) (*App, error) {
+	if appConfig == nil {
+		return nil, fmt.Errorf("form service configuration is required")
+	}
+
+	repos, err := openRepositories(ctx, appConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &App{
+		config:  appConfig,
+		repos:   repos,
+		drainer: interceptor.NewDrainer(),
+		queue:   newQueue(appConfig, repos),
+	}
+	a.initKeto()
+	a.buildServices()
+	a.interceptors = a.formInterceptors()
+	a.checker = a.newHealthChecker()
+	return a, nil
+}
+
+// initKeto initializes the Keto relation client used for authorization
+func (a *App) initKeto() {
+	cfg := a.config.KetoConfig
+	if cfg == nil {
+		log.Warn("Keto configuration not found - authorization features may not work")
+		return
+	}
+
+	log.Info("Initializing Keto relation client",
+		log.String("write_addr", cfg.WriteAddr),
+		log.String("read_addr", cfg.ReadAddr))
+	relation.Initialize(
+		relation.WithWriteAddr(cfg.WriteAddr),
+		relation.WithReadAddr(cfg.ReadAddr),
+	)
+	a.keto = true
+	log.Info("Keto relation client initialized successfully")
+}
+
+// buildServices creates the form services and the gRPC servers exposing them
+func (a *App) buildServices() {
+	appConfig := a.config
+	repos := a.repos
+
+	// Domain events are recorded in the outbox and published by the relay started with the jobs
+	var events *service.EventOutbox
+	if appConfig.EventBusConfig != nil && appConfig.EventBusConfig.Provider != "" {
+		events = service.NewEventOutbox(repos.outbox, repos.tx)
+	}
+
+	limitsService := service.NewLimitsService(repos.limits, appConfig)
+	blockService := service.NewFieldBlockService(repos.fieldBlocks, appConfig)
+	contentRulesService := service.NewContentRulesService(repos.contentRules, appConfig)
+	templateService := service.NewFormTemplateService(repos.templates, repos.forms, limitsService, blockService, contentRulesService, importer.NewRegistry(), appConfig)
+	spamGuard := newSpamGuard(appConfig)
+	formService := service.NewFormService(repos.forms, repos.templates, repos.submissions, limitsService, newPublicFormCache(appConfig), events, spamGuard, blockService, contentRulesService, appConfig)
+	configService := service.NewConfigService(appConfig)
+	invitationService := service.NewFormInvitationService(repos.invitations, repos.forms, appConfig)
+	a.storage = newStorage(appConfig)
+	fileService := service.NewFormFileService(repos.files, repos.forms, a.storage, appConfig)
+	// Answers to PII fields are refused unless a KMS is configured to encrypt them
+	var encryption *service.AnswerEncryption
+	if keys := newKMS(appConfig); keys != nil {
+		encryption = service.NewAnswerEncryption(repos.merchantKeys, keys)
+	}
+	submissionService := service.NewFormSubmissionService(repos.submissions, repos.forms, repos.filterUsage, invitationService, fileService, events, encryption, spamGuard, appConfig)
+	filterIndexService := service.NewFilterIndexService(repos.filterUsage, repos.submissions, appConfig)
+	consistencyService := service.NewConsistencyService(repos.forms, appConfig)
+	usageService := service.NewUsageService(repos.templates, repos.forms, repos.submissions, limitsService, appConfig)
+	collaboratorService := service.NewCollaboratorService(repos.forms, appConfig)
+	purgeService := service.NewMerchantPurgeService(repos.purges, formService, a.storage, a.queue, appConfig)
+	a.queue.Register(service.MerchantPurgeJob, purgeService.RunPurgeJob)
+	userDataService := service.NewUserDataService(repos.userData, a.storage, encryption, appConfig)
+	attendanceService := service.NewAttendanceService(repos.attendance, repos.submissions, repos.forms, appConfig)
+
+	a.formServer = service.NewGRPCFormServer(templateService, formService, configService, submissionService, filterIndexService, consistencyService, invitationService, limitsService, contentRulesService, usageService, fileService, collaboratorService, purgeService, userDataService, blockService, attendanceService)
+	a.publicServer = service.NewGRPCPublicFormServer(formService, spamGuard)
+	log.Info("Form services initialized", log.String("database", repos.driver))
+}
+
+// RegisterGRPC registers the form services, wrapped in their interceptors, and grpc.health.v1.
+// Streams are only drained; they check their caller themselves.
+func (a *App) RegisterGRPC(s grpc.ServiceRegistrar) {
+	wrapped := interceptor.WrapStreams(interceptor.WrapRegistrar(s, a.interceptors...), a.drainer.StreamServerInterceptor())
+	pb.RegisterFormServiceServer(wrapped, a.formServer)
+	pb.RegisterPublicFormServiceServer(wrapped, a.publicServer)
+	healthpb.RegisterHealthServer(s, a.checker.Server())
+}
+
+// RegisterGateway registers the REST handlers of the form services, proxied to the gRPC
+// endpoint, and the HTTP /healthz and /readyz probes
+func (a *App) RegisterGateway(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	if err := pb.RegisterFormServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+	if err := pb.RegisterPublicFormServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+	return a.checker.RegisterGateway(ctx, mux, endpoint, opts)
+}
+
+// Start refreshes the health status and runs the background jobs until the context is cancelled
+// or Shutdown is called
+func (a *App) Start(ctx context.Context) {
+	var interval time.Duration
+	if a.config.HealthConfig != nil {
+		interval = a.config.HealthConfig.CheckInterval
+	}
+	go a.checker.Run(ctx, interval)
+
+	a.jobs = startJobs(ctx, a.config, a.repos, a.queue, a.storage)
+}
+
+// Shutdown fails readiness, lets the in-flight calls and background jobs finish, flushes the
+// outbox and closes MongoDB and Keto, all within the configured deadline
+func (a *App) Shutdown() {
+	var cfg conf.ShutdownConfig
+	if a.config.ShutdownConfig != nil {
+		cfg = *a.config.ShutdownConfig
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+
+	// Give load balancers time to notice the failing readiness before rejecting calls
+	a.checker.Shutdown()
+	if cfg.DrainDelay > 0 {
+		log.Info("Waiting for load balancers to stop routing", log.Duration("drain_delay", cfg.DrainDelay))
+		time.Sleep(cfg.DrainDelay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	if err := a.drainer.Drain(ctx); err != nil {
+		log.Warn("Shutdown deadline reached with calls in flight", log.Int("in_flight", a.drainer.InFlight()))
+	}
+	if a.jobs != nil {
+		if err := a.jobs.Stop(ctx); err != nil {
+			log.Warn("Shutdown deadline reached before background jobs stopped", log.Err(err))
+		}
+	}
+	if err := mongodb.Close(ctx); err != nil {
+		log.Error("Failed to disconnect from MongoDB", log.Err(err))
+	}
+	if a.keto {
+		relation.Close()
+	}
+}
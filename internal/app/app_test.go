@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/arwoosa/form/conf"
+	pb "github.com/arwoosa/form/gen/pb/form"
+)
+
+func TestApp_EmbedsWithMemoryDatabase(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	appConfig := &conf.AppConfig{
+		DatabaseConfig: &conf.DatabaseConfig{Driver: "memory"},
+		ShutdownConfig: &conf.ShutdownConfig{Timeout: time.Second},
+	}
+	formApp, err := New(ctx, appConfig)
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	formApp.RegisterGRPC(server)
+	services := server.GetServiceInfo()
+	assert.Contains(t, services, pb.FormService_ServiceDesc.ServiceName)
+	assert.Contains(t, services, pb.PublicFormService_ServiceDesc.ServiceName)
+	assert.Contains(t, services, "grpc.health.v1.Health")
+
+	formApp.Start(ctx)
+	formApp.Shutdown()
+}
+
+func TestNew_RequiresConfiguration(t *testing.T) {
+	_, err := New(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func TestEndpointAddr(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{endpoint: "orders:9000", want: "orders:9000"},
+		{endpoint: "http://orders", want: "orders:80"},
+		{endpoint: "https://orders/api", want: "orders:443"},
+		{endpoint: "http://orders:8080", want: "orders:8080"},
+		{endpoint: "/relative", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			got, err := endpointAddr(tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
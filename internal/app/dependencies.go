@@ -0,0 +1,170 @@
+package app
+
+import (
+	"encoding/base64"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/bus"
+	"github.com/arwoosa/form/internal/cache"
+	"github.com/arwoosa/form/internal/kms"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/service"
+	"github.com/arwoosa/form/internal/spam"
+	"github.com/arwoosa/form/internal/storage"
+
+	"github.com/arwoosa/vulpes/log"
+)
+
+// newStorage creates the object storage used for file uploads, or nil when uploads are not configured
+func newStorage(appConfig *conf.AppConfig) storage.Storage {
+	cfg := appConfig.StorageConfig
+	if cfg == nil || cfg.Provider == "" {
+		log.Info("File uploads disabled - no storage provider configured")
+		return nil
+	}
+
+	switch cfg.Provider {
+	case "s3":
+		store, err := storage.NewS3(storage.S3Config{
+			Endpoint:        cfg.Endpoint,
+			Region:          cfg.Region,
+			Bucket:          cfg.Bucket,
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+			PathStyle:       cfg.PathStyle,
+		})
+		if err != nil {
+			log.Error("File uploads disabled - invalid storage configuration", log.Err(err))
+			return nil
+		}
+		return store
+	default:
+		log.Error("File uploads disabled - unknown storage provider", log.String("provider", cfg.Provider))
+		return nil
+	}
+}
+
+// newKMS creates the KMS wrapping the data keys of PII answers, or nil when encryption is not configured
+func newKMS(appConfig *conf.AppConfig) kms.KMS {
+	cfg := appConfig.EncryptionConfig
+	if cfg == nil || cfg.Provider == "" {
+		log.Info("PII field encryption disabled - no KMS provider configured")
+		return nil
+	}
+
+	switch cfg.Provider {
+	case "local":
+		masterKey, err := base64.StdEncoding.DecodeString(cfg.MasterKey)
+		if err != nil {
+			log.Error("PII field encryption disabled - master key is not valid base64", log.Err(err))
+			return nil
+		}
+		keys, err := kms.NewLocal(cfg.KeyID, masterKey)
+		if err != nil {
+			log.Error("PII field encryption disabled - invalid encryption configuration", log.Err(err))
+			return nil
+		}
+		return keys
+	default:
+		log.Error("PII field encryption disabled - unknown KMS provider", log.String("provider", cfg.Provider))
+		return nil
+	}
+}
+
+// newSpamGuard creates the spam protection of form submissions. Forms can only enable the
+// defenses configured here; the honeypot and per-address throttling are always available.
+func newSpamGuard(appConfig *conf.AppConfig) *service.SpamGuard {
+	cfg := appConfig.SpamConfig
+	if cfg == nil {
+		cfg = &conf.SpamConfig{}
+	}
+
+	verifiers := make(map[models.CaptchaProvider]spam.Verifier)
+	if cfg.Captcha.HCaptchaSecret != "" {
+		verifier, err := spam.NewHCaptcha(cfg.Captcha.HCaptchaSecret, cfg.Captcha.Timeout)
+		if err != nil {
+			log.Error("hCaptcha disabled - invalid captcha configuration", log.Err(err))
+		} else {
+			verifiers[models.CaptchaProviderHCaptcha] = verifier
+		}
+	}
+	if cfg.Captcha.TurnstileSecret != "" {
+		verifier, err := spam.NewTurnstile(cfg.Captcha.TurnstileSecret, cfg.Captcha.Timeout)
+		if err != nil {
+			log.Error("Turnstile disabled - invalid captcha configuration", log.Err(err))
+		} else {
+			verifiers[models.CaptchaProviderTurnstile] = verifier
+		}
+	}
+	if cfg.TokenSecret == "" {
+		log.Info("Minimum fill times disabled - no submission token secret configured")
+	}
+
+	log.Info("Spam protection initialized",
+		log.String("store", cfg.Store),
+		log.Int("captcha_providers", len(verifiers)))
+	return service.NewSpamGuard([]byte(cfg.TokenSecret), cfg.TokenMaxAge, verifiers, newRateLimitStore(appConfig, cfg.Store))
+}
+
+// newPublicFormCache creates the Redis cache of public form reads, or nil when it is disabled
+func newPublicFormCache(appConfig *conf.AppConfig) cache.Store {
+	if appConfig.CacheConfig == nil || appConfig.CacheConfig.PublicFormTTL <= 0 {
+		return nil
+	}
+	if appConfig.RedisConfig == nil {
+		log.Warn("Public form cache disabled - no redis configuration was found")
+		return nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     appConfig.RedisConfig.Addr,
+		Password: appConfig.RedisConfig.Password,
+		DB:       appConfig.RedisConfig.DB,
+	})
+	return cache.NewRedisStore(client, "form:cache:")
+}
+
+// newEventPublisher creates the publisher of domain events, or nil when no message bus is configured
+func newEventPublisher(appConfig *conf.AppConfig) bus.Publisher {
+	cfg := appConfig.EventBusConfig
+	if cfg == nil || cfg.Provider == "" {
+		log.Info("Domain events disabled - no message bus configured")
+		return nil
+	}
+
+	var (
+		publisher bus.Publisher
+		err       error
+	)
+	switch cfg.Provider {
+	case "nats":
+		publisher, err = bus.NewNATS(bus.NATSConfig{
+			URL:           cfg.NATS.URL,
+			Token:         cfg.NATS.Token,
+			User:          cfg.NATS.User,
+			Password:      cfg.NATS.Password,
+			SubjectPrefix: cfg.NATS.SubjectPrefix,
+			Timeout:       cfg.Timeout,
+		})
+	case "kafka":
+		publisher, err = bus.NewKafka(bus.KafkaConfig{
+			Endpoint: cfg.Kafka.Endpoint,
+			Topic:    cfg.Kafka.Topic,
+			Username: cfg.Kafka.Username,
+			Password: cfg.Kafka.Password,
+			Timeout:  cfg.Timeout,
+		})
+	default:
+		log.Error("Domain events disabled - unknown message bus provider", log.String("provider", cfg.Provider))
+		return nil
+	}
+	if err != nil {
+		log.Error("Domain events disabled - invalid message bus configuration", log.Err(err))
+		return nil
+	}
+
+	log.Info("Domain events enabled", log.String("provider", cfg.Provider))
+	return publisher
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/arwoosa/form/conf"
+	pb "github.com/arwoosa/form/gen/pb/form"
+	"github.com/arwoosa/form/internal/health"
+
+	"github.com/arwoosa/vulpes/log"
+)
+
+// newHealthChecker creates the checker behind grpc.health.v1 and the HTTP /healthz and /readyz
+// probes; readiness follows the database, Keto and, if required, the order service
+func (a *App) newHealthChecker() *health.Checker {
+	appConfig := a.config
+	var cfg conf.HealthConfig
+	if appConfig.HealthConfig != nil {
+		cfg = *appConfig.HealthConfig
+	}
+
+	var checks []health.Check
+	if a.repos.mongoClient != nil {
+		checks = append(checks, health.Check{Name: "mongodb", Required: true, Probe: health.MongoPing(a.repos.mongoClient)})
+	}
+	if appConfig.KetoConfig != nil {
+		checks = append(checks,
+			health.Check{Name: "keto_read", Required: true, Probe: health.Dial(appConfig.KetoConfig.ReadAddr)},
+			health.Check{Name: "keto_write", Required: true, Probe: health.Dial(appConfig.KetoConfig.WriteAddr)},
+		)
+	}
+	if appConfig.ExternalConfig != nil && appConfig.ExternalConfig.OrderService.Endpoint != "" {
+		if addr, err := endpointAddr(appConfig.ExternalConfig.OrderService.Endpoint); err != nil {
+			log.Warn("Order service health check disabled - invalid endpoint", log.Err(err))
+		} else {
+			checks = append(checks, health.Check{Name: "order_service", Required: cfg.RequireOrderService, Probe: health.Dial(addr)})
+		}
+	}
+
+	return health.NewChecker(cfg.CheckTimeout, []string{
+		pb.FormService_ServiceDesc.ServiceName,
+		pb.PublicFormService_ServiceDesc.ServiceName,
+	}, checks...)
+}
+
+// endpointAddr returns the host:port of an endpoint given as a URL or as host:port
+func endpointAddr(endpoint string) (string, error) {
+	// A URL such as http://host also splits, into host "http" and port "//host"
+	if !strings.Contains(endpoint, "://") {
+		if _, _, err := net.SplitHostPort(endpoint); err == nil {
+			return endpoint, nil
+		}
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("endpoint %q has no host", endpoint)
+	}
+	if u.Port() != "" {
+		return u.Host, nil
+	}
+	port := "80"
+	if u.Scheme == "https" {
+		port = "443"
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/authz"
+	"github.com/arwoosa/form/internal/idempotency"
+	"github.com/arwoosa/form/internal/ratelimit"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+)
+
+// formInterceptors returns the unary interceptors applied to the form services, in order
+func (a *App) formInterceptors() []grpc.UnaryServerInterceptor {
+	appConfig := a.config
+
+	// Calls arriving during shutdown are rejected before any other work is done
+	interceptors := []grpc.UnaryServerInterceptor{a.drainer.UnaryServerInterceptor()}
+
+	// Client addresses are resolved once for rate limits and spam protection
+	var trustedProxies int
+	if appConfig.RateLimitConfig != nil {
+		trustedProxies = appConfig.RateLimitConfig.TrustedProxies
+	}
+	interceptors = append(interceptors, ratelimit.ClientIPInterceptor(trustedProxies))
+
+	// Rate limiting rejects abusive callers before any other work is done
+	if appConfig.RateLimitConfig != nil && appConfig.RateLimitConfig.Enabled {
+		interceptors = append(interceptors, rateLimitInterceptor(appConfig))
+	}
+
+	// Permissions are checked before an idempotent response could be replayed to the caller
+	if appConfig.AuthorizationConfig != nil && appConfig.AuthorizationConfig.Enabled {
+		interceptors = append(interceptors, authorizationInterceptor(appConfig))
+	}
+
+	if appConfig.IdempotencyConfig != nil && len(appConfig.IdempotencyConfig.Methods) > 0 {
+		interceptors = append(interceptors, idempotency.NewInterceptor(a.repos.idempotency,
+			appConfig.IdempotencyConfig.TTL, appConfig.IdempotencyConfig.Methods).UnaryServerInterceptor())
+	}
+
+	return interceptors
+}
+
+// rateLimitInterceptor creates the rate limiting interceptor from the configuration
+func rateLimitInterceptor(appConfig *conf.AppConfig) grpc.UnaryServerInterceptor {
+	cfg := appConfig.RateLimitConfig
+	limiter := ratelimit.NewLimiter(newRateLimitStore(appConfig, cfg.Store),
+		ratelimit.Rule{Rate: cfg.PerIP.Rate, Burst: cfg.PerIP.Burst},
+		ratelimit.Rule{Rate: cfg.PerMerchant.Rate, Burst: cfg.PerMerchant.Burst},
+		cfg.Methods,
+	)
+
+	log.Info("Rate limiting enabled",
+		log.String("store", cfg.Store),
+		log.Int("methods", len(cfg.Methods)))
+
+	return limiter.UnaryServerInterceptor()
+}
+
+// newRateLimitStore creates the token bucket store of the given kind: "redis" is shared by all
+// instances, anything else is kept in memory per instance
+func newRateLimitStore(appConfig *conf.AppConfig, kind string) ratelimit.Store {
+	if kind != "redis" {
+		return ratelimit.NewMemoryStore()
+	}
+	if appConfig.RedisConfig == nil {
+		log.Warn("Rate limiting uses Redis but no redis configuration was found - falling back to memory")
+		return ratelimit.NewMemoryStore()
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     appConfig.RedisConfig.Addr,
+		Password: appConfig.RedisConfig.Password,
+		DB:       appConfig.RedisConfig.DB,
+	})
+	return ratelimit.NewRedisStore(client, "form:ratelimit:")
+}
+
+// authorizationInterceptor creates the Keto permission interceptor from the configuration
+func authorizationInterceptor(appConfig *conf.AppConfig) grpc.UnaryServerInterceptor {
+	cfg := appConfig.AuthorizationConfig
+	if appConfig.KetoConfig == nil {
+		log.Warn("Permission checks enabled without a keto configuration - checked calls will be rejected")
+	}
+
+	rules := make([]authz.Rule, len(cfg.Rules))
+	for i, rule := range cfg.Rules {
+		rules[i] = authz.Rule{
+			Method:    rule.Method,
+			Namespace: rule.Namespace,
+			Relation:  rule.Relation,
+			IDField:   rule.IDField,
+		}
+	}
+
+	log.Info("Permission checks enabled", log.Int("methods", len(rules)))
+	return authz.NewAuthorizer(relation.Check, rules).UnaryServerInterceptor()
+}
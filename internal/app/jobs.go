@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/job"
+	"github.com/arwoosa/form/internal/storage"
+
+	"github.com/arwoosa/vulpes/log"
+)
+
+// backgroundJobs are the running background jobs of the form service
+type backgroundJobs struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	relay  *job.OutboxRelay
+}
+
+// startJobs starts the form background jobs; they run until Stop is called or the context is
+// cancelled
+func startJobs(ctx context.Context, appConfig *conf.AppConfig, repos *repositories, queue *job.Queue, store storage.Storage) *backgroundJobs {
+	ctx, cancel := context.WithCancel(ctx)
+	jobs := &backgroundJobs{cancel: cancel}
+
+	var closeInterval time.Duration
+	var retentionConfig conf.RetentionJobConfig
+	if appConfig.JobsConfig != nil {
+		closeInterval = appConfig.JobsConfig.FormCloseInterval
+		retentionConfig = appConfig.JobsConfig.Retention
+	}
+	jobs.run(ctx, job.NewFormCloser(repos.forms, closeInterval).Run)
+	jobs.run(ctx, job.NewRetentionCleaner(repos.retention, store, retentionConfig.Interval, retentionConfig.BatchSize).Run)
+
+	// Asynchronous work is queued in the database; the services registered their handlers
+	jobs.run(ctx, queue.Run)
+
+	if publisher := newEventPublisher(appConfig); publisher != nil {
+		var outboxConfig conf.OutboxConfig
+		if appConfig.EventBusConfig != nil {
+			outboxConfig = appConfig.EventBusConfig.Outbox
+		}
+		jobs.relay = job.NewOutboxRelay(repos.outbox, publisher, job.OutboxRelayOptions{
+			PollInterval:    outboxConfig.PollInterval,
+			LeaseTimeout:    outboxConfig.LeaseTimeout,
+			RetryBackoff:    outboxConfig.RetryBackoff,
+			MaxRetryBackoff: outboxConfig.MaxRetryBackoff,
+			Retention:       outboxConfig.Retention,
+		})
+		jobs.run(ctx, jobs.relay.Run)
+	}
+
+	return jobs
+}
+
+// newQueue creates the queue of asynchronous work stored in the database; services register
+// their handlers by job type before the jobs start
+func newQueue(appConfig *conf.AppConfig, repos *repositories) *job.Queue {
+	var queueConfig conf.JobQueueConfig
+	if appConfig.JobsConfig != nil {
+		queueConfig = appConfig.JobsConfig.Queue
+	}
+	return job.NewQueue(repos.jobs, job.QueueOptions{
+		Workers:           queueConfig.Workers,
+		PollInterval:      queueConfig.PollInterval,
+		VisibilityTimeout: queueConfig.VisibilityTimeout,
+		MaxAttempts:       queueConfig.MaxAttempts,
+		RetryBackoff:      queueConfig.RetryBackoff,
+		MaxRetryBackoff:   queueConfig.MaxRetryBackoff,
+		Retention:         queueConfig.Retention,
+	})
+}
+
+// run runs a job in the background until the context is cancelled
+func (j *backgroundJobs) run(ctx context.Context, fn func(ctx context.Context)) {
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		fn(ctx)
+	}()
+}
+
+// Stop stops the jobs and waits for the work in progress, then publishes the events left in the
+// outbox. It gives up waiting when the context expires.
+func (j *backgroundJobs) Stop(ctx context.Context) error {
+	j.cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		j.wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		return fmt.Errorf("background jobs still running: %w", ctx.Err())
+	}
+
+	if j.relay == nil {
+		return nil
+	}
+	if published := j.relay.Flush(ctx); published > 0 {
+		log.Info("Flushed outbox events on shutdown", log.Int("published", published))
+	}
+	return j.relay.Close()
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/memory"
+	"github.com/arwoosa/form/internal/dao/mongodb"
+	"github.com/arwoosa/form/internal/dao/repository"
+
+	"github.com/arwoosa/vulpes/log"
+)
+
+// repositories are the data access implementations of the configured database driver
+type repositories struct {
+	driver       string
+	mongoClient  *mongo.Client // nil for the memory driver
+	forms        repository.FormRepository
+	templates    repository.FormTemplateRepository
+	submissions  repository.FormSubmissionRepository
+	filterUsage  repository.FilterUsageRepository
+	invitations  repository.FormInvitationRepository
+	limits       repository.LimitsRepository
+	contentRules repository.ContentRulesRepository
+	files        repository.FormFileRepository
+	idempotency  repository.IdempotencyRepository
+	jobs         repository.JobRepository
+	outbox       repository.OutboxRepository
+	purges       repository.MerchantPurgeRepository
+	userData     repository.UserDataRepository
+	merchantKeys repository.MerchantKeyRepository
+	retention    repository.RetentionRepository
+	fieldBlocks  repository.FieldBlockRepository
+	attendance   repository.AttendanceRepository
+	tx           repository.TransactionRunner
+}
+
+// memoryStore holds the data of the memory driver, shared by every App of the process
+var memoryStore = sync.OnceValue(memory.NewStore)
+
+// openRepositories connects to the configured database and creates the repositories on it
+func openRepositories(ctx context.Context, appConfig *conf.AppConfig) (*repositories, error) {
+	if appConfig.DatabaseConfig != nil && appConfig.DatabaseConfig.Driver == "memory" {
+		log.Warn("Using the in-memory database - data is lost when the server stops")
+		store := memoryStore()
+		return &repositories{
+			driver:       "memory",
+			forms:        memory.NewFormRepository(store),
+			templates:    memory.NewFormTemplateRepository(store),
+			submissions:  memory.NewFormSubmissionRepository(store),
+			filterUsage:  memory.NewFilterUsageRepository(store),
+			invitations:  memory.NewFormInvitationRepository(store),
+			limits:       memory.NewLimitsRepository(store),
+			contentRules: memory.NewContentRulesRepository(store),
+			files:        memory.NewFormFileRepository(store),
+			idempotency:  memory.NewIdempotencyRepository(store),
+			jobs:         memory.NewJobRepository(store),
+			outbox:       memory.NewOutboxRepository(store),
+			purges:       memory.NewMerchantPurgeRepository(store),
+			userData:     memory.NewUserDataRepository(store),
+			merchantKeys: memory.NewMerchantKeyRepository(store),
+			retention:    memory.NewRetentionRepository(store),
+			fieldBlocks:  memory.NewFieldBlockRepository(store),
+			attendance:   memory.NewAttendanceRepository(store),
+			tx:           store,
+		}, nil
+	}
+
+	mongoClient, err := mongodb.InitMongoDB(ctx, appConfig.MongodbConfig)
+	if err != nil {
+		return nil, fmt.Errorf("form service requires a MongoDB connection: %w", err)
+	}
+
+	mongoRepo := newMongoRepository(mongoClient, appConfig)
+	return &repositories{
+		driver:       "mongodb",
+		mongoClient:  mongoClient,
+		forms:        repository.NewFormRepository(mongoRepo),
+		templates:    repository.NewFormTemplateRepository(mongoRepo),
+		submissions:  repository.NewFormSubmissionRepository(mongoRepo),
+		filterUsage:  repository.NewFilterUsageRepository(mongoRepo),
+		invitations:  repository.NewFormInvitationRepository(mongoRepo),
+		limits:       repository.NewLimitsRepository(mongoRepo),
+		contentRules: repository.NewContentRulesRepository(mongoRepo),
+		files:        repository.NewFormFileRepository(mongoRepo),
+		idempotency:  repository.NewIdempotencyRepository(mongoRepo),
+		jobs:         repository.NewJobRepository(mongoRepo),
+		outbox:       repository.NewOutboxRepository(mongoRepo),
+		purges:       repository.NewMerchantPurgeRepository(mongoRepo),
+		userData:     repository.NewUserDataRepository(mongoRepo),
+		merchantKeys: repository.NewMerchantKeyRepository(mongoRepo),
+		retention:    repository.NewRetentionRepository(mongoRepo),
+		fieldBlocks:  repository.NewFieldBlockRepository(mongoRepo),
+		attendance:   repository.NewAttendanceRepository(mongoRepo),
+		tx:           mongoRepo,
+	}, nil
+}
+
+// newMongoRepository creates the repository base with the configured query timeout and slow
+// query threshold
+func newMongoRepository(mongoClient *mongo.Client, appConfig *conf.AppConfig) *repository.MongoRepository {
+	cfg := appConfig.MongodbConfig
+	return repository.NewMongoRepository(mongoClient, cfg.DB).WithQueryLimits(repository.QueryLimits{
+		Timeout:       cfg.QueryTimeout,
+		SlowThreshold: cfg.SlowQueryThreshold,
+	})
+}
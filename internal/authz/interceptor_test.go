@@ -0,0 +1,103 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/arwoosa/form/gen/pb/common"
+	pb "github.com/arwoosa/form/gen/pb/form"
+)
+
+const testMethod = "/form.service.FormService/UpdateFormTemplate"
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func userContext(userID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDKey, userID))
+}
+
+func newTestAuthorizer(check CheckFunc) grpc.UnaryServerInterceptor {
+	return NewAuthorizer(check, []Rule{
+		{Method: testMethod, Namespace: "FormTemplate", Relation: "editor", IDField: "id"},
+		{Method: "/form.service.FormService/ListSubmissions", Namespace: "Form", Relation: "viewer", IDField: "form_id"},
+	}).UnaryServerInterceptor()
+}
+
+func TestAuthorizer_ChecksRelation(t *testing.T) {
+	var checked []string
+	interceptor := newTestAuthorizer(func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		checked = append(checked, namespace+":"+object+"#"+relation+"@"+subjectNamespace+":"+subjectObject)
+		return subjectObject == "user-1", nil
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	req := &pb.UpdateFormTemplateRequest{Id: "template-1"}
+
+	resp, err := interceptor(userContext("user-1"), req, info, okHandler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(userContext("user-2"), req, info, okHandler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	assert.Equal(t, []string{
+		"FormTemplate:template-1#editor@User:user-1",
+		"FormTemplate:template-1#editor@User:user-2",
+	}, checked)
+}
+
+func TestAuthorizer_ReadsIDField(t *testing.T) {
+	var object string
+	interceptor := newTestAuthorizer(func(ctx context.Context, namespace, obj, relation, subjectNamespace, subjectObject string) (bool, error) {
+		object = obj
+		return true, nil
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/form.service.FormService/ListSubmissions"}
+
+	_, err := interceptor(userContext("user-1"), &pb.ListSubmissionsRequest{FormId: "form-1"}, info, okHandler)
+	require.NoError(t, err)
+	assert.Equal(t, "form-1", object)
+
+	// A request without the configured field cannot be checked
+	_, err = interceptor(userContext("user-1"), &common.ID{Id: "form-1"}, info, okHandler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthorizer_Rejections(t *testing.T) {
+	interceptor := newTestAuthorizer(func(context.Context, string, string, string, string, string) (bool, error) {
+		return false, errors.New("connection refused")
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	req := &pb.UpdateFormTemplateRequest{Id: "template-1"}
+
+	_, err := interceptor(context.Background(), req, info, okHandler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(userContext("user-1"), &pb.UpdateFormTemplateRequest{}, info, okHandler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Keto failures do not let the call through
+	_, err = interceptor(userContext("user-1"), req, info, okHandler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestAuthorizer_SkipsOtherMethods(t *testing.T) {
+	interceptor := newTestAuthorizer(func(context.Context, string, string, string, string, string) (bool, error) {
+		t.Fatal("unexpected permission check")
+		return false, nil
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/form.service.FormService/GetConfig"}
+
+	resp, err := interceptor(context.Background(), nil, info, okHandler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
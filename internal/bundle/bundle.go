@@ -0,0 +1,97 @@
+// Package bundle packages form templates as self-contained, signed JSON documents that can be
+// imported in another environment or by another merchant.
+package bundle
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Format identifies template packages
+const Format = "arwoosa.form.template"
+
+// Version is the version of the package layout written by Seal
+const Version = 1
+
+// Package errors
+var (
+	ErrMalformedPackage = errors.New("malformed template package")
+	ErrInvalidSignature = errors.New("invalid template package signature")
+)
+
+// Template is the content of a package
+type Template struct {
+	Name             string      `json:"name"`
+	SourceTemplateID string      `json:"source_template_id"` // Template the package was exported from
+	TemplateVersion  int         `json:"template_version"`
+	Schema           interface{} `json:"schema"`
+	UISchema         interface{} `json:"uischema,omitempty"`
+	ExportedAt       time.Time   `json:"exported_at"`
+}
+
+// envelope is the JSON document of a package. The signature is an HMAC-SHA256 of the compacted
+// content, so the document may be re-indented but its content not changed.
+type envelope struct {
+	Format    string          `json:"format"`
+	Version   int             `json:"version"`
+	Content   json.RawMessage `json:"content"`
+	Signature string          `json:"signature"`
+}
+
+// Seal encodes a template as a package signed with the secret
+func Seal(secret []byte, t *Template) ([]byte, error) {
+	content, err := json.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("bundle: encode template: %w", err)
+	}
+	return json.MarshalIndent(envelope{
+		Format:    Format,
+		Version:   Version,
+		Content:   content,
+		Signature: base64.RawURLEncoding.EncodeToString(signature(secret, content)),
+	}, "", "  ")
+}
+
+// Open verifies the signature of a package and returns its template
+func Open(secret []byte, data []byte) (*Template, error) {
+	var e envelope
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedPackage, err)
+	}
+	if e.Format != Format {
+		return nil, fmt.Errorf("%w: not a template package", ErrMalformedPackage)
+	}
+	if e.Version != Version {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrMalformedPackage, e.Version)
+	}
+
+	var content bytes.Buffer
+	if err := json.Compact(&content, e.Content); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedPackage, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(e.Signature)
+	if err != nil || !hmac.Equal(sig, signature(secret, content.Bytes())) {
+		return nil, ErrInvalidSignature
+	}
+
+	var t Template
+	if err := json.Unmarshal(content.Bytes(), &t); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedPackage, err)
+	}
+	if t.Schema == nil {
+		return nil, fmt.Errorf("%w: template has no schema", ErrMalformedPackage)
+	}
+	return &t, nil
+}
+
+func signature(secret, content []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(content)
+	return mac.Sum(nil)
+}
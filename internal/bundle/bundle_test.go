@@ -0,0 +1,60 @@
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTemplate() *Template {
+	return &Template{
+		Name:             "Registration",
+		SourceTemplateID: "64b7f0c2a1b2c3d4e5f60718",
+		TemplateVersion:  3,
+		Schema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+		},
+		UISchema:   map[string]interface{}{"ui:order": []interface{}{"name"}},
+		ExportedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	secret := []byte("secret")
+	data, err := Seal(secret, testTemplate())
+	require.NoError(t, err)
+
+	opened, err := Open(secret, data)
+
+	require.NoError(t, err)
+	assert.Equal(t, testTemplate(), opened)
+
+	// Re-indenting the document keeps the signature valid
+	var compact bytes.Buffer
+	require.NoError(t, json.Compact(&compact, data))
+	_, err = Open(secret, compact.Bytes())
+	assert.NoError(t, err)
+}
+
+func TestOpen_Rejected(t *testing.T) {
+	secret := []byte("secret")
+	data, err := Seal(secret, testTemplate())
+	require.NoError(t, err)
+
+	_, err = Open([]byte("other secret"), data)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	tampered := bytes.Replace(data, []byte("Registration"), []byte("Hijacked"), 1)
+	_, err = Open(secret, tampered)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	for _, malformed := range []string{`not json`, `{"format": "other"}`, `{"format": "arwoosa.form.template", "version": 2}`} {
+		_, err = Open(secret, []byte(malformed))
+		assert.ErrorIs(t, err, ErrMalformedPackage)
+	}
+}
@@ -0,0 +1,93 @@
+// Package bus publishes domain events to a message bus so other services can subscribe to form
+// changes instead of polling.
+package bus
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// EnvelopeVersion is the version of the envelope layout. Payloads carry their own version in
+// SchemaVersion, so consumers can handle both changing independently.
+const EnvelopeVersion = 1
+
+// Source identifies this service in published envelopes
+const Source = "form-service"
+
+// Event types
+const (
+	TypeFormUpdated       = "form.updated"
+	TypeFormPublished     = "form.published"
+	TypeFormClosed        = "form.closed"
+	TypeResponseSubmitted = "form.response_submitted"
+)
+
+// Publisher publishes domain events to a message bus
+type Publisher interface {
+	// Publish delivers the envelope to the bus. It returns once the bus has accepted the event.
+	Publish(ctx context.Context, envelope *Envelope) error
+	// Close releases the connection to the bus
+	Close() error
+}
+
+// Envelope wraps every published event with the metadata consumers need to route and
+// deduplicate it
+type Envelope struct {
+	ID              string          `json:"id"` // Unique per event; redeliveries keep the same ID
+	Type            string          `json:"type"`
+	EnvelopeVersion int             `json:"envelope_version"`
+	SchemaVersion   int             `json:"schema_version"` // Version of the payload layout for the event type
+	Source          string          `json:"source"`
+	MerchantID      string          `json:"merchant_id,omitempty"`
+	OccurredAt      time.Time       `json:"occurred_at"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewEnvelope wraps a payload of the given event type and schema version
+func NewEnvelope(eventType string, schemaVersion int, merchantID string, data any) (*Envelope, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		ID:              hex.EncodeToString(id),
+		Type:            eventType,
+		EnvelopeVersion: EnvelopeVersion,
+		SchemaVersion:   schemaVersion,
+		Source:          Source,
+		MerchantID:      merchantID,
+		OccurredAt:      time.Now().UTC(),
+		Data:            payload,
+	}, nil
+}
+
+// FormEvent is the payload of the form.* events (schema version 1)
+type FormEvent struct {
+	FormID        string     `json:"form_id"`
+	EventID       string     `json:"event_id,omitempty"`
+	Status        string     `json:"status"`
+	SchemaVersion int        `json:"schema_version"`
+	OpenAt        *time.Time `json:"open_at,omitempty"`
+	CloseAt       *time.Time `json:"close_at,omitempty"`
+	UpdatedBy     string     `json:"updated_by,omitempty"`
+}
+
+// ResponseSubmittedEvent is the payload of the form.response_submitted event (schema version 1).
+// Answers are left out; subscribers needing them read the submission through the API.
+type ResponseSubmittedEvent struct {
+	SubmissionID  string    `json:"submission_id"`
+	FormID        string    `json:"form_id"`
+	EventID       string    `json:"event_id,omitempty"`
+	SchemaVersion int       `json:"schema_version"`
+	SubmittedBy   string    `json:"submitted_by,omitempty"`
+	SubmittedAt   time.Time `json:"submitted_at"`
+}
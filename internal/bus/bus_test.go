@@ -0,0 +1,177 @@
+package bus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnvelope(t *testing.T) {
+	env, err := NewEnvelope(TypeFormUpdated, 1, "merchant-1", FormEvent{FormID: "f1", Status: "draft"})
+	require.NoError(t, err)
+
+	assert.Len(t, env.ID, 32)
+	assert.Equal(t, EnvelopeVersion, env.EnvelopeVersion)
+	assert.Equal(t, Source, env.Source)
+	assert.JSONEq(t, `{"form_id":"f1","status":"draft","schema_version":0}`, string(env.Data))
+
+	other, err := NewEnvelope(TypeFormUpdated, 1, "merchant-1", FormEvent{FormID: "f1"})
+	require.NoError(t, err)
+	assert.NotEqual(t, env.ID, other.ID)
+}
+
+// natsMessage is a PUB received by the fake NATS server
+type natsMessage struct {
+	subject string
+	payload []byte
+}
+
+// fakeNATS accepts connections speaking the core protocol and records published messages
+func fakeNATS(t *testing.T, token string) (string, <-chan natsMessage) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	messages := make(chan natsMessage, 10)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveNATS(conn, token, messages)
+		}
+	}()
+	return "nats://" + ln.Addr().String(), messages
+}
+
+func serveNATS(conn net.Conn, token string, messages chan<- natsMessage) {
+	defer func() { _ = conn.Close() }()
+	r := bufio.NewReader(conn)
+	_, _ = fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"auth_required\":true}\r\n")
+
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			return
+		}
+		switch {
+		case strings.HasPrefix(line, "CONNECT "):
+			var opts struct {
+				Token string `json:"auth_token"`
+			}
+			_ = json.Unmarshal([]byte(strings.TrimPrefix(line, "CONNECT ")), &opts)
+			if opts.Token != token {
+				_, _ = fmt.Fprint(conn, "-ERR 'Authorization Violation'\r\n")
+				return
+			}
+		case line == "PING":
+			_, _ = fmt.Fprint(conn, "PONG\r\n")
+		case strings.HasPrefix(line, "PUB "):
+			fields := strings.Fields(line)
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(r, payload); err != nil {
+				return
+			}
+			messages <- natsMessage{subject: fields[1], payload: payload[:size]}
+		}
+	}
+}
+
+func TestNATS_Publish(t *testing.T) {
+	url, messages := fakeNATS(t, "secret")
+	publisher, err := NewNATS(NATSConfig{URL: url, Token: "secret", SubjectPrefix: "events"})
+	require.NoError(t, err)
+	defer func() { _ = publisher.Close() }()
+
+	env, err := NewEnvelope(TypeResponseSubmitted, 1, "merchant-1", ResponseSubmittedEvent{SubmissionID: "s1", FormID: "f1"})
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), env))
+
+	msg := <-messages
+	assert.Equal(t, "events.form.response_submitted", msg.subject)
+	var received Envelope
+	require.NoError(t, json.Unmarshal(msg.payload, &received))
+	assert.Equal(t, env.ID, received.ID)
+	assert.Equal(t, TypeResponseSubmitted, received.Type)
+
+	// A dropped connection is re-opened by the next publish
+	publisher.mu.Lock()
+	_ = publisher.conn.Close()
+	publisher.mu.Unlock()
+	require.NoError(t, publisher.Publish(context.Background(), env))
+	msg = <-messages
+	assert.Equal(t, "events.form.response_submitted", msg.subject)
+}
+
+func TestNATS_Publish_Unauthorized(t *testing.T) {
+	url, _ := fakeNATS(t, "secret")
+	publisher, err := NewNATS(NATSConfig{URL: url, Token: "wrong"})
+	require.NoError(t, err)
+
+	env, err := NewEnvelope(TypeFormUpdated, 1, "merchant-1", FormEvent{FormID: "f1"})
+	require.NoError(t, err)
+	err = publisher.Publish(context.Background(), env)
+	assert.ErrorContains(t, err, "Authorization Violation")
+}
+
+func TestNewNATS_InvalidURL(t *testing.T) {
+	_, err := NewNATS(NATSConfig{URL: "http://nats:4222"})
+	assert.Error(t, err)
+	_, err = NewNATS(NATSConfig{URL: "nats"})
+	assert.Error(t, err)
+}
+
+func TestKafka_Publish(t *testing.T) {
+	var received kafkaProduceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/form-events", r.URL.Path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "pass", pass)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = fmt.Fprint(w, `{"offsets":[{"partition":0,"offset":1,"error_code":null,"error":null}]}`)
+	}))
+	defer server.Close()
+
+	publisher, err := NewKafka(KafkaConfig{Endpoint: server.URL, Topic: "form-events", Username: "user", Password: "pass"})
+	require.NoError(t, err)
+
+	env, err := NewEnvelope(TypeFormPublished, 1, "merchant-1", FormEvent{FormID: "f1", Status: "published"})
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), env))
+
+	require.Len(t, received.Records, 1)
+	assert.Equal(t, "merchant-1", received.Records[0].Key)
+	assert.Equal(t, env.ID, received.Records[0].Value.ID)
+}
+
+func TestKafka_Publish_RecordError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"offsets":[{"partition":null,"offset":null,"error_code":50002,"error":"Kafka error"}]}`)
+	}))
+	defer server.Close()
+
+	publisher, err := NewKafka(KafkaConfig{Endpoint: server.URL, Topic: "form-events"})
+	require.NoError(t, err)
+
+	env, err := NewEnvelope(TypeFormClosed, 1, "merchant-1", FormEvent{FormID: "f1"})
+	require.NoError(t, err)
+	assert.ErrorContains(t, publisher.Publish(context.Background(), env), "Kafka error")
+}
@@ -0,0 +1,117 @@
+package bus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// KafkaConfig holds the settings of a Kafka REST Proxy (Confluent REST API v2)
+type KafkaConfig struct {
+	Endpoint string // e.g. "http://kafka-rest:8082"
+	Topic    string
+	Username string // Optional basic authentication
+	Password string
+	Timeout  time.Duration
+}
+
+// Kafka is a Publisher producing to a Kafka topic through a REST Proxy. Events are keyed by
+// merchant, so the events of a merchant stay ordered within one partition.
+type Kafka struct {
+	config KafkaConfig
+	client *http.Client
+}
+
+// NewKafka creates a Kafka publisher
+func NewKafka(config KafkaConfig) (*Kafka, error) {
+	if config.Endpoint == "" || config.Topic == "" {
+		return nil, fmt.Errorf("bus: kafka endpoint and topic are required")
+	}
+	if _, err := url.Parse(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("bus: invalid kafka endpoint: %w", err)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+
+	return &Kafka{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+type kafkaRecord struct {
+	Key   string    `json:"key,omitempty"`
+	Value *Envelope `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Publish implements Publisher.Publish. The event is accepted once the proxy reports the
+// record was written to a partition.
+func (k *Kafka) Publish(ctx context.Context, envelope *Envelope) error {
+	body, err := json.Marshal(kafkaProduceRequest{
+		Records: []kafkaRecord{{Key: envelope.MerchantID, Value: envelope}},
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := strings.TrimRight(k.config.Endpoint, "/") + "/topics/" + url.PathEscape(k.config.Topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	if k.config.Username != "" {
+		req.SetBasicAuth(k.config.Username, k.config.Password)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("bus: kafka produce: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("bus: kafka produce: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var result kafkaProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("bus: kafka produce: %w", err)
+	}
+	for _, offset := range result.Offsets {
+		if offset.ErrorCode != nil || offset.Error != nil {
+			msg := "unknown error"
+			if offset.Error != nil {
+				msg = *offset.Error
+			}
+			return fmt.Errorf("bus: kafka produce: %s", msg)
+		}
+	}
+	return nil
+}
+
+// Close implements Publisher.Close
+func (k *Kafka) Close() error {
+	k.client.CloseIdleConnections()
+	return nil
+}
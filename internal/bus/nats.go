@@ -0,0 +1,248 @@
+package bus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+)
+
+// NATSConfig holds the settings of a NATS server
+type NATSConfig struct {
+	URL           string // e.g. "nats://nats:4222"
+	Token         string
+	User          string
+	Password      string
+	SubjectPrefix string // Events are published to "<prefix>.<type>", e.g. "events.form.updated"
+	Timeout       time.Duration
+}
+
+// NATS is a Publisher speaking the NATS core protocol. The connection is opened on the first
+// publish and re-opened after it breaks.
+type NATS struct {
+	config NATSConfig
+	addr   string
+
+	mu   sync.Mutex
+	conn net.Conn
+	w    *bufio.Writer
+}
+
+// NewNATS creates a NATS publisher
+func NewNATS(config NATSConfig) (*NATS, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("bus: invalid nats url %q", config.URL)
+	}
+	if u.Scheme != "nats" {
+		return nil, fmt.Errorf("bus: unsupported nats url scheme %q", u.Scheme)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	if config.SubjectPrefix == "" {
+		config.SubjectPrefix = "events"
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Second
+	}
+
+	return &NATS{config: config, addr: addr}, nil
+}
+
+// Publish implements Publisher.Publish. Core NATS has no acknowledgements, so the event is
+// accepted once it has been written to the server connection.
+func (n *NATS) Publish(ctx context.Context, envelope *Envelope) error {
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	subject := n.config.SubjectPrefix + "." + envelope.Type
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// A broken connection is only noticed on write, so retry once on a fresh one
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if n.conn == nil {
+			if err := n.connect(ctx); err != nil {
+				return err
+			}
+		}
+
+		err := n.write(subject, payload)
+		if err == nil {
+			return nil
+		}
+		n.closeConn()
+		if attempt > 0 {
+			return fmt.Errorf("bus: nats publish: %w", err)
+		}
+	}
+}
+
+// Close implements Publisher.Close
+func (n *NATS) Close() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.closeConn()
+	return nil
+}
+
+// write sends a PUB message; the caller holds mu
+func (n *NATS) write(subject string, payload []byte) error {
+	if err := n.conn.SetWriteDeadline(time.Now().Add(n.config.Timeout)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(n.w, "PUB %s %d\r\n", subject, len(payload)); err != nil {
+		return err
+	}
+	if _, err := n.w.Write(payload); err != nil {
+		return err
+	}
+	if _, err := n.w.WriteString("\r\n"); err != nil {
+		return err
+	}
+	return n.w.Flush()
+}
+
+// connect opens a connection and waits for the server to accept the credentials; the caller holds mu
+func (n *NATS) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: n.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return fmt.Errorf("bus: nats connect: %w", err)
+	}
+	if err := conn.SetDeadline(time.Now().Add(n.config.Timeout)); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	if err := n.handshake(r, w); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("bus: nats connect: %w", err)
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	n.conn = conn
+	n.w = w
+	go n.readLoop(conn, r)
+	return nil
+}
+
+// handshake reads the server INFO, sends CONNECT and waits for the PONG answering a PING,
+// which the server only sends once the connection is authorized
+func (n *NATS) handshake(r *bufio.Reader, w *bufio.Writer) error {
+	line, err := readLine(r)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected greeting %q", line)
+	}
+
+	connect, err := json.Marshal(map[string]any{
+		"verbose":    false,
+		"pedantic":   false,
+		"lang":       "go",
+		"name":       Source,
+		"protocol":   1,
+		"auth_token": n.config.Token,
+		"user":       n.config.User,
+		"pass":       n.config.Password,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "CONNECT %s\r\nPING\r\n", connect); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+// readLoop answers server pings and drops the connection when the server reports an error or
+// goes away, so the next publish reconnects
+func (n *NATS) readLoop(conn net.Conn, r *bufio.Reader) {
+loop:
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			break
+		}
+
+		switch {
+		case line == "PING":
+			n.mu.Lock()
+			if n.conn == conn {
+				_, err = n.w.WriteString("PONG\r\n")
+				if err == nil {
+					err = n.w.Flush()
+				}
+			}
+			n.mu.Unlock()
+			if err != nil {
+				break loop
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			log.Warn("NATS server reported an error", log.String("error", strings.TrimSpace(strings.TrimPrefix(line, "-ERR"))))
+		}
+	}
+
+	n.mu.Lock()
+	if n.conn == conn {
+		n.closeConn()
+	}
+	n.mu.Unlock()
+}
+
+// closeConn drops the current connection; the caller holds mu
+func (n *NATS) closeConn() {
+	if n.conn == nil {
+		return
+	}
+	_ = n.conn.Close()
+	n.conn = nil
+	n.w = nil
+}
+
+// readLine reads a protocol line without its CRLF
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
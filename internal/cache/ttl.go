@@ -0,0 +1,63 @@
+// Package cache provides small in-process caches for values that are expensive to compute
+// and may be served slightly stale.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTL is a concurrency-safe in-memory cache whose entries expire a fixed time after they are set
+type TTL[V any] struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]ttlEntry[V]
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// NewTTL creates a cache whose entries expire after ttl
+func NewTTL[V any](ttl time.Duration) *TTL[V] {
+	return &TTL[V]{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]ttlEntry[V]),
+	}
+}
+
+// Get returns the value cached for key, if it has not expired
+func (c *TTL[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Set caches value for key, replacing any previous value
+func (c *TTL[V]) Set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+// Delete removes the value cached for key
+func (c *TTL[V]) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
@@ -0,0 +1,56 @@
+// Package checkin signs the tokens encoded in the QR codes that registered respondents show to
+// check in to an event session.
+package checkin
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/signedtoken"
+)
+
+// Claims identifies the registration and session a token was issued for
+type Claims struct {
+	SubmissionID primitive.ObjectID
+	FormID       primitive.ObjectID
+	SessionID    string
+	ExpiresAt    time.Time
+}
+
+// Sign issues a signed token for the given claims
+func Sign(secret []byte, claims Claims) string {
+	// The session ID comes last as it is chosen by the event service and may contain colons
+	return signedtoken.Sign(secret, claims.SubmissionID.Hex(), claims.FormID.Hex(), signedtoken.FormatTime(claims.ExpiresAt), claims.SessionID)
+}
+
+// Parse verifies the signature and expiry of a token at the given time and returns its claims.
+// Errors are those of the signedtoken package.
+func Parse(secret []byte, token string, now time.Time) (*Claims, error) {
+	fields, err := signedtoken.Verify(secret, token, 4)
+	if err != nil {
+		return nil, err
+	}
+	submissionID, err := signedtoken.ParseObjectID(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	formID, err := signedtoken.ParseObjectID(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := signedtoken.ParseTime(fields[2])
+	if err != nil {
+		return nil, err
+	}
+	if err := signedtoken.CheckExpiry(expiresAt, now); err != nil {
+		return nil, err
+	}
+
+	return &Claims{
+		SubmissionID: submissionID,
+		FormID:       formID,
+		SessionID:    fields[3],
+		ExpiresAt:    expiresAt,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package checkin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/signedtoken"
+)
+
+func TestSignAndParse(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	claims := Claims{
+		SubmissionID: primitive.NewObjectID(),
+		FormID:       primitive.NewObjectID(),
+		SessionID:    "event:42:session:1",
+		ExpiresAt:    now.Add(time.Hour).Truncate(time.Second),
+	}
+
+	parsed, err := Parse(secret, Sign(secret, claims), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, claims.SubmissionID, parsed.SubmissionID)
+	assert.Equal(t, claims.FormID, parsed.FormID)
+	assert.Equal(t, claims.SessionID, parsed.SessionID)
+	assert.True(t, claims.ExpiresAt.Equal(parsed.ExpiresAt))
+}
+
+func TestParse_Errors(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	claims := Claims{
+		SubmissionID: primitive.NewObjectID(),
+		FormID:       primitive.NewObjectID(),
+		SessionID:    "session-1",
+		ExpiresAt:    now.Add(time.Hour),
+	}
+	token := Sign(secret, claims)
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		token    string
+		now      time.Time
+		expected error
+	}{
+		{"Wrong secret", []byte("other"), token, now, signedtoken.ErrInvalidSignature},
+		{"Missing signature", secret, "abc", now, signedtoken.ErrMalformedToken},
+		{"Missing session", secret, signedtoken.Sign(secret, claims.SubmissionID.Hex(), claims.FormID.Hex(), "1"), now, signedtoken.ErrMalformedToken},
+		{"Bad form ID", secret, signedtoken.Sign(secret, claims.SubmissionID.Hex(), "x", "1", "s"), now, signedtoken.ErrMalformedToken},
+		{"Expired", secret, token, now.Add(2 * time.Hour), signedtoken.ErrTokenExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.secret, tt.token, tt.now)
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
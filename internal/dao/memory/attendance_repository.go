@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewAttendanceRepository creates a new in-memory attendance repository
+func NewAttendanceRepository(store *Store) repository.AttendanceRepository {
+	return &memoryAttendanceRepository{store: store}
+}
+
+type memoryAttendanceRepository struct {
+	store *Store
+}
+
+// CheckIn implements AttendanceRepository.CheckIn
+func (r *memoryAttendanceRepository) CheckIn(ctx context.Context, attendance *models.Attendance) (*models.Attendance, error) {
+	if attendance.ID.IsZero() {
+		attendance.ID = primitive.NewObjectID()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, err := loadAll(r.store, attendance.TableName(), func(a *models.Attendance) bool {
+		return a.SubmissionID == attendance.SubmissionID && a.SessionID == attendance.SessionID
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return existing[0], nil
+	}
+	return nil, r.store.insert(attendance.TableName(), attendance.ID, attendance)
+}
+
+// CountBySession implements AttendanceRepository.CountBySession
+func (r *memoryAttendanceRepository) CountBySession(ctx context.Context, formID primitive.ObjectID, sessionID string) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	attendances, err := loadAll(r.store, models.Attendance{}.TableName(), func(a *models.Attendance) bool {
+		return a.FormID == formID && a.SessionID == sessionID
+	})
+	return int64(len(attendances)), err
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewContentRulesRepository creates a new in-memory content rules repository
+func NewContentRulesRepository(store *Store) repository.ContentRulesRepository {
+	return &memoryContentRulesRepository{store: store}
+}
+
+type memoryContentRulesRepository struct {
+	store *Store
+}
+
+// FindByMerchantID implements ContentRulesRepository.FindByMerchantID
+func (r *memoryContentRulesRepository) FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantContentRules, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return r.find(merchantID)
+}
+
+// Upsert implements ContentRulesRepository.Upsert
+func (r *memoryContentRulesRepository) Upsert(ctx context.Context, rules *models.MerchantContentRules) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	rules.UpdatedAt = now
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, err := r.find(rules.MerchantID)
+	if err != nil {
+		return err
+	}
+	document := *rules
+	if existing != nil {
+		document.ID = existing.ID
+		document.CreatedAt = existing.CreatedAt
+	} else {
+		document.ID = primitive.NewObjectID()
+		document.CreatedAt = now
+	}
+	return r.store.put(rules.TableName(), document.ID, &document)
+}
+
+// find returns the rules of a merchant, or nil. The caller holds a lock.
+func (r *memoryContentRulesRepository) find(merchantID string) (*models.MerchantContentRules, error) {
+	rules, err := loadAll(r.store, models.MerchantContentRules{}.TableName(), func(c *models.MerchantContentRules) bool {
+		return c.MerchantID == merchantID
+	})
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	return rules[0], nil
+}
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFieldBlockRepository creates a new in-memory field block repository
+func NewFieldBlockRepository(store *Store) repository.FieldBlockRepository {
+	return &memoryFieldBlockRepository{store: store}
+}
+
+type memoryFieldBlockRepository struct {
+	store *Store
+}
+
+// Create implements FieldBlockRepository.Create
+func (r *memoryFieldBlockRepository) Create(ctx context.Context, block *models.FieldBlock) error {
+	now := time.Now()
+	block.SetCreatedAt(now)
+	block.SetUpdatedAt(now)
+
+	if block.ID.IsZero() {
+		block.ID = primitive.NewObjectID()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(block.TableName(), block.ID, block)
+}
+
+// FindByID implements FieldBlockRepository.FindByID
+func (r *memoryFieldBlockRepository) FindByID(ctx context.Context, blockID primitive.ObjectID) (*models.FieldBlock, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	block, ok, err := load[models.FieldBlock](r.store, models.FieldBlock{}.TableName(), blockID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return block, nil
+}
+
+// FindByMerchantID implements FieldBlockRepository.FindByMerchantID
+func (r *memoryFieldBlockRepository) FindByMerchantID(ctx context.Context, options *models.FieldBlockQueryOptions) ([]*models.FieldBlock, int64, error) {
+	r.store.mu.RLock()
+	blocks, err := loadAll(r.store, models.FieldBlock{}.TableName(), func(b *models.FieldBlock) bool {
+		return b.MerchantID == options.MerchantID
+	})
+	r.store.mu.RUnlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(blocks, options.SortOrder, compareFieldBlocks(options.SortBy))
+	page := paginate(blocks, options.Page, options.PageSize)
+	if options.View == models.ListViewSummary {
+		for _, b := range page {
+			b.Schema = nil
+		}
+	}
+	return page, int64(len(blocks)), nil
+}
+
+// Update implements FieldBlockRepository.Update
+func (r *memoryFieldBlockRepository) Update(ctx context.Context, block *models.FieldBlock) error {
+	block.SetUpdatedAt(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, ok, err := load[models.FieldBlock](r.store, block.TableName(), block.ID)
+	if err != nil || !ok || existing.MerchantID != block.MerchantID {
+		return err
+	}
+	return r.store.put(block.TableName(), block.ID, block)
+}
+
+// Delete implements FieldBlockRepository.Delete
+func (r *memoryFieldBlockRepository) Delete(ctx context.Context, blockID primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.remove(models.FieldBlock{}.TableName(), blockID)
+	return nil
+}
+
+// compareFieldBlocks orders field blocks by a sort field, updated_at by default
+func compareFieldBlocks(sortBy string) func(a, b *models.FieldBlock) int {
+	switch sortBy {
+	case "name":
+		return func(a, b *models.FieldBlock) int { return cmp.Compare(a.Name, b.Name) }
+	case "created_at":
+		return func(a, b *models.FieldBlock) int { return cmp.Compare(a.CreatedAt, b.CreatedAt) }
+	default:
+		return func(a, b *models.FieldBlock) int { return cmp.Compare(a.UpdatedAt, b.UpdatedAt) }
+	}
+}
@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFilterUsageRepository creates a new in-memory filter usage repository
+func NewFilterUsageRepository(store *Store) repository.FilterUsageRepository {
+	return &memoryFilterUsageRepository{store: store}
+}
+
+type memoryFilterUsageRepository struct {
+	store *Store
+}
+
+// Record implements FilterUsageRepository.Record
+func (r *memoryFilterUsageRepository) Record(ctx context.Context, merchantID string, fields []string) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, field := range fields {
+		usages, err := loadAll(r.store, models.SubmissionFilterUsage{}.TableName(), func(u *models.SubmissionFilterUsage) bool {
+			return u.MerchantID == merchantID && u.Field == field
+		})
+		if err != nil {
+			return err
+		}
+
+		usage := &models.SubmissionFilterUsage{ID: primitive.NewObjectID(), MerchantID: merchantID, Field: field}
+		if len(usages) > 0 {
+			usage = usages[0]
+		}
+		usage.Count++
+		usage.LastUsedAt = now
+		if err := r.store.put(usage.TableName(), usage.ID, usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListFrequentFields implements FilterUsageRepository.ListFrequentFields
+func (r *memoryFilterUsageRepository) ListFrequentFields(ctx context.Context, minCount int64) ([]*models.FilterFieldUsage, error) {
+	r.store.mu.RLock()
+	usages, err := loadAll[models.SubmissionFilterUsage](r.store, models.SubmissionFilterUsage{}.TableName(), nil)
+	r.store.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	byField := make(map[string]*models.FilterFieldUsage)
+	for _, usage := range usages {
+		field, ok := byField[usage.Field]
+		if !ok {
+			field = &models.FilterFieldUsage{Field: usage.Field}
+			byField[usage.Field] = field
+		}
+		field.UsageCount += usage.Count
+		field.MerchantCount++
+		if lastUsedAt := usage.LastUsedAt.Time(); lastUsedAt.After(field.LastUsedAt) {
+			field.LastUsedAt = lastUsedAt
+		}
+	}
+
+	var result []*models.FilterFieldUsage
+	for _, field := range byField {
+		if field.UsageCount >= minCount {
+			result = append(result, field)
+		}
+	}
+	slices.SortFunc(result, func(a, b *models.FilterFieldUsage) int {
+		if c := cmp.Compare(b.UsageCount, a.UsageCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Field, b.Field)
+	})
+	return result, nil
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFormFileRepository creates a new in-memory form file repository
+func NewFormFileRepository(store *Store) repository.FormFileRepository {
+	return &memoryFormFileRepository{store: store}
+}
+
+type memoryFormFileRepository struct {
+	store *Store
+}
+
+// Create implements FormFileRepository.Create
+func (r *memoryFormFileRepository) Create(ctx context.Context, file *models.FileReference) error {
+	if file.ID.IsZero() {
+		file.ID = primitive.NewObjectID()
+	}
+	file.SetCreatedAt(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(file.TableName(), file.ID, file)
+}
+
+// FindByIDs implements FormFileRepository.FindByIDs
+func (r *memoryFormFileRepository) FindByIDs(ctx context.Context, fileIDs []primitive.ObjectID) ([]*models.FileReference, error) {
+	ids := make(map[primitive.ObjectID]bool, len(fileIDs))
+	for _, id := range fileIDs {
+		ids[id] = true
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return loadAll(r.store, models.FileReference{}.TableName(), func(f *models.FileReference) bool {
+		return ids[f.ID]
+	})
+}
+
+// Attach implements FormFileRepository.Attach
+func (r *memoryFormFileRepository) Attach(ctx context.Context, fileIDs []primitive.ObjectID, submissionID primitive.ObjectID) (int64, error) {
+	ids := make(map[primitive.ObjectID]bool, len(fileIDs))
+	for _, id := range fileIDs {
+		ids[id] = true
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// Matching only pending files keeps a file from being linked to two submissions
+	files, err := loadAll(r.store, models.FileReference{}.TableName(), func(f *models.FileReference) bool {
+		return ids[f.ID] && f.Status == models.FileStatusPending
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, file := range files {
+		file.Status = models.FileStatusAttached
+		file.SubmissionID = &submissionID
+		file.ExpiresAt = nil
+		if err := r.store.put(file.TableName(), file.ID, file); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(files)), nil
+}
+
+// Detach implements FormFileRepository.Detach
+func (r *memoryFormFileRepository) Detach(ctx context.Context, submissionID primitive.ObjectID, expiresAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	files, err := loadAll(r.store, models.FileReference{}.TableName(), func(f *models.FileReference) bool {
+		return sameID(f.SubmissionID, submissionID)
+	})
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		file.Status = models.FileStatusPending
+		file.SubmissionID = nil
+		file.SetExpiresAt(expiresAt)
+		if err := r.store.put(file.TableName(), file.ID, file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
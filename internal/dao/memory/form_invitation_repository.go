@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFormInvitationRepository creates a new in-memory form invitation repository
+func NewFormInvitationRepository(store *Store) repository.FormInvitationRepository {
+	return &memoryFormInvitationRepository{store: store}
+}
+
+type memoryFormInvitationRepository struct {
+	store *Store
+}
+
+// CreateMany implements FormInvitationRepository.CreateMany
+func (r *memoryFormInvitationRepository) CreateMany(ctx context.Context, invitations []*models.FormInvitation) error {
+	now := time.Now()
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, invitation := range invitations {
+		if invitation.ID.IsZero() {
+			invitation.ID = primitive.NewObjectID()
+		}
+		invitation.SetCreatedAt(now)
+		if err := r.store.insert(invitation.TableName(), invitation.ID, invitation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Redeem implements FormInvitationRepository.Redeem
+func (r *memoryFormInvitationRepository) Redeem(ctx context.Context, invitationID, formID primitive.ObjectID, usedBy string, usedAt time.Time) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	invitation, ok, err := load[models.FormInvitation](r.store, models.FormInvitation{}.TableName(), invitationID)
+	if err != nil || !ok || invitation.FormID != formID || invitation.UsedAt != nil {
+		return false, err
+	}
+
+	redeemedAt := primitive.NewDateTimeFromTime(usedAt)
+	invitation.UsedAt = &redeemedAt
+	invitation.UsedBy = usedBy
+	if err := r.store.put(invitation.TableName(), invitation.ID, invitation); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Release implements FormInvitationRepository.Release
+func (r *memoryFormInvitationRepository) Release(ctx context.Context, invitationID primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	invitation, ok, err := load[models.FormInvitation](r.store, models.FormInvitation{}.TableName(), invitationID)
+	if err != nil || !ok {
+		return err
+	}
+
+	invitation.UsedAt = nil
+	invitation.UsedBy = ""
+	return r.store.put(invitation.TableName(), invitation.ID, invitation)
+}
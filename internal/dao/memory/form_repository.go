@@ -0,0 +1,279 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFormRepository creates a new in-memory form repository
+func NewFormRepository(store *Store) repository.FormRepository {
+	return &memoryFormRepository{store: store}
+}
+
+type memoryFormRepository struct {
+	store *Store
+}
+
+// Create implements FormRepository.Create
+func (r *memoryFormRepository) Create(ctx context.Context, form *models.Form) error {
+	now := time.Now()
+	form.SetCreatedAt(now)
+	form.SetUpdatedAt(now)
+
+	if form.ID.IsZero() {
+		form.ID = primitive.NewObjectID()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(form.TableName(), form.ID, form)
+}
+
+// FindByID implements FormRepository.FindByID
+func (r *memoryFormRepository) FindByID(ctx context.Context, formID primitive.ObjectID) (*models.Form, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	form, ok, err := load[models.Form](r.store, models.Form{}.TableName(), formID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return form, nil
+}
+
+// FindByIDs implements FormRepository.FindByIDs
+func (r *memoryFormRepository) FindByIDs(ctx context.Context, formIDs []primitive.ObjectID) ([]*models.Form, error) {
+	ids := make(map[primitive.ObjectID]bool, len(formIDs))
+	for _, id := range formIDs {
+		ids[id] = true
+	}
+	return r.find(func(f *models.Form) bool { return ids[f.ID] })
+}
+
+// Find implements FormRepository.Find
+func (r *memoryFormRepository) Find(ctx context.Context, options *models.FormQueryOptions) ([]*models.Form, int64, error) {
+	forms, err := r.find(func(f *models.Form) bool {
+		if f.MerchantID != options.MerchantID {
+			return false
+		}
+		return options.EventID == nil || options.EventID.IsZero() || sameID(f.EventID, *options.EventID)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(forms, options.SortOrder, compareForms(options.SortBy))
+	return paginate(forms, options.Page, options.PageSize), int64(len(forms)), nil
+}
+
+// Update implements FormRepository.Update
+func (r *memoryFormRepository) Update(ctx context.Context, form *models.Form) error {
+	form.SetUpdatedAt(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if _, ok := r.store.tables[form.TableName()][form.ID]; !ok {
+		return nil
+	}
+	return r.store.put(form.TableName(), form.ID, form)
+}
+
+// Delete implements FormRepository.Delete
+func (r *memoryFormRepository) Delete(ctx context.Context, formID primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.remove(models.Form{}.TableName(), formID)
+	return nil
+}
+
+// Exists implements FormRepository.Exists
+func (r *memoryFormRepository) Exists(ctx context.Context, formID primitive.ObjectID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	_, ok := r.store.tables[models.Form{}.TableName()][formID]
+	return ok, nil
+}
+
+// FindByEventID implements FormRepository.FindByEventID
+func (r *memoryFormRepository) FindByEventID(ctx context.Context, eventID primitive.ObjectID, merchantID string, page, pageSize int) ([]*models.Form, int64, error) {
+	forms, err := r.find(func(f *models.Form) bool {
+		return f.MerchantID == merchantID && sameID(f.EventID, eventID)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(forms, "desc", compareForms(""))
+	return paginate(forms, page, pageSize), int64(len(forms)), nil
+}
+
+// FindByTemplateID implements FormRepository.FindByTemplateID
+func (r *memoryFormRepository) FindByTemplateID(ctx context.Context, templateID primitive.ObjectID, merchantID string, page, pageSize int) ([]*models.Form, int64, error) {
+	forms, err := r.find(func(f *models.Form) bool {
+		return f.MerchantID == merchantID && sameID(f.TemplateID, templateID)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(forms, "desc", compareForms(""))
+	return paginate(forms, page, pageSize), int64(len(forms)), nil
+}
+
+// CountByTemplateID implements FormRepository.CountByTemplateID
+func (r *memoryFormRepository) CountByTemplateID(ctx context.Context, templateID primitive.ObjectID, merchantID string) (int64, error) {
+	forms, err := r.find(func(f *models.Form) bool {
+		return f.MerchantID == merchantID && sameID(f.TemplateID, templateID)
+	})
+	return int64(len(forms)), err
+}
+
+// DetachTemplate implements FormRepository.DetachTemplate
+func (r *memoryFormRepository) DetachTemplate(ctx context.Context, templateID primitive.ObjectID, merchantID string) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	forms, err := loadAll(r.store, models.Form{}.TableName(), func(f *models.Form) bool {
+		return f.MerchantID == merchantID && sameID(f.TemplateID, templateID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	for _, form := range forms {
+		form.TemplateID = nil
+		form.TemplateVersion = 0
+		form.SetUpdatedAt(now)
+		if err := r.store.put(form.TableName(), form.ID, form); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(forms)), nil
+}
+
+// FindWithMissingTemplate implements FormRepository.FindWithMissingTemplate
+func (r *memoryFormRepository) FindWithMissingTemplate(ctx context.Context, afterID primitive.ObjectID, limit int) ([]*models.Form, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	templates := r.store.tables[models.FormTemplate{}.TableName()]
+	forms, err := loadAll(r.store, models.Form{}.TableName(), func(f *models.Form) bool {
+		if compareIDs(f.ID, afterID) <= 0 || f.TemplateID == nil {
+			return false
+		}
+		_, ok := templates[*f.TemplateID]
+		return !ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paginate(forms, 1, limit), nil
+}
+
+// SaveSchemaVersion implements FormRepository.SaveSchemaVersion
+func (r *memoryFormRepository) SaveSchemaVersion(ctx context.Context, version *models.FormSchemaVersion) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, err := loadAll(r.store, version.TableName(), func(v *models.FormSchemaVersion) bool {
+		return v.FormID == version.FormID && v.Version == version.Version
+	})
+	if err != nil {
+		return err
+	}
+
+	id := version.ID
+	if len(existing) > 0 {
+		id = existing[0].ID
+	} else if id.IsZero() {
+		id = primitive.NewObjectID()
+	}
+	document := *version
+	document.ID = id
+	return r.store.put(version.TableName(), id, &document)
+}
+
+// FindSchemaVersion implements FormRepository.FindSchemaVersion
+func (r *memoryFormRepository) FindSchemaVersion(ctx context.Context, formID primitive.ObjectID, version int) (*models.FormSchemaVersion, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	versions, err := loadAll(r.store, models.FormSchemaVersion{}.TableName(), func(v *models.FormSchemaVersion) bool {
+		return v.FormID == formID && v.Version == version
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return versions[0], nil
+}
+
+// CloseExpired implements FormRepository.CloseExpired
+func (r *memoryFormRepository) CloseExpired(ctx context.Context, now time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	forms, err := loadAll(r.store, models.Form{}.TableName(), func(f *models.Form) bool {
+		return f.Status == models.FormStatusPublished && f.CloseAt != nil && !f.CloseAt.After(now)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, form := range forms {
+		form.Status = models.FormStatusClosed
+		form.SetUpdatedAt(now)
+		form.UpdatedBy = repository.SystemUser
+		if err := r.store.put(form.TableName(), form.ID, form); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(forms)), nil
+}
+
+// CountByStatus implements FormRepository.CountByStatus
+func (r *memoryFormRepository) CountByStatus(ctx context.Context, merchantID string) (map[models.FormStatus]int64, error) {
+	forms, err := r.find(func(f *models.Form) bool { return f.MerchantID == merchantID })
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[models.FormStatus]int64)
+	for _, form := range forms {
+		counts[form.Status]++
+	}
+	return counts, nil
+}
+
+// find returns the forms matching the filter in insertion order
+func (r *memoryFormRepository) find(match func(*models.Form) bool) ([]*models.Form, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return loadAll(r.store, models.Form{}.TableName(), match)
+}
+
+// compareForms orders forms by a sort field, updated_at by default
+func compareForms(sortBy string) func(a, b *models.Form) int {
+	if sortBy == "created_at" {
+		return func(a, b *models.Form) int { return cmp.Compare(a.CreatedAt, b.CreatedAt) }
+	}
+	return func(a, b *models.Form) int { return cmp.Compare(a.UpdatedAt, b.UpdatedAt) }
+}
+
+// sameID reports whether an optional reference points to the ID
+func sameID(ref *primitive.ObjectID, id primitive.ObjectID) bool {
+	return ref != nil && *ref == id
+}
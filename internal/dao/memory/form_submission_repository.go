@@ -0,0 +1,425 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFormSubmissionRepository creates a new in-memory form submission repository
+func NewFormSubmissionRepository(store *Store) repository.FormSubmissionRepository {
+	return &memoryFormSubmissionRepository{store: store}
+}
+
+type memoryFormSubmissionRepository struct {
+	store *Store
+}
+
+// Create implements FormSubmissionRepository.Create
+func (r *memoryFormSubmissionRepository) Create(ctx context.Context, submission *models.FormSubmission) error {
+	if submission.ID.IsZero() {
+		submission.ID = primitive.NewObjectID()
+	}
+	submission.SetCreatedAt(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(submission.TableName(), submission.ID, submission)
+}
+
+// FindByID implements FormSubmissionRepository.FindByID
+func (r *memoryFormSubmissionRepository) FindByID(ctx context.Context, submissionID primitive.ObjectID) (*models.FormSubmission, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	submission, ok, err := load[models.FormSubmission](r.store, models.FormSubmission{}.TableName(), submissionID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return submission, nil
+}
+
+// ReserveResponse implements FormSubmissionRepository.ReserveResponse
+func (r *memoryFormSubmissionRepository) ReserveResponse(ctx context.Context, formID primitive.ObjectID, userID string, limit int) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	key := responseCounterKey{formID: formID, userID: userID}
+	if r.store.counters[key] >= limit {
+		return false, nil
+	}
+	r.store.counters[key]++
+	return true, nil
+}
+
+// ReleaseResponse implements FormSubmissionRepository.ReleaseResponse
+func (r *memoryFormSubmissionRepository) ReleaseResponse(ctx context.Context, formID primitive.ObjectID, userID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	key := responseCounterKey{formID: formID, userID: userID}
+	if r.store.counters[key] > 0 {
+		r.store.counters[key]--
+	}
+	return nil
+}
+
+// releaseResponses gives back the response counter slots of deleted submissions, stopping at zero.
+// The caller holds the store lock.
+func releaseResponses(store *Store, submissions []*models.FormSubmission) {
+	release := func(key responseCounterKey) {
+		if store.counters[key] > 0 {
+			store.counters[key]--
+		}
+	}
+	for _, submission := range submissions {
+		release(responseCounterKey{formID: submission.FormID})
+		if submission.SubmittedBy != "" {
+			release(responseCounterKey{formID: submission.FormID, userID: submission.SubmittedBy})
+		}
+	}
+}
+
+// CreateMany implements FormSubmissionRepository.CreateMany
+func (r *memoryFormSubmissionRepository) CreateMany(ctx context.Context, submissions []*models.FormSubmission) error {
+	now := time.Now()
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, submission := range submissions {
+		if submission.ID.IsZero() {
+			submission.ID = primitive.NewObjectID()
+		}
+		submission.SetCreatedAt(now)
+		if err := r.store.insert(submission.TableName(), submission.ID, submission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find implements FormSubmissionRepository.Find
+func (r *memoryFormSubmissionRepository) Find(ctx context.Context, options *models.SubmissionQueryOptions) ([]*models.FormSubmission, int64, error) {
+	submissions, err := r.find(options.MerchantID, options.FormID, options.Filters, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(submissions, options.SortOrder, func(a, b *models.FormSubmission) int {
+		return cmp.Compare(a.SubmittedAt, b.SubmittedAt)
+	})
+	return paginate(submissions, options.Page, options.PageSize), int64(len(submissions)), nil
+}
+
+// Stream implements FormSubmissionRepository.Stream
+func (r *memoryFormSubmissionRepository) Stream(ctx context.Context, options *models.SubmissionStreamOptions, fn func(*models.FormSubmission) error) error {
+	submissions, err := r.find(options.MerchantID, options.FormID, options.Filters, options.From, options.To)
+	if err != nil {
+		return err
+	}
+
+	orderBy(submissions, options.SortOrder, func(a, b *models.FormSubmission) int {
+		if c := cmp.Compare(a.SubmittedAt, b.SubmittedAt); c != 0 {
+			return c
+		}
+		return compareIDs(a.ID, b.ID)
+	})
+	for _, submission := range submissions {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(submission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AggregateStats implements FormSubmissionRepository.AggregateStats
+func (r *memoryFormSubmissionRepository) AggregateStats(ctx context.Context, query *models.FormResponseStatsQuery) (*models.FormResponseStats, error) {
+	submissions, err := r.find(query.MerchantID, query.FormID, nil, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	timeZone := query.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+	}
+
+	stats := &models.FormResponseStats{
+		FormID:         query.FormID,
+		TotalResponses: int64(len(submissions)),
+	}
+
+	daily := make(map[string]int64)
+	for _, submission := range submissions {
+		daily[submission.GetSubmittedAt().In(location).Format("2006-01-02")]++
+
+		complete := true
+		for _, field := range query.CompleteFields {
+			if answer, ok := lookupAnswer(submission.Answers, field); !ok || answer == nil {
+				complete = false
+				break
+			}
+		}
+		for _, field := range query.CompleteEncryptedFields {
+			if _, ok := submission.EncryptedAnswers[field]; !ok {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			stats.CompleteResponses++
+		}
+	}
+	if stats.TotalResponses > 0 {
+		stats.CompletionRate = float64(stats.CompleteResponses) / float64(stats.TotalResponses)
+	}
+	for _, date := range slices.Sorted(maps.Keys(daily)) {
+		stats.DailyCounts = append(stats.DailyCounts, models.DailyResponseCount{Date: date, Count: daily[date]})
+	}
+
+	for _, field := range query.ChoiceFields {
+		stats.ChoiceFields = append(stats.ChoiceFields, choiceStats(submissions, field))
+	}
+	for _, field := range query.NumericFields {
+		stats.NumericFields = append(stats.NumericFields, numericStats(submissions, field))
+	}
+	return stats, nil
+}
+
+// ListAnswerIndexes implements FormSubmissionRepository.ListAnswerIndexes
+func (r *memoryFormSubmissionRepository) ListAnswerIndexes(ctx context.Context) ([]string, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.store.answerIndexes)), nil
+}
+
+// CreateAnswerIndex implements FormSubmissionRepository.CreateAnswerIndex. Nothing is indexed in
+// memory; the field is only recorded so it is listed.
+func (r *memoryFormSubmissionRepository) CreateAnswerIndex(ctx context.Context, field string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.answerIndexes[field] = true
+	return nil
+}
+
+// CountByMerchantID implements FormSubmissionRepository.CountByMerchantID
+func (r *memoryFormSubmissionRepository) CountByMerchantID(ctx context.Context, merchantID string) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	submissions, err := loadAll(r.store, models.FormSubmission{}.TableName(), func(s *models.FormSubmission) bool {
+		return s.MerchantID == merchantID
+	})
+	return int64(len(submissions)), err
+}
+
+// SeedResponseCounters implements FormSubmissionRepository.SeedResponseCounters
+func (r *memoryFormSubmissionRepository) SeedResponseCounters(ctx context.Context, formID primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	submissions, err := loadAll(r.store, models.FormSubmission{}.TableName(), func(s *models.FormSubmission) bool {
+		return s.FormID == formID
+	})
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{"": len(submissions)}
+	for _, submission := range submissions {
+		if submission.SubmittedBy != "" {
+			counts[submission.SubmittedBy]++
+		}
+	}
+	for userID, count := range counts {
+		key := responseCounterKey{formID: formID, userID: userID}
+		r.store.counters[key] = max(r.store.counters[key], count)
+	}
+	return nil
+}
+
+// CountByFormID implements FormSubmissionRepository.CountByFormID
+func (r *memoryFormSubmissionRepository) CountByFormID(ctx context.Context, formID primitive.ObjectID) (int64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	submissions, err := loadAll(r.store, models.FormSubmission{}.TableName(), func(s *models.FormSubmission) bool {
+		return s.FormID == formID
+	})
+	return int64(len(submissions)), err
+}
+
+// find returns a form's submissions matching the answer filters and submitted in [from, to)
+func (r *memoryFormSubmissionRepository) find(merchantID string, formID primitive.ObjectID, filters map[string]interface{}, from, to *time.Time) ([]*models.FormSubmission, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return loadAll(r.store, models.FormSubmission{}.TableName(), func(s *models.FormSubmission) bool {
+		if s.MerchantID != merchantID || s.FormID != formID {
+			return false
+		}
+		if from != nil && s.GetSubmittedAt().Before(*from) {
+			return false
+		}
+		if to != nil && !s.GetSubmittedAt().Before(*to) {
+			return false
+		}
+		for field, value := range filters {
+			answer, ok := lookupAnswer(s.Answers, field)
+			if !ok || !answerMatches(answer, value) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// choiceStats counts the answers of a field per value; each choice of a multi-select answer counts
+func choiceStats(submissions []*models.FormSubmission, field string) models.ChoiceFieldStats {
+	counts := make(map[string]int64)
+	for _, submission := range submissions {
+		answer, ok := lookupAnswer(submission.Answers, field)
+		if !ok || answer == nil {
+			continue
+		}
+		if values, isArray := answer.(primitive.A); isArray {
+			for _, value := range values {
+				counts[fmt.Sprint(value)]++
+			}
+			continue
+		}
+		counts[fmt.Sprint(answer)]++
+	}
+
+	stats := models.ChoiceFieldStats{Field: field}
+	for value, count := range counts {
+		stats.Choices = append(stats.Choices, models.ChoiceCount{Value: value, Count: count})
+	}
+	slices.SortFunc(stats.Choices, func(a, b models.ChoiceCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return stats
+}
+
+// numericStats summarizes the numeric answers of a field
+func numericStats(submissions []*models.FormSubmission, field string) models.NumericFieldStats {
+	stats := models.NumericFieldStats{Field: field, Min: math.Inf(1), Max: math.Inf(-1)}
+	var sum float64
+	for _, submission := range submissions {
+		answer, _ := lookupAnswer(submission.Answers, field)
+		value, ok := toFloat64(answer)
+		if !ok {
+			continue
+		}
+		stats.Count++
+		sum += value
+		stats.Min = math.Min(stats.Min, value)
+		stats.Max = math.Max(stats.Max, value)
+	}
+
+	if stats.Count == 0 {
+		return models.NumericFieldStats{Field: field}
+	}
+	stats.Average = sum / float64(stats.Count)
+	return stats
+}
+
+// lookupAnswer returns the answer at a dotted field path
+func lookupAnswer(answers interface{}, field string) (interface{}, bool) {
+	value := answers
+	for _, key := range strings.Split(field, ".") {
+		var ok bool
+		switch doc := value.(type) {
+		case primitive.D:
+			value, ok = doc.Map()[key]
+		case primitive.M:
+			value, ok = doc[key]
+		case map[string]interface{}:
+			value, ok = doc[key]
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// answerMatches reports whether an answer equals the filter value, or contains it when the answer
+// is an array, as MongoDB equality filters do
+func answerMatches(answer, value interface{}) bool {
+	if values, ok := answer.(primitive.A); ok {
+		for _, element := range values {
+			if valuesEqual(element, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return valuesEqual(answer, value)
+}
+
+// valuesEqual compares two values, numbers by their numeric value
+func valuesEqual(a, b interface{}) bool {
+	if x, ok := toFloat64(a); ok {
+		y, ok := toFloat64(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// normalize decodes a value the way it is stored, so Go and BSON types of the same value compare
+// equal
+func normalize(value interface{}) interface{} {
+	data, err := bson.Marshal(bson.M{"v": value})
+	if err != nil {
+		return value
+	}
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return value
+	}
+	return doc["v"]
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewFormTemplateRepository creates a new in-memory form template repository
+func NewFormTemplateRepository(store *Store) repository.FormTemplateRepository {
+	return &memoryFormTemplateRepository{store: store}
+}
+
+type memoryFormTemplateRepository struct {
+	store *Store
+}
+
+// Create implements FormTemplateRepository.Create
+func (r *memoryFormTemplateRepository) Create(ctx context.Context, template *models.FormTemplate) error {
+	now := time.Now()
+	template.SetCreatedAt(now)
+	template.SetUpdatedAt(now)
+
+	if template.ID.IsZero() {
+		template.ID = primitive.NewObjectID()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(template.TableName(), template.ID, template)
+}
+
+// FindByID implements FormTemplateRepository.FindByID
+func (r *memoryFormTemplateRepository) FindByID(ctx context.Context, templateID primitive.ObjectID) (*models.FormTemplate, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	template, ok, err := load[models.FormTemplate](r.store, models.FormTemplate{}.TableName(), templateID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return template, nil
+}
+
+// FindByMerchantID implements FormTemplateRepository.FindByMerchantID
+func (r *memoryFormTemplateRepository) FindByMerchantID(ctx context.Context, options *models.FormTemplateQueryOptions) ([]*models.FormTemplate, int64, error) {
+	templates, err := r.find(func(t *models.FormTemplate) bool {
+		return t.MerchantID == options.MerchantID && t.ArchivedAt == nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(templates, options.SortOrder, compareTemplates(options.SortBy))
+	page := paginate(templates, options.Page, options.PageSize)
+	if options.View == models.ListViewSummary {
+		for _, t := range page {
+			t.Schema, t.UISchema = nil, nil
+		}
+	}
+	return page, int64(len(templates)), nil
+}
+
+// Update implements FormTemplateRepository.Update
+func (r *memoryFormTemplateRepository) Update(ctx context.Context, template *models.FormTemplate) error {
+	template.SetUpdatedAt(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, ok, err := load[models.FormTemplate](r.store, template.TableName(), template.ID)
+	if err != nil || !ok || existing.MerchantID != template.MerchantID {
+		return err
+	}
+	return r.store.put(template.TableName(), template.ID, template)
+}
+
+// Delete implements FormTemplateRepository.Delete
+func (r *memoryFormTemplateRepository) Delete(ctx context.Context, templateID primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.remove(models.FormTemplate{}.TableName(), templateID)
+	return nil
+}
+
+// CountByMerchantID implements FormTemplateRepository.CountByMerchantID
+func (r *memoryFormTemplateRepository) CountByMerchantID(ctx context.Context, merchantID string) (int64, error) {
+	templates, err := r.find(func(t *models.FormTemplate) bool {
+		return t.MerchantID == merchantID && t.ArchivedAt == nil
+	})
+	return int64(len(templates)), err
+}
+
+// Exists implements FormTemplateRepository.Exists
+func (r *memoryFormTemplateRepository) Exists(ctx context.Context, templateID primitive.ObjectID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	_, ok := r.store.tables[models.FormTemplate{}.TableName()][templateID]
+	return ok, nil
+}
+
+// Duplicate implements FormTemplateRepository.Duplicate
+func (r *memoryFormTemplateRepository) Duplicate(ctx context.Context, sourceID primitive.ObjectID, nameSuffix, createdBy, merchantID string) (*models.FormTemplate, error) {
+	source, err := r.FindByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate := &models.FormTemplate{
+		ID:         primitive.NewObjectID(),
+		Name:       source.Name + nameSuffix,
+		MerchantID: merchantID,
+		Schema:     source.Schema,
+		UISchema:   source.UISchema,
+		Version:    1,
+		CreatedBy:  createdBy,
+		UpdatedBy:  createdBy,
+	}
+	if err := r.Create(ctx, duplicate); err != nil {
+		return nil, err
+	}
+	return duplicate, nil
+}
+
+// Archive implements FormTemplateRepository.Archive
+func (r *memoryFormTemplateRepository) Archive(ctx context.Context, templateID primitive.ObjectID, archivedBy string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	template, ok, err := load[models.FormTemplate](r.store, models.FormTemplate{}.TableName(), templateID)
+	if err != nil || !ok {
+		return err
+	}
+
+	now := time.Now()
+	template.ArchivedAt = &now
+	template.SetUpdatedAt(now)
+	template.UpdatedBy = archivedBy
+	return r.store.put(template.TableName(), template.ID, template)
+}
+
+// SaveVersion implements FormTemplateRepository.SaveVersion
+func (r *memoryFormTemplateRepository) SaveVersion(ctx context.Context, version *models.FormTemplateVersion) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, err := loadAll(r.store, version.TableName(), func(v *models.FormTemplateVersion) bool {
+		return v.TemplateID == version.TemplateID && v.Version == version.Version
+	})
+	if err != nil {
+		return err
+	}
+
+	id := version.ID
+	if len(existing) > 0 {
+		id = existing[0].ID
+	} else if id.IsZero() {
+		id = primitive.NewObjectID()
+	}
+	document := *version
+	document.ID = id
+	return r.store.put(version.TableName(), id, &document)
+}
+
+// FindVersion implements FormTemplateRepository.FindVersion
+func (r *memoryFormTemplateRepository) FindVersion(ctx context.Context, templateID primitive.ObjectID, version int) (*models.FormTemplateVersion, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	versions, err := loadAll(r.store, models.FormTemplateVersion{}.TableName(), func(v *models.FormTemplateVersion) bool {
+		return v.TemplateID == templateID && v.Version == version
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	return versions[0], nil
+}
+
+// find returns the templates matching the filter in insertion order
+func (r *memoryFormTemplateRepository) find(match func(*models.FormTemplate) bool) ([]*models.FormTemplate, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return loadAll(r.store, models.FormTemplate{}.TableName(), match)
+}
+
+// compareTemplates orders templates by a sort field, updated_at by default
+func compareTemplates(sortBy string) func(a, b *models.FormTemplate) int {
+	switch sortBy {
+	case "name":
+		return func(a, b *models.FormTemplate) int { return cmp.Compare(a.Name, b.Name) }
+	case "created_at":
+		return func(a, b *models.FormTemplate) int { return cmp.Compare(a.CreatedAt, b.CreatedAt) }
+	default:
+		return func(a, b *models.FormTemplate) int { return cmp.Compare(a.UpdatedAt, b.UpdatedAt) }
+	}
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewIdempotencyRepository creates a new in-memory idempotency repository
+func NewIdempotencyRepository(store *Store) repository.IdempotencyRepository {
+	return &memoryIdempotencyRepository{store: store}
+}
+
+type memoryIdempotencyRepository struct {
+	store *Store
+}
+
+// Reserve implements IdempotencyRepository.Reserve. Expired keys are removed here, as the TTL
+// index removes them in MongoDB.
+func (r *memoryIdempotencyRepository) Reserve(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, error) {
+	if record.ID.IsZero() {
+		record.ID = primitive.NewObjectID()
+	}
+	record.Status = models.IdempotencyStatusPending
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	existing, err := loadAll(r.store, record.TableName(), func(e *models.IdempotencyRecord) bool {
+		return e.Scope == record.Scope && e.Operation == record.Operation && e.Key == record.Key
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.ExpiresAt.Time().After(now) {
+			return e, nil
+		}
+		r.store.remove(record.TableName(), e.ID)
+	}
+
+	return nil, r.store.insert(record.TableName(), record.ID, record)
+}
+
+// Complete implements IdempotencyRepository.Complete
+func (r *memoryIdempotencyRepository) Complete(ctx context.Context, recordID primitive.ObjectID, response []byte) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok, err := load[models.IdempotencyRecord](r.store, models.IdempotencyRecord{}.TableName(), recordID)
+	if err != nil || !ok {
+		return err
+	}
+
+	record.Status = models.IdempotencyStatusCompleted
+	record.Response = response
+	return r.store.put(record.TableName(), record.ID, record)
+}
+
+// Delete implements IdempotencyRepository.Delete
+func (r *memoryIdempotencyRepository) Delete(ctx context.Context, recordID primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	r.store.remove(models.IdempotencyRecord{}.TableName(), recordID)
+	return nil
+}
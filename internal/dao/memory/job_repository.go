@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewJobRepository creates a new in-memory job repository
+func NewJobRepository(store *Store) repository.JobRepository {
+	return &memoryJobRepository{store: store}
+}
+
+type memoryJobRepository struct {
+	store *Store
+}
+
+// Enqueue implements JobRepository.Enqueue
+func (r *memoryJobRepository) Enqueue(ctx context.Context, job *models.Job) error {
+	now := time.Now()
+	if job.ID.IsZero() {
+		job.ID = primitive.NewObjectID()
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = now
+	}
+	job.Status = models.JobStatusPending
+	job.SetCreatedAt(now)
+	job.SetUpdatedAt(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(job.TableName(), job.ID, job)
+}
+
+// Lease implements JobRepository.Lease. Finished jobs past their expiry are removed here, as the
+// TTL index removes them in MongoDB.
+func (r *memoryJobRepository) Lease(ctx context.Context, types []string, workerID string, now time.Time, visibility time.Duration) (*models.Job, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	jobs, err := loadAll[models.Job](r.store, models.Job{}.TableName(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var next *models.Job
+	for _, job := range jobs {
+		if job.ExpiresAt != nil && !job.ExpiresAt.After(now) {
+			r.store.remove(job.TableName(), job.ID)
+			continue
+		}
+		if !slices.Contains(types, job.Type) {
+			continue
+		}
+		due := job.Status == models.JobStatusPending && !job.RunAt.After(now) ||
+			job.Status == models.JobStatusRunning && job.LeaseUntil != nil && !job.LeaseUntil.After(now)
+		if due && (next == nil || job.RunAt.Before(next.RunAt)) {
+			next = job
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	leaseUntil := now.Add(visibility)
+	next.Status = models.JobStatusRunning
+	next.LeaseUntil = &leaseUntil
+	next.LeasedBy = workerID
+	next.Attempts++
+	next.SetUpdatedAt(now)
+	if err := r.store.put(next.TableName(), next.ID, next); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// Complete implements JobRepository.Complete
+func (r *memoryJobRepository) Complete(ctx context.Context, jobID primitive.ObjectID, workerID string, expiresAt time.Time) error {
+	return r.release(jobID, workerID, func(job *models.Job) {
+		job.Status = models.JobStatusSucceeded
+		job.ExpiresAt = &expiresAt
+	})
+}
+
+// Retry implements JobRepository.Retry
+func (r *memoryJobRepository) Retry(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, runAt time.Time) error {
+	return r.release(jobID, workerID, func(job *models.Job) {
+		job.Status = models.JobStatusPending
+		job.RunAt = runAt
+		job.LastError = lastError
+	})
+}
+
+// Fail implements JobRepository.Fail
+func (r *memoryJobRepository) Fail(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, expiresAt time.Time) error {
+	return r.release(jobID, workerID, func(job *models.Job) {
+		job.Status = models.JobStatusFailed
+		job.LastError = lastError
+		job.ExpiresAt = &expiresAt
+	})
+}
+
+// release ends the lease of workerID on a job, unless another worker took it over
+func (r *memoryJobRepository) release(jobID primitive.ObjectID, workerID string, update func(*models.Job)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	job, ok, err := load[models.Job](r.store, models.Job{}.TableName(), jobID)
+	if err != nil || !ok || job.Status != models.JobStatusRunning || job.LeasedBy != workerID {
+		return err
+	}
+
+	update(job)
+	job.LeaseUntil = nil
+	job.LeasedBy = ""
+	job.SetUpdatedAt(time.Now())
+	return r.store.put(job.TableName(), job.ID, job)
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewLimitsRepository creates a new in-memory limits repository
+func NewLimitsRepository(store *Store) repository.LimitsRepository {
+	return &memoryLimitsRepository{store: store}
+}
+
+type memoryLimitsRepository struct {
+	store *Store
+}
+
+// FindByMerchantID implements LimitsRepository.FindByMerchantID
+func (r *memoryLimitsRepository) FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantLimits, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+	return r.find(merchantID)
+}
+
+// Upsert implements LimitsRepository.Upsert
+func (r *memoryLimitsRepository) Upsert(ctx context.Context, limits *models.MerchantLimits) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	limits.UpdatedAt = now
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, err := r.find(limits.MerchantID)
+	if err != nil {
+		return err
+	}
+	document := *limits
+	if existing != nil {
+		document.ID = existing.ID
+		document.CreatedAt = existing.CreatedAt
+	} else {
+		document.ID = primitive.NewObjectID()
+		document.CreatedAt = now
+	}
+	return r.store.put(limits.TableName(), document.ID, &document)
+}
+
+// Delete implements LimitsRepository.Delete
+func (r *memoryLimitsRepository) Delete(ctx context.Context, merchantID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	existing, err := r.find(merchantID)
+	if err != nil || existing == nil {
+		return err
+	}
+	r.store.remove(existing.TableName(), existing.ID)
+	return nil
+}
+
+// List implements LimitsRepository.List
+func (r *memoryLimitsRepository) List(ctx context.Context, page, pageSize int) ([]*models.MerchantLimits, int64, error) {
+	r.store.mu.RLock()
+	limits, err := loadAll[models.MerchantLimits](r.store, models.MerchantLimits{}.TableName(), nil)
+	r.store.mu.RUnlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderBy(limits, "asc", func(a, b *models.MerchantLimits) int { return cmp.Compare(a.MerchantID, b.MerchantID) })
+	return paginate(limits, page, pageSize), int64(len(limits)), nil
+}
+
+// find returns the overrides of a merchant, or nil. The caller holds a lock.
+func (r *memoryLimitsRepository) find(merchantID string) (*models.MerchantLimits, error) {
+	limits, err := loadAll(r.store, models.MerchantLimits{}.TableName(), func(l *models.MerchantLimits) bool {
+		return l.MerchantID == merchantID
+	})
+	if err != nil || len(limits) == 0 {
+		return nil, err
+	}
+	return limits[0], nil
+}
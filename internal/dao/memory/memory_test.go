@@ -0,0 +1,264 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+func TestFormRepository_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFormRepository(NewStore())
+
+	form := &models.Form{MerchantID: "merchant-1", CreatedBy: "user-1"}
+	require.NoError(t, repo.Create(ctx, form))
+	form.CreatedBy = "user-2"
+
+	found, err := repo.FindByID(ctx, form.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", found.CreatedBy)
+
+	require.NoError(t, repo.Delete(ctx, form.ID))
+	_, err = repo.FindByID(ctx, form.ID)
+	assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
+
+func TestFormTemplateRepository_SummaryView(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFormTemplateRepository(NewStore())
+
+	template := &models.FormTemplate{
+		MerchantID: "merchant-1",
+		Name:       "Registration",
+		Schema:     map[string]interface{}{"type": "object"},
+		UISchema:   map[string]interface{}{"ui:order": []interface{}{}},
+	}
+	require.NoError(t, repo.Create(ctx, template))
+
+	templates, total, err := repo.FindByMerchantID(ctx, &models.FormTemplateQueryOptions{MerchantID: "merchant-1", Page: 1, PageSize: 10, View: models.ListViewSummary})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "Registration", templates[0].Name)
+	assert.Nil(t, templates[0].Schema)
+	assert.Nil(t, templates[0].UISchema)
+
+	// The stored template keeps its schemas
+	found, err := repo.FindByID(ctx, template.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, found.Schema)
+}
+
+func TestLimitsRepository_List(t *testing.T) {
+	ctx := context.Background()
+	repo := NewLimitsRepository(NewStore())
+
+	for _, merchantID := range []string{"merchant-c", "merchant-a", "merchant-b"} {
+		require.NoError(t, repo.Upsert(ctx, &models.MerchantLimits{MerchantID: merchantID}))
+	}
+	require.NoError(t, repo.Upsert(ctx, &models.MerchantLimits{MerchantID: "merchant-a", MaxTemplates: 10}))
+
+	limits, total, err := repo.List(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, limits, 2)
+	assert.Equal(t, "merchant-a", limits[0].MerchantID)
+	assert.Equal(t, 10, limits[0].MaxTemplates)
+	assert.Equal(t, "merchant-b", limits[1].MerchantID)
+
+	limits, _, err = repo.List(ctx, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	assert.Equal(t, "merchant-c", limits[0].MerchantID)
+}
+
+func TestFormSubmissionRepository_ReserveResponse(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFormSubmissionRepository(NewStore())
+	formID := primitive.NewObjectID()
+
+	reserved, err := repo.ReserveResponse(ctx, formID, "user-1", 1)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	reserved, err = repo.ReserveResponse(ctx, formID, "user-1", 1)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+
+	require.NoError(t, repo.ReleaseResponse(ctx, formID, "user-1"))
+	reserved, err = repo.ReserveResponse(ctx, formID, "user-1", 1)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestFormSubmissionRepository_SeedResponseCounters(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFormSubmissionRepository(NewStore())
+	formID := primitive.NewObjectID()
+	for _, user := range []string{"user-1", "user-1", ""} {
+		require.NoError(t, repo.Create(ctx, &models.FormSubmission{FormID: formID, MerchantID: "merchant-a", SubmittedBy: user}))
+	}
+
+	require.NoError(t, repo.SeedResponseCounters(ctx, formID))
+
+	// Responses stored before the quota was set count against it
+	reserved, err := repo.ReserveResponse(ctx, formID, "", 3)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	reserved, err = repo.ReserveResponse(ctx, formID, "user-1", 2)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	reserved, err = repo.ReserveResponse(ctx, formID, "user-2", 1)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestRetentionRepository_DeleteSubmissions_ReleasesResponses(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	submissionRepo := NewFormSubmissionRepository(store)
+	formID := primitive.NewObjectID()
+	expired := &models.FormSubmission{FormID: formID, MerchantID: "merchant-a", SubmittedBy: "user-1"}
+	require.NoError(t, submissionRepo.Create(ctx, expired))
+	require.NoError(t, submissionRepo.Create(ctx, &models.FormSubmission{FormID: formID, MerchantID: "merchant-a", SubmittedBy: "user-1"}))
+	require.NoError(t, submissionRepo.SeedResponseCounters(ctx, formID))
+
+	deleted, err := NewRetentionRepository(store).DeleteSubmissions(ctx, formID, []primitive.ObjectID{expired.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// The deleted response no longer holds a slot, in total or for its respondent
+	reserved, err := submissionRepo.ReserveResponse(ctx, formID, "", 2)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	reserved, err = submissionRepo.ReserveResponse(ctx, formID, "user-1", 2)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestUserDataRepository_DeleteSubmissions_ReleasesResponses(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	submissionRepo := NewFormSubmissionRepository(store)
+	formID := primitive.NewObjectID()
+	for _, user := range []string{"user-1", "user-1", "user-2"} {
+		require.NoError(t, submissionRepo.Create(ctx, &models.FormSubmission{FormID: formID, MerchantID: "merchant-a", SubmittedBy: user}))
+	}
+	require.NoError(t, submissionRepo.SeedResponseCounters(ctx, formID))
+
+	deleted, err := NewUserDataRepository(store).DeleteSubmissions(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	reserved, err := submissionRepo.ReserveResponse(ctx, formID, "", 2)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	reserved, err = submissionRepo.ReserveResponse(ctx, formID, "", 2)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	reserved, err = submissionRepo.ReserveResponse(ctx, formID, "user-2", 1)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+}
+
+func TestFormSubmissionRepository_FindFilters(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFormSubmissionRepository(NewStore())
+	formID := primitive.NewObjectID()
+
+	require.NoError(t, repo.CreateMany(ctx, []*models.FormSubmission{
+		{MerchantID: "merchant-1", FormID: formID, Answers: map[string]interface{}{"color": "red", "size": 2}},
+		{MerchantID: "merchant-1", FormID: formID, Answers: map[string]interface{}{"color": []string{"blue", "red"}, "size": 3}},
+		{MerchantID: "merchant-1", FormID: formID, Answers: map[string]interface{}{"color": "green", "size": 2}},
+	}))
+
+	submissions, total, err := repo.Find(ctx, &models.SubmissionQueryOptions{
+		MerchantID: "merchant-1",
+		FormID:     formID,
+		Filters:    map[string]interface{}{"color": "red"},
+		Page:       1,
+		PageSize:   10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, submissions, 2)
+
+	_, total, err = repo.Find(ctx, &models.SubmissionQueryOptions{
+		MerchantID: "merchant-1",
+		FormID:     formID,
+		Filters:    map[string]interface{}{"size": 2.0},
+		Page:       1,
+		PageSize:   10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
+
+func TestStore_WithTransactionRollsBack(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	repo := NewFormRepository(store)
+
+	kept := &models.Form{MerchantID: "merchant-1"}
+	require.NoError(t, repo.Create(ctx, kept))
+
+	rolledBack := &models.Form{MerchantID: "merchant-1"}
+	err := store.WithTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, repo.Create(ctx, rolledBack))
+		require.NoError(t, repo.Delete(ctx, kept.ID))
+		return errors.New("abort")
+	})
+	assert.EqualError(t, err, "abort")
+
+	exists, err := repo.Exists(ctx, kept.ID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = repo.Exists(ctx, rolledBack.ID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestJobRepository_Lease(t *testing.T) {
+	ctx := context.Background()
+	repo := NewJobRepository(NewStore())
+	now := time.Now()
+
+	later := &models.Job{Type: "export", RunAt: now.Add(-time.Minute)}
+	earlier := &models.Job{Type: "export", RunAt: now.Add(-time.Hour)}
+	future := &models.Job{Type: "export", RunAt: now.Add(time.Hour)}
+	for _, job := range []*models.Job{later, earlier, future} {
+		require.NoError(t, repo.Enqueue(ctx, job))
+	}
+
+	job, err := repo.Lease(ctx, []string{"export"}, "worker-1", now, time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, earlier.ID, job.ID)
+	assert.Equal(t, models.JobStatusRunning, job.Status)
+	assert.Equal(t, 1, job.Attempts)
+
+	// Another worker cannot release the lease
+	require.NoError(t, repo.Complete(ctx, job.ID, "worker-2", now.Add(time.Hour)))
+	job, err = repo.Lease(ctx, []string{"export"}, "worker-1", now, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, later.ID, job.ID)
+
+	job, err = repo.Lease(ctx, []string{"export"}, "worker-1", now, time.Minute)
+	require.NoError(t, err)
+	assert.Nil(t, job)
+
+	// The expired lease of the first job is taken over
+	job, err = repo.Lease(ctx, []string{"export"}, "worker-2", now.Add(2*time.Minute), time.Minute)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, earlier.ID, job.ID)
+	assert.Equal(t, 2, job.Attempts)
+}
@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewMerchantKeyRepository creates a new in-memory merchant key repository
+func NewMerchantKeyRepository(store *Store) repository.MerchantKeyRepository {
+	return &memoryMerchantKeyRepository{store: store}
+}
+
+type memoryMerchantKeyRepository struct {
+	store *Store
+}
+
+// Create implements MerchantKeyRepository.Create
+func (r *memoryMerchantKeyRepository) Create(ctx context.Context, key *models.MerchantKey) error {
+	key.SetCreatedAt(time.Now())
+
+	if key.ID.IsZero() {
+		key.ID = primitive.NewObjectID()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(key.TableName(), key.ID, key)
+}
+
+// FindByID implements MerchantKeyRepository.FindByID
+func (r *memoryMerchantKeyRepository) FindByID(ctx context.Context, keyID primitive.ObjectID) (*models.MerchantKey, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	key, ok, err := load[models.MerchantKey](r.store, models.MerchantKey{}.TableName(), keyID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return key, nil
+}
+
+// FindLatest implements MerchantKeyRepository.FindLatest
+func (r *memoryMerchantKeyRepository) FindLatest(ctx context.Context, merchantID string) (*models.MerchantKey, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	keys, err := loadAll(r.store, models.MerchantKey{}.TableName(), func(k *models.MerchantKey) bool {
+		return k.MerchantID == merchantID
+	})
+	if err != nil || len(keys) == 0 {
+		return nil, err
+	}
+	return slices.MaxFunc(keys, func(a, b *models.MerchantKey) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	}), nil
+}
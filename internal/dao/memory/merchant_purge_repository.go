@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewMerchantPurgeRepository creates a new in-memory merchant purge repository
+func NewMerchantPurgeRepository(store *Store) repository.MerchantPurgeRepository {
+	return &memoryMerchantPurgeRepository{store: store}
+}
+
+type memoryMerchantPurgeRepository struct {
+	store *Store
+}
+
+// merchantDocument holds the fields a purge reads from any merchant document
+type merchantDocument struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	MerchantID string             `bson:"merchant_id"`
+	Key        string             `bson:"key,omitempty"`
+}
+
+// Create implements MerchantPurgeRepository.Create
+func (r *memoryMerchantPurgeRepository) Create(ctx context.Context, purge *models.MerchantPurge) error {
+	now := time.Now()
+	purge.SetCreatedAt(now)
+	purge.SetUpdatedAt(now)
+
+	if purge.ID.IsZero() {
+		purge.ID = primitive.NewObjectID()
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(purge.TableName(), purge.ID, purge)
+}
+
+// FindByID implements MerchantPurgeRepository.FindByID
+func (r *memoryMerchantPurgeRepository) FindByID(ctx context.Context, purgeID primitive.ObjectID) (*models.MerchantPurge, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	purge, ok, err := load[models.MerchantPurge](r.store, models.MerchantPurge{}.TableName(), purgeID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	return purge, nil
+}
+
+// FindLatest implements MerchantPurgeRepository.FindLatest
+func (r *memoryMerchantPurgeRepository) FindLatest(ctx context.Context, merchantID string) (*models.MerchantPurge, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	purges, err := loadAll(r.store, models.MerchantPurge{}.TableName(), func(p *models.MerchantPurge) bool {
+		return p.MerchantID == merchantID
+	})
+	if err != nil || len(purges) == 0 {
+		return nil, err
+	}
+	return slices.MaxFunc(purges, func(a, b *models.MerchantPurge) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	}), nil
+}
+
+// Update implements MerchantPurgeRepository.Update
+func (r *memoryMerchantPurgeRepository) Update(ctx context.Context, purge *models.MerchantPurge) error {
+	purge.SetUpdatedAt(time.Now())
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if _, ok := r.store.tables[purge.TableName()][purge.ID]; !ok {
+		return nil
+	}
+	return r.store.put(purge.TableName(), purge.ID, purge)
+}
+
+// NextBatch implements MerchantPurgeRepository.NextBatch
+func (r *memoryMerchantPurgeRepository) NextBatch(ctx context.Context, collection, merchantID string, limit int) ([]models.PurgeDocument, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	documents, err := loadAll(r.store, collection, func(d *merchantDocument) bool {
+		return d.MerchantID == merchantID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []models.PurgeDocument
+	for _, document := range paginate(documents, 1, limit) {
+		batch = append(batch, models.PurgeDocument{ID: document.ID, Key: document.Key})
+	}
+	return batch, nil
+}
+
+// DeleteBatch implements MerchantPurgeRepository.DeleteBatch
+func (r *memoryMerchantPurgeRepository) DeleteBatch(ctx context.Context, collection, merchantID string, ids []primitive.ObjectID) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	var deleted int64
+	for _, id := range ids {
+		document, ok, err := load[merchantDocument](r.store, collection, id)
+		if err != nil {
+			return deleted, err
+		}
+		if !ok || document.MerchantID != merchantID {
+			continue
+		}
+		r.store.remove(collection, id)
+		deleted++
+	}
+	return deleted, nil
+}
+
+// DeleteResponseCounters implements MerchantPurgeRepository.DeleteResponseCounters
+func (r *memoryMerchantPurgeRepository) DeleteResponseCounters(ctx context.Context, formIDs []primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for key := range r.store.counters {
+		if slices.Contains(formIDs, key.formID) {
+			delete(r.store.counters, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewOutboxRepository creates a new in-memory outbox repository
+func NewOutboxRepository(store *Store) repository.OutboxRepository {
+	return &memoryOutboxRepository{store: store}
+}
+
+type memoryOutboxRepository struct {
+	store *Store
+}
+
+// Add implements OutboxRepository.Add
+func (r *memoryOutboxRepository) Add(ctx context.Context, event *models.OutboxEvent) error {
+	now := time.Now()
+	if event.ID.IsZero() {
+		event.ID = primitive.NewObjectID()
+	}
+	event.Status = models.OutboxStatusPending
+	event.NextAttemptAt = now
+	event.SetCreatedAt(now)
+	event.SetUpdatedAt(now)
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.store.insert(event.TableName(), event.ID, event)
+}
+
+// Lease implements OutboxRepository.Lease. Published events past their expiry are removed here,
+// as the TTL index removes them in MongoDB.
+func (r *memoryOutboxRepository) Lease(ctx context.Context, workerID string, now time.Time, visibility time.Duration) (*models.OutboxEvent, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	events, err := loadAll[models.OutboxEvent](r.store, models.OutboxEvent{}.TableName(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Events are loaded in ID order, so the first due event is the oldest
+	var next *models.OutboxEvent
+	for _, event := range events {
+		if event.ExpiresAt != nil && !event.ExpiresAt.After(now) {
+			r.store.remove(event.TableName(), event.ID)
+			continue
+		}
+		due := event.Status == models.OutboxStatusPending && !event.NextAttemptAt.After(now) ||
+			event.Status == models.OutboxStatusPublishing && event.LeaseUntil != nil && !event.LeaseUntil.After(now)
+		if due && next == nil {
+			next = event
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	leaseUntil := now.Add(visibility)
+	next.Status = models.OutboxStatusPublishing
+	next.LeaseUntil = &leaseUntil
+	next.LeasedBy = workerID
+	next.Attempts++
+	next.SetUpdatedAt(now)
+	if err := r.store.put(next.TableName(), next.ID, next); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// MarkPublished implements OutboxRepository.MarkPublished
+func (r *memoryOutboxRepository) MarkPublished(ctx context.Context, eventID primitive.ObjectID, workerID string, publishedAt, expiresAt time.Time) error {
+	return r.release(eventID, workerID, func(event *models.OutboxEvent) {
+		event.Status = models.OutboxStatusPublished
+		event.PublishedAt = &publishedAt
+		event.ExpiresAt = &expiresAt
+	})
+}
+
+// Retry implements OutboxRepository.Retry
+func (r *memoryOutboxRepository) Retry(ctx context.Context, eventID primitive.ObjectID, workerID, lastError string, nextAttemptAt time.Time) error {
+	return r.release(eventID, workerID, func(event *models.OutboxEvent) {
+		event.Status = models.OutboxStatusPending
+		event.NextAttemptAt = nextAttemptAt
+		event.LastError = lastError
+	})
+}
+
+// release ends the lease of workerID on an event, unless another relay took it over
+func (r *memoryOutboxRepository) release(eventID primitive.ObjectID, workerID string, update func(*models.OutboxEvent)) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	event, ok, err := load[models.OutboxEvent](r.store, models.OutboxEvent{}.TableName(), eventID)
+	if err != nil || !ok || event.Status != models.OutboxStatusPublishing || event.LeasedBy != workerID {
+		return err
+	}
+
+	update(event)
+	event.LeaseUntil = nil
+	event.LeasedBy = ""
+	event.SetUpdatedAt(time.Now())
+	return r.store.put(event.TableName(), event.ID, event)
+}
@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewRetentionRepository creates a new in-memory retention repository
+func NewRetentionRepository(store *Store) repository.RetentionRepository {
+	return &memoryRetentionRepository{store: store}
+}
+
+type memoryRetentionRepository struct {
+	store *Store
+}
+
+// FindForms implements RetentionRepository.FindForms
+func (r *memoryRetentionRepository) FindForms(ctx context.Context) ([]*models.Form, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return loadAll(r.store, models.Form{}.TableName(), func(f *models.Form) bool {
+		return f.RetentionDays > 0
+	})
+}
+
+// ExpiredSubmissions implements RetentionRepository.ExpiredSubmissions
+func (r *memoryRetentionRepository) ExpiredSubmissions(ctx context.Context, formID primitive.ObjectID, before time.Time, limit int) ([]primitive.ObjectID, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	submissions, err := r.expired(formID, before)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(submissions, func(a, b *models.FormSubmission) int {
+		return cmp.Compare(a.SubmittedAt, b.SubmittedAt)
+	})
+
+	var ids []primitive.ObjectID
+	for _, submission := range paginate(submissions, 1, limit) {
+		ids = append(ids, submission.ID)
+	}
+	return ids, nil
+}
+
+// DeleteSubmissions implements RetentionRepository.DeleteSubmissions
+func (r *memoryRetentionRepository) DeleteSubmissions(ctx context.Context, formID primitive.ObjectID, ids []primitive.ObjectID) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	collection := models.FormSubmission{}.TableName()
+	var deleted []*models.FormSubmission
+	for _, id := range ids {
+		submission, ok, err := load[models.FormSubmission](r.store, collection, id)
+		if err != nil {
+			releaseResponses(r.store, deleted)
+			return int64(len(deleted)), err
+		}
+		if !ok || submission.FormID != formID {
+			continue
+		}
+		r.store.remove(collection, id)
+		deleted = append(deleted, submission)
+	}
+	releaseResponses(r.store, deleted)
+	return int64(len(deleted)), nil
+}
+
+// AnonymizeSubmissions implements RetentionRepository.AnonymizeSubmissions
+func (r *memoryRetentionRepository) AnonymizeSubmissions(ctx context.Context, formID primitive.ObjectID, before, now time.Time) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	submissions, err := r.expired(formID, before)
+	if err != nil {
+		return 0, err
+	}
+
+	anonymizedAt := primitive.NewDateTimeFromTime(now)
+	var anonymized int64
+	for _, submission := range submissions {
+		if submission.AnonymizedAt != nil {
+			continue
+		}
+		submission.SubmittedBy = ""
+		submission.CreatedBy = ""
+		submission.EncryptedAnswers = nil
+		submission.KeyID = primitive.NilObjectID
+		submission.AnonymizedAt = &anonymizedAt
+		if err := r.store.put(submission.TableName(), submission.ID, submission); err != nil {
+			return anonymized, err
+		}
+		anonymized++
+	}
+	return anonymized, nil
+}
+
+// FindFiles implements RetentionRepository.FindFiles
+func (r *memoryRetentionRepository) FindFiles(ctx context.Context, submissionIDs []primitive.ObjectID) ([]*models.FileReference, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return loadAll(r.store, models.FileReference{}.TableName(), func(f *models.FileReference) bool {
+		return f.SubmissionID != nil && slices.Contains(submissionIDs, *f.SubmissionID)
+	})
+}
+
+// DeleteFiles implements RetentionRepository.DeleteFiles
+func (r *memoryRetentionRepository) DeleteFiles(ctx context.Context, fileIDs []primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, id := range fileIDs {
+		r.store.remove(models.FileReference{}.TableName(), id)
+	}
+	return nil
+}
+
+// expired returns the submissions of a form submitted before the cutoff
+func (r *memoryRetentionRepository) expired(formID primitive.ObjectID, before time.Time) ([]*models.FormSubmission, error) {
+	return loadAll(r.store, models.FormSubmission{}.TableName(), func(s *models.FormSubmission) bool {
+		return s.FormID == formID && s.GetSubmittedAt().Before(before)
+	})
+}
@@ -0,0 +1,174 @@
+// Package memory implements the repository interfaces in memory, so the form service can be
+// embedded or tested end to end without MongoDB. Documents are kept BSON encoded, which gives
+// callers copies decoded the same way as from MongoDB. Data is lost when the process exits.
+package memory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrDuplicateKey is returned when a document is inserted with an ID that is already stored
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// Store holds the documents of all memory repositories. Repositories created from the same store
+// see each other's data, as they would in a database.
+type Store struct {
+	mu            sync.RWMutex
+	tables        map[string]map[primitive.ObjectID][]byte
+	counters      map[responseCounterKey]int
+	answerIndexes map[string]bool
+
+	txMu sync.Mutex
+}
+
+// responseCounterKey identifies a form response counter; an empty user selects the form total
+type responseCounterKey struct {
+	formID primitive.ObjectID
+	userID string
+}
+
+// NewStore creates an empty store
+func NewStore() *Store {
+	return &Store{
+		tables:        make(map[string]map[primitive.ObjectID][]byte),
+		counters:      make(map[responseCounterKey]int),
+		answerIndexes: make(map[string]bool),
+	}
+}
+
+// WithTransaction implements repository.TransactionRunner.WithTransaction. Transactions run one
+// at a time; when fn fails, the store is restored to its state before the transaction, which
+// also discards writes made concurrently outside of transactions.
+func (s *Store) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+
+	s.mu.RLock()
+	tables := make(map[string]map[primitive.ObjectID][]byte, len(s.tables))
+	for name, table := range s.tables {
+		tables[name] = maps.Clone(table)
+	}
+	counters := maps.Clone(s.counters)
+	s.mu.RUnlock()
+
+	if err := fn(ctx); err != nil {
+		s.mu.Lock()
+		s.tables = tables
+		s.counters = counters
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// table returns the documents of a collection, creating it when needed. The caller holds the
+// write lock.
+func (s *Store) table(collection string) map[primitive.ObjectID][]byte {
+	table, ok := s.tables[collection]
+	if !ok {
+		table = make(map[primitive.ObjectID][]byte)
+		s.tables[collection] = table
+	}
+	return table
+}
+
+// insert stores a new document. The caller holds the write lock.
+func (s *Store) insert(collection string, id primitive.ObjectID, document interface{}) error {
+	table := s.table(collection)
+	if _, ok := table[id]; ok {
+		return fmt.Errorf("%w: %s in %s", ErrDuplicateKey, id.Hex(), collection)
+	}
+	return s.put(collection, id, document)
+}
+
+// put stores a document, replacing the one with the same ID. The caller holds the write lock.
+func (s *Store) put(collection string, id primitive.ObjectID, document interface{}) error {
+	data, err := bson.Marshal(document)
+	if err != nil {
+		return err
+	}
+	s.table(collection)[id] = data
+	return nil
+}
+
+// remove deletes a document. The caller holds the write lock.
+func (s *Store) remove(collection string, id primitive.ObjectID) {
+	delete(s.tables[collection], id)
+}
+
+// load decodes the document with the ID. The caller holds a lock.
+func load[T any](s *Store, collection string, id primitive.ObjectID) (*T, bool, error) {
+	data, ok := s.tables[collection][id]
+	if !ok {
+		return nil, false, nil
+	}
+	var document T
+	if err := bson.Unmarshal(data, &document); err != nil {
+		return nil, false, err
+	}
+	return &document, true, nil
+}
+
+// loadAll decodes the documents matching the filter in ID order, which is their insertion order.
+// A nil filter matches all documents. The caller holds a lock.
+func loadAll[T any](s *Store, collection string, match func(*T) bool) ([]*T, error) {
+	table := s.tables[collection]
+	ids := make([]primitive.ObjectID, 0, len(table))
+	for id := range table {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, compareIDs)
+
+	var documents []*T
+	for _, id := range ids {
+		var document T
+		if err := bson.Unmarshal(table[id], &document); err != nil {
+			return nil, err
+		}
+		if match == nil || match(&document) {
+			documents = append(documents, &document)
+		}
+	}
+	return documents, nil
+}
+
+// paginate returns a page of the documents; a page size of 0 returns all documents from the page
+// offset on
+func paginate[T any](documents []*T, page, pageSize int) []*T {
+	skip := 0
+	if page > 1 {
+		skip = (page - 1) * pageSize
+	}
+	if skip >= len(documents) {
+		return nil
+	}
+	documents = documents[skip:]
+	if pageSize > 0 && pageSize < len(documents) {
+		documents = documents[:pageSize]
+	}
+	return documents
+}
+
+// orderBy sorts the documents by cmp, descending unless sortOrder is "asc". Documents comparing
+// equal keep their insertion order.
+func orderBy[T any](documents []*T, sortOrder string, cmp func(a, b *T) int) {
+	slices.SortStableFunc(documents, func(a, b *T) int {
+		if sortOrder == "asc" {
+			return cmp(a, b)
+		}
+		return cmp(b, a)
+	})
+}
+
+func compareIDs(a, b primitive.ObjectID) int {
+	return bytes.Compare(a[:], b[:])
+}
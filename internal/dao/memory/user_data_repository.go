@@ -0,0 +1,178 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// NewUserDataRepository creates a new in-memory user data repository
+func NewUserDataRepository(store *Store) repository.UserDataRepository {
+	return &memoryUserDataRepository{store: store}
+}
+
+type memoryUserDataRepository struct {
+	store *Store
+}
+
+// FindByUser implements UserDataRepository.FindByUser
+func (r *memoryUserDataRepository) FindByUser(ctx context.Context, collection string, fields []string, userID string) ([]bson.M, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	if collection == (models.FormResponseCounter{}).TableName() {
+		var counters []bson.M
+		for key, count := range r.store.counters {
+			if key.userID == userID && slices.Contains(fields, "user_id") {
+				counters = append(counters, bson.M{"form_id": key.formID, "user_id": key.userID, "count": count})
+			}
+		}
+		return counters, nil
+	}
+
+	documents, err := loadAll(r.store, collection, func(d *bson.D) bool {
+		return holdsUser(*d, fields, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]bson.M, len(documents))
+	for i, document := range documents {
+		result[i] = document.Map()
+	}
+	return result, nil
+}
+
+// ReplaceUser implements UserDataRepository.ReplaceUser
+func (r *memoryUserDataRepository) ReplaceUser(ctx context.Context, collection string, fields []string, userID, pseudonym string) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if collection == (models.FormResponseCounter{}).TableName() {
+		var changed int64
+		for key, count := range r.store.counters {
+			if key.userID == userID && slices.Contains(fields, "user_id") {
+				delete(r.store.counters, key)
+				r.store.counters[responseCounterKey{formID: key.formID, userID: pseudonym}] = count
+				changed++
+			}
+		}
+		return changed, nil
+	}
+
+	documents, err := loadAll(r.store, collection, func(d *bson.D) bool {
+		return holdsUser(*d, fields, userID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, document := range documents {
+		for i, e := range *document {
+			if slices.Contains(fields, e.Key) && e.Value == userID {
+				(*document)[i].Value = pseudonym
+			}
+		}
+		id, _ := document.Map()["_id"].(primitive.ObjectID)
+		if err := r.store.put(collection, id, document); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(documents)), nil
+}
+
+// DeleteSubmissions implements UserDataRepository.DeleteSubmissions
+func (r *memoryUserDataRepository) DeleteSubmissions(ctx context.Context, userID string) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	collection := models.FormSubmission{}.TableName()
+	submissions, err := loadAll(r.store, collection, func(s *models.FormSubmission) bool {
+		return s.SubmittedBy == userID
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, submission := range submissions {
+		r.store.remove(collection, submission.ID)
+	}
+	releaseResponses(r.store, submissions)
+	return int64(len(submissions)), nil
+}
+
+// FindSubmissionFiles implements UserDataRepository.FindSubmissionFiles
+func (r *memoryUserDataRepository) FindSubmissionFiles(ctx context.Context, userID string) ([]*models.FileReference, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	submissions, err := loadAll(r.store, models.FormSubmission{}.TableName(), func(s *models.FormSubmission) bool {
+		return s.SubmittedBy == userID
+	})
+	if err != nil {
+		return nil, err
+	}
+	submissionIDs := make([]primitive.ObjectID, len(submissions))
+	for i, submission := range submissions {
+		submissionIDs[i] = submission.ID
+	}
+
+	return loadAll(r.store, models.FileReference{}.TableName(), func(f *models.FileReference) bool {
+		return f.SubmissionID != nil && slices.Contains(submissionIDs, *f.SubmissionID)
+	})
+}
+
+// DeleteFiles implements UserDataRepository.DeleteFiles
+func (r *memoryUserDataRepository) DeleteFiles(ctx context.Context, fileIDs []primitive.ObjectID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for _, id := range fileIDs {
+		r.store.remove(models.FileReference{}.TableName(), id)
+	}
+	return nil
+}
+
+// RemoveEncryptedAnswers implements UserDataRepository.RemoveEncryptedAnswers
+func (r *memoryUserDataRepository) RemoveEncryptedAnswers(ctx context.Context, userID string) (int64, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	collection := models.FormSubmission{}.TableName()
+	documents, err := loadAll(r.store, collection, func(d *bson.D) bool {
+		return holdsUser(*d, []string{"submitted_by"}, userID) && d.Map()["encrypted_answers"] != nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, document := range documents {
+		kept := (*document)[:0]
+		for _, e := range *document {
+			if e.Key != "encrypted_answers" && e.Key != "key_id" {
+				kept = append(kept, e)
+			}
+		}
+		id, _ := kept.Map()["_id"].(primitive.ObjectID)
+		if err := r.store.put(collection, id, &kept); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(documents)), nil
+}
+
+// holdsUser reports whether any of the top-level fields of a document holds the user ID
+func holdsUser(document bson.D, fields []string, userID string) bool {
+	for _, e := range document {
+		if slices.Contains(fields, e.Key) && e.Value == userID {
+			return true
+		}
+	}
+	return false
+}
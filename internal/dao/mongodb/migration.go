@@ -2,23 +2,162 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/migrations"
 
 	"github.com/arwoosa/vulpes/log"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// Migration defines the structure for a collection migration
-type Migration struct {
-	Collection string
-	Indexes    []mongo.IndexModel
+// versions are the database migrations in the order they were added. Applied versions are
+// recorded, so a migration is never changed once released; add a new version instead.
+var versions = []migrations.Migration{
+	{
+		Version:     1,
+		Description: "Create collection indexes",
+		Up:          migrations.CreateIndexes(initialIndexes...),
+	},
+	{
+		Version:     2,
+		Description: "Index merchant purges by merchant",
+		Up: migrations.CreateIndexes(migrations.CollectionIndexes{
+			Collection: "merchant_purges",
+			Indexes: []mongo.IndexModel{
+				// The latest purge of a merchant is looked up before starting another
+				{
+					Keys: bson.D{
+						{Key: "merchant_id", Value: 1},
+						{Key: "created_at", Value: -1},
+					},
+				},
+			},
+		}),
+	},
+	{
+		Version:     3,
+		Description: "Index merchant data keys by merchant",
+		Up: migrations.CreateIndexes(migrations.CollectionIndexes{
+			Collection: "merchant_keys",
+			Indexes: []mongo.IndexModel{
+				// The latest data key of a merchant encrypts new answers
+				{
+					Keys: bson.D{
+						{Key: "merchant_id", Value: 1},
+						{Key: "created_at", Value: -1},
+					},
+				},
+			},
+		}),
+	},
+	{
+		Version:     4,
+		Description: "Index responses and files for retention cleanup",
+		Up: migrations.CreateIndexes(
+			migrations.CollectionIndexes{
+				Collection: "form_submissions",
+				Indexes: []mongo.IndexModel{
+					// Expired responses of a form, oldest first
+					{
+						Keys: bson.D{
+							{Key: "form_id", Value: 1},
+							{Key: "submitted_at", Value: 1},
+						},
+					},
+				},
+			},
+			migrations.CollectionIndexes{
+				Collection: "form_files",
+				Indexes: []mongo.IndexModel{
+					// Files of the responses being deleted
+					{
+						Keys: bson.D{{Key: "submission_id", Value: 1}},
+						Options: options.Index().SetPartialFilterExpression(bson.D{
+							{Key: "submission_id", Value: bson.D{{Key: "$exists", Value: true}}},
+						}),
+					},
+				},
+			},
+		),
+	},
+	{
+		Version:     5,
+		Description: "Index field blocks by merchant",
+		Up: migrations.CreateIndexes(migrations.CollectionIndexes{
+			Collection: "field_blocks",
+			Indexes: []mongo.IndexModel{
+				// The field block library of a merchant, recently updated first
+				{
+					Keys: bson.D{
+						{Key: "merchant_id", Value: 1},
+						{Key: "updated_at", Value: -1},
+					},
+				},
+			},
+		}),
+	},
+	{
+		Version:     6,
+		Description: "Index template versions by template",
+		Up: migrations.CreateIndexes(migrations.CollectionIndexes{
+			Collection: "form_template_versions",
+			Indexes: []mongo.IndexModel{
+				// One snapshot per template version
+				{
+					Keys: bson.D{
+						{Key: "template_id", Value: 1},
+						{Key: "version", Value: 1},
+					},
+					Options: options.Index().SetUnique(true),
+				},
+			},
+		}),
+	},
+	{
+		Version:     7,
+		Description: "Index session attendance",
+		Up: migrations.CreateIndexes(migrations.CollectionIndexes{
+			Collection: "session_attendance",
+			Indexes: []mongo.IndexModel{
+				// A registration checks in to a session once
+				{
+					Keys: bson.D{
+						{Key: "submission_id", Value: 1},
+						{Key: "session_id", Value: 1},
+					},
+					Options: options.Index().SetUnique(true),
+				},
+				// Attendees of a session are counted per form
+				{
+					Keys: bson.D{
+						{Key: "form_id", Value: 1},
+						{Key: "session_id", Value: 1},
+					},
+				},
+			},
+		}),
+	},
+	{
+		Version:     8,
+		Description: "Index merchant content rules by merchant",
+		Up: migrations.CreateIndexes(migrations.CollectionIndexes{
+			Collection: "merchant_content_rules",
+			Indexes: []mongo.IndexModel{
+				// One rule set per merchant
+				{
+					Keys:    bson.D{{Key: "merchant_id", Value: 1}},
+					Options: options.Index().SetUnique(true),
+				},
+			},
+		}),
+	},
 }
 
 // collection相關的index
-var migrations = []Migration{
+var initialIndexes = []migrations.CollectionIndexes{
 	{
 		Collection: "form_templates",
 		Indexes: []mongo.IndexModel{
@@ -53,6 +192,176 @@ var migrations = []Migration{
 			},
 		},
 	},
+	{
+		Collection: "form_schema_versions",
+		Indexes: []mongo.IndexModel{
+			// One snapshot per form version
+			{
+				Keys: bson.D{
+					{Key: "form_id", Value: 1},
+					{Key: "version", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		Collection: "form_submissions",
+		Indexes: []mongo.IndexModel{
+			// Submissions of a form ordered by submission time
+			{
+				Keys: bson.D{
+					{Key: "merchant_id", Value: 1},
+					{Key: "form_id", Value: 1},
+					{Key: "submitted_at", Value: -1},
+				},
+			},
+		},
+	},
+	{
+		Collection: "forms",
+		Indexes: []mongo.IndexModel{
+			// Published forms due to be closed automatically
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "close_at", Value: 1},
+				},
+				Options: options.Index().SetPartialFilterExpression(bson.D{
+					{Key: "close_at", Value: bson.D{{Key: "$exists", Value: true}}},
+				}),
+			},
+		},
+	},
+	{
+		Collection: "submission_filter_usage",
+		Indexes: []mongo.IndexModel{
+			// One usage counter per merchant and answer field
+			{
+				Keys: bson.D{
+					{Key: "merchant_id", Value: 1},
+					{Key: "field", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		Collection: "form_response_counters",
+		Indexes: []mongo.IndexModel{
+			// One counter per form and user; the empty user holds the form total.
+			// Response quotas rely on this index to reject submissions over the limit.
+			{
+				Keys: bson.D{
+					{Key: "form_id", Value: 1},
+					{Key: "user_id", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		Collection: "form_invitations",
+		Indexes: []mongo.IndexModel{
+			{
+				Keys: bson.D{{Key: "form_id", Value: 1}},
+			},
+			// Expired invitations can no longer be redeemed and are removed automatically
+			{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		},
+	},
+	{
+		Collection: "idempotency_keys",
+		Indexes: []mongo.IndexModel{
+			// A key can be used once per caller and operation
+			{
+				Keys: bson.D{
+					{Key: "scope", Value: 1},
+					{Key: "operation", Value: 1},
+					{Key: "key", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+			// Keys are forgotten once they expire
+			{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		},
+	},
+	{
+		Collection: "merchant_limits",
+		Indexes: []mongo.IndexModel{
+			// One set of overrides per merchant
+			{
+				Keys:    bson.D{{Key: "merchant_id", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+	},
+	{
+		Collection: "form_files",
+		Indexes: []mongo.IndexModel{
+			{
+				Keys: bson.D{{Key: "form_id", Value: 1}},
+			},
+			// Pending uploads never attached to a submission are removed automatically
+			{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		},
+	},
+	{
+		Collection: "jobs",
+		Indexes: []mongo.IndexModel{
+			// Due jobs are leased by type in run_at order; expired leases are found by lease_until
+			{
+				Keys: bson.D{
+					{Key: "type", Value: 1},
+					{Key: "status", Value: 1},
+					{Key: "run_at", Value: 1},
+				},
+			},
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "lease_until", Value: 1},
+				},
+			},
+			// Finished jobs are removed after the retention period
+			{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		},
+	},
+	{
+		Collection: "outbox_events",
+		Indexes: []mongo.IndexModel{
+			// Due events are leased in creation order; expired leases are found by lease_until
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "next_attempt_at", Value: 1},
+				},
+			},
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "lease_until", Value: 1},
+				},
+			},
+			// Published events are removed after the retention period
+			{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		},
+	},
 	/*{
 		Collection: "forms",
 		Indexes: []mongo.IndexModel{
@@ -100,21 +409,35 @@ var migrations = []Migration{
 	},*/
 }
 
-// Migrate runs all the defined migrations.
-func Migrate(client *mongo.Client, cfg *conf.MongodbConfig) error {
+// Migrate applies the pending migrations, creates the indexes declared by the repositories and
+// returns the migration status
+func Migrate(ctx context.Context, client *mongo.Client, cfg *conf.MongodbConfig) ([]migrations.Status, error) {
 	log.Info("Running MongoDB migrations...")
-	db := client.Database(cfg.DB)
+	migrator, err := migrations.NewMigrator(client.Database(cfg.DB), versions)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, m := range migrations {
-		coll := db.Collection(m.Collection)
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(applied) > 0 {
+		log.Info("MongoDB migrations applied", log.Int("count", len(applied)))
+	}
 
-		if len(m.Indexes) > 0 {
-			_, err := coll.Indexes().CreateMany(context.Background(), m.Indexes)
-			if err != nil {
-				return fmt.Errorf("failed to create indexes for collection '%s': %w", m.Collection, err)
-			}
-		}
+	// Indexes declared by the repositories are checked on every run, so they follow the code
+	if err := repository.EnsureIndexes(ctx, repository.NewMongoRepository(client, cfg.DB)); err != nil {
+		return nil, err
 	}
+	return migrator.Status(ctx)
+}
 
-	return nil
+// MigrationStatus returns the status of every migration without applying any
+func MigrationStatus(ctx context.Context, client *mongo.Client, cfg *conf.MongodbConfig) ([]migrations.Status, error) {
+	migrator, err := migrations.NewMigrator(client.Database(cfg.DB), versions)
+	if err != nil {
+		return nil, err
+	}
+	return migrator.Status(ctx)
 }
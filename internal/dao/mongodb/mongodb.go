@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/metrics"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -48,7 +49,8 @@ func InitMongoDB(ctx context.Context, cfg *conf.MongodbConfig) (*mongo.Client, e
 			SetMinPoolSize(10).
 			SetMaxConnIdleTime(30 * time.Second).
 			SetConnectTimeout(10 * time.Second).
-			SetSocketTimeout(30 * time.Second)
+			SetSocketTimeout(30 * time.Second).
+			SetMonitor(metrics.NewMongoMonitor())
 
 		clientInstance, initErr = mongo.Connect(connectCtx, clientOptions)
 		if initErr != nil {
@@ -62,22 +64,19 @@ func InitMongoDB(ctx context.Context, cfg *conf.MongodbConfig) (*mongo.Client, e
 			return
 		}
 
-		// Run migrations
-		if err := Migrate(clientInstance, cfg); err != nil {
-			initErr = fmt.Errorf("failed to run migrations: %w", err)
-			return
+		// Run migrations unless they are applied separately with the migrate command
+		if !cfg.SkipMigrations {
+			if _, err := Migrate(context.Background(), clientInstance, cfg); err != nil {
+				initErr = fmt.Errorf("failed to run migrations: %w", err)
+				return
+			}
 		}
 
 		// Start cleanup goroutine with original context
 		go func() {
 			<-ctx.Done()
-			// Use atomic operation to prevent race condition
-			if atomic.CompareAndSwapInt64(&disconnected, 0, 1) {
-				if err := clientInstance.Disconnect(context.Background()); err != nil {
-					log.Error("failed to disconnect from mongodb", log.Err(err))
-				} else {
-					log.Info("MongoDB connection closed gracefully")
-				}
+			if err := Close(context.Background()); err != nil {
+				log.Error("failed to disconnect from mongodb", log.Err(err))
 			}
 		}()
 	})
@@ -85,6 +84,23 @@ func InitMongoDB(ctx context.Context, cfg *conf.MongodbConfig) (*mongo.Client, e
 	return clientInstance, initErr
 }
 
+// Close disconnects the singleton MongoDB client, waiting for in-progress operations until the
+// context expires. Closing more than once is a no-op.
+func Close(ctx context.Context) error {
+	if clientInstance == nil {
+		return nil
+	}
+	// Use atomic operation to prevent race condition
+	if !atomic.CompareAndSwapInt64(&disconnected, 0, 1) {
+		return nil
+	}
+	if err := clientInstance.Disconnect(ctx); err != nil {
+		return err
+	}
+	log.Info("MongoDB connection closed gracefully")
+	return nil
+}
+
 // GetMongoDB returns the singleton MongoDB client.
 // InitMongoDB must be called first, otherwise this will return nil.
 // Returns nil if the client has been disconnected.
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// AttendanceRepository defines the interface for session attendance data access
+type AttendanceRepository interface {
+	// CheckIn stores the attendance of a submission at a session. If the submission already
+	// checked in to the session, the existing record is returned instead and nothing is stored.
+	CheckIn(ctx context.Context, attendance *models.Attendance) (*models.Attendance, error)
+	// Count the attendees of a form's registrations at a session
+	CountBySession(ctx context.Context, formID primitive.ObjectID, sessionID string) (int64, error)
+}
+
+// NewAttendanceRepository creates a new attendance repository implementation
+func NewAttendanceRepository(mongoRepo *MongoRepository) AttendanceRepository {
+	return &mongoAttendanceRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoAttendanceRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// CheckIn implements AttendanceRepository.CheckIn
+func (r *mongoAttendanceRepository) CheckIn(ctx context.Context, attendance *models.Attendance) (*models.Attendance, error) {
+	if attendance.ID.IsZero() {
+		attendance.ID = primitive.NewObjectID()
+	}
+
+	// The unique index on submission_id and session_id rejects a second check-in
+	err := r.mongoRepo.Save(ctx, attendance.TableName(), attendance)
+	if err == nil {
+		return nil, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+
+	var existing models.Attendance
+	filter := map[string]interface{}{
+		"submission_id": attendance.SubmissionID,
+		"session_id":    attendance.SessionID,
+	}
+	if err := r.mongoRepo.FindOne(ctx, attendance.TableName(), filter, &existing); err != nil {
+		return nil, err
+	}
+	return &existing, nil
+}
+
+// CountBySession implements AttendanceRepository.CountBySession
+func (r *mongoAttendanceRepository) CountBySession(ctx context.Context, formID primitive.ObjectID, sessionID string) (int64, error) {
+	filter := map[string]interface{}{
+		"form_id":    formID,
+		"session_id": sessionID,
+	}
+
+	return r.mongoRepo.Count(ctx, models.Attendance{}.TableName(), filter)
+}
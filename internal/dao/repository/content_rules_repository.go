@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// ContentRulesRepository defines the interface for per-merchant content rule data access
+type ContentRulesRepository interface {
+	// Find the rules of a merchant, returning nil when the merchant has none
+	FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantContentRules, error)
+	// Create or replace the rules of a merchant
+	Upsert(ctx context.Context, rules *models.MerchantContentRules) error
+}
+
+// NewContentRulesRepository creates a new content rules repository implementation
+func NewContentRulesRepository(mongoRepo *MongoRepository) ContentRulesRepository {
+	return &mongoContentRulesRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoContentRulesRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// FindByMerchantID implements ContentRulesRepository.FindByMerchantID
+func (r *mongoContentRulesRepository) FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantContentRules, error) {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+
+	var rules models.MerchantContentRules
+	err := r.mongoRepo.FindOne(ctx, rules.TableName(), filter, &rules)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// Upsert implements ContentRulesRepository.Upsert
+func (r *mongoContentRulesRepository) Upsert(ctx context.Context, rules *models.MerchantContentRules) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	rules.UpdatedAt = now
+
+	filter := map[string]interface{}{
+		"merchant_id": rules.MerchantID,
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"action":        rules.Action,
+			"block_urls":    rules.BlockURLs,
+			"blocked_terms": rules.BlockedTerms,
+			"updated_at":    now,
+			"updated_by":    rules.UpdatedBy,
+		},
+		"$setOnInsert": map[string]interface{}{
+			"created_at": now,
+		},
+	}
+
+	return r.mongoRepo.UpsertOne(ctx, rules.TableName(), filter, update)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// FieldBlockRepository defines the interface for the field block library of merchants
+type FieldBlockRepository interface {
+	// Create stores a new field block
+	Create(ctx context.Context, block *models.FieldBlock) error
+	// FindByID finds a field block by ID
+	FindByID(ctx context.Context, blockID primitive.ObjectID) (*models.FieldBlock, error)
+	// FindByMerchantID finds the field blocks of a merchant with pagination
+	FindByMerchantID(ctx context.Context, options *models.FieldBlockQueryOptions) ([]*models.FieldBlock, int64, error)
+	// Update replaces a field block of the merchant
+	Update(ctx context.Context, block *models.FieldBlock) error
+	// Delete deletes a field block by ID
+	Delete(ctx context.Context, blockID primitive.ObjectID) error
+}
+
+// NewFieldBlockRepository creates a new field block repository implementation
+func NewFieldBlockRepository(mongoRepo *MongoRepository) FieldBlockRepository {
+	return &mongoFieldBlockRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoFieldBlockRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Create implements FieldBlockRepository.Create
+func (r *mongoFieldBlockRepository) Create(ctx context.Context, block *models.FieldBlock) error {
+	now := time.Now()
+	block.SetCreatedAt(now)
+	block.SetUpdatedAt(now)
+
+	if block.ID.IsZero() {
+		block.ID = primitive.NewObjectID()
+	}
+
+	return r.mongoRepo.Save(ctx, block.TableName(), block)
+}
+
+// FindByID implements FieldBlockRepository.FindByID
+func (r *mongoFieldBlockRepository) FindByID(ctx context.Context, blockID primitive.ObjectID) (*models.FieldBlock, error) {
+	var block models.FieldBlock
+	filter := map[string]interface{}{
+		"_id": blockID,
+	}
+
+	if err := r.mongoRepo.FindOne(ctx, block.TableName(), filter, &block); err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+// FindByMerchantID implements FieldBlockRepository.FindByMerchantID
+func (r *mongoFieldBlockRepository) FindByMerchantID(ctx context.Context, options *models.FieldBlockQueryOptions) ([]*models.FieldBlock, int64, error) {
+	filter := map[string]interface{}{
+		"merchant_id": options.MerchantID,
+	}
+
+	var blocks []*models.FieldBlock
+	pagination := &PaginationOptions{
+		Page:      options.Page,
+		PageSize:  options.PageSize,
+		SortBy:    options.SortBy,
+		SortOrder: options.SortOrder,
+		Exclude:   viewExclusions(options.View, "schema"),
+	}
+
+	count, err := r.mongoRepo.FindWithPagination(ctx, models.FieldBlock{}.TableName(), filter, &blocks, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return blocks, count, nil
+}
+
+// Update implements FieldBlockRepository.Update
+func (r *mongoFieldBlockRepository) Update(ctx context.Context, block *models.FieldBlock) error {
+	block.SetUpdatedAt(time.Now())
+
+	filter := map[string]interface{}{
+		"_id":         block.ID,
+		"merchant_id": block.MerchantID,
+	}
+
+	return r.mongoRepo.UpdateOne(ctx, block.TableName(), filter, block)
+}
+
+// Delete implements FieldBlockRepository.Delete
+func (r *mongoFieldBlockRepository) Delete(ctx context.Context, blockID primitive.ObjectID) error {
+	filter := map[string]interface{}{
+		"_id": blockID,
+	}
+
+	return r.mongoRepo.DeleteOne(ctx, models.FieldBlock{}.TableName(), filter)
+}
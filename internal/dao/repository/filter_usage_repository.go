@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// FilterUsageRepository defines the interface for submission filter usage tracking
+type FilterUsageRepository interface {
+	// Record that a merchant filtered submissions on the given answer fields
+	Record(ctx context.Context, merchantID string, fields []string) error
+	// List answer fields filtered at least minCount times across merchants, most used first
+	ListFrequentFields(ctx context.Context, minCount int64) ([]*models.FilterFieldUsage, error)
+}
+
+// NewFilterUsageRepository creates a new filter usage repository implementation
+func NewFilterUsageRepository(mongoRepo *MongoRepository) FilterUsageRepository {
+	return &mongoFilterUsageRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoFilterUsageRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Record implements FilterUsageRepository.Record
+func (r *mongoFilterUsageRepository) Record(ctx context.Context, merchantID string, fields []string) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	for _, field := range fields {
+		filter := map[string]interface{}{
+			"merchant_id": merchantID,
+			"field":       field,
+		}
+		update := bson.M{
+			"$inc": bson.M{"count": 1},
+			"$set": bson.M{"last_used_at": now},
+		}
+		if err := r.mongoRepo.UpsertOne(ctx, models.SubmissionFilterUsage{}.TableName(), filter, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListFrequentFields implements FilterUsageRepository.ListFrequentFields
+func (r *mongoFilterUsageRepository) ListFrequentFields(ctx context.Context, minCount int64) ([]*models.FilterFieldUsage, error) {
+	pipeline := bson.A{
+		bson.M{"$group": bson.M{
+			"_id":            "$field",
+			"usage_count":    bson.M{"$sum": "$count"},
+			"merchant_count": bson.M{"$sum": 1},
+			"last_used_at":   bson.M{"$max": "$last_used_at"},
+		}},
+		bson.M{"$match": bson.M{"usage_count": bson.M{"$gte": minCount}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "usage_count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	var results []struct {
+		Field         string             `bson:"_id"`
+		UsageCount    int64              `bson:"usage_count"`
+		MerchantCount int64              `bson:"merchant_count"`
+		LastUsedAt    primitive.DateTime `bson:"last_used_at"`
+	}
+	if err := r.mongoRepo.Aggregate(ctx, models.SubmissionFilterUsage{}.TableName(), pipeline, &results); err != nil {
+		return nil, err
+	}
+
+	usages := make([]*models.FilterFieldUsage, len(results))
+	for i, result := range results {
+		usages[i] = &models.FilterFieldUsage{
+			Field:         result.Field,
+			UsageCount:    result.UsageCount,
+			MerchantCount: result.MerchantCount,
+			LastUsedAt:    result.LastUsedAt.Time(),
+		}
+	}
+	return usages, nil
+}
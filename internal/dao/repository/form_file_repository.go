@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// FormFileRepository defines the interface for uploaded file data access
+type FormFileRepository interface {
+	// Create a pending file
+	Create(ctx context.Context, file *models.FileReference) error
+	// Find files by ID
+	FindByIDs(ctx context.Context, fileIDs []primitive.ObjectID) ([]*models.FileReference, error)
+	// Link pending files to a submission, returning the number of files linked
+	Attach(ctx context.Context, fileIDs []primitive.ObjectID, submissionID primitive.ObjectID) (int64, error)
+	// Make files linked to a submission pending again until expiresAt
+	Detach(ctx context.Context, submissionID primitive.ObjectID, expiresAt time.Time) error
+}
+
+// NewFormFileRepository creates a new form file repository implementation
+func NewFormFileRepository(mongoRepo *MongoRepository) FormFileRepository {
+	return &mongoFormFileRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoFormFileRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Create implements FormFileRepository.Create
+func (r *mongoFormFileRepository) Create(ctx context.Context, file *models.FileReference) error {
+	if file.ID.IsZero() {
+		file.ID = primitive.NewObjectID()
+	}
+	file.SetCreatedAt(time.Now())
+
+	return r.mongoRepo.Save(ctx, file.TableName(), file)
+}
+
+// FindByIDs implements FormFileRepository.FindByIDs
+func (r *mongoFormFileRepository) FindByIDs(ctx context.Context, fileIDs []primitive.ObjectID) ([]*models.FileReference, error) {
+	filter := map[string]interface{}{
+		"_id": map[string]interface{}{"$in": fileIDs},
+	}
+
+	var files []*models.FileReference
+	if err := r.mongoRepo.Find(ctx, models.FileReference{}.TableName(), filter, &files, nil); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// Attach implements FormFileRepository.Attach
+func (r *mongoFormFileRepository) Attach(ctx context.Context, fileIDs []primitive.ObjectID, submissionID primitive.ObjectID) (int64, error) {
+	// Matching only pending files keeps a file from being linked to two submissions
+	filter := map[string]interface{}{
+		"_id":    map[string]interface{}{"$in": fileIDs},
+		"status": models.FileStatusPending,
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"status":        models.FileStatusAttached,
+			"submission_id": submissionID,
+		},
+		// Attached files are kept, so they must leave the TTL index
+		"$unset": map[string]interface{}{
+			"expires_at": "",
+		},
+	}
+
+	return r.mongoRepo.UpdateManyRaw(ctx, models.FileReference{}.TableName(), filter, update)
+}
+
+// Detach implements FormFileRepository.Detach
+func (r *mongoFormFileRepository) Detach(ctx context.Context, submissionID primitive.ObjectID, expiresAt time.Time) error {
+	filter := map[string]interface{}{
+		"submission_id": submissionID,
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"status":     models.FileStatusPending,
+			"expires_at": primitive.NewDateTimeFromTime(expiresAt),
+		},
+		"$unset": map[string]interface{}{
+			"submission_id": "",
+		},
+	}
+
+	_, err := r.mongoRepo.UpdateManyRaw(ctx, models.FileReference{}.TableName(), filter, update)
+	return err
+}
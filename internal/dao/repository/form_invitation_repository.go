@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// FormInvitationRepository defines the interface for form invitation data access
+type FormInvitationRepository interface {
+	// Create multiple invitations in a single batch
+	CreateMany(ctx context.Context, invitations []*models.FormInvitation) error
+	// Mark an unused invitation of a form as used, reporting whether it was still available
+	Redeem(ctx context.Context, invitationID, formID primitive.ObjectID, usedBy string, usedAt time.Time) (bool, error)
+	// Make a redeemed invitation available again
+	Release(ctx context.Context, invitationID primitive.ObjectID) error
+}
+
+// NewFormInvitationRepository creates a new form invitation repository implementation
+func NewFormInvitationRepository(mongoRepo *MongoRepository) FormInvitationRepository {
+	return &mongoFormInvitationRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoFormInvitationRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// CreateMany implements FormInvitationRepository.CreateMany
+func (r *mongoFormInvitationRepository) CreateMany(ctx context.Context, invitations []*models.FormInvitation) error {
+	now := time.Now()
+
+	documents := make([]interface{}, len(invitations))
+	for i, invitation := range invitations {
+		if invitation.ID.IsZero() {
+			invitation.ID = primitive.NewObjectID()
+		}
+		invitation.SetCreatedAt(now)
+		documents[i] = invitation
+	}
+
+	return r.mongoRepo.SaveMany(ctx, models.FormInvitation{}.TableName(), documents)
+}
+
+// Redeem implements FormInvitationRepository.Redeem
+func (r *mongoFormInvitationRepository) Redeem(ctx context.Context, invitationID, formID primitive.ObjectID, usedBy string, usedAt time.Time) (bool, error) {
+	// Matching only unused invitations makes redemption atomic
+	filter := map[string]interface{}{
+		"_id":     invitationID,
+		"form_id": formID,
+		"used_at": nil,
+	}
+	update := map[string]interface{}{
+		"used_at": primitive.NewDateTimeFromTime(usedAt),
+		"used_by": usedBy,
+	}
+
+	modified, err := r.mongoRepo.UpdateMany(ctx, models.FormInvitation{}.TableName(), filter, update)
+	if err != nil {
+		return false, err
+	}
+	return modified > 0, nil
+}
+
+// Release implements FormInvitationRepository.Release
+func (r *mongoFormInvitationRepository) Release(ctx context.Context, invitationID primitive.ObjectID) error {
+	filter := map[string]interface{}{
+		"_id": invitationID,
+	}
+	update := map[string]interface{}{
+		"used_at": nil,
+		"used_by": "",
+	}
+
+	return r.mongoRepo.UpdateOne(ctx, models.FormInvitation{}.TableName(), filter, update)
+}
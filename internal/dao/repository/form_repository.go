@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/arwoosa/form/internal/models"
 )
@@ -17,6 +20,9 @@ type FormRepository interface {
 	// Find form by ID
 	FindByID(ctx context.Context, formID primitive.ObjectID) (*models.Form, error)
 
+	// Find forms by IDs in a single query; missing forms are left out
+	FindByIDs(ctx context.Context, formIDs []primitive.ObjectID) ([]*models.Form, error)
+
 	// Find forms with pagination and optional filters
 	Find(ctx context.Context, options *models.FormQueryOptions) ([]*models.Form, int64, error)
 
@@ -37,8 +43,29 @@ type FormRepository interface {
 
 	// Count forms using a specific template (useful for template deletion validation)
 	CountByTemplateID(ctx context.Context, templateID primitive.ObjectID, merchantID string) (int64, error)
+
+	// Remove the template reference from forms using a template, returning the number of forms changed
+	DetachTemplate(ctx context.Context, templateID primitive.ObjectID, merchantID string) (int64, error)
+
+	// Find forms referencing a template that no longer exists, in ID order after afterID
+	FindWithMissingTemplate(ctx context.Context, afterID primitive.ObjectID, limit int) ([]*models.Form, error)
+
+	// Save a snapshot of a replaced schema version (idempotent per form and version)
+	SaveSchemaVersion(ctx context.Context, version *models.FormSchemaVersion) error
+
+	// Find a historical schema version of a form
+	FindSchemaVersion(ctx context.Context, formID primitive.ObjectID, version int) (*models.FormSchemaVersion, error)
+
+	// Close published forms whose close_at time has passed, returning the number of forms closed
+	CloseExpired(ctx context.Context, now time.Time) (int64, error)
+
+	// Count a merchant's forms by status
+	CountByStatus(ctx context.Context, merchantID string) (map[models.FormStatus]int64, error)
 }
 
+// SystemUser identifies changes made by the service itself rather than a user
+const SystemUser = "system"
+
 // NewFormRepository creates a new form repository implementation
 func NewFormRepository(mongoRepo *MongoRepository) FormRepository {
 	return &mongoFormRepository{
@@ -78,6 +105,19 @@ func (r *mongoFormRepository) FindByID(ctx context.Context, formID primitive.Obj
 	return &form, nil
 }
 
+// FindByIDs implements FormRepository.FindByIDs
+func (r *mongoFormRepository) FindByIDs(ctx context.Context, formIDs []primitive.ObjectID) ([]*models.Form, error) {
+	filter := map[string]interface{}{
+		"_id": map[string]interface{}{"$in": formIDs},
+	}
+
+	var forms []*models.Form
+	if err := r.mongoRepo.Find(ctx, models.Form{}.TableName(), filter, &forms, nil); err != nil {
+		return nil, err
+	}
+	return forms, nil
+}
+
 // Find implements FormRepository.Find
 func (r *mongoFormRepository) Find(ctx context.Context, options *models.FormQueryOptions) ([]*models.Form, int64, error) {
 	filter := map[string]interface{}{
@@ -95,6 +135,7 @@ func (r *mongoFormRepository) Find(ctx context.Context, options *models.FormQuer
 		PageSize:  options.PageSize,
 		SortBy:    options.SortBy,
 		SortOrder: options.SortOrder,
+		Count:     options.Count,
 	}
 
 	count, err := r.mongoRepo.FindWithPagination(ctx, models.Form{}.TableName(), filter, &forms, pagination)
@@ -188,3 +229,131 @@ func (r *mongoFormRepository) CountByTemplateID(ctx context.Context, templateID
 
 	return r.mongoRepo.Count(ctx, models.Form{}.TableName(), filter)
 }
+
+// DetachTemplate implements FormRepository.DetachTemplate
+func (r *mongoFormRepository) DetachTemplate(ctx context.Context, templateID primitive.ObjectID, merchantID string) (int64, error) {
+	filter := map[string]interface{}{
+		"template_id": templateID,
+		"merchant_id": merchantID,
+	}
+	update := map[string]interface{}{
+		"$unset": map[string]interface{}{"template_id": "", "template_version": ""},
+		"$set":   map[string]interface{}{"updated_at": primitive.NewDateTimeFromTime(time.Now())},
+	}
+
+	return r.mongoRepo.UpdateManyRaw(ctx, models.Form{}.TableName(), filter, update)
+}
+
+// FindWithMissingTemplate implements FormRepository.FindWithMissingTemplate
+func (r *mongoFormRepository) FindWithMissingTemplate(ctx context.Context, afterID primitive.ObjectID, limit int) ([]*models.Form, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{
+			"_id":         bson.M{"$gt": afterID},
+			"template_id": bson.M{"$exists": true},
+		}},
+		bson.M{"$sort": bson.M{"_id": 1}},
+		bson.M{"$lookup": bson.M{
+			"from":         models.FormTemplate{}.TableName(),
+			"localField":   "template_id",
+			"foreignField": "_id",
+			"as":           "template",
+		}},
+		bson.M{"$match": bson.M{"template": bson.M{"$size": 0}}},
+		bson.M{"$limit": limit},
+		bson.M{"$project": bson.M{"template": 0}},
+	}
+
+	var forms []*models.Form
+	if err := r.mongoRepo.Aggregate(ctx, models.Form{}.TableName(), pipeline, &forms); err != nil {
+		return nil, err
+	}
+	return forms, nil
+}
+
+// SaveSchemaVersion implements FormRepository.SaveSchemaVersion
+func (r *mongoFormRepository) SaveSchemaVersion(ctx context.Context, version *models.FormSchemaVersion) error {
+	filter := map[string]interface{}{
+		"form_id": version.FormID,
+		"version": version.Version,
+	}
+
+	return r.mongoRepo.Upsert(ctx, version.TableName(), filter, version)
+}
+
+// FindSchemaVersion implements FormRepository.FindSchemaVersion
+func (r *mongoFormRepository) FindSchemaVersion(ctx context.Context, formID primitive.ObjectID, version int) (*models.FormSchemaVersion, error) {
+	filter := map[string]interface{}{
+		"form_id": formID,
+		"version": version,
+	}
+
+	var schemaVersion models.FormSchemaVersion
+	err := r.mongoRepo.FindOne(ctx, schemaVersion.TableName(), filter, &schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaVersion, nil
+}
+
+// CloseExpired implements FormRepository.CloseExpired
+func (r *mongoFormRepository) CloseExpired(ctx context.Context, now time.Time) (int64, error) {
+	filter := map[string]interface{}{
+		"status":   models.FormStatusPublished,
+		"close_at": map[string]interface{}{"$lte": now},
+	}
+	update := map[string]interface{}{
+		"status":     models.FormStatusClosed,
+		"updated_at": primitive.NewDateTimeFromTime(now),
+		"updated_by": SystemUser,
+	}
+
+	return r.mongoRepo.UpdateMany(ctx, models.Form{}.TableName(), filter, update)
+}
+
+// CountByStatus implements FormRepository.CountByStatus
+func (r *mongoFormRepository) CountByStatus(ctx context.Context, merchantID string) (map[models.FormStatus]int64, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"merchant_id": merchantID}},
+		bson.M{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+	}
+
+	var results []struct {
+		Status models.FormStatus `bson:"_id"`
+		Count  int64             `bson:"count"`
+	}
+	if err := r.mongoRepo.Aggregate(ctx, models.Form{}.TableName(), pipeline, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[models.FormStatus]int64, len(results))
+	for _, result := range results {
+		counts[result.Status] = result.Count
+	}
+	return counts, nil
+}
+
+// EnsureIndexes implements IndexEnsurer.EnsureIndexes
+func (r *mongoFormRepository) EnsureIndexes(ctx context.Context) error {
+	return r.mongoRepo.CreateIndexes(ctx, models.Form{}.TableName(), []mongo.IndexModel{
+		// Merchant isolation, listing newest first
+		{
+			Keys: bson.D{
+				{Key: "merchant_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		// Forms of an event
+		{
+			Keys: bson.D{
+				{Key: "merchant_id", Value: 1},
+				{Key: "event_id", Value: 1},
+			},
+		},
+		// Forms created from a template, checked before the template is deleted
+		{
+			Keys:    bson.D{{Key: "template_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	})
+}
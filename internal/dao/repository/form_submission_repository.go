@@ -0,0 +1,522 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// FormSubmissionRepository defines the interface for form submission data access
+type FormSubmissionRepository interface {
+	// Create a new submission
+	Create(ctx context.Context, submission *models.FormSubmission) error
+	// Find a submission by ID
+	FindByID(ctx context.Context, submissionID primitive.ObjectID) (*models.FormSubmission, error)
+	// Increment a form response counter unless it has reached the limit, reporting whether a slot was reserved.
+	// An empty userID selects the form's total counter.
+	ReserveResponse(ctx context.Context, formID primitive.ObjectID, userID string, limit int) (bool, error)
+	// Give back a slot reserved with ReserveResponse
+	ReleaseResponse(ctx context.Context, formID primitive.ObjectID, userID string) error
+	// Raise a form's response counters to the responses it holds, in total and per respondent,
+	// so quotas set on a form that already has responses count them
+	SeedResponseCounters(ctx context.Context, formID primitive.ObjectID) error
+	// Create multiple submissions in a single batch
+	CreateMany(ctx context.Context, submissions []*models.FormSubmission) error
+	// Find a form's submissions with answer filters and pagination
+	Find(ctx context.Context, options *models.SubmissionQueryOptions) ([]*models.FormSubmission, int64, error)
+	// Stream a form's submissions to fn one at a time, reading from the database in batches
+	Stream(ctx context.Context, options *models.SubmissionStreamOptions, fn func(*models.FormSubmission) error) error
+	// Aggregate response statistics for a form in a single round trip
+	AggregateStats(ctx context.Context, query *models.FormResponseStatsQuery) (*models.FormResponseStats, error)
+	// List the answer fields covered by automatically created indexes
+	ListAnswerIndexes(ctx context.Context) ([]string, error)
+	// Create an index supporting filters on an answer field
+	CreateAnswerIndex(ctx context.Context, field string) error
+	// Count all submissions received by a merchant's forms
+	CountByMerchantID(ctx context.Context, merchantID string) (int64, error)
+	// Count the submissions received by a form
+	CountByFormID(ctx context.Context, formID primitive.ObjectID) (int64, error)
+}
+
+// autoAnswerIndexPrefix prefixes the names of automatically created answer field indexes
+const autoAnswerIndexPrefix = "auto_answers_"
+
+// NewFormSubmissionRepository creates a new form submission repository implementation
+func NewFormSubmissionRepository(mongoRepo *MongoRepository) FormSubmissionRepository {
+	return &mongoFormSubmissionRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoFormSubmissionRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Create implements FormSubmissionRepository.Create
+func (r *mongoFormSubmissionRepository) Create(ctx context.Context, submission *models.FormSubmission) error {
+	if submission.ID.IsZero() {
+		submission.ID = primitive.NewObjectID()
+	}
+	submission.SetCreatedAt(time.Now())
+
+	return r.mongoRepo.Save(ctx, submission.TableName(), submission)
+}
+
+// FindByID implements FormSubmissionRepository.FindByID
+func (r *mongoFormSubmissionRepository) FindByID(ctx context.Context, submissionID primitive.ObjectID) (*models.FormSubmission, error) {
+	var submission models.FormSubmission
+	filter := map[string]interface{}{
+		"_id": submissionID,
+	}
+
+	if err := r.mongoRepo.FindOne(ctx, submission.TableName(), filter, &submission); err != nil {
+		return nil, err
+	}
+
+	return &submission, nil
+}
+
+// ReserveResponse implements FormSubmissionRepository.ReserveResponse
+func (r *mongoFormSubmissionRepository) ReserveResponse(ctx context.Context, formID primitive.ObjectID, userID string, limit int) (bool, error) {
+	// A counter at the limit does not match the filter, so the upsert tries to insert a second
+	// counter and is rejected by the unique index on form_id and user_id
+	filter := map[string]interface{}{
+		"form_id": formID,
+		"user_id": userID,
+		"count":   bson.M{"$lt": limit},
+	}
+	update := bson.M{"$inc": bson.M{"count": 1}}
+
+	err := r.mongoRepo.UpsertOne(ctx, models.FormResponseCounter{}.TableName(), filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		// Concurrent first reservations both try to insert the counter; the one rejected
+		// retries against the counter the other created
+		err = r.mongoRepo.UpsertOne(ctx, models.FormResponseCounter{}.TableName(), filter, update)
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseResponse implements FormSubmissionRepository.ReleaseResponse
+func (r *mongoFormSubmissionRepository) ReleaseResponse(ctx context.Context, formID primitive.ObjectID, userID string) error {
+	filter := map[string]interface{}{
+		"form_id": formID,
+		"user_id": userID,
+		"count":   bson.M{"$gt": 0},
+	}
+	return r.mongoRepo.UpdateOneRaw(ctx, models.FormResponseCounter{}.TableName(), filter, bson.M{"$inc": bson.M{"count": -1}})
+}
+
+// SeedResponseCounters implements FormSubmissionRepository.SeedResponseCounters
+func (r *mongoFormSubmissionRepository) SeedResponseCounters(ctx context.Context, formID primitive.ObjectID) error {
+	pipeline := []bson.M{
+		{"$match": bson.M{"form_id": formID}},
+		{"$group": bson.M{"_id": "$submitted_by", "count": bson.M{"$sum": 1}}},
+	}
+	var results []struct {
+		UserID string `bson:"_id"`
+		Count  int    `bson:"count"`
+	}
+	if err := r.mongoRepo.Aggregate(ctx, models.FormSubmission{}.TableName(), pipeline, &results); err != nil {
+		return err
+	}
+
+	// $max keeps counters that already include reservations of responses being stored
+	counts := map[string]int{"": 0}
+	for _, result := range results {
+		counts[""] += result.Count
+		if result.UserID != "" {
+			counts[result.UserID] = result.Count
+		}
+	}
+	for userID, count := range counts {
+		filter := map[string]interface{}{
+			"form_id": formID,
+			"user_id": userID,
+		}
+		update := bson.M{"$max": bson.M{"count": count}}
+		if err := r.mongoRepo.UpsertOne(ctx, models.FormResponseCounter{}.TableName(), filter, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSubmissions deletes the submissions matching the filter and gives back the response
+// counter slots they held, so quotas keep counting only the responses stored
+func deleteSubmissions(ctx context.Context, mongoRepo *MongoRepository, filter map[string]interface{}) (int64, error) {
+	pipeline := []bson.M{
+		{"$match": filter},
+		{"$group": bson.M{
+			"_id":   bson.M{"form_id": "$form_id", "user_id": "$submitted_by"},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+	var results []struct {
+		ID struct {
+			FormID primitive.ObjectID `bson:"form_id"`
+			UserID string             `bson:"user_id"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err := mongoRepo.Aggregate(ctx, models.FormSubmission{}.TableName(), pipeline, &results); err != nil {
+		return 0, err
+	}
+
+	deleted, err := mongoRepo.DeleteMany(ctx, models.FormSubmission{}.TableName(), filter)
+	if err != nil {
+		return deleted, err
+	}
+
+	totals := map[primitive.ObjectID]int{}
+	for _, result := range results {
+		totals[result.ID.FormID] += result.Count
+		if result.ID.UserID == "" {
+			continue
+		}
+		if err := releaseResponses(ctx, mongoRepo, result.ID.FormID, result.ID.UserID, result.Count); err != nil {
+			return deleted, err
+		}
+	}
+	for formID, count := range totals {
+		if err := releaseResponses(ctx, mongoRepo, formID, "", count); err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// releaseResponses lowers a form response counter by count, stopping at zero
+func releaseResponses(ctx context.Context, mongoRepo *MongoRepository, formID primitive.ObjectID, userID string, count int) error {
+	filter := map[string]interface{}{
+		"form_id": formID,
+		"user_id": userID,
+	}
+	update := bson.A{bson.M{"$set": bson.M{
+		"count": bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{"$count", count}}}},
+	}}}
+	return mongoRepo.UpdateOneRaw(ctx, models.FormResponseCounter{}.TableName(), filter, update)
+}
+
+// CreateMany implements FormSubmissionRepository.CreateMany
+func (r *mongoFormSubmissionRepository) CreateMany(ctx context.Context, submissions []*models.FormSubmission) error {
+	now := time.Now()
+
+	documents := make([]interface{}, len(submissions))
+	for i, submission := range submissions {
+		if submission.ID.IsZero() {
+			submission.ID = primitive.NewObjectID()
+		}
+		submission.SetCreatedAt(now)
+		documents[i] = submission
+	}
+
+	return r.mongoRepo.SaveMany(ctx, models.FormSubmission{}.TableName(), documents)
+}
+
+// Find implements FormSubmissionRepository.Find
+func (r *mongoFormSubmissionRepository) Find(ctx context.Context, options *models.SubmissionQueryOptions) ([]*models.FormSubmission, int64, error) {
+	filter := map[string]interface{}{
+		"merchant_id": options.MerchantID,
+		"form_id":     options.FormID,
+	}
+	for field, value := range options.Filters {
+		filter[answerPath(field)] = bson.M{"$eq": value}
+	}
+
+	var submissions []*models.FormSubmission
+	pagination := &PaginationOptions{
+		Page:      options.Page,
+		PageSize:  options.PageSize,
+		SortBy:    "submitted_at",
+		SortOrder: options.SortOrder,
+	}
+
+	count, err := r.mongoRepo.FindWithPagination(ctx, models.FormSubmission{}.TableName(), filter, &submissions, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return submissions, count, nil
+}
+
+// Stream implements FormSubmissionRepository.Stream
+func (r *mongoFormSubmissionRepository) Stream(ctx context.Context, streamOptions *models.SubmissionStreamOptions, fn func(*models.FormSubmission) error) error {
+	filter := bson.M{
+		"merchant_id": streamOptions.MerchantID,
+		"form_id":     streamOptions.FormID,
+	}
+	for field, value := range streamOptions.Filters {
+		filter[answerPath(field)] = bson.M{"$eq": value}
+	}
+	if streamOptions.From != nil || streamOptions.To != nil {
+		submittedAt := bson.M{}
+		if streamOptions.From != nil {
+			submittedAt["$gte"] = primitive.NewDateTimeFromTime(*streamOptions.From)
+		}
+		if streamOptions.To != nil {
+			submittedAt["$lt"] = primitive.NewDateTimeFromTime(*streamOptions.To)
+		}
+		filter["submitted_at"] = submittedAt
+	}
+
+	sortOrder := -1
+	if streamOptions.SortOrder == "asc" {
+		sortOrder = 1
+	}
+	findOptions := options.Find().
+		SetBatchSize(int32(min(streamOptions.BatchSize, math.MaxInt32))).
+		SetSort(bson.D{{Key: "submitted_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}})
+
+	return r.mongoRepo.FindEach(ctx, models.FormSubmission{}.TableName(), filter, findOptions, func(cursor *mongo.Cursor) error {
+		var submission models.FormSubmission
+		if err := cursor.Decode(&submission); err != nil {
+			return err
+		}
+		return fn(&submission)
+	})
+}
+
+// AggregateStats implements FormSubmissionRepository.AggregateStats
+func (r *mongoFormSubmissionRepository) AggregateStats(ctx context.Context, query *models.FormResponseStatsQuery) (*models.FormResponseStats, error) {
+	match := bson.M{"merchant_id": query.MerchantID, "form_id": query.FormID}
+	if query.From != nil || query.To != nil {
+		submittedAt := bson.M{}
+		if query.From != nil {
+			submittedAt["$gte"] = primitive.NewDateTimeFromTime(*query.From)
+		}
+		if query.To != nil {
+			submittedAt["$lt"] = primitive.NewDateTimeFromTime(*query.To)
+		}
+		match["submitted_at"] = submittedAt
+	}
+
+	timeZone := query.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	complete := bson.M{}
+	for _, field := range query.CompleteFields {
+		complete[answerPath(field)] = bson.M{"$exists": true, "$ne": nil}
+	}
+	for _, field := range query.CompleteEncryptedFields {
+		complete["encrypted_answers."+field] = bson.M{"$exists": true}
+	}
+
+	facets := bson.D{
+		{Key: "total", Value: bson.A{bson.M{"$count": "count"}}},
+		{Key: "complete", Value: bson.A{bson.M{"$match": complete}, bson.M{"$count": "count"}}},
+		{Key: "daily", Value: bson.A{
+			bson.M{"$group": bson.M{
+				"_id": bson.M{"$dateToString": bson.M{
+					"format":   "%Y-%m-%d",
+					"date":     "$submitted_at",
+					"timezone": timeZone,
+				}},
+				"count": bson.M{"$sum": 1},
+			}},
+			bson.M{"$sort": bson.M{"_id": 1}},
+		}},
+	}
+
+	// Facet names cannot contain dots, so field facets are keyed by position
+	for i, field := range query.ChoiceFields {
+		path := answerPath(field)
+		facets = append(facets, bson.E{Key: fmt.Sprintf("choice_%d", i), Value: bson.A{
+			bson.M{"$match": bson.M{path: bson.M{"$exists": true, "$ne": nil}}},
+			// Multi-select answers are arrays; unwinding counts each selected choice
+			bson.M{"$unwind": "$" + path},
+			bson.M{"$group": bson.M{"_id": "$" + path, "count": bson.M{"$sum": 1}}},
+			bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		}})
+	}
+	for i, field := range query.NumericFields {
+		path := answerPath(field)
+		facets = append(facets, bson.E{Key: fmt.Sprintf("numeric_%d", i), Value: bson.A{
+			bson.M{"$match": bson.M{path: bson.M{"$type": "number"}}},
+			bson.M{"$group": bson.M{
+				"_id":     nil,
+				"count":   bson.M{"$sum": 1},
+				"average": bson.M{"$avg": "$" + path},
+				"min":     bson.M{"$min": "$" + path},
+				"max":     bson.M{"$max": "$" + path},
+			}},
+		}})
+	}
+
+	pipeline := bson.A{
+		bson.M{"$match": match},
+		bson.M{"$facet": facets},
+	}
+
+	var results []bson.M
+	if err := r.mongoRepo.Aggregate(ctx, models.FormSubmission{}.TableName(), pipeline, &results); err != nil {
+		return nil, err
+	}
+
+	stats := &models.FormResponseStats{
+		FormID: query.FormID,
+	}
+	if len(results) == 0 {
+		return stats, nil
+	}
+	facetResults := results[0]
+
+	stats.TotalResponses = facetCount(facetResults["total"])
+	stats.CompleteResponses = facetCount(facetResults["complete"])
+	if stats.TotalResponses > 0 {
+		stats.CompletionRate = float64(stats.CompleteResponses) / float64(stats.TotalResponses)
+	}
+
+	for _, doc := range facetDocuments(facetResults["daily"]) {
+		date, _ := doc["_id"].(string)
+		stats.DailyCounts = append(stats.DailyCounts, models.DailyResponseCount{
+			Date:  date,
+			Count: toInt64(doc["count"]),
+		})
+	}
+
+	for i, field := range query.ChoiceFields {
+		fieldStats := models.ChoiceFieldStats{Field: field}
+		for _, doc := range facetDocuments(facetResults[fmt.Sprintf("choice_%d", i)]) {
+			fieldStats.Choices = append(fieldStats.Choices, models.ChoiceCount{
+				Value: fmt.Sprint(doc["_id"]),
+				Count: toInt64(doc["count"]),
+			})
+		}
+		stats.ChoiceFields = append(stats.ChoiceFields, fieldStats)
+	}
+
+	for i, field := range query.NumericFields {
+		fieldStats := models.NumericFieldStats{Field: field}
+		if docs := facetDocuments(facetResults[fmt.Sprintf("numeric_%d", i)]); len(docs) > 0 {
+			fieldStats.Count = toInt64(docs[0]["count"])
+			fieldStats.Average = toFloat64(docs[0]["average"])
+			fieldStats.Min = toFloat64(docs[0]["min"])
+			fieldStats.Max = toFloat64(docs[0]["max"])
+		}
+		stats.NumericFields = append(stats.NumericFields, fieldStats)
+	}
+
+	return stats, nil
+}
+
+// ListAnswerIndexes implements FormSubmissionRepository.ListAnswerIndexes
+func (r *mongoFormSubmissionRepository) ListAnswerIndexes(ctx context.Context) ([]string, error) {
+	names, err := r.mongoRepo.ListIndexNames(ctx, models.FormSubmission{}.TableName())
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for _, name := range names {
+		if field, ok := strings.CutPrefix(name, autoAnswerIndexPrefix); ok {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// CreateAnswerIndex implements FormSubmissionRepository.CreateAnswerIndex
+func (r *mongoFormSubmissionRepository) CreateAnswerIndex(ctx context.Context, field string) error {
+	return r.mongoRepo.CreateIndex(ctx, models.FormSubmission{}.TableName(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "merchant_id", Value: 1},
+			{Key: "form_id", Value: 1},
+			{Key: answerPath(field), Value: 1},
+		},
+		Options: options.Index().SetName(autoAnswerIndexPrefix + field),
+	})
+}
+
+// answerPath returns the document path of an answer field
+func answerPath(field string) string {
+	return "answers." + field
+}
+
+// facetDocuments returns the documents produced by a $facet sub-pipeline
+func facetDocuments(value interface{}) []bson.M {
+	values, _ := value.(bson.A)
+	docs := make([]bson.M, 0, len(values))
+	for _, v := range values {
+		switch doc := v.(type) {
+		case bson.M:
+			docs = append(docs, doc)
+		case bson.D:
+			docs = append(docs, doc.Map())
+		}
+	}
+	return docs
+}
+
+// facetCount returns the result of a $facet sub-pipeline ending with $count
+func facetCount(value interface{}) int64 {
+	docs := facetDocuments(value)
+	if len(docs) == 0 {
+		return 0
+	}
+	return toInt64(docs[0]["count"])
+}
+
+func toInt64(value interface{}) int64 {
+	switch n := value.(type) {
+	case int32:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+func toFloat64(value interface{}) float64 {
+	switch n := value.(type) {
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	case primitive.Decimal128:
+		f, _ := strconv.ParseFloat(n.String(), 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+// CountByMerchantID implements FormSubmissionRepository.CountByMerchantID
+func (r *mongoFormSubmissionRepository) CountByMerchantID(ctx context.Context, merchantID string) (int64, error) {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+
+	return r.mongoRepo.Count(ctx, models.FormSubmission{}.TableName(), filter)
+}
+
+// CountByFormID implements FormSubmissionRepository.CountByFormID
+func (r *mongoFormSubmissionRepository) CountByFormID(ctx context.Context, formID primitive.ObjectID) (int64, error) {
+	filter := map[string]interface{}{
+		"form_id": formID,
+	}
+
+	return r.mongoRepo.Count(ctx, models.FormSubmission{}.TableName(), filter)
+}
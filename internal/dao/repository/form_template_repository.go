@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/arwoosa/form/internal/models"
 )
@@ -34,6 +36,15 @@ type FormTemplateRepository interface {
 
 	// Duplicate a template with new name
 	Duplicate(ctx context.Context, sourceID primitive.ObjectID, nameSuffix, createdBy, merchantID string) (*models.FormTemplate, error)
+
+	// Archive a template, hiding it from listings and the merchant's template count
+	Archive(ctx context.Context, templateID primitive.ObjectID, archivedBy string) error
+
+	// Save a snapshot of a template version that is being replaced
+	SaveVersion(ctx context.Context, version *models.FormTemplateVersion) error
+
+	// Find a past version of a template
+	FindVersion(ctx context.Context, templateID primitive.ObjectID, version int) (*models.FormTemplateVersion, error)
 }
 
 // NewFormTemplateRepository creates a new form template repository implementation
@@ -79,6 +90,7 @@ func (r *mongoFormTemplateRepository) FindByID(ctx context.Context, templateID p
 func (r *mongoFormTemplateRepository) FindByMerchantID(ctx context.Context, options *models.FormTemplateQueryOptions) ([]*models.FormTemplate, int64, error) {
 	filter := map[string]interface{}{
 		"merchant_id": options.MerchantID,
+		"archived_at": map[string]interface{}{"$exists": false},
 	}
 
 	var templates []*models.FormTemplate
@@ -87,6 +99,8 @@ func (r *mongoFormTemplateRepository) FindByMerchantID(ctx context.Context, opti
 		PageSize:  options.PageSize,
 		SortBy:    options.SortBy,
 		SortOrder: options.SortOrder,
+		Count:     options.Count,
+		Exclude:   viewExclusions(options.View, "schema", "ui_schema"),
 	}
 
 	count, err := r.mongoRepo.FindWithPagination(ctx, models.FormTemplate{}.TableName(), filter, &templates, pagination)
@@ -122,6 +136,7 @@ func (r *mongoFormTemplateRepository) Delete(ctx context.Context, templateID pri
 func (r *mongoFormTemplateRepository) CountByMerchantID(ctx context.Context, merchantID string) (int64, error) {
 	filter := map[string]interface{}{
 		"merchant_id": merchantID,
+		"archived_at": map[string]interface{}{"$exists": false},
 	}
 
 	return r.mongoRepo.Count(ctx, models.FormTemplate{}.TableName(), filter)
@@ -154,6 +169,7 @@ func (r *mongoFormTemplateRepository) Duplicate(ctx context.Context, sourceID pr
 		MerchantID: merchantID,
 		Schema:     source.Schema,
 		UISchema:   source.UISchema,
+		Version:    1,
 		CreatedBy:  createdBy,
 		UpdatedBy:  createdBy,
 	}
@@ -165,3 +181,62 @@ func (r *mongoFormTemplateRepository) Duplicate(ctx context.Context, sourceID pr
 
 	return duplicate, nil
 }
+
+// Archive implements FormTemplateRepository.Archive
+func (r *mongoFormTemplateRepository) Archive(ctx context.Context, templateID primitive.ObjectID, archivedBy string) error {
+	now := time.Now()
+	filter := map[string]interface{}{
+		"_id": templateID,
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"archived_at": now,
+			"updated_at":  primitive.NewDateTimeFromTime(now),
+			"updated_by":  archivedBy,
+		},
+	}
+
+	return r.mongoRepo.UpdateOneRaw(ctx, models.FormTemplate{}.TableName(), filter, update)
+}
+
+// SaveVersion implements FormTemplateRepository.SaveVersion
+func (r *mongoFormTemplateRepository) SaveVersion(ctx context.Context, version *models.FormTemplateVersion) error {
+	filter := map[string]interface{}{
+		"template_id": version.TemplateID,
+		"version":     version.Version,
+	}
+
+	return r.mongoRepo.Upsert(ctx, version.TableName(), filter, version)
+}
+
+// FindVersion implements FormTemplateRepository.FindVersion
+func (r *mongoFormTemplateRepository) FindVersion(ctx context.Context, templateID primitive.ObjectID, version int) (*models.FormTemplateVersion, error) {
+	filter := map[string]interface{}{
+		"template_id": templateID,
+		"version":     version,
+	}
+
+	var templateVersion models.FormTemplateVersion
+	if err := r.mongoRepo.FindOne(ctx, templateVersion.TableName(), filter, &templateVersion); err != nil {
+		return nil, err
+	}
+
+	return &templateVersion, nil
+}
+
+// EnsureIndexes implements IndexEnsurer.EnsureIndexes
+func (r *mongoFormTemplateRepository) EnsureIndexes(ctx context.Context) error {
+	return r.mongoRepo.CreateIndexes(ctx, models.FormTemplate{}.TableName(), []mongo.IndexModel{
+		// Merchant isolation, listing newest first
+		{
+			Keys: bson.D{
+				{Key: "merchant_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		// Search by name
+		{
+			Keys: bson.D{{Key: "name", Value: "text"}},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// IdempotencyRepository defines the interface for idempotency key data access
+type IdempotencyRepository interface {
+	// Reserve stores a pending record for a new key. If the key is already in use, the existing
+	// record is returned instead and nothing is stored.
+	Reserve(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, error)
+	// Complete stores the response of a reserved key
+	Complete(ctx context.Context, recordID primitive.ObjectID, response []byte) error
+	// Delete removes a reserved key so that the request can be retried
+	Delete(ctx context.Context, recordID primitive.ObjectID) error
+}
+
+// NewIdempotencyRepository creates a new idempotency repository implementation
+func NewIdempotencyRepository(mongoRepo *MongoRepository) IdempotencyRepository {
+	return &mongoIdempotencyRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoIdempotencyRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Reserve implements IdempotencyRepository.Reserve
+func (r *mongoIdempotencyRepository) Reserve(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, error) {
+	if record.ID.IsZero() {
+		record.ID = primitive.NewObjectID()
+	}
+	record.Status = models.IdempotencyStatusPending
+
+	// The unique index on scope, operation and key rejects a second reservation
+	err := r.mongoRepo.Save(ctx, record.TableName(), record)
+	if err == nil {
+		return nil, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+
+	var existing models.IdempotencyRecord
+	filter := map[string]interface{}{
+		"scope":     record.Scope,
+		"operation": record.Operation,
+		"key":       record.Key,
+	}
+	if err := r.mongoRepo.FindOne(ctx, record.TableName(), filter, &existing); err != nil {
+		return nil, err
+	}
+	return &existing, nil
+}
+
+// Complete implements IdempotencyRepository.Complete
+func (r *mongoIdempotencyRepository) Complete(ctx context.Context, recordID primitive.ObjectID, response []byte) error {
+	filter := map[string]interface{}{
+		"_id": recordID,
+	}
+	update := map[string]interface{}{
+		"status":   models.IdempotencyStatusCompleted,
+		"response": response,
+	}
+
+	return r.mongoRepo.UpdateOne(ctx, models.IdempotencyRecord{}.TableName(), filter, update)
+}
+
+// Delete implements IdempotencyRepository.Delete
+func (r *mongoIdempotencyRepository) Delete(ctx context.Context, recordID primitive.ObjectID) error {
+	filter := map[string]interface{}{
+		"_id": recordID,
+	}
+
+	return r.mongoRepo.DeleteOne(ctx, models.IdempotencyRecord{}.TableName(), filter)
+}
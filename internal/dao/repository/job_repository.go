@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// JobRepository defines the interface for the background job queue
+type JobRepository interface {
+	// Enqueue stores a new pending job
+	Enqueue(ctx context.Context, job *models.Job) error
+	// Lease claims the next due job of one of the types for workerID until now+visibility.
+	// Running jobs whose lease expired are claimed again. It returns nil when no job is due.
+	Lease(ctx context.Context, types []string, workerID string, now time.Time, visibility time.Duration) (*models.Job, error)
+	// Complete marks a leased job as succeeded, keeping it until expiresAt
+	Complete(ctx context.Context, jobID primitive.ObjectID, workerID string, expiresAt time.Time) error
+	// Retry releases a leased job to run again at runAt
+	Retry(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, runAt time.Time) error
+	// Fail marks a leased job as failed for good, keeping it until expiresAt
+	Fail(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, expiresAt time.Time) error
+}
+
+// NewJobRepository creates a new job repository implementation
+func NewJobRepository(mongoRepo *MongoRepository) JobRepository {
+	return &mongoJobRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoJobRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Enqueue implements JobRepository.Enqueue
+func (r *mongoJobRepository) Enqueue(ctx context.Context, job *models.Job) error {
+	now := time.Now()
+	if job.ID.IsZero() {
+		job.ID = primitive.NewObjectID()
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = now
+	}
+	job.Status = models.JobStatusPending
+	job.SetCreatedAt(now)
+	job.SetUpdatedAt(now)
+
+	return r.mongoRepo.Save(ctx, job.TableName(), job)
+}
+
+// Lease implements JobRepository.Lease
+func (r *mongoJobRepository) Lease(ctx context.Context, types []string, workerID string, now time.Time, visibility time.Duration) (*models.Job, error) {
+	filter := map[string]interface{}{
+		"type": map[string]interface{}{"$in": types},
+		"$or": []interface{}{
+			map[string]interface{}{"status": models.JobStatusPending, "run_at": map[string]interface{}{"$lte": now}},
+			// The worker holding the lease crashed or timed out
+			map[string]interface{}{"status": models.JobStatusRunning, "lease_until": map[string]interface{}{"$lte": now}},
+		},
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"status":      models.JobStatusRunning,
+			"lease_until": now.Add(visibility),
+			"leased_by":   workerID,
+			"updated_at":  primitive.NewDateTimeFromTime(now),
+		},
+		"$inc": map[string]interface{}{"attempts": 1},
+	}
+
+	var job models.Job
+	err := r.mongoRepo.FindOneAndUpdate(ctx, job.TableName(), filter, update, map[string]interface{}{"run_at": 1}, &job)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Complete implements JobRepository.Complete
+func (r *mongoJobRepository) Complete(ctx context.Context, jobID primitive.ObjectID, workerID string, expiresAt time.Time) error {
+	return r.release(ctx, jobID, workerID, map[string]interface{}{
+		"status":     models.JobStatusSucceeded,
+		"expires_at": expiresAt,
+	})
+}
+
+// Retry implements JobRepository.Retry
+func (r *mongoJobRepository) Retry(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, runAt time.Time) error {
+	return r.release(ctx, jobID, workerID, map[string]interface{}{
+		"status":     models.JobStatusPending,
+		"run_at":     runAt,
+		"last_error": lastError,
+	})
+}
+
+// Fail implements JobRepository.Fail
+func (r *mongoJobRepository) Fail(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, expiresAt time.Time) error {
+	return r.release(ctx, jobID, workerID, map[string]interface{}{
+		"status":     models.JobStatusFailed,
+		"last_error": lastError,
+		"expires_at": expiresAt,
+	})
+}
+
+// release ends the lease of workerID on a job. A worker whose lease expired and was taken over
+// by another worker no longer matches, so it cannot overwrite the newer attempt.
+func (r *mongoJobRepository) release(ctx context.Context, jobID primitive.ObjectID, workerID string, set map[string]interface{}) error {
+	filter := map[string]interface{}{
+		"_id":       jobID,
+		"status":    models.JobStatusRunning,
+		"leased_by": workerID,
+	}
+	set["updated_at"] = primitive.NewDateTimeFromTime(time.Now())
+	update := map[string]interface{}{
+		"$set":   set,
+		"$unset": map[string]interface{}{"lease_until": "", "leased_by": ""},
+	}
+
+	return r.mongoRepo.UpdateOneRaw(ctx, models.Job{}.TableName(), filter, update)
+}
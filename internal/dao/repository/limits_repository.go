@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// LimitsRepository defines the interface for per-merchant limit override data access
+type LimitsRepository interface {
+	// Find the overrides of a merchant, returning nil when the merchant has none
+	FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantLimits, error)
+	// Create or replace the overrides of a merchant
+	Upsert(ctx context.Context, limits *models.MerchantLimits) error
+	// Delete the overrides of a merchant
+	Delete(ctx context.Context, merchantID string) error
+	// List merchants with overrides, with pagination
+	List(ctx context.Context, page, pageSize int) ([]*models.MerchantLimits, int64, error)
+}
+
+// NewLimitsRepository creates a new limits repository implementation
+func NewLimitsRepository(mongoRepo *MongoRepository) LimitsRepository {
+	return &mongoLimitsRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoLimitsRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// FindByMerchantID implements LimitsRepository.FindByMerchantID
+func (r *mongoLimitsRepository) FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantLimits, error) {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+
+	var limits models.MerchantLimits
+	err := r.mongoRepo.FindOne(ctx, limits.TableName(), filter, &limits)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &limits, nil
+}
+
+// Upsert implements LimitsRepository.Upsert
+func (r *mongoLimitsRepository) Upsert(ctx context.Context, limits *models.MerchantLimits) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	limits.UpdatedAt = now
+
+	filter := map[string]interface{}{
+		"merchant_id": limits.MerchantID,
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"max_templates":       limits.MaxTemplates,
+			"max_forms_per_event": limits.MaxFormsPerEvent,
+			"updated_at":          now,
+			"updated_by":          limits.UpdatedBy,
+		},
+		"$setOnInsert": map[string]interface{}{
+			"created_at": now,
+		},
+	}
+
+	return r.mongoRepo.UpsertOne(ctx, limits.TableName(), filter, update)
+}
+
+// Delete implements LimitsRepository.Delete
+func (r *mongoLimitsRepository) Delete(ctx context.Context, merchantID string) error {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+
+	return r.mongoRepo.DeleteOne(ctx, models.MerchantLimits{}.TableName(), filter)
+}
+
+// List implements LimitsRepository.List
+func (r *mongoLimitsRepository) List(ctx context.Context, page, pageSize int) ([]*models.MerchantLimits, int64, error) {
+	var limits []*models.MerchantLimits
+	pagination := &PaginationOptions{
+		Page:      page,
+		PageSize:  pageSize,
+		SortBy:    "merchant_id",
+		SortOrder: "asc",
+	}
+
+	count, err := r.mongoRepo.FindWithPagination(ctx, models.MerchantLimits{}.TableName(), map[string]interface{}{}, &limits, pagination)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return limits, count, nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// MerchantKeyRepository defines the interface for the wrapped data keys of merchants
+type MerchantKeyRepository interface {
+	// Create stores a new data key
+	Create(ctx context.Context, key *models.MerchantKey) error
+	// FindByID finds a data key by ID
+	FindByID(ctx context.Context, keyID primitive.ObjectID) (*models.MerchantKey, error)
+	// FindLatest finds the most recent data key of a merchant, returning nil when there is none
+	FindLatest(ctx context.Context, merchantID string) (*models.MerchantKey, error)
+}
+
+// NewMerchantKeyRepository creates a new merchant key repository implementation
+func NewMerchantKeyRepository(mongoRepo *MongoRepository) MerchantKeyRepository {
+	return &mongoMerchantKeyRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoMerchantKeyRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Create implements MerchantKeyRepository.Create
+func (r *mongoMerchantKeyRepository) Create(ctx context.Context, key *models.MerchantKey) error {
+	key.SetCreatedAt(time.Now())
+
+	if key.ID.IsZero() {
+		key.ID = primitive.NewObjectID()
+	}
+
+	return r.mongoRepo.Save(ctx, key.TableName(), key)
+}
+
+// FindByID implements MerchantKeyRepository.FindByID
+func (r *mongoMerchantKeyRepository) FindByID(ctx context.Context, keyID primitive.ObjectID) (*models.MerchantKey, error) {
+	var key models.MerchantKey
+	filter := map[string]interface{}{
+		"_id": keyID,
+	}
+
+	if err := r.mongoRepo.FindOne(ctx, key.TableName(), filter, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// FindLatest implements MerchantKeyRepository.FindLatest
+func (r *mongoMerchantKeyRepository) FindLatest(ctx context.Context, merchantID string) (*models.MerchantKey, error) {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+	opts := options.Find().SetSort(map[string]interface{}{"created_at": -1}).SetLimit(1)
+
+	var keys []*models.MerchantKey
+	if err := r.mongoRepo.Find(ctx, models.MerchantKey{}.TableName(), filter, &keys, opts); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	return keys[0], nil
+}
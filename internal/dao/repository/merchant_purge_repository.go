@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// MerchantPurgeRepository defines the interface for merchant data purges: the purge records and
+// the batched deletion of a merchant's documents
+type MerchantPurgeRepository interface {
+	// Create stores a new purge
+	Create(ctx context.Context, purge *models.MerchantPurge) error
+	// FindByID finds a purge by ID
+	FindByID(ctx context.Context, purgeID primitive.ObjectID) (*models.MerchantPurge, error)
+	// FindLatest finds the most recent purge of a merchant, returning nil when there is none
+	FindLatest(ctx context.Context, merchantID string) (*models.MerchantPurge, error)
+	// Update saves the status and progress of a purge
+	Update(ctx context.Context, purge *models.MerchantPurge) error
+	// NextBatch returns up to limit documents of the merchant in a collection
+	NextBatch(ctx context.Context, collection, merchantID string, limit int) ([]models.PurgeDocument, error)
+	// DeleteBatch deletes documents of the merchant in a collection by ID
+	DeleteBatch(ctx context.Context, collection, merchantID string, ids []primitive.ObjectID) (int64, error)
+	// DeleteResponseCounters deletes the response counters of forms
+	DeleteResponseCounters(ctx context.Context, formIDs []primitive.ObjectID) error
+}
+
+// NewMerchantPurgeRepository creates a new merchant purge repository implementation
+func NewMerchantPurgeRepository(mongoRepo *MongoRepository) MerchantPurgeRepository {
+	return &mongoMerchantPurgeRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoMerchantPurgeRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Create implements MerchantPurgeRepository.Create
+func (r *mongoMerchantPurgeRepository) Create(ctx context.Context, purge *models.MerchantPurge) error {
+	now := time.Now()
+	purge.SetCreatedAt(now)
+	purge.SetUpdatedAt(now)
+
+	if purge.ID.IsZero() {
+		purge.ID = primitive.NewObjectID()
+	}
+
+	return r.mongoRepo.Save(ctx, purge.TableName(), purge)
+}
+
+// FindByID implements MerchantPurgeRepository.FindByID
+func (r *mongoMerchantPurgeRepository) FindByID(ctx context.Context, purgeID primitive.ObjectID) (*models.MerchantPurge, error) {
+	var purge models.MerchantPurge
+	filter := map[string]interface{}{
+		"_id": purgeID,
+	}
+
+	if err := r.mongoRepo.FindOne(ctx, purge.TableName(), filter, &purge); err != nil {
+		return nil, err
+	}
+
+	return &purge, nil
+}
+
+// FindLatest implements MerchantPurgeRepository.FindLatest
+func (r *mongoMerchantPurgeRepository) FindLatest(ctx context.Context, merchantID string) (*models.MerchantPurge, error) {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+	opts := options.Find().SetSort(map[string]interface{}{"created_at": -1}).SetLimit(1)
+
+	var purges []*models.MerchantPurge
+	if err := r.mongoRepo.Find(ctx, models.MerchantPurge{}.TableName(), filter, &purges, opts); err != nil {
+		return nil, err
+	}
+	if len(purges) == 0 {
+		return nil, nil
+	}
+
+	return purges[0], nil
+}
+
+// Update implements MerchantPurgeRepository.Update
+func (r *mongoMerchantPurgeRepository) Update(ctx context.Context, purge *models.MerchantPurge) error {
+	purge.SetUpdatedAt(time.Now())
+
+	filter := map[string]interface{}{
+		"_id": purge.ID,
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"status":       purge.Status,
+			"steps":        purge.Steps,
+			"last_error":   purge.LastError,
+			"updated_at":   purge.UpdatedAt,
+			"completed_at": purge.CompletedAt,
+		},
+	}
+
+	return r.mongoRepo.UpdateOneRaw(ctx, purge.TableName(), filter, update)
+}
+
+// NextBatch implements MerchantPurgeRepository.NextBatch
+func (r *mongoMerchantPurgeRepository) NextBatch(ctx context.Context, collection, merchantID string, limit int) ([]models.PurgeDocument, error) {
+	filter := map[string]interface{}{
+		"merchant_id": merchantID,
+	}
+	opts := options.Find().
+		SetProjection(map[string]interface{}{"_id": 1, "key": 1}).
+		SetSort(map[string]interface{}{"_id": 1}).
+		SetLimit(int64(limit))
+
+	var documents []models.PurgeDocument
+	if err := r.mongoRepo.Find(ctx, collection, filter, &documents, opts); err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+// DeleteBatch implements MerchantPurgeRepository.DeleteBatch
+func (r *mongoMerchantPurgeRepository) DeleteBatch(ctx context.Context, collection, merchantID string, ids []primitive.ObjectID) (int64, error) {
+	filter := map[string]interface{}{
+		"_id":         map[string]interface{}{"$in": ids},
+		"merchant_id": merchantID,
+	}
+
+	return r.mongoRepo.DeleteMany(ctx, collection, filter)
+}
+
+// DeleteResponseCounters implements MerchantPurgeRepository.DeleteResponseCounters
+func (r *mongoMerchantPurgeRepository) DeleteResponseCounters(ctx context.Context, formIDs []primitive.ObjectID) error {
+	filter := map[string]interface{}{
+		"form_id": map[string]interface{}{"$in": formIDs},
+	}
+
+	_, err := r.mongoRepo.DeleteMany(ctx, models.FormResponseCounter{}.TableName(), filter)
+	return err
+}
@@ -2,17 +2,57 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// MongoDB error codes for an index conflicting with an existing one
+const (
+	indexOptionsConflict  = 85
+	indexKeySpecsConflict = 86
+)
+
+// MongoDB error codes for a result document over the 16MB limit and for a $facet stage building
+// a document over its own memory limit
+const (
+	bsonObjectTooLarge    = 10334
+	facetDocumentTooLarge = 4031700
 )
 
 // MongoRepository provides basic MongoDB operations
 type MongoRepository struct {
 	client   *mongo.Client
 	database string
+	limits   QueryLimits
+}
+
+// QueryLimits bound the repository operations. Streaming scans (FindEach) and index builds are
+// left to the caller's context.
+type QueryLimits struct {
+	Timeout       time.Duration // Deadline of each operation, 0 for none
+	SlowThreshold time.Duration // Operations taking longer are logged with their filter shape, 0 to disable
+}
+
+// TransactionRunner runs a function in a database transaction
+type TransactionRunner interface {
+	// WithTransaction runs fn in a transaction, committed when fn returns nil. Repository calls
+	// take part in the transaction when they are made with the context passed to fn.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// IndexEnsurer is implemented by repositories declaring the indexes their queries rely on
+type IndexEnsurer interface {
+	// EnsureIndexes creates the indexes that are missing; running it again is a no-op
+	EnsureIndexes(ctx context.Context) error
 }
 
 // PaginationOptions represents pagination parameters
@@ -21,6 +61,8 @@ type PaginationOptions struct {
 	PageSize  int
 	SortBy    string
 	SortOrder string
+	Count     models.CountMode // Empty counts with a separate query
+	Exclude   []string         // Fields left out of the results
 }
 
 // NewMongoRepository creates a new MongoDB repository
@@ -31,25 +73,150 @@ func NewMongoRepository(client *mongo.Client, database string) *MongoRepository
 	}
 }
 
+// WithQueryLimits applies the limits to the operations of the repository
+func (r *MongoRepository) WithQueryLimits(limits QueryLimits) *MongoRepository {
+	r.limits = limits
+	return r
+}
+
 // GetCollection returns a MongoDB collection
 func (r *MongoRepository) GetCollection(name string) *mongo.Collection {
 	return r.client.Database(r.database).Collection(name)
 }
 
+// WithTransaction implements TransactionRunner.WithTransaction. fn may run more than once when
+// the transaction hits a transient error. Transactions require MongoDB to run as a replica set.
+func (r *MongoRepository) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := r.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
+
+// begin applies the query timeout to an operation. The returned function ends the operation and
+// logs it when it was slow, with the shape of its filter so no values end up in the logs.
+func (r *MongoRepository) begin(ctx context.Context, operation, collection string, filter interface{}) (context.Context, func()) {
+	start := time.Now()
+	cancel := context.CancelFunc(func() {})
+	if r.limits.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.limits.Timeout)
+	}
+
+	return ctx, func() {
+		cancel()
+		elapsed := time.Since(start)
+		if r.limits.SlowThreshold <= 0 || elapsed < r.limits.SlowThreshold {
+			return
+		}
+		log.Warn("Slow MongoDB query",
+			log.String("collection", collection),
+			log.String("operation", operation),
+			log.Duration("duration", elapsed),
+			log.Any("filter", filterShape(filter)))
+	}
+}
+
+// filterShape returns the filter with its values replaced by "?", keeping the field names and
+// operators that decide which index can serve it
+func filterShape(filter interface{}) interface{} {
+	switch f := filter.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		shape := make(map[string]interface{}, len(f))
+		for key, value := range f {
+			shape[key] = filterShape(value)
+		}
+		return shape
+	case bson.M:
+		return filterShape(map[string]interface{}(f))
+	case bson.D:
+		shape := make(bson.D, len(f))
+		for i, elem := range f {
+			shape[i] = bson.E{Key: elem.Key, Value: filterShape(elem.Value)}
+		}
+		return shape
+	case mongo.Pipeline:
+		shape := make([]interface{}, len(f))
+		for i, stage := range f {
+			shape[i] = filterShape(stage)
+		}
+		return shape
+	case []bson.M:
+		shape := make([]interface{}, len(f))
+		for i, stage := range f {
+			shape[i] = filterShape(stage)
+		}
+		return shape
+	case bson.A:
+		return filterShape([]interface{}(f))
+	case []interface{}:
+		// Lists of conditions ($or, $and) keep their shape; lists of values ($in) do not
+		shape := make([]interface{}, 0, len(f))
+		for _, elem := range f {
+			switch elem.(type) {
+			case map[string]interface{}, bson.M, bson.D:
+				shape = append(shape, filterShape(elem))
+			default:
+				return "?"
+			}
+		}
+		return shape
+	default:
+		return "?"
+	}
+}
+
 // Save saves a document to the specified collection
 func (r *MongoRepository) Save(ctx context.Context, collection string, document interface{}) error {
+	ctx, done := r.begin(ctx, "insert", collection, nil)
+	defer done()
+
 	coll := r.GetCollection(collection)
 	_, err := coll.InsertOne(ctx, document)
 	return err
 }
 
+// SaveMany saves multiple documents to the specified collection in a single round trip
+func (r *MongoRepository) SaveMany(ctx context.Context, collection string, documents []interface{}) error {
+	if len(documents) == 0 {
+		return nil
+	}
+	ctx, done := r.begin(ctx, "insert", collection, nil)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	_, err := coll.InsertMany(ctx, documents)
+	return err
+}
+
+// Upsert replaces the document matching the filter, inserting it if it does not exist
+func (r *MongoRepository) Upsert(ctx context.Context, collection string, filter map[string]interface{}, document interface{}) error {
+	ctx, done := r.begin(ctx, "replace", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	_, err := coll.ReplaceOne(ctx, filter, document, options.Replace().SetUpsert(true))
+	return err
+}
+
 // FindOne finds a single document by filter
 func (r *MongoRepository) FindOne(ctx context.Context, collection string, filter map[string]interface{}, result interface{}) error {
+	ctx, done := r.begin(ctx, "find", collection, filter)
+	defer done()
+
 	coll := r.GetCollection(collection)
 	return coll.FindOne(ctx, filter).Decode(result)
 }
 
-// FindWithPagination finds documents with pagination
+// FindWithPagination finds a page of documents and counts the documents matching the filter,
+// as selected by the count mode of the pagination
 func (r *MongoRepository) FindWithPagination(ctx context.Context, collection string, filter map[string]interface{}, results interface{}, pagination *PaginationOptions) (int64, error) {
 	// Calculate skip based on pagination
 	skip := int64(0)
@@ -57,14 +224,6 @@ func (r *MongoRepository) FindWithPagination(ctx context.Context, collection str
 		skip = int64((pagination.Page - 1) * pagination.PageSize)
 	}
 
-	coll := r.GetCollection(collection)
-
-	// Get total count
-	totalCount, err := coll.CountDocuments(ctx, filter)
-	if err != nil {
-		return 0, fmt.Errorf("failed to count documents: %w", err)
-	}
-
 	// Prepare sort options
 	sortBy := "updated_at" // Default sort field
 	if pagination.SortBy != "" {
@@ -75,16 +234,149 @@ func (r *MongoRepository) FindWithPagination(ctx context.Context, collection str
 	if pagination.SortOrder == "asc" {
 		sortOrder = 1
 	}
+	sort := bson.D{{Key: sortBy, Value: sortOrder}}
+	projection := exclusion(pagination.Exclude)
+
+	switch pagination.Count {
+	case models.CountFacet:
+		total, err := r.findPageFacet(ctx, collection, filter, results, sort, projection, skip, int64(pagination.PageSize))
+		if !documentTooLarge(err) {
+			return total, err
+		}
+		// The $facet result is a single document, so a page of large documents can exceed the
+		// document size limit; such pages are found and counted with separate queries instead
+		log.Warn("Page too large for a $facet count, counting separately",
+			log.String("collection", collection), log.Int("page_size", pagination.PageSize))
+	case models.CountNone:
+		return r.findPageWithoutCount(ctx, collection, filter, results, sort, projection, skip, pagination.PageSize)
+	}
+
+	ctx, done := r.begin(ctx, "find", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+
+	// Get total count
+	totalCount, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents: %w", err)
+	}
 
 	// Find with pagination
 	findOptions := options.Find().
 		SetSkip(skip).
 		SetLimit(int64(pagination.PageSize)).
-		SetSort(map[string]interface{}{sortBy: sortOrder})
+		SetSort(sort)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
+
+	if err := r.findAll(ctx, coll, filter, results, findOptions); err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
+
+// findPageFacet finds a page of documents and their total with a single aggregation
+func (r *MongoRepository) findPageFacet(ctx context.Context, collection string, filter map[string]interface{}, results interface{}, sort bson.D, projection bson.M, skip, limit int64) (int64, error) {
+	page := bson.A{
+		bson.M{"$sort": sort},
+		bson.M{"$skip": skip},
+		bson.M{"$limit": limit},
+	}
+	if projection != nil {
+		page = append(page, bson.M{"$project": projection})
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$facet", Value: bson.M{
+			"results": page,
+			"total":   bson.A{bson.M{"$count": "count"}},
+		}}},
+	}
+
+	var facets []struct {
+		Results bson.RawValue `bson:"results"`
+		Total   []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := r.Aggregate(ctx, collection, pipeline, &facets); err != nil {
+		return 0, fmt.Errorf("failed to aggregate documents: %w", err)
+	}
+	if len(facets) == 0 {
+		return 0, nil
+	}
+
+	if err := facets[0].Results.Unmarshal(results); err != nil {
+		return 0, fmt.Errorf("failed to decode documents: %w", err)
+	}
+	if len(facets[0].Total) == 0 {
+		return 0, nil
+	}
+	return facets[0].Total[0].Count, nil
+}
+
+// documentTooLarge reports whether an operation failed because a result document exceeded the
+// document size limit
+func documentTooLarge(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && (cmdErr.Code == bsonObjectTooLarge || cmdErr.Code == facetDocumentTooLarge)
+}
+
+// findPageWithoutCount finds a page of documents without counting them. It fetches one more
+// document than the page holds to tell whether more pages follow, and returns the number of
+// documents up to the end of the page, plus one when more follow.
+func (r *MongoRepository) findPageWithoutCount(ctx context.Context, collection string, filter map[string]interface{}, results interface{}, sort bson.D, projection bson.M, skip int64, pageSize int) (int64, error) {
+	ctx, done := r.begin(ctx, "find", collection, filter)
+	defer done()
+
+	findOptions := options.Find().
+		SetSkip(skip).
+		SetLimit(int64(pageSize) + 1).
+		SetSort(sort)
+	if projection != nil {
+		findOptions.SetProjection(projection)
+	}
+
+	if err := r.findAll(ctx, r.GetCollection(collection), filter, results, findOptions); err != nil {
+		return 0, err
+	}
+
+	page := reflect.ValueOf(results).Elem()
+	found := page.Len()
+	if found > pageSize {
+		page.Set(page.Slice(0, pageSize))
+	}
+	return skip + int64(found), nil
+}
+
+// exclusion returns the projection leaving out the fields, or nil to keep every field
+func exclusion(fields []string) bson.M {
+	if len(fields) == 0 {
+		return nil
+	}
+	projection := make(bson.M, len(fields))
+	for _, field := range fields {
+		projection[field] = 0
+	}
+	return projection
+}
 
+// viewExclusions returns the fields a list view leaves out of the results
+func viewExclusions(view models.ListView, heavyFields ...string) []string {
+	if view == models.ListViewSummary {
+		return heavyFields
+	}
+	return nil
+}
+
+// findAll decodes all documents found into results
+func (r *MongoRepository) findAll(ctx context.Context, coll *mongo.Collection, filter map[string]interface{}, results interface{}, findOptions *options.FindOptions) error {
 	cursor, err := coll.Find(ctx, filter, findOptions)
 	if err != nil {
-		return 0, fmt.Errorf("failed to find documents: %w", err)
+		return fmt.Errorf("failed to find documents: %w", err)
 	}
 	defer func() {
 		if closeErr := cursor.Close(ctx); closeErr != nil {
@@ -92,16 +384,17 @@ func (r *MongoRepository) FindWithPagination(ctx context.Context, collection str
 		}
 	}()
 
-	err = cursor.All(ctx, results)
-	if err != nil {
-		return 0, fmt.Errorf("failed to decode documents: %w", err)
+	if err := cursor.All(ctx, results); err != nil {
+		return fmt.Errorf("failed to decode documents: %w", err)
 	}
-
-	return totalCount, nil
+	return nil
 }
 
 // UpdateOne updates a single document
 func (r *MongoRepository) UpdateOne(ctx context.Context, collection string, filter map[string]interface{}, update interface{}) error {
+	ctx, done := r.begin(ctx, "update", collection, filter)
+	defer done()
+
 	coll := r.GetCollection(collection)
 
 	// Wrap the update in $set operator for MongoDB
@@ -113,21 +406,109 @@ func (r *MongoRepository) UpdateOne(ctx context.Context, collection string, filt
 	return err
 }
 
+// UpdateMany updates all documents matching the filter and returns the number of modified documents
+func (r *MongoRepository) UpdateMany(ctx context.Context, collection string, filter map[string]interface{}, update interface{}) (int64, error) {
+	ctx, done := r.begin(ctx, "update", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+
+	// Wrap the update in $set operator for MongoDB
+	updateDoc := map[string]interface{}{
+		"$set": update,
+	}
+
+	result, err := coll.UpdateMany(ctx, filter, updateDoc)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// UpdateOneRaw applies an update document (with its own operators) to a single matching document
+func (r *MongoRepository) UpdateOneRaw(ctx context.Context, collection string, filter map[string]interface{}, update interface{}) error {
+	ctx, done := r.begin(ctx, "update", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	_, err := coll.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// UpdateManyRaw applies an update document (with its own operators) to all matching documents and returns the number of modified documents
+func (r *MongoRepository) UpdateManyRaw(ctx context.Context, collection string, filter map[string]interface{}, update interface{}) (int64, error) {
+	ctx, done := r.begin(ctx, "update", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	result, err := coll.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// FindOneAndUpdate applies an update document (with its own operators) to the first matching document
+// in sort order and decodes the updated document. It returns mongo.ErrNoDocuments when nothing matches.
+func (r *MongoRepository) FindOneAndUpdate(ctx context.Context, collection string, filter map[string]interface{}, update interface{}, sort map[string]interface{}, result interface{}) error {
+	ctx, done := r.begin(ctx, "findAndModify", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if sort != nil {
+		opts.SetSort(sort)
+	}
+	return coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(result)
+}
+
+// UpsertOne applies an update document (with its own operators) to the matching document, inserting it if it does not exist
+func (r *MongoRepository) UpsertOne(ctx context.Context, collection string, filter map[string]interface{}, update interface{}) error {
+	ctx, done := r.begin(ctx, "update", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	_, err := coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
 // DeleteOne deletes a single document
 func (r *MongoRepository) DeleteOne(ctx context.Context, collection string, filter map[string]interface{}) error {
+	ctx, done := r.begin(ctx, "delete", collection, filter)
+	defer done()
+
 	coll := r.GetCollection(collection)
 	_, err := coll.DeleteOne(ctx, filter)
 	return err
 }
 
+// DeleteMany deletes all documents matching the filter and returns the number deleted
+func (r *MongoRepository) DeleteMany(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	ctx, done := r.begin(ctx, "delete", collection, filter)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	result, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
 // Count counts documents matching the filter
 func (r *MongoRepository) Count(ctx context.Context, collection string, filter map[string]interface{}) (int64, error) {
+	ctx, done := r.begin(ctx, "count", collection, filter)
+	defer done()
+
 	coll := r.GetCollection(collection)
 	return coll.CountDocuments(ctx, filter)
 }
 
 // Find finds documents without pagination
 func (r *MongoRepository) Find(ctx context.Context, collection string, filter map[string]interface{}, results interface{}, opts *options.FindOptions) error {
+	ctx, done := r.begin(ctx, "find", collection, filter)
+	defer done()
+
 	coll := r.GetCollection(collection)
 	cursor, err := coll.Find(ctx, filter, opts)
 	if err != nil {
@@ -140,3 +521,100 @@ func (r *MongoRepository) Find(ctx context.Context, collection string, filter ma
 	}()
 	return cursor.All(ctx, results)
 }
+
+// FindEach finds documents and calls fn for each of them while the cursor fetches results in batches,
+// so large result sets are never held in memory at once
+func (r *MongoRepository) FindEach(ctx context.Context, collection string, filter interface{}, opts *options.FindOptions, fn func(cursor *mongo.Cursor) error) error {
+	coll := r.GetCollection(collection)
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := cursor.Close(ctx); closeErr != nil {
+			log.Error("Failed to close cursor", log.Err(closeErr))
+		}
+	}()
+
+	for cursor.Next(ctx) {
+		if err := fn(cursor); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// Aggregate runs an aggregation pipeline and decodes all resulting documents
+func (r *MongoRepository) Aggregate(ctx context.Context, collection string, pipeline interface{}, results interface{}) error {
+	ctx, done := r.begin(ctx, "aggregate", collection, pipeline)
+	defer done()
+
+	coll := r.GetCollection(collection)
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := cursor.Close(ctx); closeErr != nil {
+			log.Error("Failed to close cursor", log.Err(closeErr))
+		}
+	}()
+	return cursor.All(ctx, results)
+}
+
+// ListIndexNames returns the names of the indexes of a collection
+func (r *MongoRepository) ListIndexNames(ctx context.Context, collection string) ([]string, error) {
+	ctx, done := r.begin(ctx, "listIndexes", collection, nil)
+	defer done()
+
+	specs, err := r.GetCollection(collection).Indexes().ListSpecifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(specs))
+	for i, spec := range specs {
+		names[i] = spec.Name
+	}
+	return names, nil
+}
+
+// CreateIndex creates an index on a collection
+func (r *MongoRepository) CreateIndex(ctx context.Context, collection string, index mongo.IndexModel) error {
+	_, err := r.GetCollection(collection).Indexes().CreateOne(ctx, index)
+	return err
+}
+
+// EnsureIndexes creates the indexes declared by the repositories implementing IndexEnsurer
+func EnsureIndexes(ctx context.Context, mongoRepo *MongoRepository) error {
+	repos := []interface{}{
+		NewFormRepository(mongoRepo),
+		NewFormTemplateRepository(mongoRepo),
+	}
+	for _, repo := range repos {
+		if ensurer, ok := repo.(IndexEnsurer); ok {
+			if err := ensurer.EnsureIndexes(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// CreateIndexes creates indexes on a collection. Existing indexes are left unchanged; when one
+// exists with the same keys but other options it is kept and a warning is logged, since
+// replacing it would need a migration.
+func (r *MongoRepository) CreateIndexes(ctx context.Context, collection string, indexes []mongo.IndexModel) error {
+	for _, index := range indexes {
+		_, err := r.GetCollection(collection).Indexes().CreateOne(ctx, index)
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && (cmdErr.Code == indexOptionsConflict || cmdErr.Code == indexKeySpecsConflict) {
+			log.Warn("Index exists with different options",
+				log.String("collection", collection), log.Any("keys", index.Keys), log.Err(err))
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create index on collection '%s': %w", collection, err)
+		}
+	}
+	return nil
+}
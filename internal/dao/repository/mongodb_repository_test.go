@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestFilterShape(t *testing.T) {
+	filter := map[string]interface{}{
+		"merchant_id": "merchant-1",
+		"status":      bson.M{"$in": []interface{}{"published", "closed"}},
+		"$or": []interface{}{
+			bson.M{"close_at": bson.M{"$lt": 10}},
+			bson.D{{Key: "event_id", Value: "event-1"}},
+		},
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"merchant_id": "?",
+		"status":      map[string]interface{}{"$in": "?"},
+		"$or": []interface{}{
+			map[string]interface{}{"close_at": map[string]interface{}{"$lt": "?"}},
+			bson.D{{Key: "event_id", Value: "?"}},
+		},
+	}, filterShape(filter))
+	assert.Nil(t, filterShape(nil))
+}
+
+func TestDocumentTooLarge(t *testing.T) {
+	tooLarge := mongo.CommandError{Code: bsonObjectTooLarge, Message: "BSONObj size: 16800000 (0x1005600) is invalid"}
+
+	assert.True(t, documentTooLarge(fmt.Errorf("failed to aggregate documents: %w", tooLarge)))
+	assert.True(t, documentTooLarge(mongo.CommandError{Code: facetDocumentTooLarge}))
+	assert.False(t, documentTooLarge(mongo.CommandError{Code: indexOptionsConflict}))
+	assert.False(t, documentTooLarge(errors.New("connection reset")))
+	assert.False(t, documentTooLarge(nil))
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// OutboxRepository defines the interface for the outbox of domain events waiting to be published
+type OutboxRepository interface {
+	// Add stores a pending event. Call it in the transaction of the change the event describes.
+	Add(ctx context.Context, event *models.OutboxEvent) error
+	// Lease claims the oldest due event for workerID until now+visibility. Events whose lease
+	// expired are claimed again. It returns nil when no event is due.
+	Lease(ctx context.Context, workerID string, now time.Time, visibility time.Duration) (*models.OutboxEvent, error)
+	// MarkPublished marks a leased event as published, keeping it until expiresAt
+	MarkPublished(ctx context.Context, eventID primitive.ObjectID, workerID string, publishedAt, expiresAt time.Time) error
+	// Retry releases a leased event to be published again at nextAttemptAt
+	Retry(ctx context.Context, eventID primitive.ObjectID, workerID, lastError string, nextAttemptAt time.Time) error
+}
+
+// NewOutboxRepository creates a new outbox repository implementation
+func NewOutboxRepository(mongoRepo *MongoRepository) OutboxRepository {
+	return &mongoOutboxRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoOutboxRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// Add implements OutboxRepository.Add
+func (r *mongoOutboxRepository) Add(ctx context.Context, event *models.OutboxEvent) error {
+	now := time.Now()
+	if event.ID.IsZero() {
+		event.ID = primitive.NewObjectID()
+	}
+	event.Status = models.OutboxStatusPending
+	event.NextAttemptAt = now
+	event.SetCreatedAt(now)
+	event.SetUpdatedAt(now)
+
+	return r.mongoRepo.Save(ctx, event.TableName(), event)
+}
+
+// Lease implements OutboxRepository.Lease
+func (r *mongoOutboxRepository) Lease(ctx context.Context, workerID string, now time.Time, visibility time.Duration) (*models.OutboxEvent, error) {
+	filter := map[string]interface{}{
+		"$or": []interface{}{
+			map[string]interface{}{"status": models.OutboxStatusPending, "next_attempt_at": map[string]interface{}{"$lte": now}},
+			// The relay holding the lease crashed or timed out
+			map[string]interface{}{"status": models.OutboxStatusPublishing, "lease_until": map[string]interface{}{"$lte": now}},
+		},
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"status":      models.OutboxStatusPublishing,
+			"lease_until": now.Add(visibility),
+			"leased_by":   workerID,
+			"updated_at":  primitive.NewDateTimeFromTime(now),
+		},
+		"$inc": map[string]interface{}{"attempts": 1},
+	}
+
+	// ObjectIDs grow with creation time, so events are published in the order they were stored
+	var event models.OutboxEvent
+	err := r.mongoRepo.FindOneAndUpdate(ctx, event.TableName(), filter, update, map[string]interface{}{"_id": 1}, &event)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// MarkPublished implements OutboxRepository.MarkPublished
+func (r *mongoOutboxRepository) MarkPublished(ctx context.Context, eventID primitive.ObjectID, workerID string, publishedAt, expiresAt time.Time) error {
+	return r.release(ctx, eventID, workerID, map[string]interface{}{
+		"status":       models.OutboxStatusPublished,
+		"published_at": publishedAt,
+		"expires_at":   expiresAt,
+	})
+}
+
+// Retry implements OutboxRepository.Retry
+func (r *mongoOutboxRepository) Retry(ctx context.Context, eventID primitive.ObjectID, workerID, lastError string, nextAttemptAt time.Time) error {
+	return r.release(ctx, eventID, workerID, map[string]interface{}{
+		"status":          models.OutboxStatusPending,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	})
+}
+
+// release ends the lease of workerID on an event, unless another relay took it over
+func (r *mongoOutboxRepository) release(ctx context.Context, eventID primitive.ObjectID, workerID string, set map[string]interface{}) error {
+	filter := map[string]interface{}{
+		"_id":       eventID,
+		"status":    models.OutboxStatusPublishing,
+		"leased_by": workerID,
+	}
+	set["updated_at"] = primitive.NewDateTimeFromTime(time.Now())
+	update := map[string]interface{}{
+		"$set":   set,
+		"$unset": map[string]interface{}{"lease_until": "", "leased_by": ""},
+	}
+
+	return r.mongoRepo.UpdateOneRaw(ctx, models.OutboxEvent{}.TableName(), filter, update)
+}
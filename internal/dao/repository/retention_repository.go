@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// RetentionRepository defines the interface for cleaning up responses past their form's
+// retention period
+type RetentionRepository interface {
+	// FindForms returns the forms with a retention period
+	FindForms(ctx context.Context) ([]*models.Form, error)
+	// ExpiredSubmissions returns the IDs of up to limit submissions of a form submitted before the cutoff
+	ExpiredSubmissions(ctx context.Context, formID primitive.ObjectID, before time.Time, limit int) ([]primitive.ObjectID, error)
+	// DeleteSubmissions deletes submissions of a form by ID, giving back the response counter slots they held
+	DeleteSubmissions(ctx context.Context, formID primitive.ObjectID, ids []primitive.ObjectID) (int64, error)
+	// AnonymizeSubmissions removes the respondent and the encrypted answers of the submissions of a
+	// form submitted before the cutoff, returning the number of submissions anonymized
+	AnonymizeSubmissions(ctx context.Context, formID primitive.ObjectID, before, now time.Time) (int64, error)
+	// FindFiles returns the files linked to submissions
+	FindFiles(ctx context.Context, submissionIDs []primitive.ObjectID) ([]*models.FileReference, error)
+	// DeleteFiles deletes files by ID
+	DeleteFiles(ctx context.Context, fileIDs []primitive.ObjectID) error
+}
+
+// NewRetentionRepository creates a new retention repository implementation
+func NewRetentionRepository(mongoRepo *MongoRepository) RetentionRepository {
+	return &mongoRetentionRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoRetentionRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// FindForms implements RetentionRepository.FindForms
+func (r *mongoRetentionRepository) FindForms(ctx context.Context) ([]*models.Form, error) {
+	filter := map[string]interface{}{
+		"retention_days": map[string]interface{}{"$gt": 0},
+	}
+	opts := options.Find().SetProjection(map[string]interface{}{
+		"merchant_id":      1,
+		"retention_days":   1,
+		"retention_action": 1,
+	})
+
+	var forms []*models.Form
+	if err := r.mongoRepo.Find(ctx, models.Form{}.TableName(), filter, &forms, opts); err != nil {
+		return nil, err
+	}
+
+	return forms, nil
+}
+
+// ExpiredSubmissions implements RetentionRepository.ExpiredSubmissions
+func (r *mongoRetentionRepository) ExpiredSubmissions(ctx context.Context, formID primitive.ObjectID, before time.Time, limit int) ([]primitive.ObjectID, error) {
+	filter := map[string]interface{}{
+		"form_id":      formID,
+		"submitted_at": map[string]interface{}{"$lt": before},
+	}
+	opts := options.Find().
+		SetProjection(map[string]interface{}{"_id": 1}).
+		SetSort(map[string]interface{}{"submitted_at": 1}).
+		SetLimit(int64(limit))
+
+	var documents []models.PurgeDocument
+	if err := r.mongoRepo.Find(ctx, models.FormSubmission{}.TableName(), filter, &documents, opts); err != nil {
+		return nil, err
+	}
+
+	ids := make([]primitive.ObjectID, len(documents))
+	for i, document := range documents {
+		ids[i] = document.ID
+	}
+	return ids, nil
+}
+
+// DeleteSubmissions implements RetentionRepository.DeleteSubmissions
+func (r *mongoRetentionRepository) DeleteSubmissions(ctx context.Context, formID primitive.ObjectID, ids []primitive.ObjectID) (int64, error) {
+	filter := map[string]interface{}{
+		"_id":     map[string]interface{}{"$in": ids},
+		"form_id": formID,
+	}
+
+	return deleteSubmissions(ctx, r.mongoRepo, filter)
+}
+
+// AnonymizeSubmissions implements RetentionRepository.AnonymizeSubmissions
+func (r *mongoRetentionRepository) AnonymizeSubmissions(ctx context.Context, formID primitive.ObjectID, before, now time.Time) (int64, error) {
+	filter := map[string]interface{}{
+		"form_id":       formID,
+		"submitted_at":  map[string]interface{}{"$lt": before},
+		"anonymized_at": map[string]interface{}{"$exists": false},
+	}
+	update := map[string]interface{}{
+		"$set": map[string]interface{}{
+			"created_by":    "",
+			"anonymized_at": primitive.NewDateTimeFromTime(now),
+		},
+		"$unset": map[string]interface{}{
+			"submitted_by":      "",
+			"encrypted_answers": "",
+			"key_id":            "",
+		},
+	}
+
+	return r.mongoRepo.UpdateManyRaw(ctx, models.FormSubmission{}.TableName(), filter, update)
+}
+
+// FindFiles implements RetentionRepository.FindFiles
+func (r *mongoRetentionRepository) FindFiles(ctx context.Context, submissionIDs []primitive.ObjectID) ([]*models.FileReference, error) {
+	filter := map[string]interface{}{
+		"submission_id": map[string]interface{}{"$in": submissionIDs},
+	}
+
+	var files []*models.FileReference
+	if err := r.mongoRepo.Find(ctx, models.FileReference{}.TableName(), filter, &files, nil); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// DeleteFiles implements RetentionRepository.DeleteFiles
+func (r *mongoRetentionRepository) DeleteFiles(ctx context.Context, fileIDs []primitive.ObjectID) error {
+	filter := map[string]interface{}{
+		"_id": map[string]interface{}{"$in": fileIDs},
+	}
+
+	_, err := r.mongoRepo.DeleteMany(ctx, models.FileReference{}.TableName(), filter)
+	return err
+}
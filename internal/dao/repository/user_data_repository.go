@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// UserDataRepository defines the interface for data subject requests: finding, pseudonymizing
+// and deleting the documents that refer to a user in any collection
+type UserDataRepository interface {
+	// FindByUser returns the documents of a collection in which any of the fields holds the user ID
+	FindByUser(ctx context.Context, collection string, fields []string, userID string) ([]bson.M, error)
+	// ReplaceUser replaces the user ID with a pseudonym in the fields of a collection's documents
+	// and returns the number of documents changed
+	ReplaceUser(ctx context.Context, collection string, fields []string, userID, pseudonym string) (int64, error)
+	// DeleteSubmissions deletes the user's submissions, giving back the response counter slots
+	// they held, and returns the number of submissions deleted
+	DeleteSubmissions(ctx context.Context, userID string) (int64, error)
+	// FindSubmissionFiles returns the files linked to the user's submissions
+	FindSubmissionFiles(ctx context.Context, userID string) ([]*models.FileReference, error)
+	// DeleteFiles deletes files by ID
+	DeleteFiles(ctx context.Context, fileIDs []primitive.ObjectID) error
+	// RemoveEncryptedAnswers removes the encrypted PII answers of the user's submissions and
+	// returns the number of submissions changed
+	RemoveEncryptedAnswers(ctx context.Context, userID string) (int64, error)
+}
+
+// NewUserDataRepository creates a new user data repository implementation
+func NewUserDataRepository(mongoRepo *MongoRepository) UserDataRepository {
+	return &mongoUserDataRepository{
+		mongoRepo: mongoRepo,
+	}
+}
+
+type mongoUserDataRepository struct {
+	mongoRepo *MongoRepository
+}
+
+// FindByUser implements UserDataRepository.FindByUser
+func (r *mongoUserDataRepository) FindByUser(ctx context.Context, collection string, fields []string, userID string) ([]bson.M, error) {
+	var documents []bson.M
+	opts := options.Find().SetSort(map[string]interface{}{"_id": 1})
+	if err := r.mongoRepo.Find(ctx, collection, userFilter(fields, userID), &documents, opts); err != nil {
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+// ReplaceUser implements UserDataRepository.ReplaceUser
+func (r *mongoUserDataRepository) ReplaceUser(ctx context.Context, collection string, fields []string, userID, pseudonym string) (int64, error) {
+	// Each field is replaced only where it holds the user, so the update is a pipeline choosing
+	// per document and per field
+	set := map[string]interface{}{}
+	for _, field := range fields {
+		set[field] = map[string]interface{}{
+			"$cond": []interface{}{
+				map[string]interface{}{"$eq": []interface{}{"$" + field, userID}},
+				pseudonym,
+				"$" + field,
+			},
+		}
+	}
+	update := []interface{}{map[string]interface{}{"$set": set}}
+
+	return r.mongoRepo.UpdateManyRaw(ctx, collection, userFilter(fields, userID), update)
+}
+
+// DeleteSubmissions implements UserDataRepository.DeleteSubmissions
+func (r *mongoUserDataRepository) DeleteSubmissions(ctx context.Context, userID string) (int64, error) {
+	filter := map[string]interface{}{
+		"submitted_by": userID,
+	}
+
+	return deleteSubmissions(ctx, r.mongoRepo, filter)
+}
+
+// FindSubmissionFiles implements UserDataRepository.FindSubmissionFiles
+func (r *mongoUserDataRepository) FindSubmissionFiles(ctx context.Context, userID string) ([]*models.FileReference, error) {
+	var submissions []models.PurgeDocument
+	opts := options.Find().SetProjection(map[string]interface{}{"_id": 1})
+	if err := r.mongoRepo.Find(ctx, models.FormSubmission{}.TableName(), map[string]interface{}{"submitted_by": userID}, &submissions, opts); err != nil {
+		return nil, err
+	}
+	if len(submissions) == 0 {
+		return nil, nil
+	}
+
+	submissionIDs := make([]primitive.ObjectID, len(submissions))
+	for i, submission := range submissions {
+		submissionIDs[i] = submission.ID
+	}
+	filter := map[string]interface{}{
+		"submission_id": map[string]interface{}{"$in": submissionIDs},
+	}
+
+	var files []*models.FileReference
+	if err := r.mongoRepo.Find(ctx, models.FileReference{}.TableName(), filter, &files, nil); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// DeleteFiles implements UserDataRepository.DeleteFiles
+func (r *mongoUserDataRepository) DeleteFiles(ctx context.Context, fileIDs []primitive.ObjectID) error {
+	filter := map[string]interface{}{
+		"_id": map[string]interface{}{"$in": fileIDs},
+	}
+
+	_, err := r.mongoRepo.DeleteMany(ctx, models.FileReference{}.TableName(), filter)
+	return err
+}
+
+// RemoveEncryptedAnswers implements UserDataRepository.RemoveEncryptedAnswers
+func (r *mongoUserDataRepository) RemoveEncryptedAnswers(ctx context.Context, userID string) (int64, error) {
+	filter := map[string]interface{}{
+		"submitted_by":      userID,
+		"encrypted_answers": map[string]interface{}{"$exists": true},
+	}
+	update := map[string]interface{}{
+		"$unset": map[string]interface{}{
+			"encrypted_answers": "",
+			"key_id":            "",
+		},
+	}
+
+	return r.mongoRepo.UpdateManyRaw(ctx, models.FormSubmission{}.TableName(), filter, update)
+}
+
+// userFilter matches the documents in which any of the fields holds the user ID
+func userFilter(fields []string, userID string) map[string]interface{} {
+	or := make([]interface{}, len(fields))
+	for i, field := range fields {
+		or[i] = map[string]interface{}{field: userID}
+	}
+	return map[string]interface{}{"$or": or}
+}
@@ -10,4 +10,5 @@ const (
 	ErrorCodeLastSession              = "LAST_SESSION"
 	ErrorCodeSessionNotFound          = "SESSION_NOT_FOUND"
 	ErrorCodeValidationError          = "VALIDATION_ERROR"
+	ErrorCodeQuotaExceeded            = "QUOTA_EXCEEDED"
 )
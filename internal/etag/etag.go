@@ -0,0 +1,87 @@
+// Package etag supports conditional reads: handlers tag their responses with an ETag and skip
+// the response body when the client already holds the same version (If-None-Match).
+package etag
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// Header and metadata names
+const (
+	// HeaderName is the response header carrying the ETag
+	HeaderName = "ETag"
+	// metadataKey is the response metadata carrying the ETag; the gateway forwards it as the ETag header
+	metadataKey = "etag"
+	// notModifiedKey marks a response whose body was skipped because the client's copy is current
+	notModifiedKey = "x-not-modified"
+)
+
+// ifNoneMatchKeys are the request metadata keys holding If-None-Match, sent directly by gRPC
+// clients or forwarded by the gateway's default header matcher
+var ifNoneMatchKeys = []string{"if-none-match", runtime.MetadataPrefix + "if-none-match"}
+
+// Compute returns a strong ETag identifying the version described by parts
+func Compute(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// Matches reports whether the request's If-None-Match lists tag, meaning the client already has it.
+// Weak comparison is used, as recommended for If-None-Match.
+func Matches(ctx context.Context, tag string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, key := range ifNoneMatchKeys {
+		for _, value := range md.Get(key) {
+			for _, candidate := range strings.Split(value, ",") {
+				candidate = strings.TrimSpace(candidate)
+				if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(tag, "W/") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// SetHeader sends tag as the response ETag; notModified marks a response without a body
+func SetHeader(ctx context.Context, tag string, notModified bool) error {
+	md := metadata.Pairs(metadataKey, tag)
+	if notModified {
+		md.Set(notModifiedKey, "true")
+	}
+	return grpc.SetHeader(ctx, md)
+}
+
+// NotModified reports whether ctx belongs to a request answered with SetHeader(ctx, tag, true).
+// It is meant for gateway response options.
+func NotModified(ctx context.Context) bool {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	return ok && len(md.HeaderMD.Get(notModifiedKey)) > 0
+}
+
+// GatewayResponseOption answers requests marked as not modified with 304 Not Modified and no body.
+// It is meant for runtime.WithForwardResponseOption.
+func GatewayResponseOption(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	if !NotModified(ctx) {
+		return nil
+	}
+
+	header := w.Header()
+	header.Del(notModifiedKey)
+	header.Del("Content-Type")
+	w.WriteHeader(http.StatusNotModified)
+	return nil
+}
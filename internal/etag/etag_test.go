@@ -0,0 +1,76 @@
+package etag
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCompute(t *testing.T) {
+	tag := Compute("form", "1")
+
+	assert.Equal(t, tag, Compute("form", "1"))
+	assert.NotEqual(t, tag, Compute("form", "2"))
+	assert.NotEqual(t, Compute("ab", "c"), Compute("a", "bc"))
+	assert.Regexp(t, `^"[0-9a-f]{32}"$`, tag)
+}
+
+func TestMatches(t *testing.T) {
+	tag := Compute("form", "1")
+
+	tests := map[string]struct {
+		md       metadata.MD
+		expected bool
+	}{
+		"no metadata":     {nil, false},
+		"no header":       {metadata.Pairs("x-user-id", "u1"), false},
+		"grpc client":     {metadata.Pairs("if-none-match", tag), true},
+		"gateway":         {metadata.Pairs("grpcgateway-if-none-match", tag), true},
+		"list":            {metadata.Pairs("if-none-match", `"other", `+tag), true},
+		"weak":            {metadata.Pairs("if-none-match", "W/"+tag), true},
+		"wildcard":        {metadata.Pairs("if-none-match", "*"), true},
+		"other version":   {metadata.Pairs("if-none-match", Compute("form", "2")), false},
+		"unquoted prefix": {metadata.Pairs("if-none-match", tag[1:]), false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			assert.Equal(t, tt.expected, Matches(ctx, tag))
+		})
+	}
+}
+
+func TestGatewayResponseOption(t *testing.T) {
+	t.Run("not modified", func(t *testing.T) {
+		ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{
+			HeaderMD: metadata.Pairs(metadataKey, `"v1"`, notModifiedKey, "true"),
+		})
+		w := httptest.NewRecorder()
+		w.Header().Set("X-Not-Modified", "true")
+		w.Header().Set("Content-Type", "application/json")
+
+		assert.NoError(t, GatewayResponseOption(ctx, w, nil))
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Header().Get("X-Not-Modified"))
+		assert.Empty(t, w.Header().Get("Content-Type"))
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{
+			HeaderMD: metadata.Pairs(metadataKey, `"v1"`),
+		})
+		w := httptest.NewRecorder()
+
+		assert.NoError(t, GatewayResponseOption(ctx, w, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,395 @@
+// Package expr evaluates the arithmetic expressions of computed form fields. The language only
+// has numbers, field names, the operators + - * / % with parentheses and a few functions
+// (min, max, abs, round, floor, ceil), so expressions cannot loop, allocate or reach anything
+// but the answers they are given.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression limits, bounding the work done for an untrusted expression
+const (
+	MaxLength = 512
+	maxDepth  = 32
+)
+
+// Evaluation errors
+var (
+	ErrMissingVariable = errors.New("variable has no value")
+	ErrDivisionByZero  = errors.New("division by zero")
+	ErrNotANumber      = errors.New("result is not a finite number")
+)
+
+// Expr is a parsed expression
+type Expr struct {
+	root node
+	vars []string
+}
+
+// Parse parses an expression
+func Parse(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("expression exceeds %d characters", MaxLength)
+	}
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, vars: make(map[string]bool)}
+	root, err := p.parseExpr(0)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+	}
+
+	vars := make([]string, 0, len(p.vars))
+	for name := range p.vars {
+		vars = append(vars, name)
+	}
+	sort.Strings(vars)
+	return &Expr{root: root, vars: vars}, nil
+}
+
+// Vars returns the names of the variables the expression refers to, sorted
+func (e *Expr) Vars() []string {
+	return e.vars
+}
+
+// Eval evaluates the expression with the given variable values
+func (e *Expr) Eval(vars map[string]float64) (float64, error) {
+	result, err := e.root.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, ErrNotANumber
+	}
+	return result, nil
+}
+
+// Abstract syntax tree
+
+type node interface {
+	eval(vars map[string]float64) (float64, error)
+}
+
+type numberNode float64
+
+func (n numberNode) eval(map[string]float64) (float64, error) {
+	return float64(n), nil
+}
+
+type varNode string
+
+func (n varNode) eval(vars map[string]float64) (float64, error) {
+	value, ok := vars[string(n)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingVariable, string(n))
+	}
+	return value, nil
+}
+
+type negNode struct {
+	operand node
+}
+
+func (n negNode) eval(vars map[string]float64) (float64, error) {
+	value, err := n.operand.eval(vars)
+	return -value, err
+}
+
+type binaryNode struct {
+	op          byte
+	left, right node
+}
+
+func (n binaryNode) eval(vars map[string]float64) (float64, error) {
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		if right == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return left / right, nil
+	default: // '%'
+		if right == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return math.Mod(left, right), nil
+	}
+}
+
+type callNode struct {
+	fn   function
+	args []node
+}
+
+func (n callNode) eval(vars map[string]float64) (float64, error) {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		value, err := arg.eval(vars)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = value
+	}
+	return n.fn.call(args), nil
+}
+
+// function is a built-in function taking between minArgs and maxArgs arguments; maxArgs < 0
+// means any number
+type function struct {
+	minArgs, maxArgs int
+	call             func(args []float64) float64
+}
+
+var functions = map[string]function{
+	"min": {1, -1, func(args []float64) float64 {
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Min(result, arg)
+		}
+		return result
+	}},
+	"max": {1, -1, func(args []float64) float64 {
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Max(result, arg)
+		}
+		return result
+	}},
+	"abs":   {1, 1, func(args []float64) float64 { return math.Abs(args[0]) }},
+	"floor": {1, 1, func(args []float64) float64 { return math.Floor(args[0]) }},
+	"ceil":  {1, 1, func(args []float64) float64 { return math.Ceil(args[0]) }},
+	// round(x) rounds to an integer, round(x, digits) to a number of decimal digits
+	"round": {1, 2, func(args []float64) float64 {
+		if len(args) == 1 {
+			return math.Round(args[0])
+		}
+		scale := math.Pow(10, math.Round(args[1]))
+		return math.Round(args[0]*scale) / scale
+	}},
+}
+
+// Tokenizer
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator // + - * / % ( ) ,
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.ContainsRune("+-*/%(),", c):
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), pos: i})
+			i++
+		case c == '.' || unicode.IsDigit(c):
+			start := i
+			for i < len(src) && (src[i] == '.' || unicode.IsDigit(rune(src[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: src[start:i], pos: start})
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(src) && (src[i] == '_' || unicode.IsLetter(rune(src[i])) || unicode.IsDigit(rune(src[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: src[start:i], pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", pos: len(src)}), nil
+}
+
+// Parser: recursive descent with the usual precedence, unary minus binding tightest
+
+type parser struct {
+	tokens []token
+	pos    int
+	vars   map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) accept(op string) bool {
+	if tok := p.peek(); tok.kind == tokenOperator && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.accept(op) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, found %q", op, tok.pos, tok.text)
+	}
+	return nil
+}
+
+// parseExpr parses a sum of terms
+func (p *parser) parseExpr(depth int) (node, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("expression nested deeper than %d levels", maxDepth)
+	}
+	left, err := p.parseTerm(depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator || (tok.text != "+" && tok.text != "-") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseTerm(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: tok.text[0], left: left, right: right}
+	}
+}
+
+// parseTerm parses a product of factors
+func (p *parser) parseTerm(depth int) (node, error) {
+	left, err := p.parseUnary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator || (tok.text != "*" && tok.text != "/" && tok.text != "%") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: tok.text[0], left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary(depth int) (node, error) {
+	if p.accept("-") {
+		if depth > maxDepth {
+			return nil, fmt.Errorf("expression nested deeper than %d levels", maxDepth)
+		}
+		operand, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return negNode{operand: operand}, nil
+	}
+	return p.parsePrimary(depth)
+}
+
+func (p *parser) parsePrimary(depth int) (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return numberNode(value), nil
+	case tokenIdent:
+		if !p.accept("(") {
+			p.vars[tok.text] = true
+			return varNode(tok.text), nil
+		}
+		return p.parseCall(tok, depth)
+	case tokenOperator:
+		if tok.text == "(" {
+			inner, err := p.parseExpr(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return inner, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+// parseCall parses the arguments of a function call, after its opening parenthesis
+func (p *parser) parseCall(name token, depth int) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+	}
+
+	var args []node
+	if !p.accept(")") {
+		for {
+			arg, err := p.parseExpr(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.accept(")") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, fmt.Errorf("wrong number of arguments to %s at position %d", name.text, name.pos)
+	}
+	return callNode{fn: fn, args: args}, nil
+}
@@ -0,0 +1,92 @@
+package expr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]float64{"quantity": 3, "price": 12.5, "discount_pct": 10}
+
+	tests := []struct {
+		src      string
+		expected float64
+	}{
+		{src: "quantity * price", expected: 37.5},
+		{src: "quantity * price * (1 - discount_pct / 100)", expected: 33.75},
+		{src: "1 + 2 * 3 - 4 / 2", expected: 5},
+		{src: "-quantity + --2", expected: -1},
+		{src: "10 % 4", expected: 2},
+		{src: "round(price / 3, 2)", expected: 4.17},
+		{src: "round(2.5) + floor(1.9) + ceil(1.1) + abs(-3)", expected: 9},
+		{src: "min(quantity, 2, price) + max(quantity)", expected: 5},
+		{src: ".5 + 1.", expected: 1.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			e, err := Parse(tt.src)
+			require.NoError(t, err)
+
+			result, err := e.Eval(vars)
+
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, result, 1e-9)
+		})
+	}
+}
+
+func TestParse_Vars(t *testing.T) {
+	e, err := Parse("price * quantity + round(price, digits)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"digits", "price", "quantity"}, e.Vars())
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []string{
+		"",
+		"1 +",
+		"(1 + 2",
+		"1 2",
+		"quantity ^ 2",
+		"1..2",
+		"unknown(1)",
+		"min()",
+		"abs(1, 2)",
+		"round(1, 2, 3)",
+		"price,",
+		strings.Repeat("(", 40) + "1" + strings.Repeat(")", 40),
+		strings.Repeat("-", 40) + "1",
+		strings.Repeat("1+", MaxLength),
+	}
+	for _, src := range tests {
+		t.Run(src, func(t *testing.T) {
+			_, err := Parse(src)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEval_Errors(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected error
+	}{
+		{src: "quantity * price", expected: ErrMissingVariable},
+		{src: "1 / (2 - 2)", expected: ErrDivisionByZero},
+		{src: "5 % 0", expected: ErrDivisionByZero},
+		{src: "round(1, 400)", expected: ErrNotANumber},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			e, err := Parse(tt.src)
+			require.NoError(t, err)
+
+			_, err = e.Eval(map[string]float64{"quantity": 1})
+
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Package health reports whether the service and the dependencies it needs are available, through
+// grpc.health.v1 and the HTTP /healthz and /readyz probes.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Report statuses
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// Check probes a dependency. Required checks make the service unready when they fail; the
+// others are only reported.
+type Check struct {
+	Name     string
+	Required bool
+	Probe    func(ctx context.Context) error
+}
+
+// Report is the outcome of running the checks
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// CheckResult is the outcome of a single check
+type CheckResult struct {
+	Status   string `json:"status"`
+	Required bool   `json:"required"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Ready reports whether all required checks passed
+func (r *Report) Ready() bool {
+	return r.Status == StatusOK
+}
+
+// Checker runs the dependency checks and publishes the result to the gRPC health server
+type Checker struct {
+	checks   []Check
+	timeout  time.Duration
+	services []string
+	server   *grpchealth.Server
+
+	mu       sync.Mutex
+	shutdown bool
+}
+
+// NewChecker creates a checker running each check with the timeout. services are the gRPC
+// services whose health status follows the checks, in addition to the overall "" status.
+func NewChecker(timeout time.Duration, services []string, checks ...Check) *Checker {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	server := grpchealth.NewServer()
+	// Not serving until the first checks passed
+	for _, service := range append([]string{""}, services...) {
+		server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return &Checker{
+		checks:   checks,
+		timeout:  timeout,
+		services: services,
+		server:   server,
+	}
+}
+
+// Server returns the grpc.health.v1 server to register on the gRPC server
+func (c *Checker) Server() *grpchealth.Server {
+	return c.server
+}
+
+// Check runs all checks concurrently
+func (c *Checker) Check(ctx context.Context) *Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results := make([]CheckResult, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := CheckResult{Status: StatusOK, Required: check.Required}
+			if err := check.Probe(ctx); err != nil {
+				result.Status = StatusUnavailable
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(c.checks))}
+	for i, check := range c.checks {
+		report.Checks[check.Name] = results[i]
+		if check.Required && results[i].Status != StatusOK {
+			report.Status = StatusUnavailable
+		}
+	}
+
+	c.mu.Lock()
+	if c.shutdown {
+		report.Status = StatusUnavailable
+	}
+	c.mu.Unlock()
+	return report
+}
+
+// Run refreshes the gRPC health status every interval until the context is cancelled
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ready := false
+	for {
+		report := c.Check(ctx)
+		if report.Ready() != ready {
+			ready = report.Ready()
+			if ready {
+				log.Info("Service ready - dependency checks passed")
+			} else {
+				log.Warn("Service not ready - dependency checks failed", log.Any("checks", report.Checks))
+			}
+		}
+		c.setServing(ready)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports the service as not serving for good, so load balancers stop routing to it
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	c.shutdown = true
+	c.mu.Unlock()
+	c.server.Shutdown()
+}
+
+// setServing publishes the readiness to the gRPC health server
+func (c *Checker) setServing(ready bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.shutdown {
+		return
+	}
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if ready {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	for _, service := range append([]string{""}, c.services...) {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// RegisterGateway adds GET /healthz (the process is up) and GET /readyz (the dependencies are
+// available) to the gateway. It has the signature of a gateway handler registration.
+func (c *Checker) RegisterGateway(_ context.Context, mux *runtime.ServeMux, _ string, _ []grpc.DialOption) error {
+	if err := mux.HandlePath(http.MethodGet, "/healthz", func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+	}); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodGet, "/readyz", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		report := c.Check(r.Context())
+		code := http.StatusOK
+		if !report.Ready() {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Warn("Failed to write health response", log.Err(err))
+	}
+}
+
+// MongoPing probes MongoDB by pinging the primary
+func MongoPing(client *mongo.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	}
+}
+
+// Dial probes a TCP endpoint by opening and closing a connection
+func Dial(addr string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func passing(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestChecker_Check(t *testing.T) {
+	checker := NewChecker(0, nil,
+		Check{Name: "mongodb", Required: true, Probe: passing},
+		Check{Name: "order_service", Required: false, Probe: failing},
+	)
+
+	report := checker.Check(context.Background())
+	assert.True(t, report.Ready())
+	assert.Equal(t, StatusOK, report.Checks["mongodb"].Status)
+	assert.Equal(t, StatusUnavailable, report.Checks["order_service"].Status)
+	assert.Equal(t, "connection refused", report.Checks["order_service"].Error)
+
+	checker = NewChecker(0, nil, Check{Name: "mongodb", Required: true, Probe: failing})
+	assert.False(t, checker.Check(context.Background()).Ready())
+}
+
+func TestChecker_Run_SetsServingStatus(t *testing.T) {
+	checker := NewChecker(0, []string{"form.service.FormService"}, Check{Name: "mongodb", Required: true, Probe: passing})
+
+	resp, err := checker.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: "form.service.FormService"})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	checker.Run(ctx, 0)
+
+	resp, err = checker.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: "form.service.FormService"})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	checker.Shutdown()
+	resp, err = checker.Server().Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+	assert.False(t, checker.Check(context.Background()).Ready())
+}
+
+func TestChecker_RegisterGateway(t *testing.T) {
+	healthy := true
+	checker := NewChecker(0, nil, Check{Name: "mongodb", Required: true, Probe: func(context.Context) error {
+		if healthy {
+			return nil
+		}
+		return errors.New("no reachable servers")
+	}})
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, checker.RegisterGateway(context.Background(), mux, "", nil))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	healthy = false
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, "no reachable servers", report.Checks["mongodb"].Error)
+
+	// Liveness does not depend on the dependencies
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestDial(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+
+	assert.NoError(t, Dial(addr)(context.Background()))
+	require.NoError(t, ln.Close())
+	assert.Error(t, Dial(addr)(context.Background()))
+}
@@ -0,0 +1,192 @@
+// Package idempotency replays the stored result of a request retried with the same Idempotency-Key,
+// so that client retries of create and submit operations do not create duplicates.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Header and metadata names
+const (
+	HeaderName  = "Idempotency-Key"
+	MetadataKey = "idempotency-key"
+)
+
+// Metadata keys identifying the caller, set by the vulpes header matcher
+const (
+	userIDKey     = "user-id"
+	merchantIDKey = "merchant-id"
+)
+
+// maxKeyLength bounds the keys accepted from clients
+const maxKeyLength = 255
+
+// DefaultTTL is used when no lifetime is configured
+const DefaultTTL = 24 * time.Hour
+
+// GatewayMetadata forwards the Idempotency-Key HTTP header to gRPC metadata. It is meant for
+// runtime.WithMetadata, as the default header matcher only forwards prefixed headers.
+func GatewayMetadata(_ context.Context, r *http.Request) metadata.MD {
+	if key := r.Header.Get(HeaderName); key != "" {
+		return metadata.Pairs(MetadataKey, key)
+	}
+	return nil
+}
+
+// Interceptor makes the configured gRPC methods idempotent for requests carrying an idempotency key
+type Interceptor struct {
+	repo    repository.IdempotencyRepository
+	ttl     time.Duration
+	methods map[string]bool
+	now     func() time.Time
+}
+
+// NewInterceptor creates an idempotency interceptor for the given full method names
+func NewInterceptor(repo repository.IdempotencyRepository, ttl time.Duration, methods []string) *Interceptor {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	idempotent := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		idempotent[method] = true
+	}
+	return &Interceptor{
+		repo:    repo,
+		ttl:     ttl,
+		methods: idempotent,
+		now:     time.Now,
+	}
+}
+
+// UnaryServerInterceptor returns the interceptor. The first request with a key runs normally and its
+// successful response is stored; retries with the same key and request get the stored response back.
+// Failed requests are not stored, so they can be retried with the same key.
+func (i *Interceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := metadataValue(ctx, MetadataKey)
+		if key == "" || !i.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		if len(key) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key exceeds %d characters", maxKeyLength)
+		}
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		fingerprint, err := fingerprint(message)
+		if err != nil {
+			log.Error("Failed to fingerprint request", log.Err(err), log.String("method", info.FullMethod))
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		now := i.now()
+		record := &models.IdempotencyRecord{
+			Scope:       metadataValue(ctx, merchantIDKey) + ":" + metadataValue(ctx, userIDKey),
+			Operation:   info.FullMethod,
+			Key:         key,
+			Fingerprint: fingerprint,
+		}
+		record.SetCreatedAt(now)
+		record.SetExpiresAt(now.Add(i.ttl))
+
+		existing, err := i.repo.Reserve(ctx, record)
+		if err != nil {
+			log.Error("Failed to reserve idempotency key", log.Err(err), log.String("method", info.FullMethod))
+			return nil, status.Error(codes.Unavailable, "idempotency store unavailable, retry later")
+		}
+		if existing != nil {
+			return replay(existing, fingerprint)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if deleteErr := i.repo.Delete(ctx, record.ID); deleteErr != nil {
+				log.Error("Failed to release idempotency key", log.Err(deleteErr), log.String("key", key))
+			}
+			return nil, err
+		}
+
+		if err := i.complete(ctx, record, resp); err != nil {
+			// The operation succeeded; a retry will be told the request is still in progress
+			// until the key expires rather than running the operation again
+			log.Error("Failed to store idempotent response", log.Err(err), log.String("key", key))
+		}
+		return resp, nil
+	}
+}
+
+func (i *Interceptor) complete(ctx context.Context, record *models.IdempotencyRecord, resp interface{}) error {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return status.Error(codes.Internal, "response is not a protobuf message")
+	}
+	packed, err := anypb.New(message)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(packed)
+	if err != nil {
+		return err
+	}
+	return i.repo.Complete(ctx, record.ID, data)
+}
+
+// replay returns the stored response of a completed request with the same fingerprint
+func replay(record *models.IdempotencyRecord, fingerprint string) (interface{}, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used with a different request")
+	}
+	if record.Status != models.IdempotencyStatusCompleted {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	var packed anypb.Any
+	if err := proto.Unmarshal(record.Response, &packed); err != nil {
+		log.Error("Failed to decode idempotent response", log.Err(err), log.String("key", record.Key))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	resp, err := packed.UnmarshalNew()
+	if err != nil {
+		log.Error("Failed to decode idempotent response", log.Err(err), log.String("key", record.Key))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+	return resp, nil
+}
+
+// fingerprint hashes the deterministic encoding of a request message
+func fingerprint(message proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,177 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+const testMethod = "/form.service.FormService/SubmitFormResponse"
+
+// fakeRepository keeps idempotency records in memory
+type fakeRepository struct {
+	records    map[string]*models.IdempotencyRecord
+	reserveErr error
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{records: make(map[string]*models.IdempotencyRecord)}
+}
+
+func (r *fakeRepository) Reserve(ctx context.Context, record *models.IdempotencyRecord) (*models.IdempotencyRecord, error) {
+	if r.reserveErr != nil {
+		return nil, r.reserveErr
+	}
+	id := record.Scope + "|" + record.Operation + "|" + record.Key
+	if existing, ok := r.records[id]; ok {
+		return existing, nil
+	}
+	record.ID = primitive.NewObjectID()
+	record.Status = models.IdempotencyStatusPending
+	r.records[id] = record
+	return nil, nil
+}
+
+func (r *fakeRepository) Complete(ctx context.Context, recordID primitive.ObjectID, response []byte) error {
+	for _, record := range r.records {
+		if record.ID == recordID {
+			record.Status = models.IdempotencyStatusCompleted
+			record.Response = response
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepository) Delete(ctx context.Context, recordID primitive.ObjectID) error {
+	for id, record := range r.records {
+		if record.ID == recordID {
+			delete(r.records, id)
+		}
+	}
+	return nil
+}
+
+func keyContext(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		MetadataKey, key,
+		userIDKey, "user123",
+		merchantIDKey, "merchant123",
+	))
+}
+
+func countingHandler(calls *int) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		*calls++
+		return wrapperspb.String("submission-" + req.(*wrapperspb.StringValue).Value), nil
+	}
+}
+
+func TestInterceptor_ReplaysCompletedRequest(t *testing.T) {
+	interceptor := NewInterceptor(newFakeRepository(), time.Hour, []string{testMethod}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	first, err := interceptor(keyContext("key-1"), wrapperspb.String("a"), info, countingHandler(&calls))
+	require.NoError(t, err)
+	retry, err := interceptor(keyContext("key-1"), wrapperspb.String("a"), info, countingHandler(&calls))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls)
+	assert.True(t, proto.Equal(first.(proto.Message), retry.(proto.Message)))
+}
+
+func TestInterceptor_DifferentRequestSameKey(t *testing.T) {
+	interceptor := NewInterceptor(newFakeRepository(), time.Hour, []string{testMethod}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	_, err := interceptor(keyContext("key-1"), wrapperspb.String("a"), info, countingHandler(&calls))
+	require.NoError(t, err)
+	_, err = interceptor(keyContext("key-1"), wrapperspb.String("b"), info, countingHandler(&calls))
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestInterceptor_InProgress(t *testing.T) {
+	repo := newFakeRepository()
+	interceptor := NewInterceptor(repo, time.Hour, []string{testMethod}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	// The retry arrives while the first request is still being handled
+	var retryErr error
+	_, err := interceptor(keyContext("key-1"), wrapperspb.String("a"), info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, retryErr = interceptor(keyContext("key-1"), wrapperspb.String("a"), info, countingHandler(&calls))
+		return wrapperspb.String("done"), nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(retryErr))
+	assert.Equal(t, 0, calls)
+}
+
+func TestInterceptor_FailedRequestCanBeRetried(t *testing.T) {
+	repo := newFakeRepository()
+	interceptor := NewInterceptor(repo, time.Hour, []string{testMethod}).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	_, err := interceptor(keyContext("key-1"), wrapperspb.String("a"), info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("database error")
+	})
+	require.Error(t, err)
+	assert.Empty(t, repo.records)
+
+	_, err = interceptor(keyContext("key-1"), wrapperspb.String("a"), info, countingHandler(&calls))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestInterceptor_WithoutKeyOrUnlistedMethod(t *testing.T) {
+	repo := newFakeRepository()
+	interceptor := NewInterceptor(repo, time.Hour, []string{testMethod}).UnaryServerInterceptor()
+	calls := 0
+
+	_, err := interceptor(context.Background(), wrapperspb.String("a"), &grpc.UnaryServerInfo{FullMethod: testMethod}, countingHandler(&calls))
+	require.NoError(t, err)
+	_, err = interceptor(keyContext("key-1"), wrapperspb.String("a"), &grpc.UnaryServerInfo{FullMethod: "/form.service.FormService/ListSubmissions"}, countingHandler(&calls))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, repo.records)
+}
+
+func TestInterceptor_StoreUnavailable(t *testing.T) {
+	repo := newFakeRepository()
+	repo.reserveErr = errors.New("connection refused")
+	interceptor := NewInterceptor(repo, time.Hour, []string{testMethod}).UnaryServerInterceptor()
+	calls := 0
+
+	_, err := interceptor(keyContext("key-1"), wrapperspb.String("a"), &grpc.UnaryServerInfo{FullMethod: testMethod}, countingHandler(&calls))
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 0, calls)
+}
+
+func TestGatewayMetadata(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "/forms/1/submissions", nil)
+	assert.Nil(t, GatewayMetadata(context.Background(), req))
+
+	req.Header.Set(HeaderName, "key-1")
+	assert.Equal(t, []string{"key-1"}, GatewayMetadata(context.Background(), req).Get(MetadataKey))
+}
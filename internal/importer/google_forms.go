@@ -0,0 +1,155 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// GoogleForms converts the Form resource returned by the Google Forms API (forms.get).
+// Text, choice, scale, rating, date and time questions are converted; the "Other" option of
+// choice questions is left out. Grids and file uploads are reported as unsupported, and items
+// without a question (text, images, videos, page breaks) are skipped.
+type GoogleForms struct{}
+
+type googleForm struct {
+	Info struct {
+		Title         string `json:"title"`
+		DocumentTitle string `json:"documentTitle"`
+		Description   string `json:"description"`
+	} `json:"info"`
+	Items []googleItem `json:"items"`
+}
+
+type googleItem struct {
+	ItemID       string `json:"itemId"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	QuestionItem *struct {
+		Question googleQuestion `json:"question"`
+	} `json:"questionItem"`
+	QuestionGroupItem json.RawMessage `json:"questionGroupItem"`
+}
+
+type googleQuestion struct {
+	QuestionID   string `json:"questionId"`
+	Required     bool   `json:"required"`
+	TextQuestion *struct {
+		Paragraph bool `json:"paragraph"`
+	} `json:"textQuestion"`
+	ChoiceQuestion *struct {
+		Type    string `json:"type"` // RADIO, CHECKBOX or DROP_DOWN
+		Options []struct {
+			Value   string `json:"value"`
+			IsOther bool   `json:"isOther"`
+		} `json:"options"`
+	} `json:"choiceQuestion"`
+	ScaleQuestion *struct {
+		Low  int `json:"low"`
+		High int `json:"high"`
+	} `json:"scaleQuestion"`
+	RatingQuestion *struct {
+		RatingScaleLevel int `json:"ratingScaleLevel"`
+	} `json:"ratingQuestion"`
+	DateQuestion *struct {
+		IncludeTime bool `json:"includeTime"`
+	} `json:"dateQuestion"`
+	TimeQuestion *struct {
+		Duration bool `json:"duration"`
+	} `json:"timeQuestion"`
+	FileUploadQuestion json.RawMessage `json:"fileUploadQuestion"`
+}
+
+// Convert implements Converter.Convert
+func (GoogleForms) Convert(data []byte) (*Result, error) {
+	var form googleForm
+	if err := json.Unmarshal(data, &form); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+	}
+	if form.Items == nil && form.Info.Title == "" && form.Info.DocumentTitle == "" {
+		return nil, fmt.Errorf("%w: not a Google Forms form resource", ErrInvalidExport)
+	}
+
+	b := newObjectBuilder()
+	var unsupported []Unsupported
+	for _, item := range form.Items {
+		switch {
+		case item.QuestionItem != nil:
+			q := item.QuestionItem.Question
+			field, fieldUI, questionType := googleField(q)
+			if field == nil {
+				unsupported = append(unsupported, Unsupported{ID: q.QuestionID, Title: item.Title, Type: questionType})
+				continue
+			}
+			if item.Description != "" {
+				field["description"] = item.Description
+			}
+			b.add(item.Title, q.QuestionID, field, fieldUI, q.Required)
+		case item.QuestionGroupItem != nil:
+			unsupported = append(unsupported, Unsupported{ID: item.ItemID, Title: item.Title, Type: "questionGroupItem"})
+		}
+	}
+
+	title := form.Info.Title
+	if title == "" {
+		title = form.Info.DocumentTitle
+	}
+	return b.result(title, form.Info.Description, unsupported), nil
+}
+
+// googleField returns the field of a question, or nil with the question type when the type is
+// not supported
+func googleField(q googleQuestion) (map[string]interface{}, map[string]interface{}, string) {
+	switch {
+	case q.TextQuestion != nil:
+		if q.TextQuestion.Paragraph {
+			return map[string]interface{}{"type": "string"}, map[string]interface{}{schema.UIWidgetKey: "textarea"}, ""
+		}
+		return map[string]interface{}{"type": "string"}, nil, ""
+	case q.ChoiceQuestion != nil:
+		var options []interface{}
+		for _, option := range q.ChoiceQuestion.Options {
+			if !option.IsOther {
+				options = append(options, option.Value)
+			}
+		}
+		if len(options) == 0 {
+			return nil, nil, "choiceQuestion"
+		}
+		switch q.ChoiceQuestion.Type {
+		case "CHECKBOX":
+			return enumField(options, true), map[string]interface{}{schema.UIWidgetKey: "checkboxes"}, ""
+		case "DROP_DOWN":
+			return enumField(options, false), map[string]interface{}{schema.UIWidgetKey: "select"}, ""
+		default:
+			return enumField(options, false), map[string]interface{}{schema.UIWidgetKey: "radio"}, ""
+		}
+	case q.ScaleQuestion != nil:
+		return map[string]interface{}{
+			"type":    "integer",
+			"minimum": q.ScaleQuestion.Low,
+			"maximum": q.ScaleQuestion.High,
+		}, map[string]interface{}{schema.UIWidgetKey: "range"}, ""
+	case q.RatingQuestion != nil:
+		return map[string]interface{}{
+			"type":    "integer",
+			"minimum": 1,
+			"maximum": q.RatingQuestion.RatingScaleLevel,
+		}, map[string]interface{}{schema.UIWidgetKey: "range"}, ""
+	case q.DateQuestion != nil:
+		if q.DateQuestion.IncludeTime {
+			return map[string]interface{}{"type": "string", "format": "date-time"}, nil, ""
+		}
+		return map[string]interface{}{"type": "string", "format": "date"}, nil, ""
+	case q.TimeQuestion != nil:
+		if q.TimeQuestion.Duration {
+			return nil, nil, "timeQuestion.duration"
+		}
+		return map[string]interface{}{"type": "string", "format": "time"}, nil, ""
+	case q.FileUploadQuestion != nil:
+		return nil, nil, "fileUploadQuestion"
+	default:
+		return nil, nil, "unknown"
+	}
+}
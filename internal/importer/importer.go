@@ -0,0 +1,211 @@
+// Package importer converts forms exported from other form builders into a JSON Schema and
+// UI Schema, so merchants can migrate their existing forms.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// Supported export formats
+const (
+	FormatGoogleForms = "google_forms" // Form resource of the Google Forms API
+	FormatTypeform    = "typeform"     // Form definition of the Typeform Create API
+)
+
+// Errors returned by Convert
+var (
+	ErrUnknownFormat = errors.New("unknown import format")
+	ErrInvalidExport = errors.New("invalid form export")
+)
+
+// maxKeyLength is the length of the field keys derived from question titles
+const maxKeyLength = 40
+
+// Converter translates the export of a form builder into a form
+type Converter interface {
+	// Convert parses an exported form. Questions that have no equivalent are left out and
+	// reported in the result; an error means the data is not an export of the format.
+	Convert(data []byte) (*Result, error)
+}
+
+// Result is an imported form
+type Result struct {
+	Title       string
+	Description string
+	Schema      map[string]interface{}
+	UISchema    map[string]interface{}
+	Unsupported []Unsupported
+}
+
+// Unsupported is a question of the export that could not be converted
+type Unsupported struct {
+	ID    string // Question ID in the export
+	Title string
+	Type  string // Question type in the export
+}
+
+// Registry holds the converters by export format
+type Registry struct {
+	converters map[string]Converter
+}
+
+// NewRegistry creates a registry with the Google Forms and Typeform converters
+func NewRegistry() *Registry {
+	r := &Registry{converters: make(map[string]Converter)}
+	r.Register(FormatGoogleForms, GoogleForms{})
+	r.Register(FormatTypeform, Typeform{})
+	return r
+}
+
+// Register sets the converter of a format, replacing any previous one
+func (r *Registry) Register(format string, c Converter) {
+	r.converters[format] = c
+}
+
+// Formats returns the registered formats in name order
+func (r *Registry) Formats() []string {
+	formats := make([]string, 0, len(r.converters))
+	for format := range r.converters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Convert converts an export with the converter of its format
+func (r *Registry) Convert(format string, data []byte) (*Result, error) {
+	c, ok := r.converters[format]
+	if !ok {
+		return nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownFormat, format, strings.Join(r.Formats(), ", "))
+	}
+	return c.Convert(data)
+}
+
+// objectBuilder collects the fields of an object schema and its UI Schema in question order
+type objectBuilder struct {
+	properties map[string]interface{}
+	required   []interface{}
+	ui         map[string]interface{}
+	order      []interface{}
+	keys       map[string]bool
+}
+
+func newObjectBuilder() *objectBuilder {
+	return &objectBuilder{
+		properties: make(map[string]interface{}),
+		ui:         make(map[string]interface{}),
+		keys:       make(map[string]bool),
+	}
+}
+
+// add adds a field under a key derived from its title, or its ID for titles without letters
+// or digits. Fields with a format get the widget of the format unless fieldUI sets one.
+func (b *objectBuilder) add(title, id string, field, fieldUI map[string]interface{}, required bool) {
+	key := b.key(title, id)
+	if title != "" {
+		field["title"] = title
+	}
+	if format, ok := field["format"].(string); ok {
+		if widget, ok := schema.DefaultFormatWidgets[format]; ok {
+			if fieldUI == nil {
+				fieldUI = make(map[string]interface{})
+			}
+			if _, ok := fieldUI[schema.UIWidgetKey]; !ok {
+				fieldUI[schema.UIWidgetKey] = widget
+			}
+		}
+	}
+
+	b.properties[key] = field
+	b.order = append(b.order, key)
+	if required {
+		b.required = append(b.required, key)
+	}
+	if len(fieldUI) > 0 {
+		b.ui[key] = fieldUI
+	}
+}
+
+var nonKeyChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// key returns a unique snake_case field key
+func (b *objectBuilder) key(title, id string) string {
+	key := slug(title)
+	if key == "" {
+		key = slug(id)
+	}
+	if key == "" {
+		key = "question"
+	}
+	if key[0] >= '0' && key[0] <= '9' {
+		key = "q_" + key
+	}
+
+	unique := key
+	for n := 2; b.keys[unique]; n++ {
+		unique = key + "_" + strconv.Itoa(n)
+	}
+	b.keys[unique] = true
+	return unique
+}
+
+func slug(s string) string {
+	key := strings.Trim(nonKeyChars.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if len(key) > maxKeyLength {
+		key = strings.TrimRight(key[:maxKeyLength], "_")
+	}
+	return key
+}
+
+// schema returns the object schema and its UI Schema
+func (b *objectBuilder) schema() (map[string]interface{}, map[string]interface{}) {
+	s := map[string]interface{}{
+		"type":       "object",
+		"properties": b.properties,
+	}
+	if len(b.required) > 0 {
+		s["required"] = b.required
+	}
+	ui := b.ui
+	if len(b.order) > 0 {
+		ui[schema.UIOrderKey] = b.order
+	}
+	return s, ui
+}
+
+// result returns the form built from the top-level questions
+func (b *objectBuilder) result(title, description string, unsupported []Unsupported) *Result {
+	s, ui := b.schema()
+	if title != "" {
+		s["title"] = title
+	}
+	if description != "" {
+		s["description"] = description
+	}
+	return &Result{
+		Title:       title,
+		Description: description,
+		Schema:      s,
+		UISchema:    ui,
+		Unsupported: unsupported,
+	}
+}
+
+// enumField returns a field choosing one of the options, or any number of them when multiple
+func enumField(options []interface{}, multiple bool) map[string]interface{} {
+	if multiple {
+		return map[string]interface{}{
+			"type":        "array",
+			"items":       map[string]interface{}{"type": "string", "enum": options},
+			"uniqueItems": true,
+		}
+	}
+	return map[string]interface{}{"type": "string", "enum": options}
+}
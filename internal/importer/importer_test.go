@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoogleForms_Convert(t *testing.T) {
+	export := `{
+		"formId": "1FAIpQL",
+		"info": {"title": "Event registration", "description": "Tell us about you"},
+		"items": [
+			{"itemId": "a1", "title": "Full name", "questionItem": {"question": {"questionId": "q1", "required": true, "textQuestion": {}}}},
+			{"itemId": "a2", "title": "Full name", "description": "Again", "questionItem": {"question": {"questionId": "q2", "textQuestion": {"paragraph": true}}}},
+			{"itemId": "a3", "title": "Meals", "questionItem": {"question": {"questionId": "q3", "choiceQuestion": {"type": "CHECKBOX", "options": [{"value": "Lunch"}, {"value": "Dinner"}, {"isOther": true}]}}}},
+			{"itemId": "a4", "title": "Arrival", "questionItem": {"question": {"questionId": "q4", "dateQuestion": {"includeTime": true}}}},
+			{"itemId": "a5", "title": "Satisfaction", "questionItem": {"question": {"questionId": "q5", "scaleQuestion": {"low": 1, "high": 5}}}},
+			{"itemId": "a6", "title": "CV", "questionItem": {"question": {"questionId": "q6", "fileUploadQuestion": {"folderId": "f"}}}},
+			{"itemId": "a7", "title": "Sessions", "questionGroupItem": {"grid": {}}},
+			{"itemId": "a8", "title": "Thanks", "textItem": {}}
+		]
+	}`
+
+	result, err := GoogleForms{}.Convert([]byte(export))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Event registration", result.Title)
+	assert.Equal(t, "Tell us about you", result.Schema["description"])
+	properties := result.Schema["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "string", "title": "Full name"}, properties["full_name"])
+	assert.Equal(t, "Again", properties["full_name_2"].(map[string]interface{})["description"])
+	assert.Equal(t, []interface{}{"Lunch", "Dinner"}, properties["meals"].(map[string]interface{})["items"].(map[string]interface{})["enum"])
+	assert.Equal(t, "date-time", properties["arrival"].(map[string]interface{})["format"])
+	assert.Equal(t, 5, properties["satisfaction"].(map[string]interface{})["maximum"])
+	assert.Equal(t, []interface{}{"full_name"}, result.Schema["required"])
+	assert.Equal(t, []interface{}{"full_name", "full_name_2", "meals", "arrival", "satisfaction"}, result.UISchema["ui:order"])
+	assert.Equal(t, map[string]interface{}{"ui:widget": "datetime"}, result.UISchema["arrival"])
+	assert.Equal(t, []Unsupported{
+		{ID: "q6", Title: "CV", Type: "fileUploadQuestion"},
+		{ID: "a7", Title: "Sessions", Type: "questionGroupItem"},
+	}, result.Unsupported)
+}
+
+func TestTypeform_Convert(t *testing.T) {
+	export := `{
+		"id": "abc",
+		"title": "Workshop signup",
+		"fields": [
+			{"id": "f1", "ref": "email", "title": "What is your email?", "type": "email", "validations": {"required": true}},
+			{"id": "f2", "title": "Which workshops?", "type": "multiple_choice", "properties": {"allow_multiple_selection": true, "choices": [{"label": "Go"}, {"label": "Rust"}]}},
+			{"id": "f3", "title": "How likely are you to recommend us?", "type": "opinion_scale", "properties": {"steps": 10, "start_at_one": true}},
+			{"id": "f4", "title": "Welcome!", "type": "statement"},
+			{"id": "f5", "title": "Emergency contact", "type": "group", "properties": {"fields": [
+				{"id": "f6", "title": "Name", "type": "short_text", "validations": {"max_length": 50}},
+				{"id": "f7", "title": "Signature", "type": "signature"}
+			]}},
+			{"id": "f8", "title": "Pay", "type": "payment"},
+			{"id": "f9", "title": "你好", "type": "yes_no"}
+		]
+	}`
+
+	result, err := Typeform{}.Convert([]byte(export))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Workshop signup", result.Title)
+	properties := result.Schema["properties"].(map[string]interface{})
+	assert.Equal(t, "email", properties["what_is_your_email"].(map[string]interface{})["format"])
+	assert.Equal(t, "array", properties["which_workshops"].(map[string]interface{})["type"])
+	assert.Equal(t, 10, properties["how_likely_are_you_to_recommend_us"].(map[string]interface{})["maximum"])
+	assert.Equal(t, map[string]interface{}{"type": "boolean", "title": "你好"}, properties["f9"])
+	group := properties["emergency_contact"].(map[string]interface{})
+	assert.Equal(t, "object", group["type"])
+	assert.Equal(t, 50, group["properties"].(map[string]interface{})["name"].(map[string]interface{})["maxLength"])
+	assert.Equal(t, []interface{}{"name"}, result.UISchema["emergency_contact"].(map[string]interface{})["ui:order"])
+	assert.Equal(t, []interface{}{"what_is_your_email"}, result.Schema["required"])
+	assert.Equal(t, []Unsupported{
+		{ID: "f7", Title: "Signature", Type: "signature"},
+		{ID: "f8", Title: "Pay", Type: "payment"},
+	}, result.Unsupported)
+}
+
+func TestRegistry_Convert(t *testing.T) {
+	r := NewRegistry()
+
+	_, err := r.Convert("surveymonkey", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+
+	_, err = r.Convert(FormatTypeform, []byte(`not json`))
+	assert.ErrorIs(t, err, ErrInvalidExport)
+
+	_, err = r.Convert(FormatGoogleForms, []byte(`{"something": "else"}`))
+	assert.ErrorIs(t, err, ErrInvalidExport)
+
+	assert.Equal(t, []string{FormatGoogleForms, FormatTypeform}, r.Formats())
+}
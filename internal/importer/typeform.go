@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// Typeform converts the form definition returned by the Typeform Create API (GET /forms/{id}).
+// Text, email, number, phone, website, date, yes/no, legal, choice, rating, opinion scale and NPS
+// fields are converted, and question groups become object fields. Statements are skipped; other
+// field types (file uploads, payments, rankings, matrices, ...) are reported as unsupported.
+type Typeform struct{}
+
+type typeformForm struct {
+	Title  string          `json:"title"`
+	Fields []typeformField `json:"fields"`
+}
+
+type typeformField struct {
+	ID         string `json:"id"`
+	Ref        string `json:"ref"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+	Properties struct {
+		Description string `json:"description"`
+		Choices     []struct {
+			Label string `json:"label"`
+		} `json:"choices"`
+		AllowMultipleSelection bool            `json:"allow_multiple_selection"`
+		Steps                  int             `json:"steps"`
+		StartAtOne             bool            `json:"start_at_one"`
+		Fields                 []typeformField `json:"fields"`
+	} `json:"properties"`
+	Validations struct {
+		Required  bool     `json:"required"`
+		MaxLength *int     `json:"max_length"`
+		MinValue  *float64 `json:"min_value"`
+		MaxValue  *float64 `json:"max_value"`
+	} `json:"validations"`
+}
+
+// Convert implements Converter.Convert
+func (Typeform) Convert(data []byte) (*Result, error) {
+	var form typeformForm
+	if err := json.Unmarshal(data, &form); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+	}
+	if form.Fields == nil && form.Title == "" {
+		return nil, fmt.Errorf("%w: not a Typeform form definition", ErrInvalidExport)
+	}
+
+	var unsupported []Unsupported
+	b := typeformObject(form.Fields, &unsupported)
+	return b.result(form.Title, "", unsupported), nil
+}
+
+// typeformObject builds an object from fields, collecting the unsupported ones
+func typeformObject(fields []typeformField, unsupported *[]Unsupported) *objectBuilder {
+	b := newObjectBuilder()
+	for _, f := range fields {
+		if f.Type == "statement" {
+			continue
+		}
+
+		var field, fieldUI map[string]interface{}
+		if f.Type == "group" {
+			group := typeformObject(f.Properties.Fields, unsupported)
+			field, fieldUI = group.schema()
+		} else {
+			field, fieldUI = typeformSchema(f)
+		}
+		if field == nil {
+			*unsupported = append(*unsupported, Unsupported{ID: f.ID, Title: f.Title, Type: f.Type})
+			continue
+		}
+		if f.Properties.Description != "" {
+			field["description"] = f.Properties.Description
+		}
+
+		id := f.Ref
+		if id == "" {
+			id = f.ID
+		}
+		b.add(f.Title, id, field, fieldUI, f.Validations.Required)
+	}
+	return b
+}
+
+// typeformSchema returns the field of a Typeform field, or nil when its type is not supported
+func typeformSchema(f typeformField) (map[string]interface{}, map[string]interface{}) {
+	switch f.Type {
+	case "short_text", "long_text":
+		field := map[string]interface{}{"type": "string"}
+		if f.Validations.MaxLength != nil {
+			field["maxLength"] = *f.Validations.MaxLength
+		}
+		if f.Type == "long_text" {
+			return field, map[string]interface{}{schema.UIWidgetKey: "textarea"}
+		}
+		return field, nil
+	case "email":
+		return map[string]interface{}{"type": "string", "format": "email"}, nil
+	case "website":
+		return map[string]interface{}{"type": "string", "format": "uri"}, nil
+	case "phone_number":
+		return map[string]interface{}{"type": "string"}, map[string]interface{}{"ui:options": map[string]interface{}{"inputType": "tel"}}
+	case "date":
+		return map[string]interface{}{"type": "string", "format": "date"}, nil
+	case "number":
+		field := map[string]interface{}{"type": "number"}
+		if f.Validations.MinValue != nil {
+			field["minimum"] = *f.Validations.MinValue
+		}
+		if f.Validations.MaxValue != nil {
+			field["maximum"] = *f.Validations.MaxValue
+		}
+		return field, nil
+	case "yes_no", "legal":
+		return map[string]interface{}{"type": "boolean"}, nil
+	case "multiple_choice", "picture_choice", "dropdown":
+		var options []interface{}
+		for _, choice := range f.Properties.Choices {
+			options = append(options, choice.Label)
+		}
+		if len(options) == 0 {
+			return nil, nil
+		}
+		switch {
+		case f.Type == "dropdown":
+			return enumField(options, false), map[string]interface{}{schema.UIWidgetKey: "select"}
+		case f.Properties.AllowMultipleSelection:
+			return enumField(options, true), map[string]interface{}{schema.UIWidgetKey: "checkboxes"}
+		default:
+			return enumField(options, false), map[string]interface{}{schema.UIWidgetKey: "radio"}
+		}
+	case "rating":
+		steps := f.Properties.Steps
+		if steps <= 0 {
+			steps = 5
+		}
+		return map[string]interface{}{"type": "integer", "minimum": 1, "maximum": steps}, map[string]interface{}{schema.UIWidgetKey: "range"}
+	case "opinion_scale":
+		steps := f.Properties.Steps
+		if steps <= 0 {
+			steps = 11
+		}
+		low := 0
+		if f.Properties.StartAtOne {
+			low = 1
+		}
+		return map[string]interface{}{"type": "integer", "minimum": low, "maximum": low + steps - 1}, map[string]interface{}{schema.UIWidgetKey: "range"}
+	case "nps":
+		return map[string]interface{}{"type": "integer", "minimum": 0, "maximum": 10}, map[string]interface{}{schema.UIWidgetKey: "range"}
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,92 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Drainer tracks in-flight calls so shutdown can wait for them. Once draining, new calls are
+// rejected with Unavailable so clients retry on another replica.
+type Drainer struct {
+	mu       sync.Mutex
+	draining bool
+	inflight int
+	idle     chan struct{} // Closed once draining and no call is in flight
+}
+
+// NewDrainer creates a new drainer
+func NewDrainer() *Drainer {
+	return &Drainer{idle: make(chan struct{})}
+}
+
+// UnaryServerInterceptor counts in-flight calls and rejects new ones while draining
+func (d *Drainer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !d.begin() {
+			return nil, status.Error(codes.Unavailable, "server is shutting down")
+		}
+		defer d.end()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor counts running streams, such as response exports, as in-flight calls
+// and rejects new ones while draining
+func (d *Drainer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !d.begin() {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+		defer d.end()
+		return handler(srv, ss)
+	}
+}
+
+// Drain stops accepting calls and waits until the in-flight calls finished or the context expired
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.draining {
+		d.draining = true
+		if d.inflight == 0 {
+			close(d.idle)
+		}
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-d.idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// InFlight returns the number of calls being handled
+func (d *Drainer) InFlight() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.inflight
+}
+
+func (d *Drainer) begin() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return false
+	}
+	d.inflight++
+	return true
+}
+
+func (d *Drainer) end() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inflight--
+	if d.draining && d.inflight == 0 {
+		close(d.idle)
+	}
+}
@@ -0,0 +1,122 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDrainer_WaitsForInFlightCalls(t *testing.T) {
+	drainer := NewDrainer()
+	intercept := drainer.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		_, err := intercept(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return "ok", nil
+		})
+		result <- err
+	}()
+	<-started
+	assert.Equal(t, 1, drainer.InFlight())
+
+	drained := make(chan error, 1)
+	go func() { drained <- drainer.Drain(context.Background()) }()
+
+	// New calls are rejected while the running call is drained
+	require.Eventually(t, func() bool {
+		_, err := intercept(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		})
+		return status.Code(err) == codes.Unavailable
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-drained:
+		t.Fatal("drain returned before the in-flight call finished")
+	default:
+	}
+
+	close(release)
+	assert.NoError(t, <-result)
+	assert.NoError(t, <-drained)
+	assert.Equal(t, 0, drainer.InFlight())
+}
+
+func TestDrainer_Drain_Deadline(t *testing.T) {
+	drainer := NewDrainer()
+	intercept := drainer.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		_, _ = intercept(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return "ok", nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, drainer.Drain(ctx), context.DeadlineExceeded)
+}
+
+func TestDrainer_Drain_Idle(t *testing.T) {
+	drainer := NewDrainer()
+	assert.NoError(t, drainer.Drain(context.Background()))
+	// Draining twice is harmless
+	assert.NoError(t, drainer.Drain(context.Background()))
+}
+
+func TestDrainer_WaitsForStreams(t *testing.T) {
+	drainer := NewDrainer()
+	intercept := drainer.StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream", IsServerStream: true}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- intercept(nil, nil, info, func(srv interface{}, ss grpc.ServerStream) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	assert.Equal(t, 1, drainer.InFlight())
+
+	drained := make(chan error, 1)
+	go func() { drained <- drainer.Drain(context.Background()) }()
+
+	// New streams are rejected while the running one is drained
+	require.Eventually(t, func() bool {
+		err := intercept(nil, nil, info, func(srv interface{}, ss grpc.ServerStream) error { return nil })
+		return status.Code(err) == codes.Unavailable
+	}, time.Second, time.Millisecond)
+
+	select {
+	case <-drained:
+		t.Fatal("drain returned before the running stream finished")
+	default:
+	}
+
+	close(release)
+	assert.NoError(t, <-result)
+	assert.NoError(t, <-drained)
+}
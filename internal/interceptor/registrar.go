@@ -0,0 +1,103 @@
+// Package interceptor applies gRPC unary and stream interceptors to the services of this module.
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// interceptingRegistrar registers services with additional unary interceptors around their methods
+type interceptingRegistrar struct {
+	grpc.ServiceRegistrar
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// WrapRegistrar returns a registrar that runs the interceptors, in order, for every unary method of the
+// services registered through it. It is used because the gRPC server, and therefore its interceptor
+// chain, is owned by vulpes. The interceptors run after the server's own interceptors.
+func WrapRegistrar(s grpc.ServiceRegistrar, interceptors ...grpc.UnaryServerInterceptor) grpc.ServiceRegistrar {
+	if len(interceptors) == 0 {
+		return s
+	}
+	return &interceptingRegistrar{
+		ServiceRegistrar: s,
+		interceptor:      chain(interceptors),
+	}
+}
+
+// RegisterService implements grpc.ServiceRegistrar
+func (r *interceptingRegistrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    r.wrapHandler(method.Handler),
+		}
+	}
+	r.ServiceRegistrar.RegisterService(&wrapped, impl)
+}
+
+// streamRegistrar registers services with an additional stream interceptor around their streams
+type streamRegistrar struct {
+	grpc.ServiceRegistrar
+	interceptor grpc.StreamServerInterceptor
+}
+
+// WrapStreams returns a registrar that runs the interceptor for every streaming method of the
+// services registered through it, after the server's own stream interceptors. It complements
+// WrapRegistrar, which only wraps unary methods.
+func WrapStreams(s grpc.ServiceRegistrar, interceptor grpc.StreamServerInterceptor) grpc.ServiceRegistrar {
+	return &streamRegistrar{ServiceRegistrar: s, interceptor: interceptor}
+}
+
+// RegisterService implements grpc.ServiceRegistrar
+func (r *streamRegistrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	wrapped := *desc
+	wrapped.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, stream := range desc.Streams {
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + desc.ServiceName + "/" + stream.StreamName,
+			IsClientStream: stream.ClientStreams,
+			IsServerStream: stream.ServerStreams,
+		}
+		handler := stream.Handler
+		wrapped.Streams[i] = stream
+		wrapped.Streams[i].Handler = func(srv interface{}, ss grpc.ServerStream) error {
+			return r.interceptor(srv, ss, info, handler)
+		}
+	}
+	r.ServiceRegistrar.RegisterService(&wrapped, impl)
+}
+
+// chain combines interceptors into one that runs them in order
+func chain(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if len(interceptors) == 1 {
+		return interceptors[0]
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (r *interceptingRegistrar) wrapHandler(handler grpc.MethodHandler) grpc.MethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, serverInterceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		if serverInterceptor == nil {
+			return handler(srv, ctx, dec, r.interceptor)
+		}
+		chained := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+			return serverInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return r.interceptor(ctx, req, info, next)
+			})
+		}
+		return handler(srv, ctx, dec, chained)
+	}
+}
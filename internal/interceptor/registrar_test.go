@@ -0,0 +1,93 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func recordingInterceptor(calls *[]string, name string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		*calls = append(*calls, name)
+		return handler(ctx, req)
+	}
+}
+
+type recordingRegistrar struct {
+	desc *grpc.ServiceDesc
+}
+
+func (r *recordingRegistrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	r.desc = desc
+}
+
+func TestWrapRegistrar(t *testing.T) {
+	var calls []string
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Method",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+				return interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+					calls = append(calls, "handler")
+					return "ok", nil
+				})
+			},
+		}},
+	}
+	recorder := &recordingRegistrar{}
+	registrar := WrapRegistrar(recorder, recordingInterceptor(&calls, "first"), recordingInterceptor(&calls, "second"))
+	serverInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "server")
+		return handler(ctx, req)
+	}
+
+	registrar.RegisterService(desc, nil)
+	require.NotNil(t, recorder.desc)
+
+	resp, err := recorder.desc.Methods[0].Handler(nil, context.Background(), nil, serverInterceptor)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.Equal(t, []string{"server", "first", "second", "handler"}, calls)
+
+	calls = nil
+	_, err = recorder.desc.Methods[0].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second", "handler"}, calls)
+}
+
+func TestWrapStreams(t *testing.T) {
+	var calls []string
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Service",
+		Methods:     []grpc.MethodDesc{{MethodName: "Method"}},
+		Streams: []grpc.StreamDesc{{
+			StreamName:    "Stream",
+			ServerStreams: true,
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				calls = append(calls, "handler")
+				return nil
+			},
+		}},
+	}
+	recorder := &recordingRegistrar{}
+	var info *grpc.StreamServerInfo
+	registrar := WrapStreams(recorder, func(srv interface{}, ss grpc.ServerStream, i *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		calls = append(calls, "interceptor")
+		info = i
+		return handler(srv, ss)
+	})
+
+	registrar.RegisterService(desc, nil)
+	require.NotNil(t, recorder.desc)
+	assert.Equal(t, "Method", recorder.desc.Methods[0].MethodName)
+
+	require.NoError(t, recorder.desc.Streams[0].Handler(nil, nil))
+	assert.Equal(t, []string{"interceptor", "handler"}, calls)
+	assert.Equal(t, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream", IsServerStream: true}, info)
+	assert.True(t, recorder.desc.Streams[0].ServerStreams)
+}
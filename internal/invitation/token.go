@@ -0,0 +1,51 @@
+package invitation
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/signedtoken"
+)
+
+// Claims identifies the invitation a token was issued for
+type Claims struct {
+	InvitationID primitive.ObjectID
+	FormID       primitive.ObjectID
+	ExpiresAt    time.Time
+}
+
+// Sign issues a signed token for the given claims
+func Sign(secret []byte, claims Claims) string {
+	return signedtoken.Sign(secret, claims.InvitationID.Hex(), claims.FormID.Hex(), signedtoken.FormatTime(claims.ExpiresAt))
+}
+
+// Parse verifies the signature and expiry of a token at the given time and returns its claims.
+// Errors are those of the signedtoken package.
+func Parse(secret []byte, token string, now time.Time) (*Claims, error) {
+	fields, err := signedtoken.Verify(secret, token, 3)
+	if err != nil {
+		return nil, err
+	}
+	invitationID, err := signedtoken.ParseObjectID(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	formID, err := signedtoken.ParseObjectID(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := signedtoken.ParseTime(fields[2])
+	if err != nil {
+		return nil, err
+	}
+	if err := signedtoken.CheckExpiry(expiresAt, now); err != nil {
+		return nil, err
+	}
+
+	return &Claims{
+		InvitationID: invitationID,
+		FormID:       formID,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package invitation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/signedtoken"
+)
+
+func TestSignAndParse(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	claims := Claims{
+		InvitationID: primitive.NewObjectID(),
+		FormID:       primitive.NewObjectID(),
+		ExpiresAt:    now.Add(time.Hour).Truncate(time.Second),
+	}
+
+	parsed, err := Parse(secret, Sign(secret, claims), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, claims.InvitationID, parsed.InvitationID)
+	assert.Equal(t, claims.FormID, parsed.FormID)
+	assert.True(t, claims.ExpiresAt.Equal(parsed.ExpiresAt))
+}
+
+func TestParse_Errors(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	claims := Claims{
+		InvitationID: primitive.NewObjectID(),
+		FormID:       primitive.NewObjectID(),
+		ExpiresAt:    now.Add(time.Hour),
+	}
+	token := Sign(secret, claims)
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		token    string
+		now      time.Time
+		expected error
+	}{
+		{"Wrong secret", []byte("other"), token, now, signedtoken.ErrInvalidSignature},
+		{"Missing signature", secret, "abc", now, signedtoken.ErrMalformedToken},
+		{"Bad invitation ID", secret, signedtoken.Sign(secret, "x", claims.FormID.Hex(), "1"), now, signedtoken.ErrMalformedToken},
+		{"Bad expiry", secret, signedtoken.Sign(secret, claims.InvitationID.Hex(), claims.FormID.Hex(), "x"), now, signedtoken.ErrMalformedToken},
+		{"Expired", secret, token, now.Add(2 * time.Hour), signedtoken.ErrTokenExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.secret, tt.token, tt.now)
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
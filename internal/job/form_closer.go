@@ -0,0 +1,63 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+)
+
+// DefaultFormCloseInterval is used when no interval is configured
+const DefaultFormCloseInterval = time.Minute
+
+// FormCloser periodically closes published forms whose close_at time has passed
+type FormCloser struct {
+	formRepo repository.FormRepository
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewFormCloser creates a new form closer job
+func NewFormCloser(formRepo repository.FormRepository, interval time.Duration) *FormCloser {
+	if interval <= 0 {
+		interval = DefaultFormCloseInterval
+	}
+	return &FormCloser{
+		formRepo: formRepo,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run closes expired forms on every tick until the context is cancelled
+func (j *FormCloser) Run(ctx context.Context) {
+	log.Info("Form closer started", log.String("interval", j.interval.String()))
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info("Form closer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce closes the forms that expired up to now
+func (j *FormCloser) RunOnce(ctx context.Context) {
+	closed, err := j.formRepo.CloseExpired(ctx, j.now())
+	if err != nil {
+		log.Error("Failed to close expired forms", log.Err(err))
+		return
+	}
+	if closed > 0 {
+		log.Info("Closed expired forms", log.Int64("count", closed))
+	}
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+)
+
+// Mock FormRepository; only CloseExpired is used by the job
+type MockFormRepository struct {
+	repository.FormRepository
+	mock.Mock
+}
+
+func (m *MockFormRepository) CloseExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestFormCloser_RunOnce(t *testing.T) {
+	mockFormRepo := &MockFormRepository{}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	closer := NewFormCloser(mockFormRepo, time.Minute)
+	closer.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	mockFormRepo.On("CloseExpired", ctx, now).Return(int64(2), nil).Once()
+	mockFormRepo.On("CloseExpired", ctx, now).Return(int64(0), errors.New("database error")).Once()
+
+	closer.RunOnce(ctx)
+	closer.RunOnce(ctx)
+
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormCloser_Run_StopsOnCancel(t *testing.T) {
+	mockFormRepo := &MockFormRepository{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockFormRepo.On("CloseExpired", ctx, mock.AnythingOfType("time.Time")).Return(int64(0), nil)
+
+	done := make(chan struct{})
+	go func() {
+		NewFormCloser(mockFormRepo, 0).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("form closer did not stop after context cancellation")
+	}
+}
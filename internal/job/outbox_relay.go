@@ -0,0 +1,169 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/bus"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Outbox relay defaults, used when the options leave a value unset
+const (
+	DefaultOutboxPollInterval    = time.Second
+	DefaultOutboxLeaseTimeout    = time.Minute
+	DefaultOutboxRetryBackoff    = 5 * time.Second
+	DefaultOutboxMaxRetryBackoff = 5 * time.Minute
+	DefaultOutboxRetention       = 24 * time.Hour
+)
+
+// OutboxRelayOptions configures an OutboxRelay; zero values use the defaults
+type OutboxRelayOptions struct {
+	PollInterval    time.Duration // Wait between polls when no event is due
+	LeaseTimeout    time.Duration // An event still being published after it is handed to another relay
+	RetryBackoff    time.Duration // Delay before the first retry, doubled for each further attempt
+	MaxRetryBackoff time.Duration // Cap on the retry delay
+	Retention       time.Duration // How long published events are kept
+}
+
+// OutboxRelay publishes the domain events stored in the outbox to the message bus. Events are
+// retried until the bus accepts them, so delivery is at least once; subscribers deduplicate by
+// envelope ID.
+type OutboxRelay struct {
+	outboxRepo repository.OutboxRepository
+	publisher  bus.Publisher
+	opts       OutboxRelayOptions
+	workerID   string
+	now        func() time.Time
+}
+
+// NewOutboxRelay creates a new outbox relay
+func NewOutboxRelay(outboxRepo repository.OutboxRepository, publisher bus.Publisher, opts OutboxRelayOptions) *OutboxRelay {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultOutboxPollInterval
+	}
+	if opts.LeaseTimeout <= 0 {
+		opts.LeaseTimeout = DefaultOutboxLeaseTimeout
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = DefaultOutboxRetryBackoff
+	}
+	if opts.MaxRetryBackoff <= 0 {
+		opts.MaxRetryBackoff = DefaultOutboxMaxRetryBackoff
+	}
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultOutboxRetention
+	}
+
+	hostname, _ := os.Hostname()
+	return &OutboxRelay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		opts:       opts,
+		workerID:   fmt.Sprintf("%s/%s", hostname, primitive.NewObjectID().Hex()),
+		now:        time.Now,
+	}
+}
+
+// Run publishes due events until the context is cancelled
+func (r *OutboxRelay) Run(ctx context.Context) {
+	log.Info("Outbox relay started", log.String("worker_id", r.workerID))
+
+	for {
+		if r.RunOnce(ctx) {
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("Outbox relay stopped")
+			return
+		case <-time.After(r.opts.PollInterval):
+		}
+	}
+	log.Info("Outbox relay stopped")
+}
+
+// Flush publishes the due events until none is left, a publish fails or the context expires,
+// and returns the number of events published. It is used on shutdown after Run returned.
+func (r *OutboxRelay) Flush(ctx context.Context) int {
+	published := 0
+	for ctx.Err() == nil && r.RunOnce(ctx) {
+		published++
+	}
+	return published
+}
+
+// Close closes the publisher; call it once Run and Flush returned
+func (r *OutboxRelay) Close() error {
+	return r.publisher.Close()
+}
+
+// RunOnce leases and publishes a single due event, reporting whether it was published. A failed
+// publish reports false, so the relay waits for the poll interval while the bus is unavailable.
+func (r *OutboxRelay) RunOnce(ctx context.Context) bool {
+	event, err := r.outboxRepo.Lease(ctx, r.workerID, r.now(), r.opts.LeaseTimeout)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error("Failed to lease outbox event", log.Err(err))
+		}
+		return false
+	}
+	if event == nil {
+		return false
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, r.opts.LeaseTimeout)
+	err = r.publisher.Publish(publishCtx, envelopeFromOutbox(event))
+	cancel()
+
+	// Record the outcome even when shutting down, so the event is not published again
+	recordCtx := context.WithoutCancel(ctx)
+	now := r.now()
+
+	if err == nil {
+		metrics.EventsPublished.WithLabelValues(event.Type).Inc()
+		if err := r.outboxRepo.MarkPublished(recordCtx, event.ID, r.workerID, now, now.Add(r.opts.Retention)); err != nil {
+			log.Error("Failed to mark outbox event as published", log.Err(err), log.String("event_id", event.EventID))
+		}
+		return true
+	}
+
+	metrics.EventPublishFailures.WithLabelValues(event.Type).Inc()
+	retryAt := now.Add(backoff(r.opts.RetryBackoff, r.opts.MaxRetryBackoff, event.Attempts))
+	log.Warn("Failed to publish outbox event - retrying",
+		log.Err(err),
+		log.String("event_id", event.EventID),
+		log.String("type", event.Type),
+		log.Int("attempts", event.Attempts),
+		log.String("retry_at", retryAt.Format(time.RFC3339)))
+	if err := r.outboxRepo.Retry(recordCtx, event.ID, r.workerID, err.Error(), retryAt); err != nil {
+		log.Error("Failed to schedule outbox event retry", log.Err(err), log.String("event_id", event.EventID))
+	}
+	return false
+}
+
+// envelopeFromOutbox rebuilds the envelope stored with an outbox event
+func envelopeFromOutbox(event *models.OutboxEvent) *bus.Envelope {
+	return &bus.Envelope{
+		ID:              event.EventID,
+		Type:            event.Type,
+		EnvelopeVersion: bus.EnvelopeVersion,
+		SchemaVersion:   event.SchemaVersion,
+		Source:          bus.Source,
+		MerchantID:      event.MerchantID,
+		OccurredAt:      event.OccurredAt,
+		Data:            json.RawMessage(event.Data),
+	}
+}
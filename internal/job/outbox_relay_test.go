@@ -0,0 +1,130 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/bus"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock OutboxRepository
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Add(ctx context.Context, event *models.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) Lease(ctx context.Context, workerID string, now time.Time, visibility time.Duration) (*models.OutboxEvent, error) {
+	args := m.Called(ctx, workerID, now, visibility)
+	return args.Get(0).(*models.OutboxEvent), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, eventID primitive.ObjectID, workerID string, publishedAt, expiresAt time.Time) error {
+	args := m.Called(ctx, eventID, workerID, publishedAt, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) Retry(ctx context.Context, eventID primitive.ObjectID, workerID, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, eventID, workerID, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+// fakePublisher records published envelopes, failing with err when set
+type fakePublisher struct {
+	published []*bus.Envelope
+	err       error
+}
+
+func (p *fakePublisher) Publish(_ context.Context, envelope *bus.Envelope) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, envelope)
+	return nil
+}
+
+func (p *fakePublisher) Close() error {
+	return nil
+}
+
+func setupOutboxRelay() (*OutboxRelay, *MockOutboxRepository, *fakePublisher, time.Time) {
+	mockOutboxRepo := &MockOutboxRepository{}
+	publisher := &fakePublisher{}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	relay := NewOutboxRelay(mockOutboxRepo, publisher, OutboxRelayOptions{
+		LeaseTimeout:    time.Minute,
+		RetryBackoff:    5 * time.Second,
+		MaxRetryBackoff: time.Minute,
+		Retention:       time.Hour,
+	})
+	relay.now = func() time.Time { return now }
+	return relay, mockOutboxRepo, publisher, now
+}
+
+func leasedOutboxEvent(attempts int) *models.OutboxEvent {
+	return &models.OutboxEvent{
+		ID:            primitive.NewObjectID(),
+		EventID:       "evt-1",
+		Type:          bus.TypeFormUpdated,
+		SchemaVersion: 1,
+		MerchantID:    "merchant123",
+		OccurredAt:    time.Date(2024, 5, 1, 11, 59, 0, 0, time.UTC),
+		Data:          `{"form_id":"f1"}`,
+		Status:        models.OutboxStatusPublishing,
+		Attempts:      attempts,
+	}
+}
+
+func TestOutboxRelay_RunOnce_Published(t *testing.T) {
+	relay, mockOutboxRepo, publisher, now := setupOutboxRelay()
+	ctx := context.Background()
+	event := leasedOutboxEvent(1)
+
+	mockOutboxRepo.On("Lease", ctx, relay.workerID, now, time.Minute).Return(event, nil)
+	mockOutboxRepo.On("MarkPublished", mock.Anything, event.ID, relay.workerID, now, now.Add(time.Hour)).Return(nil)
+
+	assert.True(t, relay.RunOnce(ctx))
+	if assert.Len(t, publisher.published, 1) {
+		envelope := publisher.published[0]
+		assert.Equal(t, "evt-1", envelope.ID)
+		assert.Equal(t, bus.TypeFormUpdated, envelope.Type)
+		assert.Equal(t, "merchant123", envelope.MerchantID)
+		assert.JSONEq(t, `{"form_id":"f1"}`, string(envelope.Data))
+	}
+	mockOutboxRepo.AssertExpectations(t)
+}
+
+func TestOutboxRelay_RunOnce_PublishFailureRetries(t *testing.T) {
+	relay, mockOutboxRepo, publisher, now := setupOutboxRelay()
+	publisher.err = errors.New("bus unavailable")
+	ctx := context.Background()
+	event := leasedOutboxEvent(3)
+
+	mockOutboxRepo.On("Lease", ctx, relay.workerID, now, time.Minute).Return(event, nil)
+	// Third attempt: 5s doubled twice
+	mockOutboxRepo.On("Retry", mock.Anything, event.ID, relay.workerID, "bus unavailable", now.Add(20*time.Second)).Return(nil)
+
+	assert.False(t, relay.RunOnce(ctx))
+	mockOutboxRepo.AssertExpectations(t)
+}
+
+func TestOutboxRelay_RunOnce_NothingDue(t *testing.T) {
+	relay, mockOutboxRepo, publisher, now := setupOutboxRelay()
+	ctx := context.Background()
+
+	mockOutboxRepo.On("Lease", ctx, relay.workerID, now, time.Minute).Return((*models.OutboxEvent)(nil), nil)
+
+	assert.False(t, relay.RunOnce(ctx))
+	assert.Empty(t, publisher.published)
+	mockOutboxRepo.AssertExpectations(t)
+}
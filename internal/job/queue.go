@@ -0,0 +1,272 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Queue defaults, used when the options leave a value unset
+const (
+	DefaultWorkers           = 2
+	DefaultPollInterval      = 5 * time.Second
+	DefaultVisibilityTimeout = 5 * time.Minute
+	DefaultMaxAttempts       = 5
+	DefaultRetryBackoff      = 10 * time.Second
+	DefaultMaxRetryBackoff   = time.Hour
+	DefaultRetention         = 7 * 24 * time.Hour
+)
+
+// Handler processes the payload of a job. Returning an error retries the job with backoff,
+// unless the error is wrapped with Permanent.
+type Handler func(ctx context.Context, job *models.Job) error
+
+// permanentError marks a job failure that retrying cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the job fails without further attempts
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// QueueOptions configures a Queue; zero values use the defaults
+type QueueOptions struct {
+	Workers           int           // Jobs processed concurrently
+	PollInterval      time.Duration // Wait between polls when no job is due
+	VisibilityTimeout time.Duration // Lease duration; a job still running after it is handed to another worker
+	MaxAttempts       int           // Attempts of jobs enqueued without their own limit
+	RetryBackoff      time.Duration // Delay before the first retry, doubled for each further attempt
+	MaxRetryBackoff   time.Duration // Cap on the retry delay
+	Retention         time.Duration // How long finished jobs are kept
+}
+
+// Queue runs jobs stored in MongoDB on a pool of workers. Jobs are leased for the visibility
+// timeout, so a job whose worker died is picked up again, possibly by another replica.
+type Queue struct {
+	jobRepo  repository.JobRepository
+	opts     QueueOptions
+	workerID string
+	now      func() time.Time
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewQueue creates a new job queue
+func NewQueue(jobRepo repository.JobRepository, opts QueueOptions) *Queue {
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultWorkers
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.VisibilityTimeout <= 0 {
+		opts.VisibilityTimeout = DefaultVisibilityTimeout
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = DefaultRetryBackoff
+	}
+	if opts.MaxRetryBackoff <= 0 {
+		opts.MaxRetryBackoff = DefaultMaxRetryBackoff
+	}
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultRetention
+	}
+
+	hostname, _ := os.Hostname()
+	return &Queue{
+		jobRepo:  jobRepo,
+		opts:     opts,
+		workerID: fmt.Sprintf("%s/%s", hostname, primitive.NewObjectID().Hex()),
+		now:      time.Now,
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register sets the handler of a job type. Handlers must be registered before Run.
+func (q *Queue) Register(jobType string, handler Handler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[jobType] = handler
+}
+
+// Enqueue stores a job of the given type to run at runAt, or as soon as possible when runAt is zero
+func (q *Queue) Enqueue(ctx context.Context, jobType string, payload map[string]interface{}, runAt time.Time) (*models.Job, error) {
+	job := &models.Job{
+		Type:        jobType,
+		Payload:     payload,
+		MaxAttempts: q.opts.MaxAttempts,
+		RunAt:       runAt,
+	}
+	if err := q.jobRepo.Enqueue(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Run processes jobs on the worker pool until the context is cancelled
+func (q *Queue) Run(ctx context.Context) {
+	types := q.types()
+	if len(types) == 0 {
+		log.Info("Job queue not started - no job handlers registered")
+		return
+	}
+
+	log.Info("Job queue started",
+		log.Int("workers", q.opts.Workers),
+		log.String("worker_id", q.workerID))
+
+	var wg sync.WaitGroup
+	for i := 0; i < q.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx, types)
+		}()
+	}
+	wg.Wait()
+
+	log.Info("Job queue stopped")
+}
+
+// work leases and processes jobs, waiting for the poll interval whenever no job is due
+func (q *Queue) work(ctx context.Context, types []string) {
+	for {
+		processed := q.RunOnce(ctx, types)
+		if processed {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(q.opts.PollInterval):
+		}
+	}
+}
+
+// RunOnce leases and processes a single due job, reporting whether there was one
+func (q *Queue) RunOnce(ctx context.Context, types []string) bool {
+	job, err := q.jobRepo.Lease(ctx, types, q.workerID, q.now(), q.opts.VisibilityTimeout)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error("Failed to lease job", log.Err(err))
+		}
+		return false
+	}
+	if job == nil {
+		return false
+	}
+
+	q.process(ctx, job)
+	return true
+}
+
+// process runs the handler of a leased job and records the outcome
+func (q *Queue) process(ctx context.Context, job *models.Job) {
+	q.mu.RLock()
+	handler := q.handlers[job.Type]
+	q.mu.RUnlock()
+
+	var err error
+	if handler == nil {
+		err = Permanent(fmt.Errorf("no handler for job type %q", job.Type))
+	} else {
+		// The handler must finish before its lease expires and the job is handed out again
+		handlerCtx, cancel := context.WithTimeout(ctx, q.opts.VisibilityTimeout)
+		err = handler(handlerCtx, job)
+		cancel()
+	}
+
+	// Record the outcome even when shutting down, so the job is not leased again
+	recordCtx := context.WithoutCancel(ctx)
+	now := q.now()
+
+	if err == nil {
+		if err := q.jobRepo.Complete(recordCtx, job.ID, q.workerID, now.Add(q.opts.Retention)); err != nil {
+			log.Error("Failed to complete job", log.Err(err), log.String("job_id", job.ID.Hex()))
+		}
+		return
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) || job.Attempts >= q.maxAttempts(job) {
+		log.Error("Job failed",
+			log.Err(err),
+			log.String("job_id", job.ID.Hex()),
+			log.String("type", job.Type),
+			log.Int("attempts", job.Attempts))
+		if err := q.jobRepo.Fail(recordCtx, job.ID, q.workerID, err.Error(), now.Add(q.opts.Retention)); err != nil {
+			log.Error("Failed to mark job as failed", log.Err(err), log.String("job_id", job.ID.Hex()))
+		}
+		return
+	}
+
+	retryAt := now.Add(q.backoff(job.Attempts))
+	log.Warn("Job failed - retrying",
+		log.Err(err),
+		log.String("job_id", job.ID.Hex()),
+		log.String("type", job.Type),
+		log.Int("attempts", job.Attempts),
+		log.String("retry_at", retryAt.Format(time.RFC3339)))
+	if err := q.jobRepo.Retry(recordCtx, job.ID, q.workerID, err.Error(), retryAt); err != nil {
+		log.Error("Failed to schedule job retry", log.Err(err), log.String("job_id", job.ID.Hex()))
+	}
+}
+
+// backoff returns the delay before retrying after the given number of attempts
+func (q *Queue) backoff(attempts int) time.Duration {
+	return backoff(q.opts.RetryBackoff, q.opts.MaxRetryBackoff, attempts)
+}
+
+// maxAttempts returns the attempt limit of a job
+func (q *Queue) maxAttempts(job *models.Job) int {
+	if job.MaxAttempts > 0 {
+		return job.MaxAttempts
+	}
+	return q.opts.MaxAttempts
+}
+
+// types returns the registered job types
+func (q *Queue) types() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	types := make([]string, 0, len(q.handlers))
+	for jobType := range q.handlers {
+		types = append(types, jobType)
+	}
+	return types
+}
+
+// backoff doubles the base delay for every attempt after the first, up to maxDelay
+func backoff(base, maxDelay time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
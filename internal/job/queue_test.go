@@ -0,0 +1,165 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock JobRepository
+type MockJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockJobRepository) Enqueue(ctx context.Context, job *models.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Lease(ctx context.Context, types []string, workerID string, now time.Time, visibility time.Duration) (*models.Job, error) {
+	args := m.Called(ctx, types, workerID, now, visibility)
+	return args.Get(0).(*models.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) Complete(ctx context.Context, jobID primitive.ObjectID, workerID string, expiresAt time.Time) error {
+	args := m.Called(ctx, jobID, workerID, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Retry(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, runAt time.Time) error {
+	args := m.Called(ctx, jobID, workerID, lastError, runAt)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Fail(ctx context.Context, jobID primitive.ObjectID, workerID, lastError string, expiresAt time.Time) error {
+	args := m.Called(ctx, jobID, workerID, lastError, expiresAt)
+	return args.Error(0)
+}
+
+func setupQueue() (*Queue, *MockJobRepository, time.Time) {
+	mockJobRepo := &MockJobRepository{}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	queue := NewQueue(mockJobRepo, QueueOptions{
+		VisibilityTimeout: time.Minute,
+		MaxAttempts:       3,
+		RetryBackoff:      10 * time.Second,
+		MaxRetryBackoff:   30 * time.Second,
+		Retention:         time.Hour,
+	})
+	queue.now = func() time.Time { return now }
+	return queue, mockJobRepo, now
+}
+
+func leasedJob(jobType string, attempts int) *models.Job {
+	return &models.Job{
+		ID:          primitive.NewObjectID(),
+		Type:        jobType,
+		Status:      models.JobStatusRunning,
+		Attempts:    attempts,
+		MaxAttempts: 3,
+	}
+}
+
+func TestQueue_RunOnce_Success(t *testing.T) {
+	queue, mockJobRepo, now := setupQueue()
+	ctx := context.Background()
+	job := leasedJob("send", 1)
+
+	var handled *models.Job
+	queue.Register("send", func(ctx context.Context, job *models.Job) error {
+		handled = job
+		return nil
+	})
+
+	mockJobRepo.On("Lease", ctx, []string{"send"}, queue.workerID, now, time.Minute).Return(job, nil)
+	mockJobRepo.On("Complete", mock.Anything, job.ID, queue.workerID, now.Add(time.Hour)).Return(nil)
+
+	assert.True(t, queue.RunOnce(ctx, queue.types()))
+	assert.Equal(t, job, handled)
+	mockJobRepo.AssertExpectations(t)
+}
+
+func TestQueue_RunOnce_NoJob(t *testing.T) {
+	queue, mockJobRepo, now := setupQueue()
+	ctx := context.Background()
+
+	mockJobRepo.On("Lease", ctx, []string{"send"}, queue.workerID, now, time.Minute).Return((*models.Job)(nil), nil)
+
+	assert.False(t, queue.RunOnce(ctx, []string{"send"}))
+	mockJobRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestQueue_RunOnce_Failures(t *testing.T) {
+	tests := map[string]struct {
+		attempts int
+		err      error
+		retry    bool
+	}{
+		"retried":          {attempts: 1, err: errors.New("timeout"), retry: true},
+		"attempts used up": {attempts: 3, err: errors.New("timeout")},
+		"permanent":        {attempts: 1, err: Permanent(errors.New("bad payload"))},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			queue, mockJobRepo, now := setupQueue()
+			ctx := context.Background()
+			job := leasedJob("send", tt.attempts)
+			queue.Register("send", func(context.Context, *models.Job) error { return tt.err })
+
+			mockJobRepo.On("Lease", ctx, []string{"send"}, queue.workerID, now, time.Minute).Return(job, nil)
+			if tt.retry {
+				mockJobRepo.On("Retry", mock.Anything, job.ID, queue.workerID, tt.err.Error(), now.Add(10*time.Second)).Return(nil)
+			} else {
+				mockJobRepo.On("Fail", mock.Anything, job.ID, queue.workerID, tt.err.Error(), now.Add(time.Hour)).Return(nil)
+			}
+
+			assert.True(t, queue.RunOnce(ctx, []string{"send"}))
+			mockJobRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQueue_RunOnce_UnknownType(t *testing.T) {
+	queue, mockJobRepo, now := setupQueue()
+	ctx := context.Background()
+	job := leasedJob("unknown", 1)
+
+	mockJobRepo.On("Lease", ctx, []string{"unknown"}, queue.workerID, now, time.Minute).Return(job, nil)
+	mockJobRepo.On("Fail", mock.Anything, job.ID, queue.workerID, `no handler for job type "unknown"`, now.Add(time.Hour)).Return(nil)
+
+	assert.True(t, queue.RunOnce(ctx, []string{"unknown"}))
+	mockJobRepo.AssertExpectations(t)
+}
+
+func TestQueue_Backoff(t *testing.T) {
+	queue, _, _ := setupQueue()
+
+	assert.Equal(t, 10*time.Second, queue.backoff(1))
+	assert.Equal(t, 20*time.Second, queue.backoff(2))
+	assert.Equal(t, 30*time.Second, queue.backoff(3))
+	assert.Equal(t, 30*time.Second, queue.backoff(50))
+}
+
+func TestQueue_Enqueue(t *testing.T) {
+	queue, mockJobRepo, _ := setupQueue()
+	ctx := context.Background()
+	runAt := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	mockJobRepo.On("Enqueue", ctx, mock.MatchedBy(func(job *models.Job) bool {
+		return job.Type == "send" && job.MaxAttempts == 3 && job.RunAt.Equal(runAt) && job.Payload["to"] == "a@b.c"
+	})).Return(nil)
+
+	job, err := queue.Enqueue(ctx, "send", map[string]interface{}{"to": "a@b.c"}, runAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "send", job.Type)
+	mockJobRepo.AssertExpectations(t)
+}
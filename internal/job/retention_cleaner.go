@@ -0,0 +1,149 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/storage"
+)
+
+// Retention cleaner defaults, used when no value is configured
+const (
+	DefaultRetentionInterval  = time.Hour
+	DefaultRetentionBatchSize = 500
+)
+
+// RetentionCleaner periodically deletes or anonymizes the responses of forms with a retention
+// period once they are older than it. It is idempotent, so every instance may run it.
+type RetentionCleaner struct {
+	retentionRepo repository.RetentionRepository
+	storage       storage.Storage // nil when file uploads are not configured
+	interval      time.Duration
+	batchSize     int
+	now           func() time.Time
+}
+
+// NewRetentionCleaner creates a new retention cleaner job
+func NewRetentionCleaner(retentionRepo repository.RetentionRepository, store storage.Storage, interval time.Duration, batchSize int) *RetentionCleaner {
+	if interval <= 0 {
+		interval = DefaultRetentionInterval
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultRetentionBatchSize
+	}
+	return &RetentionCleaner{
+		retentionRepo: retentionRepo,
+		storage:       store,
+		interval:      interval,
+		batchSize:     batchSize,
+		now:           time.Now,
+	}
+}
+
+// Run cleans up expired responses on every tick until the context is cancelled
+func (j *RetentionCleaner) Run(ctx context.Context) {
+	log.Info("Retention cleaner started", log.String("interval", j.interval.String()))
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.RunOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info("Retention cleaner stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce cleans up the responses that expired up to now. A failing form is logged and retried
+// on the next run without holding up the others.
+func (j *RetentionCleaner) RunOnce(ctx context.Context) {
+	forms, err := j.retentionRepo.FindForms(ctx)
+	if err != nil {
+		log.Error("Failed to find forms with a retention period", log.Err(err))
+		return
+	}
+
+	now := j.now()
+	for _, form := range forms {
+		if ctx.Err() != nil {
+			return
+		}
+		cutoff, ok := form.RetentionCutoff(now)
+		if !ok {
+			continue
+		}
+
+		action := form.CurrentRetentionAction()
+		var count int64
+		if action == models.RetentionActionAnonymize {
+			count, err = j.retentionRepo.AnonymizeSubmissions(ctx, form.ID, cutoff, now)
+		} else {
+			count, err = j.deleteExpired(ctx, form.ID, cutoff)
+		}
+		if count > 0 {
+			metrics.RetentionResponses.WithLabelValues(string(action)).Add(float64(count))
+			log.Info("Expired responses cleaned up",
+				log.String("form_id", form.ID.Hex()),
+				log.String("merchant_id", form.MerchantID),
+				log.String("action", string(action)),
+				log.Int64("count", count))
+		}
+		if err != nil {
+			log.Error("Failed to clean up expired responses", log.Err(err),
+				log.String("form_id", form.ID.Hex()),
+				log.String("action", string(action)))
+		}
+	}
+}
+
+// deleteExpired deletes the expired responses of a form in batches, together with their files
+func (j *RetentionCleaner) deleteExpired(ctx context.Context, formID primitive.ObjectID, cutoff time.Time) (int64, error) {
+	var deleted int64
+	for ctx.Err() == nil {
+		ids, err := j.retentionRepo.ExpiredSubmissions(ctx, formID, cutoff, j.batchSize)
+		if err != nil || len(ids) == 0 {
+			return deleted, err
+		}
+
+		// Files go first: if they cannot be deleted, the responses still refer to them for the next run
+		if err := j.deleteFiles(ctx, ids); err != nil {
+			return deleted, err
+		}
+		count, err := j.retentionRepo.DeleteSubmissions(ctx, formID, ids)
+		deleted += count
+		if err != nil || len(ids) < j.batchSize {
+			return deleted, err
+		}
+	}
+	return deleted, ctx.Err()
+}
+
+// deleteFiles deletes the uploaded files of responses from storage and their references
+func (j *RetentionCleaner) deleteFiles(ctx context.Context, submissionIDs []primitive.ObjectID) error {
+	files, err := j.retentionRepo.FindFiles(ctx, submissionIDs)
+	if err != nil || len(files) == 0 {
+		return err
+	}
+
+	fileIDs := make([]primitive.ObjectID, len(files))
+	for i, file := range files {
+		if j.storage != nil && file.Key != "" {
+			if err := j.storage.Delete(ctx, file.Key); err != nil {
+				return err
+			}
+		}
+		fileIDs[i] = file.ID
+	}
+	return j.retentionRepo.DeleteFiles(ctx, fileIDs)
+}
@@ -0,0 +1,122 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/memory"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/storage"
+)
+
+// memoryStorage is a storage.Storage recording the keys of its objects
+type memoryStorage struct {
+	storage.Storage
+	keys map[string]bool
+}
+
+func (s *memoryStorage) Delete(_ context.Context, key string) error {
+	delete(s.keys, key)
+	return nil
+}
+
+// retentionFixture holds a RetentionCleaner on an in-memory database
+type retentionFixture struct {
+	cleaner     *RetentionCleaner
+	forms       repository.FormRepository
+	submissions repository.FormSubmissionRepository
+	files       repository.FormFileRepository
+	storage     *memoryStorage
+	now         time.Time
+}
+
+func setupRetentionCleaner(t *testing.T) *retentionFixture {
+	store := memory.NewStore()
+	f := &retentionFixture{
+		forms:       memory.NewFormRepository(store),
+		submissions: memory.NewFormSubmissionRepository(store),
+		files:       memory.NewFormFileRepository(store),
+		storage:     &memoryStorage{keys: map[string]bool{}},
+		now:         time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+	f.cleaner = NewRetentionCleaner(memory.NewRetentionRepository(store), f.storage, time.Minute, 2)
+	f.cleaner.now = func() time.Time { return f.now }
+	return f
+}
+
+// createForm creates a form with a submission for each age in days
+func (f *retentionFixture) createForm(t *testing.T, form *models.Form, ages ...int) {
+	ctx := context.Background()
+	form.MerchantID = "merchant123"
+	require.NoError(t, f.forms.Create(ctx, form))
+	for _, age := range ages {
+		submission := &models.FormSubmission{
+			ID:               primitive.NewObjectID(),
+			FormID:           form.ID,
+			MerchantID:       "merchant123",
+			Answers:          map[string]interface{}{"rating": 5},
+			SubmittedBy:      "user123",
+			CreatedBy:        "user123",
+			EncryptedAnswers: map[string][]byte{"email": []byte("sealed")},
+		}
+		submission.SetSubmittedAt(f.now.AddDate(0, 0, -age))
+		require.NoError(t, f.submissions.Create(ctx, submission))
+
+		key := "merchant123/" + submission.ID.Hex()
+		f.storage.keys[key] = true
+		require.NoError(t, f.files.Create(ctx, &models.FileReference{
+			FormID: form.ID, MerchantID: "merchant123", Key: key, SubmissionID: &submission.ID,
+		}))
+	}
+}
+
+func (f *retentionFixture) submissionsOf(t *testing.T, formID primitive.ObjectID) []*models.FormSubmission {
+	submissions, _, err := f.submissions.Find(context.Background(), &models.SubmissionQueryOptions{
+		FormID: formID, MerchantID: "merchant123", Page: 1, PageSize: 100,
+	})
+	require.NoError(t, err)
+	return submissions
+}
+
+func TestRetentionCleaner_DeletesExpiredResponses(t *testing.T) {
+	f := setupRetentionCleaner(t)
+	form := &models.Form{RetentionDays: 30}
+	f.createForm(t, form, 40, 35, 31, 10)
+	kept := &models.Form{}
+	f.createForm(t, kept, 400)
+
+	f.cleaner.RunOnce(context.Background())
+
+	remaining := f.submissionsOf(t, form.ID)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, f.now.AddDate(0, 0, -10), remaining[0].GetSubmittedAt().UTC())
+	assert.Len(t, f.submissionsOf(t, kept.ID), 1)
+	assert.Len(t, f.storage.keys, 2)
+	assert.True(t, f.storage.keys["merchant123/"+remaining[0].ID.Hex()])
+}
+
+func TestRetentionCleaner_AnonymizesExpiredResponses(t *testing.T) {
+	f := setupRetentionCleaner(t)
+	form := &models.Form{RetentionDays: 7, RetentionAction: models.RetentionActionAnonymize}
+	f.createForm(t, form, 8, 1)
+
+	f.cleaner.RunOnce(context.Background())
+	f.cleaner.RunOnce(context.Background())
+
+	submissions := f.submissionsOf(t, form.ID)
+	require.Len(t, submissions, 2)
+	for _, submission := range submissions {
+		expired := submission.GetSubmittedAt().Before(f.now.AddDate(0, 0, -7))
+		assert.Equal(t, expired, submission.AnonymizedAt != nil)
+		assert.Equal(t, expired, submission.SubmittedBy == "")
+		assert.Equal(t, expired, submission.EncryptedAnswers == nil)
+		assert.NotNil(t, submission.Answers)
+	}
+	assert.Len(t, f.storage.keys, 2)
+}
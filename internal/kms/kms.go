@@ -0,0 +1,126 @@
+// Package kms provides envelope encryption: data keys encrypt the data and are themselves
+// stored wrapped under a master key that never leaves the key management service.
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// DataKeySize is the size in bytes of the AES-256 data keys
+const DataKeySize = 32
+
+// Key errors
+var (
+	ErrUnknownMasterKey = errors.New("unknown master key")
+	ErrDecrypt          = errors.New("message authentication failed")
+)
+
+// KMS generates data keys and unwraps them
+type KMS interface {
+	// GenerateDataKey returns a new data key, in plaintext and wrapped under the current master key
+	GenerateDataKey(ctx context.Context) (*DataKey, error)
+	// Decrypt unwraps a data key wrapped under the identified master key
+	Decrypt(ctx context.Context, masterKeyID string, wrapped []byte) ([]byte, error)
+}
+
+// DataKey is a data key in plaintext and in its stored, wrapped form
+type DataKey struct {
+	MasterKeyID string
+	Plaintext   []byte
+	Wrapped     []byte
+}
+
+// Local is a KMS holding its master key in process memory, for deployments without a managed KMS
+type Local struct {
+	keyID string
+	aead  cipher.AEAD
+}
+
+// NewLocal creates a KMS wrapping data keys under a 32 byte master key
+func NewLocal(keyID string, masterKey []byte) (*Local, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("kms: key id is required")
+	}
+	if len(masterKey) != DataKeySize {
+		return nil, fmt.Errorf("kms: master key must be %d bytes, got %d", DataKeySize, len(masterKey))
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Local{keyID: keyID, aead: aead}, nil
+}
+
+// GenerateDataKey implements KMS.GenerateDataKey
+func (k *Local) GenerateDataKey(_ context.Context) (*DataKey, error) {
+	plaintext := make([]byte, DataKeySize)
+	if _, err := rand.Read(plaintext); err != nil {
+		return nil, err
+	}
+	wrapped, err := seal(k.aead, plaintext, []byte(k.keyID))
+	if err != nil {
+		return nil, err
+	}
+	return &DataKey{MasterKeyID: k.keyID, Plaintext: plaintext, Wrapped: wrapped}, nil
+}
+
+// Decrypt implements KMS.Decrypt
+func (k *Local) Decrypt(_ context.Context, masterKeyID string, wrapped []byte) ([]byte, error) {
+	if masterKeyID != k.keyID {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMasterKey, masterKeyID)
+	}
+	return open(k.aead, wrapped, []byte(k.keyID))
+}
+
+// Encrypt encrypts plaintext with a data key using AES-256-GCM. The additional data is
+// authenticated but not encrypted, so a ciphertext only decrypts in the context it was made for.
+func Encrypt(dataKey, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return seal(aead, plaintext, additionalData)
+}
+
+// Decrypt decrypts a ciphertext made by Encrypt with the same data key and additional data
+func Decrypt(dataKey, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(aead, ciphertext, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("kms: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal prefixes the ciphertext with its random nonce
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
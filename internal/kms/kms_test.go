@@ -0,0 +1,59 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_GenerateDataKey(t *testing.T) {
+	ctx := context.Background()
+	k, err := NewLocal("master-1", bytes.Repeat([]byte{1}, DataKeySize))
+	require.NoError(t, err)
+
+	dataKey, err := k.GenerateDataKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "master-1", dataKey.MasterKeyID)
+	assert.Len(t, dataKey.Plaintext, DataKeySize)
+	assert.NotContains(t, string(dataKey.Wrapped), string(dataKey.Plaintext))
+
+	plaintext, err := k.Decrypt(ctx, "master-1", dataKey.Wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey.Plaintext, plaintext)
+
+	_, err = k.Decrypt(ctx, "master-2", dataKey.Wrapped)
+	assert.ErrorIs(t, err, ErrUnknownMasterKey)
+
+	other, err := NewLocal("master-1", bytes.Repeat([]byte{2}, DataKeySize))
+	require.NoError(t, err)
+	_, err = other.Decrypt(ctx, "master-1", dataKey.Wrapped)
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestNewLocal_Errors(t *testing.T) {
+	_, err := NewLocal("", bytes.Repeat([]byte{1}, DataKeySize))
+	assert.Error(t, err)
+
+	_, err = NewLocal("master-1", []byte("short"))
+	assert.Error(t, err)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := bytes.Repeat([]byte{3}, DataKeySize)
+
+	ciphertext, err := Encrypt(key, []byte(`"alice@example.com"`), []byte("email"))
+	require.NoError(t, err)
+
+	plaintext, err := Decrypt(key, ciphertext, []byte("email"))
+	require.NoError(t, err)
+	assert.Equal(t, `"alice@example.com"`, string(plaintext))
+
+	_, err = Decrypt(key, ciphertext, []byte("phone"))
+	assert.ErrorIs(t, err, ErrDecrypt)
+
+	_, err = Decrypt(key, ciphertext[:4], []byte("email"))
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
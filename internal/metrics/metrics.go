@@ -0,0 +1,104 @@
+// Package metrics defines the Prometheus metrics of the form service. They are registered with
+// the default registry, which the server exposes on /metrics.
+package metrics
+
+import (
+	"sync"
+
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "form"
+
+// Cache results
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+)
+
+var (
+	// MongoQueryDuration observes MongoDB commands by collection, command and outcome
+	MongoQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "mongo",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of MongoDB commands.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"collection", "command", "status"})
+
+	// FormSubmissions counts stored form responses by merchant and source
+	FormSubmissions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "submissions_total",
+		Help:      "Form responses stored, by merchant and source.",
+	}, []string{"merchant_id", "source"})
+
+	// EventsPublished counts domain events accepted by the message bus
+	EventsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "published_total",
+		Help:      "Domain events accepted by the message bus, by type.",
+	}, []string{"type"})
+
+	// EventPublishFailures counts failed attempts to publish a domain event
+	EventPublishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "publish_failures_total",
+		Help:      "Failed attempts to publish a domain event, by type. Failed events are retried.",
+	}, []string{"type"})
+
+	// KetoWriteFailures counts failed writes of Keto relation tuples
+	KetoWriteFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "keto",
+		Name:      "write_failures_total",
+		Help:      "Failed writes of Keto relation tuples, by object namespace and operation.",
+	}, []string{"namespace", "operation"})
+
+	// RetentionResponses counts responses cleaned up past their form's retention period
+	RetentionResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "retention",
+		Name:      "responses_total",
+		Help:      "Responses cleaned up past their form's retention period, by action (delete or anonymize).",
+	}, []string{"action"})
+
+	// SpamRejections counts responses rejected by form spam protection
+	SpamRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "spam",
+		Name:      "rejections_total",
+		Help:      "Responses rejected by form spam protection, by reason (honeypot, too_fast, invalid_token, throttled or captcha).",
+	}, []string{"reason"})
+
+	// CacheRequests counts cache lookups by cache and result; the hit rate is hits over all lookups
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+)
+
+var enableOnce sync.Once
+
+// EnableRPCLatency adds latency histograms by method to the gRPC server metrics
+// (grpc_server_handling_seconds). The request counters are always collected.
+func EnableRPCLatency() {
+	enableOnce.Do(func() {
+		grpc_prometheus.EnableHandlingTimeHistogram()
+	})
+}
+
+// ObserveCache records the result of a cache lookup
+func ObserveCache(cache string, hit bool) {
+	result := CacheMiss
+	if hit {
+		result = CacheHit
+	}
+	CacheRequests.WithLabelValues(cache, result).Inc()
+}
@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+func TestCommandCollection(t *testing.T) {
+	find, _ := bson.Marshal(bson.D{{Key: "find", Value: "forms"}, {Key: "filter", Value: bson.D{}}})
+	getMore, _ := bson.Marshal(bson.D{{Key: "getMore", Value: int64(42)}, {Key: "collection", Value: "form_submissions"}})
+	commit, _ := bson.Marshal(bson.D{{Key: "commitTransaction", Value: 1}})
+
+	assert.Equal(t, "forms", commandCollection("find", find))
+	assert.Equal(t, "form_submissions", commandCollection("getMore", getMore))
+	assert.Equal(t, "", commandCollection("commitTransaction", commit))
+}
+
+func TestMongoMonitor_ObservesDuration(t *testing.T) {
+	monitor := NewMongoMonitor()
+	command, _ := bson.Marshal(bson.D{{Key: "insert", Value: "outbox_events"}})
+	before := testutil.CollectAndCount(MongoQueryDuration, "form_mongo_query_duration_seconds")
+
+	monitor.Started(context.Background(), &event.CommandStartedEvent{
+		Command: command, CommandName: "insert", RequestID: 7, ConnectionID: "conn-1",
+	})
+	monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{
+			CommandName: "insert", RequestID: 7, ConnectionID: "conn-1", Duration: 3 * time.Millisecond,
+		},
+	})
+
+	assert.Equal(t, before+1, testutil.CollectAndCount(MongoQueryDuration, "form_mongo_query_duration_seconds"))
+}
+
+func TestObserveCache(t *testing.T) {
+	hits := testutil.ToFloat64(CacheRequests.WithLabelValues("test", CacheHit))
+	misses := testutil.ToFloat64(CacheRequests.WithLabelValues("test", CacheMiss))
+
+	ObserveCache("test", true)
+	ObserveCache("test", false)
+	ObserveCache("test", false)
+
+	assert.Equal(t, hits+1, testutil.ToFloat64(CacheRequests.WithLabelValues("test", CacheHit)))
+	assert.Equal(t, misses+2, testutil.ToFloat64(CacheRequests.WithLabelValues("test", CacheMiss)))
+}
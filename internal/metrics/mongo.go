@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// commandKey identifies a command between its started and finished events
+type commandKey struct {
+	connectionID string
+	requestID    int64
+}
+
+// NewMongoMonitor returns a command monitor recording MongoQueryDuration. Set it on the client
+// options before connecting.
+func NewMongoMonitor() *event.CommandMonitor {
+	var collections sync.Map // commandKey -> collection name
+
+	finished := func(e event.CommandFinishedEvent, status string) {
+		key := commandKey{connectionID: e.ConnectionID, requestID: e.RequestID}
+		collection, ok := collections.LoadAndDelete(key)
+		if !ok {
+			return
+		}
+		MongoQueryDuration.WithLabelValues(collection.(string), e.CommandName, status).Observe(e.Duration.Seconds())
+	}
+
+	return &event.CommandMonitor{
+		Started: func(_ context.Context, e *event.CommandStartedEvent) {
+			key := commandKey{connectionID: e.ConnectionID, requestID: e.RequestID}
+			collections.Store(key, commandCollection(e.CommandName, e.Command))
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			finished(e.CommandFinishedEvent, "ok")
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			finished(e.CommandFinishedEvent, "error")
+		},
+	}
+}
+
+// commandCollection returns the collection a command operates on, or "" for database commands
+// such as commitTransaction
+func commandCollection(commandName string, command bson.Raw) string {
+	// getMore names the cursor, and its collection in a separate field
+	if commandName == "getMore" {
+		if collection, ok := command.Lookup("collection").StringValueOK(); ok {
+			return collection
+		}
+		return ""
+	}
+	if collection, ok := command.Lookup(commandName).StringValueOK(); ok {
+		return collection
+	}
+	return ""
+}
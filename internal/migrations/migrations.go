@@ -0,0 +1,199 @@
+// Package migrations applies versioned changes to the database: index creation, field backfills
+// and renames. Applied versions are recorded in the migrations collection so each migration runs
+// once, and a lock document keeps replicas starting together from running them concurrently.
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection records the applied migrations, and the lock while migrations run
+const Collection = "migrations"
+
+const (
+	lockID           = "lock"
+	lockTimeout      = 10 * time.Minute
+	lockPollInterval = time.Second
+)
+
+// Migration is a versioned change to the database. Up must be safe to run again when it failed
+// part way, since the version is only recorded once it succeeded.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+}
+
+// Record is an applied migration
+type Record struct {
+	Version     int       `bson:"_id" json:"version"`
+	Description string    `bson:"description" json:"description"`
+	AppliedAt   time.Time `bson:"applied_at" json:"applied_at"`
+	DurationMs  int64     `bson:"duration_ms" json:"duration_ms"`
+}
+
+// Status is a migration and when it was applied, if it was
+type Status struct {
+	Version     int        `json:"version"`
+	Description string     `json:"description"`
+	AppliedAt   *time.Time `json:"applied_at,omitempty"`
+}
+
+// Migrator applies migrations to a database
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+	owner      string
+}
+
+// NewMigrator creates a migrator for the migrations, which need distinct positive versions
+func NewMigrator(db *mongo.Database, migrations []Migration) (*Migrator, error) {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	for i, m := range sorted {
+		if m.Version <= 0 {
+			return nil, fmt.Errorf("migration %q has invalid version %d", m.Description, m.Version)
+		}
+		if m.Up == nil {
+			return nil, fmt.Errorf("migration %d has no Up function", m.Version)
+		}
+		if i > 0 && sorted[i-1].Version == m.Version {
+			return nil, fmt.Errorf("duplicate migration version %d", m.Version)
+		}
+	}
+
+	owner, _ := os.Hostname()
+	return &Migrator{
+		db:         db,
+		migrations: sorted,
+		owner:      fmt.Sprintf("%s-%d-%d", owner, os.Getpid(), time.Now().UnixNano()),
+	}, nil
+}
+
+// Up applies the pending migrations in version order and returns those it applied. It waits
+// while another process holds the migration lock.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := m.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer m.unlock()
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range pending(m.migrations, applied) {
+		log.Info("Applying migration", log.Int("version", migration.Version), log.String("description", migration.Description))
+		start := time.Now()
+		if err := migration.Up(ctx, m.db); err != nil {
+			return done, fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+		}
+
+		record := Record{
+			Version:     migration.Version,
+			Description: migration.Description,
+			AppliedAt:   time.Now(),
+			DurationMs:  time.Since(start).Milliseconds(),
+		}
+		if _, err := m.db.Collection(Collection).InsertOne(ctx, record); err != nil {
+			return done, fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Status lists the known migrations with the time they were applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Description: migration.Description}
+		if record, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &record.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// applied returns the applied migrations by version
+func (m *Migrator) applied(ctx context.Context) (map[int]Record, error) {
+	// The lock document shares the collection; versions are the numeric ids
+	cursor, err := m.db.Collection(Collection).Find(ctx, bson.M{"_id": bson.M{"$type": "number"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %w", err)
+	}
+	var records []Record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode applied migrations: %w", err)
+	}
+
+	applied := make(map[int]Record, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// pending returns the migrations not applied yet, in version order
+func pending(migrations []Migration, applied map[int]Record) []Migration {
+	var result []Migration
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			result = append(result, migration)
+		}
+	}
+	return result
+}
+
+// lock takes the migration lock, waiting while another process holds it. A lock left behind by
+// a crashed process is taken over once it expired.
+func (m *Migrator) lock(ctx context.Context) error {
+	for {
+		now := time.Now()
+		_, err := m.db.Collection(Collection).UpdateOne(ctx,
+			bson.M{"_id": lockID, "locked_until": bson.M{"$lt": now}},
+			bson.M{"$set": bson.M{"owner": m.owner, "locked_until": now.Add(lockTimeout)}},
+			options.Update().SetUpsert(true),
+		)
+		if err == nil {
+			return nil
+		}
+		// The upsert conflicts with the lock document while it is held
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to take migration lock: %w", err)
+		}
+
+		log.Info("Waiting for migrations running in another process")
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for migration lock: %w", ctx.Err())
+		case <-time.After(lockPollInterval):
+		}
+	}
+}
+
+// unlock releases the migration lock, even when the migration context was cancelled
+func (m *Migrator) unlock() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := m.db.Collection(Collection).DeleteOne(ctx, bson.M{"_id": lockID, "owner": m.owner}); err != nil {
+		log.Warn("Failed to release migration lock", log.Err(err))
+	}
+}
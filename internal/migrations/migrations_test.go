@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func noop(context.Context, *mongo.Database) error { return nil }
+
+func TestNewMigrator_SortsByVersion(t *testing.T) {
+	migrator, err := NewMigrator(nil, []Migration{
+		{Version: 3, Description: "third", Up: noop},
+		{Version: 1, Description: "first", Up: noop},
+		{Version: 2, Description: "second", Up: noop},
+	})
+	require.NoError(t, err)
+
+	var versions []int
+	for _, m := range migrator.migrations {
+		versions = append(versions, m.Version)
+	}
+	assert.Equal(t, []int{1, 2, 3}, versions)
+}
+
+func TestNewMigrator_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		migrations []Migration
+	}{
+		{"duplicate version", []Migration{{Version: 1, Up: noop}, {Version: 1, Up: noop}}},
+		{"zero version", []Migration{{Version: 0, Up: noop}}},
+		{"missing up", []Migration{{Version: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMigrator(nil, tt.migrations)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPending(t *testing.T) {
+	migrations := []Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+	result := pending(migrations, map[int]Record{2: {Version: 2}})
+
+	require.Len(t, result, 2)
+	assert.Equal(t, 1, result[0].Version)
+	assert.Equal(t, 3, result[1].Version)
+	assert.Empty(t, pending(migrations, map[int]Record{1: {}, 2: {}, 3: {}}))
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CollectionIndexes are the indexes to create on a collection
+type CollectionIndexes struct {
+	Collection string
+	Indexes    []mongo.IndexModel
+}
+
+// CreateIndexes returns a migration step creating the indexes. Creating an index that already
+// exists with the same options is a no-op.
+func CreateIndexes(collections ...CollectionIndexes) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		for _, c := range collections {
+			if len(c.Indexes) == 0 {
+				continue
+			}
+			if _, err := db.Collection(c.Collection).Indexes().CreateMany(ctx, c.Indexes); err != nil {
+				return fmt.Errorf("failed to create indexes for collection '%s': %w", c.Collection, err)
+			}
+		}
+		return nil
+	}
+}
+
+// RenameField returns a migration step renaming a field in the documents that still have it
+func RenameField(collection, from, to string) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(collection).UpdateMany(ctx,
+			bson.M{from: bson.M{"$exists": true}},
+			bson.M{"$rename": bson.M{from: to}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to rename '%s' to '%s' in collection '%s': %w", from, to, collection, err)
+		}
+		return nil
+	}
+}
+
+// Backfill returns a migration step setting a field to a value in the documents missing it
+func Backfill(collection, field string, value interface{}) func(ctx context.Context, db *mongo.Database) error {
+	return func(ctx context.Context, db *mongo.Database) error {
+		_, err := db.Collection(collection).UpdateMany(ctx,
+			bson.M{field: bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{field: value}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to backfill '%s' in collection '%s': %w", field, collection, err)
+		}
+		return nil
+	}
+}
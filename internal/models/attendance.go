@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Attendance records that the respondent of a registration form checked in to an event session.
+// Sessions belong to the event service and are identified by its session IDs.
+type Attendance struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty"`
+	MerchantID   string              `bson:"merchant_id"`
+	FormID       primitive.ObjectID  `bson:"form_id"`
+	EventID      *primitive.ObjectID `bson:"event_id,omitempty"` // Event of the form when the attendee checked in
+	SessionID    string              `bson:"session_id"`
+	SubmissionID primitive.ObjectID  `bson:"submission_id"` // Registration the attendee checked in with
+	CheckedInAt  primitive.DateTime  `bson:"checked_in_at"`
+	CheckedInBy  string              `bson:"checked_in_by"`
+}
+
+// TableName returns the collection name for Attendance
+func (Attendance) TableName() string {
+	return "session_attendance"
+}
+
+// GetCheckedInAt returns the check-in timestamp as time.Time
+func (a Attendance) GetCheckedInAt() time.Time {
+	return a.CheckedInAt.Time()
+}
+
+// SetCheckedInAt sets the check-in timestamp from time.Time
+func (a *Attendance) SetCheckedInAt(t time.Time) {
+	a.CheckedInAt = primitive.NewDateTimeFromTime(t)
+}
+
+// CheckInInput represents a request to check in the respondent of a submission to a session
+type CheckInInput struct {
+	FormID       primitive.ObjectID `json:"form_id" validate:"required"`
+	SubmissionID primitive.ObjectID `json:"submission_id" validate:"required"`
+	SessionID    string             `json:"session_id" validate:"required,max=100"`
+	MerchantID   string             `json:"merchant_id" validate:"required"`
+	CheckedInBy  string             `json:"checked_in_by" validate:"required"`
+}
+
+// CheckInResult is the attendance recorded by a check-in. A repeated check-in returns the
+// attendance recorded the first time.
+type CheckInResult struct {
+	Attendance       *Attendance
+	AlreadyCheckedIn bool
+}
+
+// SessionAttendance counts the attendees of a session against the registrations of a form.
+// Registrations and capacity are per form: sessions and their capacity belong to the event service.
+type SessionAttendance struct {
+	FormID           primitive.ObjectID
+	SessionID        string
+	CheckedIn        int64
+	FormResponses    int64 // Responses submitted to the form, across all sessions
+	FormMaxResponses int   // Total responses the form accepts, 0 means unlimited
+}
+
+// IssueCheckInTokenInput represents a request to issue the check-in token of a registration
+type IssueCheckInTokenInput struct {
+	FormID       primitive.ObjectID `json:"form_id" validate:"required"`
+	SubmissionID primitive.ObjectID `json:"submission_id" validate:"required"`
+	SessionID    string             `json:"session_id" validate:"required,max=100"`
+	MerchantID   string             `json:"merchant_id" validate:"required"`
+	TTL          time.Duration      `json:"ttl" validate:"min=0"` // 0 selects the configured default
+}
+
+// CheckInToken is a signed token checking a registration in to a session, rendered as a QR code
+type CheckInToken struct {
+	Token        string
+	SubmissionID primitive.ObjectID
+	SessionID    string
+	ExpiresAt    time.Time
+}
+
+// RedeemCheckInTokenInput represents a check-in token scanned at the door
+type RedeemCheckInTokenInput struct {
+	Token       string `json:"token" validate:"required"`
+	SessionID   string `json:"session_id" validate:"max=100"` // Session scanned for; tokens of other sessions are rejected when set
+	MerchantID  string `json:"merchant_id" validate:"required"`
+	CheckedInBy string `json:"checked_in_by" validate:"required"`
+}
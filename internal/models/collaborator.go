@@ -0,0 +1,40 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CollaboratorRole is a Keto role a user holds on a form
+type CollaboratorRole string
+
+const (
+	CollaboratorRoleOwner  CollaboratorRole = "owner"
+	CollaboratorRoleEditor CollaboratorRole = "editor"
+	CollaboratorRoleViewer CollaboratorRole = "viewer"
+)
+
+// CollaboratorRoles lists the roles from the most to the least privileged
+var CollaboratorRoles = []CollaboratorRole{CollaboratorRoleOwner, CollaboratorRoleEditor, CollaboratorRoleViewer}
+
+// Collaborator is a user holding a role on a form
+type Collaborator struct {
+	UserID string
+	Role   CollaboratorRole
+}
+
+// ShareFormInput represents a request to grant a user a role on a form
+type ShareFormInput struct {
+	FormID     primitive.ObjectID `json:"form_id" validate:"required"`
+	MerchantID string             `json:"merchant_id" validate:"required"`
+	UserID     string             `json:"user_id" validate:"required"`
+	Role       CollaboratorRole   `json:"role" validate:"required,oneof=editor viewer"`
+	SharedBy   string             `json:"shared_by" validate:"required"`
+}
+
+// UnshareFormInput represents a request to remove the role a form is shared with a user
+type UnshareFormInput struct {
+	FormID     primitive.ObjectID `json:"form_id" validate:"required"`
+	MerchantID string             `json:"merchant_id" validate:"required"`
+	UserID     string             `json:"user_id" validate:"required"`
+	UnsharedBy string             `json:"unshared_by" validate:"required"`
+}
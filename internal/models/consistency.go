@@ -0,0 +1,38 @@
+package models
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Consistency check statuses
+const (
+	CheckStatusOK       = "ok"
+	CheckStatusFailed   = "failed"
+	CheckStatusSkipped  = "skipped"  // The check covers data not managed by this service
+	CheckStatusRepaired = "repaired" // The check failed and the inconsistency was repaired
+)
+
+// ConsistencyCheck is the outcome of a single consistency check
+type ConsistencyCheck struct {
+	Name       string
+	Status     string
+	ResourceID string // Resource the check ran against, empty for event-wide checks
+	Message    string
+}
+
+// EventConsistencyReport lists the consistency checks run for the forms of an event
+type EventConsistencyReport struct {
+	EventID    primitive.ObjectID
+	MerchantID string
+	FormCount  int
+	Healthy    bool // No check failed
+	Checks     []ConsistencyCheck
+}
+
+// IntegrityReport lists the dangling references found by a referential integrity scan
+type IntegrityReport struct {
+	Found    int  // Forms found with a dangling reference
+	Repaired int  // Forms whose dangling reference was removed
+	Healthy  bool // No check failed
+	Checks   []ConsistencyCheck
+}
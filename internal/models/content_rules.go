@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ContentAction is what happens to labels breaking the content rules when a form or template is saved
+type ContentAction string
+
+// Content actions
+const (
+	ContentActionReject ContentAction = "reject" // The form or template is rejected
+	ContentActionStrip  ContentAction = "strip"  // Links and blocked terms are removed from the labels
+)
+
+// MerchantContentRules is a merchant's rule set for the field titles and descriptions of its forms
+// and templates. It replaces the platform rules for the merchant.
+type MerchantContentRules struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	MerchantID   string             `bson:"merchant_id"`
+	Action       ContentAction      `bson:"action"`
+	BlockURLs    bool               `bson:"block_urls"`
+	BlockedTerms []string           `bson:"blocked_terms"`
+	CreatedAt    primitive.DateTime `bson:"created_at"`
+	UpdatedAt    primitive.DateTime `bson:"updated_at"`
+	UpdatedBy    string             `bson:"updated_by"`
+}
+
+// TableName returns the collection name for MerchantContentRules
+func (MerchantContentRules) TableName() string {
+	return "merchant_content_rules"
+}
+
+// GetUpdatedAt returns the updated timestamp as time.Time
+func (r MerchantContentRules) GetUpdatedAt() time.Time {
+	return r.UpdatedAt.Time()
+}
+
+// SetMerchantContentRulesInput represents a request to set a merchant's content rules
+type SetMerchantContentRulesInput struct {
+	MerchantID   string        `json:"merchant_id" validate:"required"`
+	Action       ContentAction `json:"action" validate:"required,oneof=reject strip"`
+	BlockURLs    bool          `json:"block_urls"`
+	BlockedTerms []string      `json:"blocked_terms" validate:"max=500,dive,required,max=100"`
+	UpdatedBy    string        `json:"updated_by" validate:"required"`
+}
+
+// EffectiveContentRules are the content rules applied to a merchant
+type EffectiveContentRules struct {
+	MerchantID   string
+	Action       ContentAction
+	BlockURLs    bool
+	BlockedTerms []string
+	Overrides    *MerchantContentRules // nil when the merchant uses the platform rules
+}
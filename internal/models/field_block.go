@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FieldBlock is a reusable group of questions of a merchant, e.g. contact info or dietary
+// needs. Forms and templates refer to a block from their schema and get its questions copied
+// in when they are saved, so changing a block does not change existing forms.
+type FieldBlock struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	MerchantID  string             `bson:"merchant_id"`
+	Name        string             `bson:"name"`
+	Description string             `bson:"description,omitempty"`
+	Schema      interface{}        `bson:"schema"` // JSON Schema of an object holding the block's questions
+	CreatedAt   primitive.DateTime `bson:"created_at"`
+	CreatedBy   string             `bson:"created_by"`
+	UpdatedAt   primitive.DateTime `bson:"updated_at"`
+	UpdatedBy   string             `bson:"updated_by"`
+}
+
+// TableName returns the collection name for FieldBlock
+func (FieldBlock) TableName() string {
+	return "field_blocks"
+}
+
+// GetCreatedAt returns the created timestamp as time.Time
+func (fb FieldBlock) GetCreatedAt() time.Time {
+	return fb.CreatedAt.Time()
+}
+
+// GetUpdatedAt returns the updated timestamp as time.Time
+func (fb FieldBlock) GetUpdatedAt() time.Time {
+	return fb.UpdatedAt.Time()
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (fb *FieldBlock) SetCreatedAt(t time.Time) {
+	fb.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetUpdatedAt sets the updated timestamp from time.Time
+func (fb *FieldBlock) SetUpdatedAt(t time.Time) {
+	fb.UpdatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// FieldBlockQueryOptions represents query options for listing the field blocks of a merchant
+type FieldBlockQueryOptions struct {
+	MerchantID string   `json:"merchant_id" validate:"required"`
+	Page       int      `json:"page" validate:"min=1"`
+	PageSize   int      `json:"page_size" validate:"min=1,max=2000"`
+	SortBy     string   `json:"sort_by" validate:"omitempty,oneof=name created_at updated_at"`
+	SortOrder  string   `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+	View       ListView `json:"view,omitempty" validate:"omitempty,oneof=full summary"`
+}
+
+// CreateFieldBlockInput represents the input for creating a field block
+type CreateFieldBlockInput struct {
+	Name        string      `json:"name" validate:"required,min=1,max=100"`
+	Description string      `json:"description" validate:"max=500"`
+	Schema      interface{} `json:"schema" validate:"required"`
+	CreatedBy   string      `json:"created_by" validate:"required"`
+	MerchantID  string      `json:"merchant_id" validate:"required"`
+}
+
+// UpdateFieldBlockInput represents the input for updating a field block
+type UpdateFieldBlockInput struct {
+	ID          primitive.ObjectID `json:"id" validate:"required"`
+	Name        string             `json:"name" validate:"required,min=1,max=100"`
+	Description string             `json:"description" validate:"max=500"`
+	Schema      interface{}        `json:"schema" validate:"required"`
+	UpdatedBy   string             `json:"updated_by" validate:"required"`
+	MerchantID  string             `json:"merchant_id" validate:"required"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SubmissionFilterUsage records how often a merchant filters submissions on an answer field
+type SubmissionFilterUsage struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	MerchantID string             `bson:"merchant_id"`
+	Field      string             `bson:"field"`
+	Count      int64              `bson:"count"`
+	LastUsedAt primitive.DateTime `bson:"last_used_at"`
+}
+
+// TableName returns the collection name for SubmissionFilterUsage
+func (SubmissionFilterUsage) TableName() string {
+	return "submission_filter_usage"
+}
+
+// FilterFieldUsage aggregates the filter usage of an answer field across merchants
+type FilterFieldUsage struct {
+	Field         string
+	UsageCount    int64
+	MerchantCount int64
+	LastUsedAt    time.Time
+}
+
+// FilterIndexRecommendation suggests indexing a frequently filtered answer field
+type FilterIndexRecommendation struct {
+	Field         string
+	UsageCount    int64
+	MerchantCount int64
+	Indexed       bool // An index on the field already exists
+	Created       bool // The index was created while generating the report
+}
+
+// FilterIndexReport lists index recommendations for frequently filtered answer fields
+type FilterIndexReport struct {
+	MinFilterUsage  int64
+	AutoCreate      bool
+	MaxAutoIndexes  int
+	AutoIndexCount  int // Automatically created indexes, including the ones created for this report
+	Recommendations []FilterIndexRecommendation
+}
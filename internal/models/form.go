@@ -4,19 +4,128 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// FormStatus represents the lifecycle status of a form
+type FormStatus string
+
+// Form lifecycle statuses
+const (
+	FormStatusDraft     FormStatus = "draft"     // Editable, not accepting submissions
+	FormStatusPublished FormStatus = "published" // Schema locked, accepting submissions
+	FormStatusClosed    FormStatus = "closed"    // Schema locked, no longer accepting submissions
+)
+
+// formStatusTransitions lists the statuses a form can move to from each status
+var formStatusTransitions = map[FormStatus][]FormStatus{
+	FormStatusDraft:     {FormStatusPublished},
+	FormStatusPublished: {FormStatusClosed},
+	FormStatusClosed:    {FormStatusPublished},
+}
+
+// IsValid checks if the status is a known form status
+func (s FormStatus) IsValid() bool {
+	_, ok := formStatusTransitions[s]
+	return ok
+}
+
+// SubmissionMode controls who may submit responses to a form
+type SubmissionMode string
+
+// Form submission modes
+const (
+	SubmissionModeAnonymous     SubmissionMode = "anonymous"     // Anyone may submit; responses are not attributed to a user
+	SubmissionModeAuthenticated SubmissionMode = "authenticated" // Signed-in users may submit
+	SubmissionModeInviteOnly    SubmissionMode = "invite_only"   // Submissions require a one-time invitation token
 )
 
+// IsValid checks if the mode is a known submission mode
+func (m SubmissionMode) IsValid() bool {
+	switch m {
+	case SubmissionModeAnonymous, SubmissionModeAuthenticated, SubmissionModeInviteOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetentionAction is what happens to responses once a form's retention period has passed
+type RetentionAction string
+
+// Retention actions
+const (
+	RetentionActionDelete    RetentionAction = "delete"    // Responses are deleted with their uploaded files
+	RetentionActionAnonymize RetentionAction = "anonymize" // Respondent identity and PII answers are removed; other answers are kept for statistics
+)
+
+// IsValid checks if the action is a known retention action
+func (a RetentionAction) IsValid() bool {
+	switch a {
+	case RetentionActionDelete, RetentionActionAnonymize:
+		return true
+	default:
+		return false
+	}
+}
+
+// CaptchaProvider is a CAPTCHA service verifying that a respondent is a person
+type CaptchaProvider string
+
+// Supported CAPTCHA providers
+const (
+	CaptchaProviderHCaptcha  CaptchaProvider = "hcaptcha"
+	CaptchaProviderTurnstile CaptchaProvider = "turnstile"
+)
+
+// IsValid checks if the provider is a supported CAPTCHA provider
+func (p CaptchaProvider) IsValid() bool {
+	switch p {
+	case CaptchaProviderHCaptcha, CaptchaProviderTurnstile:
+		return true
+	default:
+		return false
+	}
+}
+
+// SpamProtection configures the defenses a form applies to submitted responses
+type SpamProtection struct {
+	HoneypotField   string          `bson:"honeypot_field,omitempty"`      // Hidden field people leave empty; responses filling it are rejected
+	MinFillSeconds  int             `bson:"min_fill_seconds,omitempty"`    // Responses submitted sooner after the form was loaded are rejected
+	MaxPerIPPerHour int             `bson:"max_per_ip_per_hour,omitempty"` // Responses accepted per client address and hour, 0 means unlimited
+	CaptchaProvider CaptchaProvider `bson:"captcha_provider,omitempty"`    // Optional: responses must carry a token accepted by the provider
+	CaptchaSiteKey  string          `bson:"captcha_site_key,omitempty"`    // Public key rendering the provider's widget
+}
+
+// Enabled reports whether any defense is configured
+func (p *SpamProtection) Enabled() bool {
+	return p != nil && (p.HoneypotField != "" || p.MinFillSeconds > 0 || p.MaxPerIPPerHour > 0 || p.CaptchaProvider != "")
+}
+
 // Form represents an individual form instance that can be based on a template or have custom schema
 type Form struct {
-	ID         primitive.ObjectID  `bson:"_id,omitempty"`
-	EventID    *primitive.ObjectID `bson:"event_id,omitempty"` // Optional reference to an event
-	MerchantID string              `bson:"merchant_id"`
-	Schema     interface{}         `bson:"schema"`    // JSON Schema for data structure and validation
-	UISchema   interface{}         `bson:"ui_schema"` // UI Schema for form layout and appearance
-	CreatedAt  primitive.DateTime  `bson:"created_at"`
-	CreatedBy  string              `bson:"created_by"`
-	UpdatedAt  primitive.DateTime  `bson:"updated_at"`
-	UpdatedBy  string              `bson:"updated_by"`
+	ID              primitive.ObjectID  `bson:"_id,omitempty"`
+	EventID         *primitive.ObjectID `bson:"event_id,omitempty"`         // Optional reference to an event
+	TemplateID      *primitive.ObjectID `bson:"template_id,omitempty"`      // Optional reference to the source template
+	TemplateVersion int                 `bson:"template_version,omitempty"` // Template version the schema was taken from
+	MerchantID      string              `bson:"merchant_id"`
+	Schema          interface{}         `bson:"schema"`         // JSON Schema for data structure and validation
+	UISchema        interface{}         `bson:"ui_schema"`      // UI Schema for form layout and appearance
+	SchemaVersion   int                 `bson:"schema_version"` // Incremented every time the schema is updated
+	Status          FormStatus          `bson:"status"`
+	OpenAt          *time.Time          `bson:"open_at,omitempty"`                // Optional: submissions are accepted from this time
+	CloseAt         *time.Time          `bson:"close_at,omitempty"`               // Optional: the form is closed automatically at this time
+	MaxResponses    int                 `bson:"max_responses,omitempty"`          // Optional: total responses accepted, 0 means unlimited
+	MaxPerUser      int                 `bson:"max_responses_per_user,omitempty"` // Optional: responses accepted per user, 0 means unlimited
+	SubmissionMode  SubmissionMode      `bson:"submission_mode,omitempty"`
+	RetentionDays   int                 `bson:"retention_days,omitempty"`   // Optional: responses are cleaned up this many days after submission, 0 keeps them
+	RetentionAction RetentionAction     `bson:"retention_action,omitempty"` // Defaults to delete
+	SpamProtection  *SpamProtection     `bson:"spam_protection,omitempty"`  // Optional: defenses against automated submissions
+	CreatedAt       primitive.DateTime  `bson:"created_at"`
+	CreatedBy       string              `bson:"created_by"`
+	UpdatedAt       primitive.DateTime  `bson:"updated_at"`
+	UpdatedBy       string              `bson:"updated_by"`
 }
 
 // TableName returns the collection name for Form
@@ -55,9 +164,110 @@ func (f Form) HasEventID() bool {
 	return f.EventID != nil && !f.EventID.IsZero()
 }
 
+// HasTemplateID checks if the form was created from a template
+func (f Form) HasTemplateID() bool {
+	return f.TemplateID != nil && !f.TemplateID.IsZero()
+}
+
+// CurrentSchemaVersion returns the version of the current schema.
+// Forms created before schema versioning was introduced are treated as version 1.
+func (f Form) CurrentSchemaVersion() int {
+	if f.SchemaVersion < 1 {
+		return 1
+	}
+	return f.SchemaVersion
+}
+
+// FormSchemaVersion is a snapshot of a form schema that has since been replaced.
+// The current schema always lives on the Form document itself.
+type FormSchemaVersion struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	FormID     primitive.ObjectID `bson:"form_id"`
+	MerchantID string             `bson:"merchant_id"`
+	Version    int                `bson:"version"`
+	Schema     interface{}        `bson:"schema"`
+	UISchema   interface{}        `bson:"ui_schema"`
+	CreatedAt  primitive.DateTime `bson:"created_at"` // When this version was originally created
+	CreatedBy  string             `bson:"created_by"`
+}
+
+// TableName returns the collection name for FormSchemaVersion
+func (FormSchemaVersion) TableName() string {
+	return "form_schema_versions"
+}
+
+// CurrentStatus returns the lifecycle status of the form.
+// Forms created before the lifecycle was introduced are treated as drafts.
+func (f Form) CurrentStatus() FormStatus {
+	if f.Status == "" {
+		return FormStatusDraft
+	}
+	return f.Status
+}
+
+// CanTransitionTo checks if the form can move from its current status to the target status
+func (f Form) CanTransitionTo(target FormStatus) bool {
+	for _, allowed := range formStatusTransitions[f.CurrentStatus()] {
+		if allowed == target {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSchemaLocked checks if the form's schema can no longer be edited
+func (f Form) IsSchemaLocked() bool {
+	return f.CurrentStatus() != FormStatusDraft
+}
+
+// CurrentSubmissionMode returns the form's submission mode; forms without one require authentication
+func (f Form) CurrentSubmissionMode() SubmissionMode {
+	if f.SubmissionMode == "" {
+		return SubmissionModeAuthenticated
+	}
+	return f.SubmissionMode
+}
+
+// CurrentRetentionAction returns what happens to the form's expired responses; deletion by default
+func (f Form) CurrentRetentionAction() RetentionAction {
+	if f.RetentionAction == "" {
+		return RetentionActionDelete
+	}
+	return f.RetentionAction
+}
+
+// RetentionCutoff returns the submission time before which responses have expired, or false
+// when the form keeps its responses
+func (f Form) RetentionCutoff(now time.Time) (time.Time, bool) {
+	if f.RetentionDays <= 0 {
+		return time.Time{}, false
+	}
+	return now.AddDate(0, 0, -f.RetentionDays), true
+}
+
+// AcceptsSubmissions checks if the form is open for new submissions
+func (f Form) AcceptsSubmissions() bool {
+	return f.AcceptsSubmissionsAt(time.Now())
+}
+
+// AcceptsSubmissionsAt checks if the form is published and within its access window at the given time
+func (f Form) AcceptsSubmissionsAt(t time.Time) bool {
+	if f.CurrentStatus() != FormStatusPublished {
+		return false
+	}
+	if f.OpenAt != nil && t.Before(*f.OpenAt) {
+		return false
+	}
+	if f.CloseAt != nil && !t.Before(*f.CloseAt) {
+		return false
+	}
+	return true
+}
+
 // CreateFormInput represents the input for creating a new form
 type CreateFormInput struct {
 	EventID    *primitive.ObjectID `json:"event_id,omitempty"`
+	TemplateID *primitive.ObjectID `json:"template_id,omitempty"` // Schemas default to the template's when omitted
 	Schema     interface{}         `json:"schema"`
 	UISchema   interface{}         `json:"ui_schema"`
 	CreatedBy  string              `json:"created_by" validate:"required"`
@@ -72,6 +282,68 @@ type UpdateFormInput struct {
 	UpdatedBy string             `json:"updated_by" validate:"required"`
 }
 
+// FormTemplateComparison describes how a form's schema differs from the latest version of its source template
+type FormTemplateComparison struct {
+	FormID          primitive.ObjectID
+	TemplateID      primitive.ObjectID
+	FormVersion     int // Template version the form is based on
+	TemplateVersion int // Latest template version
+	VersionsBehind  int
+	Changes         []schema.FieldChange
+}
+
+// SchemaSource identifies the schema of a form or a template, at a past version or the current one
+type SchemaSource struct {
+	FormID     primitive.ObjectID // Set to compare a form's schema
+	TemplateID primitive.ObjectID // Set to compare a template's schema
+	Version    int                // Form schema version or template version; 0 for the current one
+}
+
+// IsForm reports whether the source is a form rather than a template
+func (s SchemaSource) IsForm() bool {
+	return !s.FormID.IsZero()
+}
+
+// SchemaComparison describes how the schema of one source differs from another's
+type SchemaComparison struct {
+	From    SchemaSource // With the version that was compared
+	To      SchemaSource
+	Changes []schema.FieldChange
+}
+
+// SchemaValidation is the result of checking a schema before it is saved. Errors prevent the
+// schema from being saved; warnings point out deviations from best practices.
+type SchemaValidation struct {
+	Errors   []schema.Issue
+	Warnings []schema.Issue
+}
+
+// Valid reports whether the schema has no errors
+func (v SchemaValidation) Valid() bool {
+	return len(v.Errors) == 0
+}
+
+// CountMode selects how a list query counts the documents matching it
+type CountMode string
+
+// Count modes
+const (
+	CountExact CountMode = "exact" // A find and a separate count query
+	CountFacet CountMode = "facet" // The page and the total from a single $facet aggregation
+	// No total: one extra document is fetched to tell whether more pages follow, and the count
+	// returned only covers the documents up to the end of the page, plus one when more follow
+	CountNone CountMode = "none"
+)
+
+// ListView selects how much of each document a list returns
+type ListView string
+
+// List views
+const (
+	ListViewFull    ListView = "full"    // Every field
+	ListViewSummary ListView = "summary" // Without the schemas, for table views
+)
+
 // FormQueryOptions represents query options for listing forms
 type FormQueryOptions struct {
 	MerchantID string              `json:"merchant_id" validate:"required"`
@@ -80,13 +352,16 @@ type FormQueryOptions struct {
 	PageSize   int                 `json:"page_size" validate:"min=1,max=2000"`
 	SortBy     string              `json:"sort_by" validate:"omitempty,oneof=created_at updated_at"`
 	SortOrder  string              `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Count      CountMode           `json:"count,omitempty"` // Empty selects the configured mode
 }
 
 // FormTemplateQueryOptions represents query options for listing form templates
 type FormTemplateQueryOptions struct {
-	MerchantID string `json:"merchant_id" validate:"required"`
-	Page       int    `json:"page" validate:"min=1"`
-	PageSize   int    `json:"page_size" validate:"min=1,max=2000"`
-	SortBy     string `json:"sort_by" validate:"omitempty,oneof=name created_at updated_at"`
-	SortOrder  string `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+	MerchantID string    `json:"merchant_id" validate:"required"`
+	Page       int       `json:"page" validate:"min=1"`
+	PageSize   int       `json:"page_size" validate:"min=1,max=2000"`
+	SortBy     string    `json:"sort_by" validate:"omitempty,oneof=name created_at updated_at"`
+	SortOrder  string    `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Count      CountMode `json:"count,omitempty"` // Empty selects the configured mode
+	View       ListView  `json:"view,omitempty" validate:"omitempty,oneof=full summary"`
 }
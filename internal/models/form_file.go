@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FileStatus represents whether an uploaded file is linked to a submission
+type FileStatus string
+
+// File statuses
+const (
+	FileStatusPending  FileStatus = "pending"  // Upload URL issued, not yet part of a submission
+	FileStatusAttached FileStatus = "attached" // Linked to a submission
+)
+
+// FileReference is a file uploaded to object storage for a file field of a form
+type FileReference struct {
+	ID           primitive.ObjectID  `bson:"_id,omitempty"`
+	FormID       primitive.ObjectID  `bson:"form_id"`
+	MerchantID   string              `bson:"merchant_id"`
+	Field        string              `bson:"field"`
+	Key          string              `bson:"key"` // Object key in the storage bucket
+	FileName     string              `bson:"file_name"`
+	ContentType  string              `bson:"content_type"`
+	Size         int64               `bson:"size"`
+	Status       FileStatus          `bson:"status"`
+	SubmissionID *primitive.ObjectID `bson:"submission_id,omitempty"`
+	UploadedBy   string              `bson:"uploaded_by,omitempty"`
+	CreatedAt    primitive.DateTime  `bson:"created_at"`
+	ExpiresAt    *primitive.DateTime `bson:"expires_at,omitempty"` // Pending files are removed once expired
+}
+
+// TableName returns the collection name for FileReference
+func (FileReference) TableName() string {
+	return "form_files"
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (f *FileReference) SetCreatedAt(t time.Time) {
+	f.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetExpiresAt sets the expiry timestamp from time.Time
+func (f *FileReference) SetExpiresAt(t time.Time) {
+	expiresAt := primitive.NewDateTimeFromTime(t)
+	f.ExpiresAt = &expiresAt
+}
+
+// RequestUploadURLInput represents a request to upload a file for a form's file field
+type RequestUploadURLInput struct {
+	FormID      primitive.ObjectID `json:"form_id" validate:"required"`
+	Field       string             `json:"field" validate:"required"`
+	FileName    string             `json:"file_name" validate:"required,max=255"`
+	ContentType string             `json:"content_type" validate:"required,max=255"`
+	Size        int64              `json:"size" validate:"required,min=1"`
+	UploadedBy  string             `json:"uploaded_by"` // Empty for anonymous forms
+}
+
+// FileUpload is a pending file together with the pre-signed request that uploads it
+type FileUpload struct {
+	File      *FileReference
+	URL       string
+	Method    string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
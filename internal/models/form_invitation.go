@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FormInvitation represents a one-time invitation to submit a response to an invite-only form
+type FormInvitation struct {
+	ID         primitive.ObjectID  `bson:"_id,omitempty"`
+	FormID     primitive.ObjectID  `bson:"form_id"`
+	MerchantID string              `bson:"merchant_id"`
+	ExpiresAt  primitive.DateTime  `bson:"expires_at"`
+	UsedAt     *primitive.DateTime `bson:"used_at"` // Set once the invitation has been redeemed
+	UsedBy     string              `bson:"used_by,omitempty"`
+	CreatedAt  primitive.DateTime  `bson:"created_at"`
+	CreatedBy  string              `bson:"created_by"`
+}
+
+// TableName returns the collection name for FormInvitation
+func (FormInvitation) TableName() string {
+	return "form_invitations"
+}
+
+// GetExpiresAt returns the expiry timestamp as time.Time
+func (fi FormInvitation) GetExpiresAt() time.Time {
+	return fi.ExpiresAt.Time()
+}
+
+// SetExpiresAt sets the expiry timestamp from time.Time
+func (fi *FormInvitation) SetExpiresAt(t time.Time) {
+	fi.ExpiresAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (fi *FormInvitation) SetCreatedAt(t time.Time) {
+	fi.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// CreateFormInvitationsInput represents a request to issue invitations for a form
+type CreateFormInvitationsInput struct {
+	FormID     primitive.ObjectID `json:"form_id" validate:"required"`
+	MerchantID string             `json:"merchant_id" validate:"required"`
+	Count      int                `json:"count" validate:"required,min=1,max=100"`
+	TTL        time.Duration      `json:"ttl" validate:"min=0"` // 0 selects the configured default
+	CreatedBy  string             `json:"created_by" validate:"required"`
+}
+
+// IssuedFormInvitation is an invitation together with its signed token and link
+type IssuedFormInvitation struct {
+	Invitation *FormInvitation
+	Token      string
+	Link       string // Empty when no link base URL is configured
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Submission sources
+const (
+	SubmissionSourceImport = "import"
+	SubmissionSourceWeb    = "web"
+)
+
+// FormSubmission represents a set of answers submitted to a form
+type FormSubmission struct {
+	ID               primitive.ObjectID  `bson:"_id,omitempty"`
+	FormID           primitive.ObjectID  `bson:"form_id"`
+	MerchantID       string              `bson:"merchant_id"`
+	SchemaVersion    int                 `bson:"schema_version"` // Form schema version the answers were validated against
+	Answers          interface{}         `bson:"answers"`
+	EncryptedAnswers map[string][]byte   `bson:"encrypted_answers,omitempty"` // Answers to PII fields, encrypted with the data key KeyID
+	KeyID            primitive.ObjectID  `bson:"key_id,omitempty"`            // Merchant data key of the encrypted answers
+	Source           string              `bson:"source"`
+	ExternalID       string              `bson:"external_id,omitempty"` // Identifier in the system the submission was imported from
+	SubmittedBy      string              `bson:"submitted_by,omitempty"`
+	SubmittedAt      primitive.DateTime  `bson:"submitted_at"`
+	CreatedAt        primitive.DateTime  `bson:"created_at"`
+	CreatedBy        string              `bson:"created_by"`
+	AnonymizedAt     *primitive.DateTime `bson:"anonymized_at,omitempty"` // Set when retention removed the respondent's identity
+}
+
+// TableName returns the collection name for FormSubmission
+func (FormSubmission) TableName() string {
+	return "form_submissions"
+}
+
+// GetSubmittedAt returns the submitted timestamp as time.Time
+func (fs FormSubmission) GetSubmittedAt() time.Time {
+	return fs.SubmittedAt.Time()
+}
+
+// GetCreatedAt returns the created timestamp as time.Time
+func (fs FormSubmission) GetCreatedAt() time.Time {
+	return fs.CreatedAt.Time()
+}
+
+// SetSubmittedAt sets the submitted timestamp from time.Time
+func (fs *FormSubmission) SetSubmittedAt(t time.Time) {
+	fs.SubmittedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (fs *FormSubmission) SetCreatedAt(t time.Time) {
+	fs.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// FormResponseCounter counts the responses accepted by a form, in total or for a single user,
+// so that response quotas can be enforced atomically
+type FormResponseCounter struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	FormID primitive.ObjectID `bson:"form_id"`
+	UserID string             `bson:"user_id"` // Empty for the form's total counter
+	Count  int                `bson:"count"`
+}
+
+// TableName returns the collection name for FormResponseCounter
+func (FormResponseCounter) TableName() string {
+	return "form_response_counters"
+}
+
+// SubmitFormResponseInput represents a response submitted to a form
+type SubmitFormResponseInput struct {
+	FormID          primitive.ObjectID     `json:"form_id" validate:"required"`
+	Answers         map[string]interface{} `json:"answers" validate:"required"`
+	SubmittedBy     string                 `json:"submitted_by"`     // Empty for unauthenticated users
+	InvitationToken string                 `json:"invitation_token"` // Required by invite-only forms
+	SubmissionToken string                 `json:"submission_token"` // Issued with the public form; required by forms with a minimum fill time
+	CaptchaToken    string                 `json:"captcha_token"`    // Required by forms with a CAPTCHA provider
+	ClientIP        string                 `json:"-"`                // Address of the respondent
+}
+
+// ImportSubmissionsInput represents a batch of historical submissions to import into a form
+type ImportSubmissionsInput struct {
+	FormID        primitive.ObjectID      `json:"form_id" validate:"required"`
+	MerchantID    string                  `json:"merchant_id" validate:"required"`
+	SchemaVersion int                     `json:"schema_version" validate:"min=0"` // 0 selects the current schema
+	Submissions   []ImportSubmissionInput `json:"submissions" validate:"required,min=1,dive"`
+	ImportedBy    string                  `json:"imported_by" validate:"required"`
+}
+
+// ImportSubmissionInput represents a single historical submission
+type ImportSubmissionInput struct {
+	Answers     map[string]interface{} `json:"answers" validate:"required"`
+	SubmittedAt time.Time              `json:"submitted_at" validate:"required"`
+	SubmittedBy string                 `json:"submitted_by"`
+	ExternalID  string                 `json:"external_id"`
+}
+
+// ImportSubmissionsResult summarizes the outcome of an import batch
+type ImportSubmissionsResult struct {
+	SchemaVersion int
+	ImportedCount int
+	Rejected      []RejectedSubmission
+}
+
+// RejectedSubmission describes why a submission in an import batch was not imported
+type RejectedSubmission struct {
+	Index  int
+	Errors []string
+}
+
+// FormResponseStatsInput represents a request for aggregated response statistics of a form
+type FormResponseStatsInput struct {
+	FormID     primitive.ObjectID `json:"form_id" validate:"required"`
+	MerchantID string             `json:"merchant_id" validate:"required"`
+	From       *time.Time         `json:"from,omitempty"` // Optional: inclusive lower bound on submitted_at
+	To         *time.Time         `json:"to,omitempty"`   // Optional: exclusive upper bound on submitted_at
+}
+
+// FormResponseStatsQuery describes the aggregations to run over a form's submissions
+type FormResponseStatsQuery struct {
+	FormID         primitive.ObjectID
+	MerchantID     string
+	From           *time.Time
+	To             *time.Time
+	TimeZone       string   // Time zone used to group submissions by day
+	ChoiceFields   []string // Fields whose answers are counted per value
+	NumericFields  []string // Fields whose answers are averaged
+	CompleteFields []string // Fields that must all be answered for a submission to count as complete
+	// PII fields that must all be answered for a submission to count as complete; their answers are
+	// encrypted, so only their presence is checked
+	CompleteEncryptedFields []string
+}
+
+// FormResponseStats holds aggregated statistics over a form's submissions
+type FormResponseStats struct {
+	FormID            primitive.ObjectID
+	TotalResponses    int64
+	CompleteResponses int64
+	CompletionRate    float64 // CompleteResponses / TotalResponses, 0 when there are no responses
+	ChoiceFields      []ChoiceFieldStats
+	NumericFields     []NumericFieldStats
+	DailyCounts       []DailyResponseCount
+}
+
+// ChoiceFieldStats holds answer counts per choice of an enum field
+type ChoiceFieldStats struct {
+	Field   string
+	Choices []ChoiceCount // Ordered by count, most frequent first
+}
+
+// ChoiceCount holds the number of responses that selected a choice
+type ChoiceCount struct {
+	Value string
+	Count int64
+}
+
+// NumericFieldStats holds summary statistics for a numeric field
+type NumericFieldStats struct {
+	Field   string
+	Count   int64 // Number of responses that answered the field
+	Average float64
+	Min     float64
+	Max     float64
+}
+
+// DailyResponseCount holds the number of responses submitted on a day
+type DailyResponseCount struct {
+	Date  string // Formatted as YYYY-MM-DD in the query time zone
+	Count int64
+}
+
+// SubmissionQueryOptions represents query options for listing a form's submissions
+type SubmissionQueryOptions struct {
+	FormID     primitive.ObjectID     `json:"form_id" validate:"required"`
+	MerchantID string                 `json:"merchant_id" validate:"required"`
+	Filters    map[string]interface{} `json:"filters,omitempty"` // Answer field equality filters
+	Page       int                    `json:"page" validate:"min=1"`
+	PageSize   int                    `json:"page_size" validate:"min=1,max=2000"`
+	SortOrder  string                 `json:"sort_order" validate:"omitempty,oneof=asc desc"` // Sorted by submitted_at
+}
+
+// SubmissionStreamOptions represents options for streaming all of a form's submissions
+type SubmissionStreamOptions struct {
+	FormID     primitive.ObjectID     `json:"form_id" validate:"required"`
+	MerchantID string                 `json:"merchant_id" validate:"required"`
+	Filters    map[string]interface{} `json:"filters,omitempty"`                              // Answer field equality filters
+	From       *time.Time             `json:"from,omitempty"`                                 // Inclusive lower bound on submitted_at
+	To         *time.Time             `json:"to,omitempty"`                                   // Exclusive upper bound on submitted_at
+	SortOrder  string                 `json:"sort_order" validate:"omitempty,oneof=asc desc"` // Sorted by submitted_at
+	BatchSize  int                    `json:"batch_size" validate:"min=1"`                    // Documents fetched per cursor round trip
+}
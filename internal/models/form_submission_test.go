@@ -0,0 +1,26 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormSubmission_TableName(t *testing.T) {
+	submission := FormSubmission{}
+	assert.Equal(t, "form_submissions", submission.TableName())
+}
+
+func TestFormSubmission_SetAndGetTimes(t *testing.T) {
+	submission := FormSubmission{}
+	submittedAt := time.Now().Add(-24 * time.Hour)
+	now := time.Now()
+
+	submission.SetSubmittedAt(submittedAt)
+	submission.SetCreatedAt(now)
+
+	// Allow for small differences due to precision
+	assert.WithinDuration(t, submittedAt, submission.GetSubmittedAt(), time.Millisecond)
+	assert.WithinDuration(t, now, submission.GetCreatedAt(), time.Millisecond)
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/importer"
 )
 
 // FormTemplate represents a reusable form template with JSON schema and UI schema
@@ -13,10 +15,12 @@ type FormTemplate struct {
 	MerchantID string             `bson:"merchant_id"`
 	Schema     interface{}        `bson:"schema"`    // JSON Schema for data structure and validation
 	UISchema   interface{}        `bson:"ui_schema"` // UI Schema for form layout and appearance
+	Version    int                `bson:"version"`   // Incremented every time the template is updated
 	CreatedAt  primitive.DateTime `bson:"created_at"`
 	CreatedBy  string             `bson:"created_by"`
 	UpdatedAt  primitive.DateTime `bson:"updated_at"`
 	UpdatedBy  string             `bson:"updated_by"`
+	ArchivedAt *time.Time         `bson:"archived_at,omitempty"` // Set when a template still used by forms was deleted
 }
 
 // TableName returns the collection name for FormTemplate
@@ -51,6 +55,60 @@ func (ft FormTemplate) IsValid() bool {
 		ft.CreatedBy != ""
 }
 
+// IsArchived reports whether the template was deleted while forms still used it
+func (ft FormTemplate) IsArchived() bool {
+	return ft.ArchivedAt != nil
+}
+
+// FormTemplateVersion is a snapshot of a template that has since been updated.
+// The current version always lives on the FormTemplate document itself.
+type FormTemplateVersion struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	TemplateID primitive.ObjectID `bson:"template_id"`
+	MerchantID string             `bson:"merchant_id"`
+	Version    int                `bson:"version"`
+	Schema     interface{}        `bson:"schema"`
+	UISchema   interface{}        `bson:"ui_schema"`
+	CreatedAt  primitive.DateTime `bson:"created_at"` // When this version was originally created
+	CreatedBy  string             `bson:"created_by"`
+}
+
+// TableName returns the collection name for FormTemplateVersion
+func (FormTemplateVersion) TableName() string {
+	return "form_template_versions"
+}
+
+// TemplateDeletePolicy decides what happens when a template used by forms is deleted
+type TemplateDeletePolicy string
+
+const (
+	// TemplateDeleteBlock rejects the deletion
+	TemplateDeleteBlock TemplateDeletePolicy = "block"
+	// TemplateDeleteDetach removes the template reference from its forms and deletes the template
+	TemplateDeleteDetach TemplateDeletePolicy = "detach"
+	// TemplateDeleteArchive keeps the template for its forms but hides it from the merchant
+	TemplateDeleteArchive TemplateDeletePolicy = "archive"
+)
+
+// IsValid reports whether the policy is known
+func (p TemplateDeletePolicy) IsValid() bool {
+	switch p {
+	case TemplateDeleteBlock, TemplateDeleteDetach, TemplateDeleteArchive:
+		return true
+	default:
+		return false
+	}
+}
+
+// CurrentVersion returns the version of the template.
+// Templates created before versioning was introduced are treated as version 1.
+func (ft FormTemplate) CurrentVersion() int {
+	if ft.Version < 1 {
+		return 1
+	}
+	return ft.Version
+}
+
 // CreateFormTemplateInput represents the input for creating a new form template
 type CreateFormTemplateInput struct {
 	Name       string      `json:"name" validate:"required,min=1,max=100"`
@@ -69,6 +127,33 @@ type UpdateFormTemplateInput struct {
 	UpdatedBy string             `json:"updated_by" validate:"required"`
 }
 
+// ImportFormInput represents the input for importing a form exported from another form builder
+// as a template
+type ImportFormInput struct {
+	Format     string `json:"format" validate:"required"` // Export format, e.g. "google_forms" or "typeform"
+	Data       []byte `json:"data" validate:"required"`   // Exported form as JSON
+	Name       string `json:"name" validate:"max=100"`    // Template name, defaults to the form title
+	DryRun     bool   `json:"dry_run"`                    // Convert without creating the template
+	CreatedBy  string `json:"created_by" validate:"required"`
+	MerchantID string `json:"merchant_id" validate:"required"`
+}
+
+// ImportTemplateInput represents the input for creating a template from an exported template package
+type ImportTemplateInput struct {
+	Package    []byte `json:"package" validate:"required"` // Signed package produced by ExportTemplate
+	Name       string `json:"name" validate:"max=100"`     // Template name, defaults to the name in the package
+	CreatedBy  string `json:"created_by" validate:"required"`
+	MerchantID string `json:"merchant_id" validate:"required"`
+}
+
+// FormImport is the result of importing a form
+type FormImport struct {
+	Template    *FormTemplate // Created template, nil on a dry run
+	Schema      interface{}
+	UISchema    interface{}
+	Unsupported []importer.Unsupported // Questions left out of the schema
+}
+
 // DuplicateFormTemplateInput represents the input for duplicating a form template
 type DuplicateFormTemplateInput struct {
 	SourceID   primitive.ObjectID `json:"source_id" validate:"required"`
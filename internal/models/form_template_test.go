@@ -104,3 +104,8 @@ func TestDuplicateFormTemplateInput_Fields(t *testing.T) {
 	assert.Equal(t, "user123", input.CreatedBy)
 	assert.Equal(t, "merchant123", input.MerchantID)
 }
+
+func TestFormTemplate_CurrentVersion(t *testing.T) {
+	assert.Equal(t, 1, (&FormTemplate{}).CurrentVersion())
+	assert.Equal(t, 3, (&FormTemplate{Version: 3}).CurrentVersion())
+}
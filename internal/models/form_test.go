@@ -128,3 +128,112 @@ func TestFormQueryOptions_Validation(t *testing.T) {
 	assert.Equal(t, 1, options.Page)
 	assert.Equal(t, 20, options.PageSize)
 }
+
+func TestForm_CurrentSchemaVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		form     Form
+		expected int
+	}{
+		{
+			name:     "legacy form without version",
+			form:     Form{},
+			expected: 1,
+		},
+		{
+			name:     "versioned form",
+			form:     Form{SchemaVersion: 4},
+			expected: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.form.CurrentSchemaVersion())
+		})
+	}
+}
+
+func TestForm_HasTemplateID(t *testing.T) {
+	templateID := primitive.NewObjectID()
+
+	assert.True(t, (&Form{TemplateID: &templateID}).HasTemplateID())
+	assert.False(t, (&Form{}).HasTemplateID())
+	assert.False(t, (&Form{TemplateID: &primitive.NilObjectID}).HasTemplateID())
+}
+
+func TestForm_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   FormStatus
+		target   FormStatus
+		expected bool
+	}{
+		{name: "legacy form can be published", status: "", target: FormStatusPublished, expected: true},
+		{name: "draft can be published", status: FormStatusDraft, target: FormStatusPublished, expected: true},
+		{name: "draft cannot be closed", status: FormStatusDraft, target: FormStatusClosed, expected: false},
+		{name: "published can be closed", status: FormStatusPublished, target: FormStatusClosed, expected: true},
+		{name: "published cannot return to draft", status: FormStatusPublished, target: FormStatusDraft, expected: false},
+		{name: "closed can be reopened", status: FormStatusClosed, target: FormStatusPublished, expected: true},
+		{name: "unknown target", status: FormStatusDraft, target: "archived", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := Form{Status: tt.status}
+			assert.Equal(t, tt.expected, form.CanTransitionTo(tt.target))
+		})
+	}
+}
+
+func TestForm_StatusRules(t *testing.T) {
+	draft := Form{}
+	published := Form{Status: FormStatusPublished}
+	closed := Form{Status: FormStatusClosed}
+
+	assert.Equal(t, FormStatusDraft, draft.CurrentStatus())
+	assert.False(t, draft.IsSchemaLocked())
+	assert.False(t, draft.AcceptsSubmissions())
+	assert.True(t, published.IsSchemaLocked())
+	assert.True(t, published.AcceptsSubmissions())
+	assert.True(t, closed.IsSchemaLocked())
+	assert.False(t, closed.AcceptsSubmissions())
+	assert.True(t, FormStatusClosed.IsValid())
+	assert.False(t, FormStatus("archived").IsValid())
+}
+
+func TestForm_AcceptsSubmissionsAt(t *testing.T) {
+	now := time.Now()
+	openAt := now.Add(time.Hour)
+	closeAt := now.Add(-time.Hour)
+
+	published := Form{Status: FormStatusPublished}
+	notYetOpen := Form{Status: FormStatusPublished, OpenAt: &openAt}
+	expired := Form{Status: FormStatusPublished, CloseAt: &closeAt}
+
+	assert.True(t, published.AcceptsSubmissionsAt(now))
+	assert.False(t, notYetOpen.AcceptsSubmissionsAt(now))
+	assert.True(t, notYetOpen.AcceptsSubmissionsAt(openAt))
+	assert.False(t, expired.AcceptsSubmissionsAt(now))
+}
+
+func TestForm_CurrentSubmissionMode(t *testing.T) {
+	assert.Equal(t, SubmissionModeAuthenticated, Form{}.CurrentSubmissionMode())
+	assert.Equal(t, SubmissionModeInviteOnly, Form{SubmissionMode: SubmissionModeInviteOnly}.CurrentSubmissionMode())
+	assert.True(t, SubmissionModeAnonymous.IsValid())
+	assert.False(t, SubmissionMode("public").IsValid())
+}
+
+func TestForm_Retention(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	_, ok := Form{}.RetentionCutoff(now)
+	assert.False(t, ok)
+	cutoff, ok := Form{RetentionDays: 30}.RetentionCutoff(now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 2, 9, 12, 0, 0, 0, time.UTC), cutoff)
+
+	assert.Equal(t, RetentionActionDelete, Form{}.CurrentRetentionAction())
+	assert.Equal(t, RetentionActionAnonymize, Form{RetentionAction: RetentionActionAnonymize}.CurrentRetentionAction())
+	assert.False(t, RetentionAction("archive").IsValid())
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IdempotencyStatus represents the state of a request made with an idempotency key
+type IdempotencyStatus string
+
+// Idempotency statuses
+const (
+	IdempotencyStatusPending   IdempotencyStatus = "pending"   // The first request is still being processed
+	IdempotencyStatusCompleted IdempotencyStatus = "completed" // The response is stored and replayed on retries
+)
+
+// IdempotencyRecord stores the fingerprint and result of a request made with an idempotency key
+type IdempotencyRecord struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Scope       string             `bson:"scope"`     // Caller the key belongs to
+	Operation   string             `bson:"operation"` // Full gRPC method name
+	Key         string             `bson:"key"`
+	Fingerprint string             `bson:"fingerprint"` // Hash of the request message
+	Status      IdempotencyStatus  `bson:"status"`
+	Response    []byte             `bson:"response,omitempty"` // Serialized response message
+	CreatedAt   primitive.DateTime `bson:"created_at"`
+	ExpiresAt   primitive.DateTime `bson:"expires_at"`
+}
+
+// TableName returns the collection name for IdempotencyRecord
+func (IdempotencyRecord) TableName() string {
+	return "idempotency_keys"
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (r *IdempotencyRecord) SetCreatedAt(t time.Time) {
+	r.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetExpiresAt sets the expiry timestamp from time.Time
+func (r *IdempotencyRecord) SetExpiresAt(t time.Time) {
+	r.ExpiresAt = primitive.NewDateTimeFromTime(t)
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// JobStatus represents the state of a queued background job
+type JobStatus string
+
+// Job statuses
+const (
+	JobStatusPending   JobStatus = "pending"   // Waiting for run_at, or for a retry
+	JobStatusRunning   JobStatus = "running"   // Leased by a worker until lease_until
+	JobStatusSucceeded JobStatus = "succeeded" // Finished; removed after the retention period
+	JobStatusFailed    JobStatus = "failed"    // Gave up after the last attempt; removed after the retention period
+)
+
+// Job is a unit of asynchronous work stored in the job queue
+type Job struct {
+	ID          primitive.ObjectID     `bson:"_id,omitempty"`
+	Type        string                 `bson:"type"` // Selects the handler
+	Payload     map[string]interface{} `bson:"payload,omitempty"`
+	Status      JobStatus              `bson:"status"`
+	Attempts    int                    `bson:"attempts"` // Number of times the job was leased
+	MaxAttempts int                    `bson:"max_attempts"`
+	RunAt       time.Time              `bson:"run_at"`                // The job is not leased before this time
+	LeaseUntil  *time.Time             `bson:"lease_until,omitempty"` // A running job is leased again after this time
+	LeasedBy    string                 `bson:"leased_by,omitempty"`   // Worker holding the lease
+	LastError   string                 `bson:"last_error,omitempty"`
+	CreatedAt   primitive.DateTime     `bson:"created_at"`
+	UpdatedAt   primitive.DateTime     `bson:"updated_at"`
+	ExpiresAt   *time.Time             `bson:"expires_at,omitempty"` // Finished jobs are removed at this time
+}
+
+// TableName returns the collection name for Job
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (j *Job) SetCreatedAt(t time.Time) {
+	j.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetUpdatedAt sets the updated timestamp from time.Time
+func (j *Job) SetUpdatedAt(t time.Time) {
+	j.UpdatedAt = primitive.NewDateTimeFromTime(t)
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MerchantKey is a merchant's data key for encrypting PII answers, stored wrapped under a KMS
+// master key. Deleting a merchant's keys makes its encrypted answers unreadable.
+type MerchantKey struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	MerchantID  string             `bson:"merchant_id"`
+	MasterKeyID string             `bson:"master_key_id"` // KMS master key the data key is wrapped under
+	WrappedKey  []byte             `bson:"wrapped_key"`
+	CreatedAt   primitive.DateTime `bson:"created_at"`
+}
+
+// TableName returns the collection name for MerchantKey
+func (MerchantKey) TableName() string {
+	return "merchant_keys"
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (mk *MerchantKey) SetCreatedAt(t time.Time) {
+	mk.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
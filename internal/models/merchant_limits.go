@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MerchantLimits holds a merchant's overrides of the platform business rule limits.
+// A zero value keeps the configured platform default.
+type MerchantLimits struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	MerchantID       string             `bson:"merchant_id"`
+	MaxTemplates     int                `bson:"max_templates"`
+	MaxFormsPerEvent int                `bson:"max_forms_per_event"`
+	CreatedAt        primitive.DateTime `bson:"created_at"`
+	UpdatedAt        primitive.DateTime `bson:"updated_at"`
+	UpdatedBy        string             `bson:"updated_by"`
+}
+
+// TableName returns the collection name for MerchantLimits
+func (MerchantLimits) TableName() string {
+	return "merchant_limits"
+}
+
+// GetUpdatedAt returns the updated timestamp as time.Time
+func (ml MerchantLimits) GetUpdatedAt() time.Time {
+	return ml.UpdatedAt.Time()
+}
+
+// SetMerchantLimitsInput represents a request to set a merchant's limit overrides
+type SetMerchantLimitsInput struct {
+	MerchantID       string `json:"merchant_id" validate:"required"`
+	MaxTemplates     int    `json:"max_templates" validate:"min=0"`       // 0 keeps the platform default
+	MaxFormsPerEvent int    `json:"max_forms_per_event" validate:"min=0"` // 0 keeps the platform default
+	UpdatedBy        string `json:"updated_by" validate:"required"`
+}
+
+// MerchantLimitsQueryOptions represents pagination for listing merchant limit overrides
+type MerchantLimitsQueryOptions struct {
+	Page     int `json:"page" validate:"min=1"`
+	PageSize int `json:"page_size" validate:"min=1,max=2000"`
+}
+
+// EffectiveLimits are the limits applied to a merchant after overrides are resolved
+type EffectiveLimits struct {
+	MerchantID       string
+	MaxTemplates     int
+	MaxFormsPerEvent int             // 0 means unlimited
+	Overrides        *MerchantLimits // nil when the merchant uses the platform defaults
+}
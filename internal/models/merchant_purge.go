@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PurgeStatus represents the state of a merchant data purge
+type PurgeStatus string
+
+// Purge statuses
+const (
+	PurgeStatusPending   PurgeStatus = "pending"   // Queued, no batch processed yet
+	PurgeStatusRunning   PurgeStatus = "running"   // Batches are being deleted
+	PurgeStatusCompleted PurgeStatus = "completed" // All data of the merchant was deleted
+	PurgeStatusFailed    PurgeStatus = "failed"    // Gave up; starting a new purge resumes from the progress
+)
+
+// PurgeResources are the collections holding merchant data, in the order they are purged.
+// Responses and files go first so nothing refers to a deleted form while the purge runs; data keys
+// go last, leaving any encrypted answer that escaped the purge unreadable.
+var PurgeResources = []string{
+	Attendance{}.TableName(),
+	FormSubmission{}.TableName(),
+	FileReference{}.TableName(),
+	FormInvitation{}.TableName(),
+	FormSchemaVersion{}.TableName(),
+	Form{}.TableName(),
+	FormTemplateVersion{}.TableName(),
+	FormTemplate{}.TableName(),
+	FieldBlock{}.TableName(),
+	SubmissionFilterUsage{}.TableName(),
+	MerchantLimits{}.TableName(),
+	MerchantContentRules{}.TableName(),
+	MerchantKey{}.TableName(),
+}
+
+// MerchantPurge records the progress of deleting all data of a merchant
+type MerchantPurge struct {
+	ID          primitive.ObjectID  `bson:"_id,omitempty"`
+	MerchantID  string              `bson:"merchant_id"`
+	Status      PurgeStatus         `bson:"status"`
+	Steps       []PurgeStep         `bson:"steps"` // One per PurgeResources entry, in order
+	RequestedBy string              `bson:"requested_by"`
+	LastError   string              `bson:"last_error,omitempty"`
+	CreatedAt   primitive.DateTime  `bson:"created_at"`
+	UpdatedAt   primitive.DateTime  `bson:"updated_at"`
+	CompletedAt *primitive.DateTime `bson:"completed_at,omitempty"`
+}
+
+// PurgeStep is the progress of a purge in one collection
+type PurgeStep struct {
+	Resource string `bson:"resource"`
+	Deleted  int64  `bson:"deleted"`
+	Done     bool   `bson:"done"`
+}
+
+// TableName returns the collection name for MerchantPurge
+func (MerchantPurge) TableName() string {
+	return "merchant_purges"
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (mp *MerchantPurge) SetCreatedAt(t time.Time) {
+	mp.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetUpdatedAt sets the updated timestamp from time.Time
+func (mp *MerchantPurge) SetUpdatedAt(t time.Time) {
+	mp.UpdatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// Active reports whether the purge still has data to delete
+func (mp MerchantPurge) Active() bool {
+	return mp.Status == PurgeStatusPending || mp.Status == PurgeStatusRunning
+}
+
+// NewMerchantPurge creates a pending purge of a merchant's data
+func NewMerchantPurge(merchantID, requestedBy string) *MerchantPurge {
+	steps := make([]PurgeStep, len(PurgeResources))
+	for i, resource := range PurgeResources {
+		steps[i] = PurgeStep{Resource: resource}
+	}
+	return &MerchantPurge{
+		MerchantID:  merchantID,
+		Status:      PurgeStatusPending,
+		Steps:       steps,
+		RequestedBy: requestedBy,
+	}
+}
+
+// PurgeDocument identifies a merchant document to delete; Key is the storage object key of files
+type PurgeDocument struct {
+	ID  primitive.ObjectID `bson:"_id"`
+	Key string             `bson:"key,omitempty"`
+}
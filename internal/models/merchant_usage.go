@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// MerchantUsage summarizes how much of its quotas a merchant is using
+type MerchantUsage struct {
+	MerchantID    string
+	Templates     int64
+	Forms         int64
+	FormsByStatus map[FormStatus]int64
+	Responses     int64
+	Limits        *EffectiveLimits
+	GeneratedAt   time.Time // Usage may be served from a cache up to the configured TTL old
+}
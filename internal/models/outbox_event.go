@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OutboxStatus represents the delivery state of a domain event in the outbox
+type OutboxStatus string
+
+// Outbox statuses
+const (
+	OutboxStatusPending    OutboxStatus = "pending"    // Waiting for next_attempt_at to be published
+	OutboxStatusPublishing OutboxStatus = "publishing" // Leased by a relay until lease_until
+	OutboxStatusPublished  OutboxStatus = "published"  // Accepted by the message bus; removed after the retention period
+)
+
+// OutboxEvent is a domain event stored with the change that caused it, waiting to be published
+type OutboxEvent struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	EventID       string             `bson:"event_id"` // Envelope ID, kept across redeliveries so subscribers can deduplicate
+	Type          string             `bson:"type"`
+	SchemaVersion int                `bson:"schema_version"`
+	MerchantID    string             `bson:"merchant_id,omitempty"`
+	OccurredAt    time.Time          `bson:"occurred_at"`
+	Data          string             `bson:"data"` // JSON payload
+	Status        OutboxStatus       `bson:"status"`
+	Attempts      int                `bson:"attempts"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	LeaseUntil    *time.Time         `bson:"lease_until,omitempty"`
+	LeasedBy      string             `bson:"leased_by,omitempty"`
+	LastError     string             `bson:"last_error,omitempty"`
+	PublishedAt   *time.Time         `bson:"published_at,omitempty"`
+	CreatedAt     primitive.DateTime `bson:"created_at"`
+	UpdatedAt     primitive.DateTime `bson:"updated_at"`
+	ExpiresAt     *time.Time         `bson:"expires_at,omitempty"` // Published events are removed at this time
+}
+
+// TableName returns the collection name for OutboxEvent
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// SetCreatedAt sets the created timestamp from time.Time
+func (e *OutboxEvent) SetCreatedAt(t time.Time) {
+	e.CreatedAt = primitive.NewDateTimeFromTime(t)
+}
+
+// SetUpdatedAt sets the updated timestamp from time.Time
+func (e *OutboxEvent) SetUpdatedAt(t time.Time) {
+	e.UpdatedAt = primitive.NewDateTimeFromTime(t)
+}
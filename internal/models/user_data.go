@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+// UserDataFields lists, per collection, the fields that hold user IDs. Data subject requests
+// find, export and pseudonymize users through them.
+var UserDataFields = []UserDataCollection{
+	{Collection: Form{}.TableName(), Fields: []string{"created_by", "updated_by"}},
+	{Collection: FormTemplate{}.TableName(), Fields: []string{"created_by", "updated_by"}},
+	{Collection: FormSchemaVersion{}.TableName(), Fields: []string{"created_by"}},
+	{Collection: FormTemplateVersion{}.TableName(), Fields: []string{"created_by"}},
+	{Collection: FieldBlock{}.TableName(), Fields: []string{"created_by", "updated_by"}},
+	{Collection: FormSubmission{}.TableName(), Fields: []string{"submitted_by", "created_by"}},
+	{Collection: FormResponseCounter{}.TableName(), Fields: []string{"user_id"}},
+	{Collection: Attendance{}.TableName(), Fields: []string{"checked_in_by"}},
+	{Collection: FileReference{}.TableName(), Fields: []string{"uploaded_by"}},
+	{Collection: FormInvitation{}.TableName(), Fields: []string{"created_by", "used_by"}},
+	{Collection: MerchantLimits{}.TableName(), Fields: []string{"updated_by"}},
+	{Collection: MerchantContentRules{}.TableName(), Fields: []string{"updated_by"}},
+	{Collection: MerchantPurge{}.TableName(), Fields: []string{"requested_by"}},
+}
+
+// UserDataCollection is a collection and its fields holding user IDs
+type UserDataCollection struct {
+	Collection string
+	Fields     []string
+}
+
+// UserDataExport is every document referring to a user, grouped by collection
+type UserDataExport struct {
+	UserID      string
+	ExportedAt  time.Time
+	Collections map[string][]map[string]interface{} // Documents in relaxed Extended JSON form
+}
+
+// UserDataErasure reports what an erasure changed
+type UserDataErasure struct {
+	UserID      string
+	Pseudonym   string // Replaces the user ID in the documents that were kept
+	Collections []ErasedCollection
+}
+
+// ErasedCollection is the outcome of an erasure in one collection
+type ErasedCollection struct {
+	Collection    string
+	Pseudonymized int64
+	Deleted       int64
+}
+
+// EraseUserDataInput represents a data subject erasure request
+type EraseUserDataInput struct {
+	UserID          string `json:"user_id" validate:"required"`
+	RequestedBy     string `json:"requested_by" validate:"required"`
+	DeleteResponses bool   `json:"delete_responses"` // Delete the user's responses instead of pseudonymizing them
+}
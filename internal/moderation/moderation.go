@@ -0,0 +1,132 @@
+// Package moderation checks the labels of form schemas (field titles and descriptions) against
+// content rules: links and blocked terms.
+package moderation
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// Violation reasons
+const (
+	ReasonURL         = "url"
+	ReasonBlockedTerm = "blocked_term"
+)
+
+// urlPattern matches links starting with a scheme or "www."
+var urlPattern = regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.-]*://|www\.)[^\s<>"]+`)
+
+// extraSpace matches the runs of spaces left behind by stripped content
+var extraSpace = regexp.MustCompile(`[ \t]{2,}`)
+
+// Rules are the content rules applied to labels
+type Rules struct {
+	BlockURLs    bool
+	BlockedTerms []string // Matched case-insensitively, as whole words for terms made of letters and digits
+}
+
+// Violation is a label breaking the rules. Field is the dotted path of the field, empty for the
+// schema itself.
+type Violation struct {
+	Field   string
+	Keyword string // "title" or "description"
+	Reason  string
+}
+
+// Filter applies rules to labels
+type Filter struct {
+	urls  bool
+	terms *regexp.Regexp // nil without blocked terms
+}
+
+// NewFilter compiles rules into a filter
+func NewFilter(rules Rules) *Filter {
+	f := &Filter{urls: rules.BlockURLs}
+
+	var alternatives []string
+	for _, term := range rules.BlockedTerms {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		pattern := regexp.QuoteMeta(term)
+		// \b only knows ASCII word characters, so other terms (such as CJK ones) match anywhere
+		if wordBounded(term) {
+			pattern = `\b` + pattern + `\b`
+		}
+		alternatives = append(alternatives, pattern)
+	}
+	if len(alternatives) > 0 {
+		f.terms = regexp.MustCompile(`(?i)(?:` + strings.Join(alternatives, "|") + `)`)
+	}
+	return f
+}
+
+// Empty reports whether the filter has no rules
+func (f *Filter) Empty() bool {
+	return !f.urls && f.terms == nil
+}
+
+// Check returns the reasons a label breaks the rules
+func (f *Filter) Check(label string) []string {
+	var reasons []string
+	if f.urls && urlPattern.MatchString(label) {
+		reasons = append(reasons, ReasonURL)
+	}
+	if f.terms != nil && f.terms.MatchString(label) {
+		reasons = append(reasons, ReasonBlockedTerm)
+	}
+	return reasons
+}
+
+// Strip removes links and blocked terms from a label
+func (f *Filter) Strip(label string) string {
+	stripped := label
+	if f.urls {
+		stripped = urlPattern.ReplaceAllString(stripped, "")
+	}
+	if f.terms != nil {
+		stripped = f.terms.ReplaceAllString(stripped, "")
+	}
+	if stripped == label {
+		return label
+	}
+	return strings.TrimSpace(extraSpace.ReplaceAllString(stripped, " "))
+}
+
+// CheckSchema returns the violations found in the labels of a schema
+func (f *Filter) CheckSchema(s interface{}) []Violation {
+	if f.Empty() {
+		return nil
+	}
+	var violations []Violation
+	schema.RewriteLabels(s, func(field, keyword, label string) string {
+		for _, reason := range f.Check(label) {
+			violations = append(violations, Violation{Field: field, Keyword: keyword, Reason: reason})
+		}
+		return label
+	})
+	return violations
+}
+
+// StripSchema returns a copy of a schema with links and blocked terms removed from its labels
+func (f *Filter) StripSchema(s interface{}) interface{} {
+	if f.Empty() {
+		return s
+	}
+	return schema.RewriteLabels(s, func(_, _, label string) string {
+		return f.Strip(label)
+	})
+}
+
+// wordBounded reports whether a term starts and ends with an ASCII word character, where \b
+// can mark its boundaries
+func wordBounded(term string) bool {
+	return isWordByte(term[0]) && isWordByte(term[len(term)-1])
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
@@ -0,0 +1,68 @@
+package moderation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter_Check(t *testing.T) {
+	f := NewFilter(Rules{BlockURLs: true, BlockedTerms: []string{"darn", "壞話", " "}})
+
+	tests := []struct {
+		label    string
+		expected []string
+	}{
+		{"Your name", nil},
+		{"See https://example.com/terms", []string{ReasonURL}},
+		{"Visit www.example.com", []string{ReasonURL}},
+		{"Darn it", []string{ReasonBlockedTerm}},
+		{"Darned socks", nil},
+		{"這是壞話嗎", []string{ReasonBlockedTerm}},
+		{"darn www.example.com", []string{ReasonURL, ReasonBlockedTerm}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			assert.Equal(t, tt.expected, f.Check(tt.label))
+		})
+	}
+}
+
+func TestFilter_Strip(t *testing.T) {
+	f := NewFilter(Rules{BlockURLs: true, BlockedTerms: []string{"darn"}})
+
+	assert.Equal(t, "See for details", f.Strip("See https://example.com/terms for details"))
+	assert.Equal(t, "it", f.Strip("Darn it"))
+	assert.Equal(t, "Your  name", f.Strip("Your  name"))
+}
+
+func TestFilter_Schema(t *testing.T) {
+	s := map[string]interface{}{
+		"type":  "object",
+		"title": "Signup at www.example.com",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string", "title": "Name"},
+			"guests": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{"type": "object", "properties": map[string]interface{}{
+					"note": map[string]interface{}{"type": "string", "description": "No darn jokes"},
+				}},
+			},
+		},
+	}
+	f := NewFilter(Rules{BlockURLs: true, BlockedTerms: []string{"darn"}})
+
+	assert.Equal(t, []Violation{
+		{Field: "", Keyword: "title", Reason: ReasonURL},
+		{Field: "guests[].note", Keyword: "description", Reason: ReasonBlockedTerm},
+	}, f.CheckSchema(s))
+
+	stripped := f.StripSchema(s).(map[string]interface{})
+	assert.Equal(t, "Signup at", stripped["title"])
+	note := stripped["properties"].(map[string]interface{})["guests"].(map[string]interface{})["items"].(map[string]interface{})["properties"].(map[string]interface{})["note"].(map[string]interface{})
+	assert.Equal(t, "No jokes", note["description"])
+	assert.Equal(t, "Signup at www.example.com", s["title"], "the original schema is not changed")
+
+	assert.Nil(t, NewFilter(Rules{}).CheckSchema(s))
+}
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/arwoosa/vulpes/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys identifying the caller
+const (
+	forwardedForKey = "x-forwarded-for" // Set by grpc-gateway from the HTTP client address
+	merchantIDKey   = "merchant-id"     // Set by the vulpes header matcher from x-merchant-id
+)
+
+// clientIPKey holds the client address resolved by ClientIPInterceptor in the call context
+type clientIPKey struct{}
+
+// Limiter applies per-IP and per-merchant token bucket limits to a set of gRPC methods
+type Limiter struct {
+	store       Store
+	perIP       Rule
+	perMerchant Rule
+	methods     map[string]bool
+}
+
+// NewLimiter creates a limiter for the given full method names (e.g. "/form.service.FormService/SubmitFormResponse").
+// A rule with a zero rate or burst is disabled.
+func NewLimiter(store Store, perIP, perMerchant Rule, methods []string) *Limiter {
+	limited := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		limited[method] = true
+	}
+	return &Limiter{
+		store:       store,
+		perIP:       perIP,
+		perMerchant: perMerchant,
+		methods:     limited,
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor rejecting calls over a limit with ResourceExhausted.
+// Store failures are logged and the call is let through, so an unavailable store never blocks traffic.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !l.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		if ip := ClientIP(ctx); ip != "" && l.perIP.Enabled() {
+			if !l.allow(ctx, "ip:"+ip, l.perIP, info.FullMethod) {
+				return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", ip)
+			}
+		}
+		if merchantID := metadataValue(ctx, merchantIDKey); merchantID != "" && l.perMerchant.Enabled() {
+			if !l.allow(ctx, "merchant:"+merchantID, l.perMerchant, info.FullMethod) {
+				return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for merchant %s", merchantID)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (l *Limiter) allow(ctx context.Context, key string, rule Rule, method string) bool {
+	allowed, err := l.store.Allow(ctx, key, rule)
+	if err != nil {
+		log.Warn("Rate limit store unavailable", log.Err(err), log.String("method", method))
+		return true
+	}
+	if !allowed {
+		log.Info("Rate limit exceeded", log.String("key", key), log.String("method", method))
+	}
+	return allowed
+}
+
+// ClientIPInterceptor returns an interceptor resolving the client address of each call for
+// ClientIP. trustedProxies is the number of proxies in front of the gateway, each appending the
+// address it received the request from to X-Forwarded-For.
+func ClientIPInterceptor(trustedProxies int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(context.WithValue(ctx, clientIPKey{}, resolveClientIP(ctx, trustedProxies)), req)
+	}
+}
+
+// ClientIP returns the client address resolved by ClientIPInterceptor, or resolves it assuming
+// no proxy in front of the gateway
+func ClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return resolveClientIP(ctx, 0)
+}
+
+// resolveClientIP returns the peer address of the gRPC connection. Calls through the gateway
+// come from the loopback address; for them the address is read from X-Forwarded-For, counting
+// from the right past the trusted proxies, as entries further left are set by the client.
+func resolveClientIP(ctx context.Context, trustedProxies int) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get(forwardedForKey) {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	if len(hops) == 0 {
+		return host
+	}
+	return hops[max(len(hops)-1-trustedProxies, 0)]
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,179 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/form.service.FormService/SubmitFormResponse"
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+type failingStore struct{}
+
+func (failingStore) Allow(context.Context, string, Rule) (bool, error) {
+	return false, errors.New("connection refused")
+}
+
+func peerContext(addr string, md metadata.MD) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+	if md != nil {
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	return ctx
+}
+
+func TestMemoryStore_Allow(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	rule := Rule{Rate: 1, Burst: 2}
+	ctx := context.Background()
+
+	for _, expected := range []bool{true, true, false} {
+		allowed, err := store.Allow(ctx, "ip:1.2.3.4", rule)
+		require.NoError(t, err)
+		assert.Equal(t, expected, allowed)
+	}
+
+	// Other keys have their own bucket
+	allowed, _ := store.Allow(ctx, "ip:5.6.7.8", rule)
+	assert.True(t, allowed)
+
+	// Tokens are refilled over time
+	now = now.Add(time.Second)
+	allowed, _ = store.Allow(ctx, "ip:1.2.3.4", rule)
+	assert.True(t, allowed)
+}
+
+func TestMemoryStore_SweepsIdleBuckets(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, _ = store.Allow(ctx, "ip:1.2.3.4", Rule{Rate: 1, Burst: 1})
+	now = now.Add(memoryIdleTTL)
+	_, _ = store.Allow(ctx, "ip:5.6.7.8", Rule{Rate: 1, Burst: 1})
+
+	assert.NotContains(t, store.buckets, "ip:1.2.3.4")
+	assert.Contains(t, store.buckets, "ip:5.6.7.8")
+}
+
+func TestMemoryStore_KeepsRefillingBuckets(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	hourly := Rule{Rate: 1.0 / 3600, Burst: 1}
+
+	allowed, _ := store.Allow(ctx, "ip:1.2.3.4", hourly)
+	require.True(t, allowed)
+	now = now.Add(2 * memoryIdleTTL)
+	_, _ = store.Allow(ctx, "ip:5.6.7.8", hourly)
+
+	allowed, _ = store.Allow(ctx, "ip:1.2.3.4", hourly)
+	assert.False(t, allowed)
+}
+
+func TestLimiter_PerIP(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), Rule{Rate: 0.001, Burst: 1}, Rule{}, []string{testMethod})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	ctx := peerContext("10.0.0.1:5000", nil)
+
+	_, err := interceptor(ctx, nil, info, okHandler)
+	require.NoError(t, err)
+
+	_, err = interceptor(ctx, nil, info, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Another client is not affected
+	_, err = interceptor(peerContext("10.0.0.2:5000", nil), nil, info, okHandler)
+	assert.NoError(t, err)
+}
+
+func TestLimiter_ForwardedFor(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), Rule{Rate: 0.001, Burst: 1}, Rule{}, []string{testMethod})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	// Calls through the gateway share the gateway's peer address but not the client address,
+	// which the gateway appends to the entries sent by the client
+	_, err := interceptor(peerContext("127.0.0.1:5000", metadata.Pairs(forwardedForKey, "203.0.113.1, 10.0.0.1")), nil, info, okHandler)
+	require.NoError(t, err)
+	_, err = interceptor(peerContext("127.0.0.1:5000", metadata.Pairs(forwardedForKey, "10.0.0.2")), nil, info, okHandler)
+	require.NoError(t, err)
+	// Rotating the entries set by the client does not change its address
+	_, err = interceptor(peerContext("127.0.0.1:5001", metadata.Pairs(forwardedForKey, "203.0.113.9, 10.0.0.1")), nil, info, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestClientIP(t *testing.T) {
+	resolved := func(ctx context.Context, trustedProxies int) string {
+		var ip string
+		_, err := ClientIPInterceptor(trustedProxies)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				ip = ClientIP(ctx)
+				return nil, nil
+			})
+		require.NoError(t, err)
+		return ip
+	}
+	forwarded := metadata.Pairs(forwardedForKey, "203.0.113.1, 198.51.100.7, 10.0.0.1")
+
+	assert.Equal(t, "10.0.0.1", resolved(peerContext("127.0.0.1:5000", forwarded), 0))
+	assert.Equal(t, "198.51.100.7", resolved(peerContext("127.0.0.1:5000", forwarded), 1))
+	assert.Equal(t, "203.0.113.1", resolved(peerContext("127.0.0.1:5000", forwarded), 5))
+	// Direct gRPC callers cannot choose their address
+	assert.Equal(t, "192.0.2.4", resolved(peerContext("192.0.2.4:5000", forwarded), 0))
+	assert.Equal(t, "127.0.0.1", resolved(peerContext("127.0.0.1:5000", nil), 0))
+}
+
+func TestLimiter_PerMerchant(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), Rule{}, Rule{Rate: 0.001, Burst: 1}, []string{testMethod})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	_, err := interceptor(peerContext("10.0.0.1:5000", metadata.Pairs(merchantIDKey, "merchant123")), nil, info, okHandler)
+	require.NoError(t, err)
+	_, err = interceptor(peerContext("10.0.0.2:5000", metadata.Pairs(merchantIDKey, "merchant123")), nil, info, okHandler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestLimiter_UnlistedMethod(t *testing.T) {
+	limiter := NewLimiter(NewMemoryStore(), Rule{Rate: 0.001, Burst: 1}, Rule{}, []string{testMethod})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/form.service.FormService/ListFormTemplates"}
+	ctx := peerContext("10.0.0.1:5000", nil)
+
+	for i := 0; i < 3; i++ {
+		_, err := interceptor(ctx, nil, info, okHandler)
+		assert.NoError(t, err)
+	}
+}
+
+func TestLimiter_StoreErrorFailsOpen(t *testing.T) {
+	limiter := NewLimiter(failingStore{}, Rule{Rate: 1, Burst: 1}, Rule{}, []string{testMethod})
+	interceptor := limiter.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	resp, err := interceptor(peerContext("10.0.0.1:5000", nil), nil, info, okHandler)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// tokenBucketScript refills and takes a token from a bucket stored as a hash in a single
+// atomic step. KEYS[1] is the bucket; ARGV holds the rate, burst and current time in milliseconds.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tokens, "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000))
+return allowed
+`)
+
+// RedisStore keeps token buckets in Redis so that limits are shared by all replicas
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore creates a new Redis store; keys are prefixed to share a database with other data
+func NewRedisStore(client *redis.Client, prefix string) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Allow implements Store.Allow
+func (s *RedisStore) Allow(ctx context.Context, key string, rule Rule) (bool, error) {
+	now := time.Now().UnixMilli()
+	allowed, err := tokenBucketScript.Run(ctx, s.client, []string{s.prefix + key}, rule.Rate, rule.Burst, now).Int()
+	if err != nil {
+		return false, err
+	}
+	return allowed == 1, nil
+}
@@ -0,0 +1,86 @@
+// Package ratelimit provides token bucket rate limiting for gRPC endpoints, keyed by
+// client IP and merchant and backed by an in-memory or Redis store.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Rule describes a token bucket: Rate tokens are added per second up to Burst tokens
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// Enabled reports whether the rule limits anything
+func (r Rule) Enabled() bool {
+	return r.Rate > 0 && r.Burst > 0
+}
+
+// Store keeps the token buckets of rate limited keys
+type Store interface {
+	// Allow takes a token from the bucket of the key, reporting false when the bucket is empty
+	Allow(ctx context.Context, key string, rule Rule) (bool, error)
+}
+
+// memoryIdleTTL is how long an unused bucket is kept; a full bucket carries no state worth keeping
+const memoryIdleTTL = 10 * time.Minute
+
+// MemoryStore keeps token buckets in process memory. Limits are per instance, so with several
+// replicas the effective limit is multiplied by the number of replicas.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type memoryBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewMemoryStore creates a new in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*memoryBucket),
+		now:     time.Now,
+	}
+}
+
+// Allow implements Store.Allow
+func (s *MemoryStore) Allow(_ context.Context, key string, rule Rule) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{limiter: rate.NewLimiter(rate.Limit(rule.Rate), rule.Burst)}
+		s.buckets[key] = bucket
+	}
+	bucket.lastSeen = now
+
+	return bucket.limiter.AllowN(now, 1), nil
+}
+
+// sweep drops idle buckets so that the map does not grow with every client ever seen. Buckets
+// still refilling are kept, so that slow rules (e.g. per hour) are not reset by a short pause.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memoryIdleTTL {
+		return
+	}
+	for key, bucket := range s.buckets {
+		full := bucket.limiter.TokensAt(now) >= float64(bucket.limiter.Burst())
+		if full && now.Sub(bucket.lastSeen) >= memoryIdleTTL {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+// BlockKey marks a top-level field as a reference to a field block of the merchant, e.g.
+// "emergency_contact": {"$block": "<block id>", "title": "In case of emergency"}. References are
+// expanded into an object field holding the block's questions when a form or template is saved;
+// the other keywords of the reference override those of the block.
+const BlockKey = "$block"
+
+// BlockRefs returns the IDs of the field blocks a schema refers to, sorted and without duplicates
+func BlockRefs(s interface{}) ([]string, error) {
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+
+	var ids []string
+	seen := make(map[string]bool)
+	for _, name := range sortedKeys(properties) {
+		p, _ := properties[name].(map[string]interface{})
+		raw, ok := p[BlockKey]
+		if !ok {
+			continue
+		}
+		id, ok := raw.(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("field %q: block reference must be a non-empty string", name)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// ExpandBlocks returns a copy of a schema with its block references replaced by the schemas of
+// the blocks, keyed by block ID. A schema without references is returned unchanged.
+func ExpandBlocks(s interface{}, blocks map[string]interface{}) (interface{}, error) {
+	ids, err := BlockRefs(s)
+	if err != nil || len(ids) == 0 {
+		return s, err
+	}
+
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+	for _, name := range sortedKeys(properties) {
+		ref, _ := properties[name].(map[string]interface{})
+		id, ok := ref[BlockKey].(string)
+		if !ok {
+			continue
+		}
+		block, ok := Normalize(blocks[id]).(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("field %q refers to unknown field block %q", name, id)
+		}
+
+		field := make(map[string]interface{}, len(block)+len(ref))
+		for key, value := range block {
+			field[key] = value
+		}
+		for key, value := range ref {
+			if key != BlockKey {
+				field[key] = value
+			}
+		}
+		if _, ok := field["type"]; !ok {
+			field["type"] = "object"
+		}
+		properties[name] = field
+	}
+	return root, nil
+}
+
+// CheckBlock checks that the schema of a field block is an object schema with questions and
+// no block references of its own
+func CheckBlock(s interface{}) error {
+	root, ok := Normalize(s).(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("block schema must be an object")
+	}
+	if t, ok := root["type"]; ok && t != "object" {
+		return fmt.Errorf("block schema must be of type object")
+	}
+	properties, _ := root["properties"].(map[string]interface{})
+	if len(properties) == 0 {
+		return fmt.Errorf("block schema must have properties")
+	}
+	ids, err := BlockRefs(root)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		return fmt.Errorf("block schema cannot refer to other blocks")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRefs(t *testing.T) {
+	ids, err := BlockRefs(map[string]interface{}{
+		"properties": map[string]interface{}{
+			"name":      map[string]interface{}{"type": "string"},
+			"secondary": map[string]interface{}{BlockKey: "b2"},
+			"emergency": map[string]interface{}{BlockKey: "b2"},
+			"diet":      map[string]interface{}{BlockKey: "b1"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b1", "b2"}, ids)
+
+	_, err = BlockRefs(map[string]interface{}{
+		"properties": map[string]interface{}{"diet": map[string]interface{}{BlockKey: 1}},
+	})
+	assert.Error(t, err)
+}
+
+func TestExpandBlocks(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"diet": map[string]interface{}{BlockKey: "b1", "description": "Tell us what you eat"},
+		},
+	}
+	blocks := map[string]interface{}{
+		"b1": map[string]interface{}{
+			"title":      "Dietary needs",
+			"properties": map[string]interface{}{"vegetarian": map[string]interface{}{"type": "boolean"}},
+		},
+	}
+
+	expanded, err := ExpandBlocks(s, blocks)
+
+	require.NoError(t, err)
+	diet := expanded.(map[string]interface{})["properties"].(map[string]interface{})["diet"]
+	assert.Equal(t, map[string]interface{}{
+		"type":        "object",
+		"title":       "Dietary needs",
+		"description": "Tell us what you eat",
+		"properties":  map[string]interface{}{"vegetarian": map[string]interface{}{"type": "boolean"}},
+	}, diet)
+	// The original schema is left unchanged
+	assert.Contains(t, s["properties"].(map[string]interface{})["diet"], BlockKey)
+
+	_, err = ExpandBlocks(s, nil)
+	assert.Error(t, err)
+}
+
+func TestCheckBlock(t *testing.T) {
+	assert.NoError(t, CheckBlock(map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"phone": map[string]interface{}{"type": "string"}},
+	}))
+	assert.Error(t, CheckBlock("not a schema"))
+	assert.Error(t, CheckBlock(map[string]interface{}{"type": "object"}))
+}
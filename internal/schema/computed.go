@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/arwoosa/form/internal/expr"
+)
+
+// ComputedKey marks a top-level field as computed from the answers to other top-level fields,
+// e.g. "total": {"type": "number", "computed": "quantity * price"}. Computed values are
+// evaluated at submission time and stored with the answers; respondents cannot answer them.
+const ComputedKey = "computed"
+
+// ComputedFields returns the parsed expressions of the computed top-level fields of a schema.
+// Fields with an invalid expression are reported as an error.
+func ComputedFields(s interface{}) (map[string]*expr.Expr, error) {
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+
+	fields := make(map[string]*expr.Expr)
+	for _, name := range sortedKeys(properties) {
+		p, _ := properties[name].(map[string]interface{})
+		raw, ok := p[ComputedKey]
+		if !ok {
+			continue
+		}
+		src, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("computed field %q: expression must be a string", name)
+		}
+		e, err := expr.Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("computed field %q: %w", name, err)
+		}
+		fields[name] = e
+	}
+	return fields, nil
+}
+
+// CheckComputed checks that the computed fields of a schema have valid expressions that only
+// refer to other top-level fields which are not computed themselves
+func CheckComputed(s interface{}) error {
+	fields, err := ComputedFields(s)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, variable := range fields[name].Vars() {
+			if _, ok := properties[variable]; !ok {
+				return fmt.Errorf("computed field %q refers to unknown field %q", name, variable)
+			}
+			if _, ok := fields[variable]; ok {
+				return fmt.Errorf("computed field %q refers to computed field %q", name, variable)
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyComputed replaces the answers to the computed fields of a schema with their values
+// computed from the other answers. A value is left out when an answer it needs is missing or
+// not a number, or when it cannot be computed, e.g. on a division by zero; the schema then
+// decides whether the field was required.
+func ApplyComputed(s interface{}, answers map[string]interface{}) error {
+	fields, err := ComputedFields(s)
+	if err != nil || len(fields) == 0 || answers == nil {
+		return err
+	}
+
+	vars := make(map[string]float64, len(answers))
+	for name, answer := range answers {
+		if _, computed := fields[name]; computed {
+			continue
+		}
+		if value, ok := ToFloat(answer); ok {
+			vars[name] = value
+		}
+	}
+
+	for name, e := range fields {
+		delete(answers, name)
+		if value, err := e.Eval(vars); err == nil {
+			answers[name] = value
+		}
+	}
+	return nil
+}
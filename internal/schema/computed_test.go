@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	apperrors "github.com/arwoosa/form/internal/errors"
+)
+
+func computedSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"quantity":   map[string]interface{}{"type": "integer", "minimum": 1},
+			"price":      map[string]interface{}{"type": "number"},
+			"total":      map[string]interface{}{"type": "number", "maximum": 100, "computed": "round(quantity * price, 2)"},
+			"per_item":   map[string]interface{}{"type": "number", "computed": "total_paid / quantity"},
+			"total_paid": map[string]interface{}{"type": "number"},
+		},
+		"required": []interface{}{"quantity", "total"},
+	}
+}
+
+func TestApplyComputed(t *testing.T) {
+	answers := map[string]interface{}{"quantity": 3, "price": 12.345, "total": 1, "total_paid": 0.0}
+
+	require.NoError(t, ApplyComputed(computedSchema(), answers))
+
+	// Respondent values are replaced; values that cannot be computed are left out
+	assert.Equal(t, 37.04, answers["total"])
+	assert.Equal(t, 0.0, answers["per_item"])
+	assert.Empty(t, Validate(computedSchema(), answers))
+}
+
+func TestApplyComputed_ValidatedAgainstSchema(t *testing.T) {
+	tooMuch := map[string]interface{}{"quantity": 10, "price": 20}
+	require.NoError(t, ApplyComputed(computedSchema(), tooMuch))
+	assert.Equal(t, []string{"total"}, errorFields(Validate(computedSchema(), tooMuch)))
+
+	// Missing or non-numeric answers leave the computed value out
+	missing := map[string]interface{}{"quantity": 0, "price": "free", "per_item": 5}
+	require.NoError(t, ApplyComputed(computedSchema(), missing))
+	assert.NotContains(t, missing, "total")
+	assert.NotContains(t, missing, "per_item")
+}
+
+func TestApplyComputed_StoredSchemas(t *testing.T) {
+	s := primitive.D{
+		{Key: "type", Value: "object"},
+		{Key: "properties", Value: primitive.D{
+			{Key: "a", Value: primitive.D{{Key: "type", Value: "number"}}},
+			{Key: "double", Value: primitive.D{{Key: "type", Value: "number"}, {Key: ComputedKey, Value: "a * 2"}}},
+		}},
+	}
+	answers := map[string]interface{}{"a": int32(4)}
+
+	require.NoError(t, ApplyComputed(s, answers))
+
+	assert.Equal(t, 8.0, answers["double"])
+}
+
+func TestCheckComputed(t *testing.T) {
+	assert.NoError(t, CheckComputed(computedSchema()))
+	assert.NoError(t, CheckComputed(map[string]interface{}{"type": "object"}))
+
+	tests := []struct {
+		name     string
+		computed interface{}
+	}{
+		{name: "not a string", computed: 42},
+		{name: "syntax error", computed: "quantity *"},
+		{name: "unknown field", computed: "quantity * discount"},
+		{name: "computed operand", computed: "per_item * 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := computedSchema()
+			s["properties"].(map[string]interface{})["extra"] = map[string]interface{}{"type": "number", "computed": tt.computed}
+			assert.Error(t, CheckComputed(s))
+		})
+	}
+}
+
+func errorFields(errs []*apperrors.ValidationError) []string {
+	fields := make([]string, len(errs))
+	for i, err := range errs {
+		fields[i] = err.Field
+	}
+	return fields
+}
@@ -0,0 +1,356 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	apperrors "github.com/arwoosa/form/internal/errors"
+)
+
+// ConditionsKey is the UI Schema keyword listing the top-level fields shown only under conditions.
+// Each entry names a field, the clauses on other answers under which it is shown, whether all
+// ("all", the default) or any of them must hold, and whether the field is required when shown:
+//
+//	"ui:conditions": [
+//	  {"field": "allergies", "when": [{"field": "has_allergies", "equals": "yes"}], "required": true}
+//	]
+//
+// A clause tests one answer with exactly one of the operators equals, not_equals, in (a list of
+// values), contains (a value of an array answer) or answered (true or false).
+const ConditionsKey = "ui:conditions"
+
+// Clause operators
+const (
+	OperatorEquals    = "equals"
+	OperatorNotEquals = "not_equals"
+	OperatorIn        = "in"
+	OperatorContains  = "contains"
+	OperatorAnswered  = "answered"
+)
+
+var clauseOperators = []string{OperatorEquals, OperatorNotEquals, OperatorIn, OperatorContains, OperatorAnswered}
+
+// Condition shows a top-level field only when its clauses hold
+type Condition struct {
+	Field    string
+	When     []Clause
+	Any      bool // Any clause shows the field, instead of all of them
+	Required bool // The field must be answered when shown
+}
+
+// Clause tests the answer to a top-level field
+type Clause struct {
+	Field    string
+	Operator string
+	Value    interface{}
+}
+
+// Conditions returns the conditions declared in a UI Schema, or an error when they are malformed
+func Conditions(uiSchema interface{}) ([]Condition, error) {
+	root, _ := Normalize(uiSchema).(map[string]interface{})
+	raw, ok := root[ConditionsKey]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	entries, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", ConditionsKey)
+	}
+
+	conditions := make([]Condition, 0, len(entries))
+	for i, entry := range entries {
+		condition, err := parseCondition(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", ConditionsKey, i, err)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func parseCondition(entry interface{}) (Condition, error) {
+	m, ok := entry.(map[string]interface{})
+	if !ok {
+		return Condition{}, fmt.Errorf("must be an object")
+	}
+
+	condition := Condition{}
+	if condition.Field, ok = m["field"].(string); !ok || condition.Field == "" {
+		return Condition{}, fmt.Errorf("field is required")
+	}
+	switch match := m["match"]; match {
+	case nil, "all":
+	case "any":
+		condition.Any = true
+	default:
+		return Condition{}, fmt.Errorf("match must be \"all\" or \"any\"")
+	}
+	if required, exists := m["required"]; exists {
+		if condition.Required, ok = required.(bool); !ok {
+			return Condition{}, fmt.Errorf("required must be a boolean")
+		}
+	}
+
+	clauses, ok := m["when"].([]interface{})
+	if !ok || len(clauses) == 0 {
+		return Condition{}, fmt.Errorf("when must list at least one clause")
+	}
+	for i, raw := range clauses {
+		clause, err := parseClause(raw)
+		if err != nil {
+			return Condition{}, fmt.Errorf("when[%d]: %w", i, err)
+		}
+		condition.When = append(condition.When, clause)
+	}
+	return condition, nil
+}
+
+func parseClause(raw interface{}) (Clause, error) {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return Clause{}, fmt.Errorf("must be an object")
+	}
+
+	clause := Clause{}
+	if clause.Field, ok = m["field"].(string); !ok || clause.Field == "" {
+		return Clause{}, fmt.Errorf("field is required")
+	}
+	for _, operator := range clauseOperators {
+		value, exists := m[operator]
+		if !exists {
+			continue
+		}
+		if clause.Operator != "" {
+			return Clause{}, fmt.Errorf("only one of %v is allowed", clauseOperators)
+		}
+		clause.Operator, clause.Value = operator, value
+	}
+
+	switch clause.Operator {
+	case "":
+		return Clause{}, fmt.Errorf("one of %v is required", clauseOperators)
+	case OperatorIn:
+		if _, ok := clause.Value.([]interface{}); !ok {
+			return Clause{}, fmt.Errorf("in must be a list")
+		}
+	case OperatorAnswered:
+		if _, ok := clause.Value.(bool); !ok {
+			return Clause{}, fmt.Errorf("answered must be a boolean")
+		}
+	}
+	return clause, nil
+}
+
+// CheckConditions checks that the conditions of a UI Schema are well formed and fit the schema:
+// they refer to its top-level fields, each field has at most one condition and no field
+// depends on itself, directly or through other conditions.
+func CheckConditions(s, uiSchema interface{}) error {
+	conditions, err := Conditions(uiSchema)
+	if err != nil || len(conditions) == 0 {
+		return err
+	}
+
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+
+	dependencies := make(map[string][]string, len(conditions))
+	for _, condition := range conditions {
+		if _, ok := properties[condition.Field]; !ok {
+			return fmt.Errorf("%s: %q is not a field of the schema", ConditionsKey, condition.Field)
+		}
+		if _, ok := dependencies[condition.Field]; ok {
+			return fmt.Errorf("%s: %q has more than one condition", ConditionsKey, condition.Field)
+		}
+		dependencies[condition.Field] = nil
+		for _, clause := range condition.When {
+			if _, ok := properties[clause.Field]; !ok {
+				return fmt.Errorf("%s: condition of %q refers to unknown field %q", ConditionsKey, condition.Field, clause.Field)
+			}
+			dependencies[condition.Field] = append(dependencies[condition.Field], clause.Field)
+		}
+	}
+
+	// Depth-first search for a field reachable from itself
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(dependencies))
+	var visit func(field string) error
+	visit = func(field string) error {
+		switch state[field] {
+		case visiting:
+			return fmt.Errorf("%s: the condition of %q depends on itself", ConditionsKey, field)
+		case visited:
+			return nil
+		}
+		state[field] = visiting
+		for _, dependency := range dependencies[field] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		state[field] = visited
+		return nil
+	}
+	fields := make([]string, 0, len(dependencies))
+	for field := range dependencies {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		if err := visit(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HiddenFields returns the fields whose conditions do not hold for the answers. Answers to
+// hidden fields are ignored when evaluating other conditions, so a field depending on a hidden
+// field is evaluated as if that field was not answered.
+func HiddenFields(conditions []Condition, answers map[string]interface{}) map[string]bool {
+	hidden := make(map[string]bool)
+	// Each pass settles at least one more level of dependencies; conditions are acyclic
+	for pass := 0; pass <= len(conditions); pass++ {
+		changed := false
+		for _, condition := range conditions {
+			shown := condition.holds(answers, hidden)
+			if shown == hidden[condition.Field] {
+				if shown {
+					delete(hidden, condition.Field)
+				} else {
+					hidden[condition.Field] = true
+				}
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return hidden
+}
+
+func (c Condition) holds(answers map[string]interface{}, hidden map[string]bool) bool {
+	for _, clause := range c.When {
+		var answer interface{}
+		if !hidden[clause.Field] {
+			answer = answers[clause.Field]
+		}
+		matched := clause.matches(answer)
+		if c.Any && matched {
+			return true
+		}
+		if !c.Any && !matched {
+			return false
+		}
+	}
+	return !c.Any
+}
+
+func (c Clause) matches(answer interface{}) bool {
+	switch c.Operator {
+	case OperatorEquals:
+		return answer != nil && equalValues(c.Value, answer)
+	case OperatorNotEquals:
+		return answer == nil || !equalValues(c.Value, answer)
+	case OperatorIn:
+		values, _ := c.Value.([]interface{})
+		return answer != nil && containsValue(values, answer)
+	case OperatorContains:
+		values, _ := answer.([]interface{})
+		return containsValue(values, c.Value)
+	case OperatorAnswered:
+		expected, _ := c.Value.(bool)
+		return isAnswered(answer) == expected
+	default:
+		return false
+	}
+}
+
+// isAnswered reports whether an answer carries a value; empty strings and lists do not
+func isAnswered(answer interface{}) bool {
+	switch v := answer.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+// ValidateWithConditions validates answers against a schema and the conditions of its UI Schema.
+// Answers to hidden fields are rejected, fields required when shown must be answered, and hidden
+// fields are exempt from the schema's required list.
+func ValidateWithConditions(s, uiSchema, data interface{}) []*apperrors.ValidationError {
+	conditions, err := Conditions(uiSchema)
+	if err != nil {
+		return []*apperrors.ValidationError{apperrors.NewValidationError("ui_schema", err.Error())}
+	}
+	answers, ok := Normalize(data).(map[string]interface{})
+	if len(conditions) == 0 || !ok {
+		return Validate(s, data)
+	}
+	root, ok := Normalize(s).(map[string]interface{})
+	if !ok {
+		return Validate(s, data)
+	}
+
+	hidden := HiddenFields(conditions, answers)
+
+	var errs []*apperrors.ValidationError
+	visible := make(map[string]interface{}, len(answers))
+	for _, key := range sortedKeys(answers) {
+		if !hidden[key] {
+			visible[key] = answers[key]
+		} else if isAnswered(answers[key]) {
+			errs = append(errs, apperrors.NewValidationError(key, "is not shown for the other answers"))
+		}
+	}
+
+	// Hidden fields cannot be answered, so the schema cannot require them
+	required, _ := root["required"].([]interface{})
+	schemaRequired := make(map[string]bool, len(required))
+	if len(required) > 0 {
+		shown := make([]interface{}, 0, len(required))
+		for _, name := range required {
+			field, ok := name.(string)
+			if !ok || !hidden[field] {
+				shown = append(shown, name)
+				schemaRequired[field] = true
+			}
+		}
+		copied := make(map[string]interface{}, len(root))
+		for key, value := range root {
+			copied[key] = value
+		}
+		copied["required"] = shown
+		root = copied
+	}
+
+	for _, condition := range conditions {
+		field := condition.Field
+		if !condition.Required || hidden[field] || isAnswered(answers[field]) {
+			continue
+		}
+		// An empty answer is missing; the schema reports missing answers to the fields it requires
+		delete(visible, field)
+		if !schemaRequired[field] {
+			errs = append(errs, apperrors.NewValidationError(field, "is required"))
+		}
+	}
+	return append(errs, Validate(root, visible)...)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,159 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func conditionalSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"has_allergies": map[string]interface{}{"type": "string", "enum": []interface{}{"yes", "no"}},
+			"allergies":     map[string]interface{}{"type": "string", "minLength": 2},
+			"severity":      map[string]interface{}{"type": "integer"},
+			"diet":          map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+			"vegan_notes":   map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"has_allergies", "severity"},
+	}
+}
+
+func conditionalUISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"ui:order": []interface{}{"has_allergies", "allergies", "severity", "diet", "vegan_notes"},
+		ConditionsKey: []interface{}{
+			map[string]interface{}{
+				"field":    "allergies",
+				"when":     []interface{}{map[string]interface{}{"field": "has_allergies", "equals": "yes"}},
+				"required": true,
+			},
+			// Chained: only shown when allergies is shown and answered
+			map[string]interface{}{
+				"field": "severity",
+				"when":  []interface{}{map[string]interface{}{"field": "allergies", "answered": true}},
+			},
+			map[string]interface{}{
+				"field": "vegan_notes",
+				"match": "any",
+				"when": []interface{}{
+					map[string]interface{}{"field": "diet", "contains": "vegan"},
+					map[string]interface{}{"field": "has_allergies", "in": []interface{}{"yes"}},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateWithConditions(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           map[string]interface{}
+		expectedFields []string
+	}{
+		{
+			name:           "hidden fields are not required",
+			data:           map[string]interface{}{"has_allergies": "no"},
+			expectedFields: nil,
+		},
+		{
+			name:           "empty answers to hidden fields are ignored",
+			data:           map[string]interface{}{"has_allergies": "no", "allergies": "", "diet": []interface{}{}},
+			expectedFields: nil,
+		},
+		{
+			name:           "answers to hidden fields are rejected",
+			data:           map[string]interface{}{"has_allergies": "no", "allergies": "nuts", "vegan_notes": "none"},
+			expectedFields: []string{"allergies", "vegan_notes"},
+		},
+		{
+			name:           "fields required when shown",
+			data:           map[string]interface{}{"has_allergies": "yes", "allergies": ""},
+			expectedFields: []string{"allergies"},
+		},
+		{
+			name:           "shown fields required by the schema",
+			data:           map[string]interface{}{"has_allergies": "yes", "allergies": "nuts"},
+			expectedFields: []string{"severity"},
+		},
+		{
+			name:           "shown fields are validated",
+			data:           map[string]interface{}{"has_allergies": "yes", "allergies": "n", "severity": 2},
+			expectedFields: []string{"allergies"},
+		},
+		{
+			name:           "any clause shows the field",
+			data:           map[string]interface{}{"has_allergies": "no", "diet": []interface{}{"vegan"}, "vegan_notes": "no honey"},
+			expectedFields: nil,
+		},
+		{
+			name:           "fields without conditions are always validated",
+			data:           map[string]interface{}{},
+			expectedFields: []string{"has_allergies"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateWithConditions(conditionalSchema(), conditionalUISchema(), tt.data)
+
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			assert.ElementsMatch(t, tt.expectedFields, fields)
+		})
+	}
+}
+
+func TestValidateWithConditions_StoredSchemas(t *testing.T) {
+	uiSchema := primitive.D{{Key: ConditionsKey, Value: primitive.A{
+		primitive.D{
+			{Key: "field", Value: "allergies"},
+			{Key: "when", Value: primitive.A{primitive.D{{Key: "field", Value: "has_allergies"}, {Key: "equals", Value: "yes"}}}},
+		},
+	}}}
+
+	errs := ValidateWithConditions(conditionalSchema(), uiSchema, map[string]interface{}{"has_allergies": "no", "severity": 1, "allergies": "nuts"})
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, "allergies", errs[0].Field)
+}
+
+func TestCheckConditions(t *testing.T) {
+	assert.NoError(t, CheckConditions(conditionalSchema(), conditionalUISchema()))
+	assert.NoError(t, CheckConditions(conditionalSchema(), map[string]interface{}{"ui:order": []interface{}{}}))
+
+	condition := func(field string, clause map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"field": field, "when": []interface{}{clause}}
+	}
+	tests := []struct {
+		name       string
+		conditions interface{}
+	}{
+		{name: "not a list", conditions: "allergies"},
+		{name: "unknown field", conditions: []interface{}{condition("other", map[string]interface{}{"field": "has_allergies", "equals": "yes"})}},
+		{name: "unknown clause field", conditions: []interface{}{condition("allergies", map[string]interface{}{"field": "other", "equals": "yes"})}},
+		{name: "no operator", conditions: []interface{}{condition("allergies", map[string]interface{}{"field": "has_allergies"})}},
+		{name: "two operators", conditions: []interface{}{condition("allergies", map[string]interface{}{"field": "has_allergies", "equals": "yes", "in": []interface{}{"yes"}})}},
+		{name: "in without a list", conditions: []interface{}{condition("allergies", map[string]interface{}{"field": "has_allergies", "in": "yes"})}},
+		{name: "no clauses", conditions: []interface{}{map[string]interface{}{"field": "allergies", "when": []interface{}{}}}},
+		{name: "unknown match", conditions: []interface{}{map[string]interface{}{"field": "allergies", "match": "none", "when": []interface{}{map[string]interface{}{"field": "has_allergies", "answered": true}}}}},
+		{name: "duplicate field", conditions: []interface{}{
+			condition("allergies", map[string]interface{}{"field": "has_allergies", "equals": "yes"}),
+			condition("allergies", map[string]interface{}{"field": "severity", "answered": true}),
+		}},
+		{name: "cycle", conditions: []interface{}{
+			condition("allergies", map[string]interface{}{"field": "severity", "answered": true}),
+			condition("severity", map[string]interface{}{"field": "allergies", "answered": true}),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, CheckConditions(conditionalSchema(), map[string]interface{}{ConditionsKey: tt.conditions}))
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Field change types
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// FieldChange describes how a single field differs between two schemas
+type FieldChange struct {
+	Field          string      // Dotted path of the field, e.g. "address.city"
+	Type           string      // One of ChangeAdded, ChangeRemoved, ChangeChanged
+	Before         interface{} // Field schema in the source schema, nil when added
+	After          interface{} // Field schema in the target schema, nil when removed
+	TypeBefore     []string    // Types of the field in the source schema, from its "type" keyword
+	TypeAfter      []string    // Types of the field in the target schema
+	RequiredBefore bool
+	RequiredAfter  bool
+}
+
+// TypeChanged reports whether a field present in both schemas changed its type
+func (c FieldChange) TypeChanged() bool {
+	return c.Type == ChangeChanged && !reflect.DeepEqual(c.TypeBefore, c.TypeAfter)
+}
+
+// RequiredChanged reports whether a field present in both schemas became required or optional
+func (c FieldChange) RequiredChanged() bool {
+	return c.Type == ChangeChanged && c.RequiredBefore != c.RequiredAfter
+}
+
+// Diff compares the fields of two JSON Schema documents and returns the changes needed
+// to go from the "from" schema to the "to" schema, ordered by field path.
+// Nested object properties are compared field by field rather than as a whole.
+func Diff(from, to interface{}) []FieldChange {
+	fromMap, _ := Normalize(from).(map[string]interface{})
+	toMap, _ := Normalize(to).(map[string]interface{})
+
+	var changes []FieldChange
+	diffObject(fromMap, toMap, "", &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+func diffObject(from, to map[string]interface{}, path string, changes *[]FieldChange) {
+	fromProps, _ := from["properties"].(map[string]interface{})
+	toProps, _ := to["properties"].(map[string]interface{})
+	fromRequired := requiredSet(from)
+	toRequired := requiredSet(to)
+
+	for name, before := range fromProps {
+		field := joinPath(path, name)
+		after, exists := toProps[name]
+		if !exists {
+			*changes = append(*changes, FieldChange{
+				Field:          field,
+				Type:           ChangeRemoved,
+				Before:         before,
+				TypeBefore:     fieldTypes(before),
+				RequiredBefore: fromRequired[name],
+			})
+			continue
+		}
+
+		beforeMap, beforeIsMap := before.(map[string]interface{})
+		afterMap, afterIsMap := after.(map[string]interface{})
+		nested := beforeIsMap && afterIsMap && hasProperties(beforeMap) && hasProperties(afterMap)
+
+		// Nested objects only report their own keywords here; children are diffed separately
+		changed := fromRequired[name] != toRequired[name]
+		if nested {
+			changed = changed || !reflect.DeepEqual(ownKeywords(beforeMap), ownKeywords(afterMap))
+		} else {
+			changed = changed || !reflect.DeepEqual(before, after)
+		}
+
+		if changed {
+			*changes = append(*changes, FieldChange{
+				Field:          field,
+				Type:           ChangeChanged,
+				Before:         before,
+				After:          after,
+				TypeBefore:     fieldTypes(before),
+				TypeAfter:      fieldTypes(after),
+				RequiredBefore: fromRequired[name],
+				RequiredAfter:  toRequired[name],
+			})
+		}
+
+		if nested {
+			diffObject(beforeMap, afterMap, field, changes)
+		}
+	}
+
+	for name, after := range toProps {
+		if _, exists := fromProps[name]; !exists {
+			*changes = append(*changes, FieldChange{
+				Field:         joinPath(path, name),
+				Type:          ChangeAdded,
+				After:         after,
+				TypeAfter:     fieldTypes(after),
+				RequiredAfter: toRequired[name],
+			})
+		}
+	}
+}
+
+// requiredSet returns the names listed in a schema's "required" keyword
+func requiredSet(s map[string]interface{}) map[string]bool {
+	result := make(map[string]bool)
+	required, _ := s["required"].([]interface{})
+	for _, r := range required {
+		if name, ok := r.(string); ok {
+			result[name] = true
+		}
+	}
+	return result
+}
+
+// fieldTypes returns the types of a field schema
+func fieldTypes(field interface{}) []string {
+	s, _ := field.(map[string]interface{})
+	return schemaTypes(s)
+}
+
+func hasProperties(s map[string]interface{}) bool {
+	_, ok := s["properties"].(map[string]interface{})
+	return ok
+}
+
+// ownKeywords returns a schema without the keywords describing its child fields
+func ownKeywords(s map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(s))
+	for key, value := range s {
+		if key == "properties" || key == "required" {
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	from := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":  map[string]interface{}{"type": "string"},
+			"phone": map[string]interface{}{"type": "string"},
+			"age":   map[string]interface{}{"type": "integer"},
+			"address": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"city": map[string]interface{}{"type": "string"},
+				},
+			},
+		},
+		"required": []interface{}{"name"},
+	}
+	to := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":  map[string]interface{}{"type": "string"},
+			"email": map[string]interface{}{"type": "string", "format": "email"},
+			"age":   map[string]interface{}{"type": "integer", "minimum": 18},
+			"address": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"city": map[string]interface{}{"type": "string"},
+					"zip":  map[string]interface{}{"type": "string"},
+				},
+			},
+		},
+		"required": []interface{}{"name", "email"},
+	}
+
+	changes := Diff(from, to)
+
+	require.Len(t, changes, 4)
+	assert.Equal(t, FieldChange{
+		Field:     "address.zip",
+		Type:      ChangeAdded,
+		After:     map[string]interface{}{"type": "string"},
+		Before:    nil,
+		TypeAfter: []string{"string"},
+	}, changes[0])
+	assert.Equal(t, "age", changes[1].Field)
+	assert.Equal(t, ChangeChanged, changes[1].Type)
+	assert.Equal(t, "email", changes[2].Field)
+	assert.Equal(t, ChangeAdded, changes[2].Type)
+	assert.True(t, changes[2].RequiredAfter)
+	assert.Equal(t, "phone", changes[3].Field)
+	assert.Equal(t, ChangeRemoved, changes[3].Type)
+}
+
+func TestDiff_RequiredChange(t *testing.T) {
+	from := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+		},
+	}
+	to := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"name"},
+	}
+
+	changes := Diff(from, to)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, ChangeChanged, changes[0].Type)
+	assert.False(t, changes[0].RequiredBefore)
+	assert.True(t, changes[0].RequiredAfter)
+}
+
+func TestDiff_Identical(t *testing.T) {
+	assert.Empty(t, Diff(testSchema(), testSchema()))
+}
+
+func TestDiff_TypeChange(t *testing.T) {
+	from := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"age":  map[string]interface{}{"type": "string"},
+			"name": map[string]interface{}{"type": "string"},
+		},
+	}
+	to := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"age":  map[string]interface{}{"type": []interface{}{"integer", "null"}},
+			"name": map[string]interface{}{"type": "string", "maxLength": 50},
+		},
+	}
+
+	changes := Diff(from, to)
+
+	require.Len(t, changes, 2)
+	assert.True(t, changes[0].TypeChanged())
+	assert.Equal(t, []string{"string"}, changes[0].TypeBefore)
+	assert.Equal(t, []string{"integer", "null"}, changes[0].TypeAfter)
+	assert.False(t, changes[1].TypeChanged())
+	assert.False(t, changes[1].RequiredChanged())
+}
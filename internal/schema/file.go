@@ -0,0 +1,71 @@
+package schema
+
+import "strings"
+
+// FileFormat is the string format of fields whose answers reference uploaded files by ID.
+// An array field whose items use the format accepts several files.
+const FileFormat = "file"
+
+// File field keywords
+const (
+	MaxSizeKey = "maxSize" // Maximum file size in bytes
+	AcceptKey  = "accept"  // Allowed MIME types; "type/*" matches any subtype
+)
+
+// FileField holds the upload constraints of a file field
+type FileField struct {
+	MaxSize  int64    // 0 when the field does not set a limit
+	Accept   []string // Empty when the field accepts any type
+	Multiple bool     // The answer is an array of file IDs
+}
+
+// FileFields returns the top-level file fields of a schema, keyed by field name
+func FileFields(s interface{}) map[string]FileField {
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+
+	fields := make(map[string]FileField)
+	for name, property := range properties {
+		p, _ := property.(map[string]interface{})
+		multiple := false
+		if items, ok := p["items"].(map[string]interface{}); ok && p["type"] == "array" {
+			p, multiple = items, true
+		}
+		if format, _ := p["format"].(string); format != FileFormat {
+			continue
+		}
+
+		field := FileField{Multiple: multiple}
+		if maxSize, ok := ToFloat(p[MaxSizeKey]); ok && maxSize > 0 {
+			field.MaxSize = int64(maxSize)
+		}
+		if accept, ok := p[AcceptKey].([]interface{}); ok {
+			for _, a := range accept {
+				if mimeType, ok := a.(string); ok {
+					field.Accept = append(field.Accept, mimeType)
+				}
+			}
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+// Accepts reports whether a MIME type is allowed by the patterns, where "type/*" matches any
+// subtype. No patterns allow every type.
+func Accepts(patterns []string, contentType string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	contentType = strings.ToLower(strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]))
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == contentType || pattern == "*/*" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(contentType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
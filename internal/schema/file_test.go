@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestFileFields(t *testing.T) {
+	s := primitive.D{
+		{Key: "type", Value: "object"},
+		{Key: "properties", Value: primitive.D{
+			{Key: "name", Value: primitive.D{{Key: "type", Value: "string"}}},
+			{Key: "resume", Value: primitive.D{
+				{Key: "type", Value: "string"},
+				{Key: "format", Value: "file"},
+				{Key: "maxSize", Value: int32(1048576)},
+				{Key: "accept", Value: primitive.A{"application/pdf"}},
+			}},
+			{Key: "photos", Value: primitive.D{
+				{Key: "type", Value: "array"},
+				{Key: "items", Value: primitive.D{{Key: "type", Value: "string"}, {Key: "format", Value: "file"}}},
+			}},
+		}},
+	}
+
+	fields := FileFields(s)
+
+	assert.Equal(t, map[string]FileField{
+		"resume": {MaxSize: 1048576, Accept: []string{"application/pdf"}},
+		"photos": {Multiple: true},
+	}, fields)
+}
+
+func TestAccepts(t *testing.T) {
+	assert.True(t, Accepts(nil, "application/zip"))
+	assert.True(t, Accepts([]string{"application/pdf"}, "application/pdf"))
+	assert.True(t, Accepts([]string{"image/*"}, "image/png"))
+	assert.True(t, Accepts([]string{"text/plain"}, "Text/Plain; charset=utf-8"))
+	assert.False(t, Accepts([]string{"image/*"}, "application/pdf"))
+	assert.False(t, Accepts([]string{"image/*"}, "imagex/png"))
+}
@@ -0,0 +1,35 @@
+package schema
+
+// Label keywords rewritten by RewriteLabels
+var labelKeywords = []string{"title", "description"}
+
+// RewriteLabels returns a copy of a schema with the title and description of the schema and of
+// every field replaced by the result of rewrite, which receives the dotted path of the field
+// (empty for the schema itself), the keyword and the label. Schemas that are not objects are
+// returned unchanged.
+func RewriteLabels(s interface{}, rewrite func(field, keyword, label string) string) interface{} {
+	root, ok := Normalize(s).(map[string]interface{})
+	if !ok {
+		return s
+	}
+	rewriteLabels(root, "", rewrite)
+	return root
+}
+
+func rewriteLabels(field map[string]interface{}, path string, rewrite func(field, keyword, label string) string) {
+	for _, keyword := range labelKeywords {
+		if label, ok := field[keyword].(string); ok {
+			field[keyword] = rewrite(path, keyword, label)
+		}
+	}
+
+	if items, ok := field["items"].(map[string]interface{}); ok {
+		rewriteLabels(items, path+"[]", rewrite)
+	}
+	properties, _ := field["properties"].(map[string]interface{})
+	for _, name := range sortedKeys(properties) {
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			rewriteLabels(property, joinPath(path, name), rewrite)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Issue codes reported by CheckStructure and Lint
+const (
+	IssueInvalidSchema     = "invalid_schema"
+	IssueMissingTitle      = "missing_title"
+	IssueEnumWithoutLabels = "enum_without_labels"
+	IssueDeepNesting       = "deep_nesting"
+	IssueUnusedUISchema    = "unused_ui_schema"
+	IssueTooManyRequired   = "too_many_required"
+)
+
+// Default lint thresholds
+const (
+	DefaultMaxNestingDepth   = 3
+	DefaultMaxRequiredFields = 20
+)
+
+// knownTypes are the JSON Schema types
+var knownTypes = map[string]bool{
+	"string": true, "number": true, "integer": true, "boolean": true, "object": true, "array": true, "null": true,
+}
+
+// Issue is a problem found in a schema. Field is the dotted path of the field, empty for the
+// schema as a whole.
+type Issue struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+// LintOptions are the thresholds of Lint; zero values use the defaults
+type LintOptions struct {
+	MaxNestingDepth   int // Object levels, top-level fields being at level 1
+	MaxRequiredFields int // Required top-level fields
+}
+
+// CheckStructure checks that a schema is a well-formed JSON Schema object the form service can
+// validate answers against, returning every problem found
+func CheckStructure(s interface{}) []Issue {
+	root, ok := Normalize(s).(map[string]interface{})
+	if !ok {
+		return []Issue{{Code: IssueInvalidSchema, Message: "schema must be a JSON object"}}
+	}
+
+	var issues []Issue
+	if types := schemaTypes(root); len(types) > 0 && (len(types) != 1 || types[0] != "object") {
+		issues = append(issues, Issue{Code: IssueInvalidSchema, Message: `schema must be of type "object"`})
+	}
+	checkObjectStructure(root, "", &issues)
+	return issues
+}
+
+func checkObjectStructure(s map[string]interface{}, path string, issues *[]Issue) {
+	fail := func(field, format string, args ...interface{}) {
+		*issues = append(*issues, Issue{Field: field, Code: IssueInvalidSchema, Message: fmt.Sprintf(format, args...)})
+	}
+
+	rawProperties, hasProperties := s["properties"]
+	properties, ok := rawProperties.(map[string]interface{})
+	if hasProperties && !ok {
+		fail(path, "properties must be an object")
+	}
+
+	if raw, ok := s["required"]; ok {
+		required, ok := raw.([]interface{})
+		if !ok {
+			fail(path, "required must be an array of field names")
+		}
+		for _, r := range required {
+			name, ok := r.(string)
+			if !ok {
+				fail(path, "required must be an array of field names")
+				continue
+			}
+			if _, ok := properties[name]; !ok {
+				fail(joinPath(path, name), "required field is not defined in properties")
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(properties) {
+		field, ok := properties[name].(map[string]interface{})
+		if !ok {
+			fail(joinPath(path, name), "field schema must be an object")
+			continue
+		}
+		checkFieldStructure(field, joinPath(path, name), issues)
+	}
+}
+
+func checkFieldStructure(field map[string]interface{}, path string, issues *[]Issue) {
+	fail := func(format string, args ...interface{}) {
+		*issues = append(*issues, Issue{Field: path, Code: IssueInvalidSchema, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if raw, ok := field["type"]; ok {
+		types := schemaTypes(field)
+		if len(types) == 0 {
+			fail("type must be a type name or an array of them")
+		}
+		if list, ok := raw.([]interface{}); ok && len(list) != len(types) {
+			fail("type must be a type name or an array of them")
+		}
+		for _, t := range types {
+			if !knownTypes[t] {
+				fail("unknown type %q", t)
+			}
+		}
+	}
+
+	if raw, ok := field["enum"]; ok {
+		if values, ok := raw.([]interface{}); !ok || len(values) == 0 {
+			fail("enum must be a non-empty array")
+		}
+	}
+
+	for _, bounds := range [][2]string{{"minLength", "maxLength"}, {"minimum", "maximum"}, {"minItems", "maxItems"}} {
+		low, lowOK := ToFloat(field[bounds[0]])
+		high, highOK := ToFloat(field[bounds[1]])
+		if lowOK && highOK && low > high {
+			fail("%s is greater than %s", bounds[0], bounds[1])
+		}
+	}
+
+	if raw, ok := field["items"]; ok {
+		items, ok := raw.(map[string]interface{})
+		if !ok {
+			fail("items must be an object")
+		} else {
+			checkFieldStructure(items, path+"[]", issues)
+		}
+	}
+
+	if _, ok := field["properties"]; ok {
+		checkObjectStructure(field, path, issues)
+	}
+}
+
+// Lint returns best-practice warnings for a schema and its UI Schema: fields without a title,
+// choices without labels, objects nested too deeply, UI Schema entries for unknown fields and
+// too many required fields. Warnings do not prevent a schema from being used.
+func Lint(s, uiSchema interface{}, opts LintOptions) []Issue {
+	if opts.MaxNestingDepth <= 0 {
+		opts.MaxNestingDepth = DefaultMaxNestingDepth
+	}
+	if opts.MaxRequiredFields <= 0 {
+		opts.MaxRequiredFields = DefaultMaxRequiredFields
+	}
+
+	root, ok := Normalize(s).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	ui, _ := Normalize(uiSchema).(map[string]interface{})
+
+	var issues []Issue
+	if required := requiredSet(root); len(required) > opts.MaxRequiredFields {
+		issues = append(issues, Issue{
+			Code:    IssueTooManyRequired,
+			Message: fmt.Sprintf("%d fields are required, consider requiring at most %d", len(required), opts.MaxRequiredFields),
+		})
+	}
+	lintObject(root, ui, "", 1, opts, &issues)
+	return issues
+}
+
+func lintObject(s, ui map[string]interface{}, path string, depth int, opts LintOptions, issues *[]Issue) {
+	warn := func(field, code, format string, args ...interface{}) {
+		*issues = append(*issues, Issue{Field: field, Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+	properties, _ := s["properties"].(map[string]interface{})
+
+	// UI Schema entries must refer to fields of the schema
+	for _, key := range sortedKeys(ui) {
+		if strings.HasPrefix(key, "ui:") {
+			continue
+		}
+		if _, ok := properties[key]; !ok {
+			warn(joinPath(path, key), IssueUnusedUISchema, "UI Schema entry does not match a field")
+		}
+	}
+	if order, ok := ui["ui:order"].([]interface{}); ok {
+		for _, entry := range order {
+			name, _ := entry.(string)
+			if _, ok := properties[name]; !ok && name != "*" {
+				warn(path, IssueUnusedUISchema, "ui:order lists unknown field %q", name)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(properties) {
+		field, ok := properties[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := field[BlockKey]; ok {
+			continue // Expanded from the block when the form or template is saved
+		}
+		fieldPath := joinPath(path, name)
+		fieldUI, _ := ui[name].(map[string]interface{})
+
+		if _, ok := field["title"]; !ok {
+			warn(fieldPath, IssueMissingTitle, "field has no title; respondents will see its key")
+		}
+		if hasUnlabeledEnum(field, fieldUI) {
+			warn(fieldPath, IssueEnumWithoutLabels, "choices have no labels; respondents will see the raw values")
+		}
+
+		if _, ok := field["properties"].(map[string]interface{}); ok {
+			if depth >= opts.MaxNestingDepth {
+				warn(fieldPath, IssueDeepNesting, "objects are nested more than %d levels deep", opts.MaxNestingDepth)
+				continue
+			}
+			lintObject(field, fieldUI, fieldPath, depth+1, opts, issues)
+		}
+	}
+}
+
+// hasUnlabeledEnum reports whether a field, or the items of an array field, offers enum choices
+// without enumNames labels in the schema or the UI Schema
+func hasUnlabeledEnum(field, ui map[string]interface{}) bool {
+	if items, ok := field["items"].(map[string]interface{}); ok {
+		if _, ok := items["enum"]; ok {
+			field = items
+		}
+	}
+	if _, ok := field["enum"]; !ok {
+		return false
+	}
+	if _, ok := field["enumNames"]; ok {
+		return false
+	}
+	_, ok := ui["ui:enumNames"]
+	return !ok
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issueCodes maps the fields of issues to their codes
+func issueCodes(issues []Issue) map[string][]string {
+	codes := make(map[string][]string)
+	for _, issue := range issues {
+		codes[issue.Field] = append(codes[issue.Field], issue.Code)
+	}
+	return codes
+}
+
+func TestCheckStructure(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":  map[string]interface{}{"type": "text"},
+			"age":   map[string]interface{}{"type": "integer", "minimum": 10, "maximum": 5},
+			"size":  map[string]interface{}{"enum": []interface{}{}},
+			"tags":  map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": []interface{}{"string", 1}}},
+			"other": "not a schema",
+			"address": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"city": map[string]interface{}{"type": "string"}},
+				"required":   []interface{}{"zip"},
+			},
+		},
+		"required": []interface{}{"name", "email"},
+	}
+
+	issues := CheckStructure(s)
+
+	assert.Equal(t, map[string][]string{
+		"email":       {IssueInvalidSchema},
+		"name":        {IssueInvalidSchema},
+		"age":         {IssueInvalidSchema},
+		"size":        {IssueInvalidSchema},
+		"tags[]":      {IssueInvalidSchema},
+		"other":       {IssueInvalidSchema},
+		"address.zip": {IssueInvalidSchema},
+	}, issueCodes(issues))
+}
+
+func TestCheckStructure_Valid(t *testing.T) {
+	assert.Empty(t, CheckStructure(map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string", "minLength": 1, "maxLength": 50},
+			"tags": map[string]interface{}{"type": "array", "items": map[string]interface{}{"enum": []interface{}{"a", "b"}}},
+		},
+		"required": []interface{}{"name"},
+	}))
+	assert.NotEmpty(t, CheckStructure("not a schema"))
+	assert.NotEmpty(t, CheckStructure(map[string]interface{}{"type": "array"}))
+}
+
+func TestLint(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":  map[string]interface{}{"type": "string", "title": "Name"},
+			"size":  map[string]interface{}{"title": "Size", "enum": []interface{}{"s", "m"}},
+			"color": map[string]interface{}{"title": "Color", "enum": []interface{}{"r"}, "enumNames": []interface{}{"Red"}},
+			"meals": map[string]interface{}{"title": "Meals", "type": "array", "items": map[string]interface{}{"enum": []interface{}{"veg"}}},
+			"a": map[string]interface{}{"title": "A", "properties": map[string]interface{}{
+				"b": map[string]interface{}{"title": "B", "properties": map[string]interface{}{
+					"c": map[string]interface{}{"title": "C", "properties": map[string]interface{}{
+						"d": map[string]interface{}{"type": "string"},
+					}},
+				}},
+			}},
+		},
+		"required": []interface{}{"name", "size", "color"},
+	}
+	ui := map[string]interface{}{
+		"ui:order": []interface{}{"name", "phone", "*"},
+		"meals":    map[string]interface{}{"ui:enumNames": []interface{}{"Vegetarian"}},
+		"email":    map[string]interface{}{"ui:widget": "email"},
+		"a":        map[string]interface{}{"x": map[string]interface{}{}},
+	}
+
+	issues := Lint(s, ui, LintOptions{MaxRequiredFields: 2})
+
+	assert.Equal(t, map[string][]string{
+		"":      {IssueTooManyRequired, IssueUnusedUISchema},
+		"email": {IssueUnusedUISchema},
+		"size":  {IssueEnumWithoutLabels},
+		"a.x":   {IssueUnusedUISchema},
+		"a.b.c": {IssueDeepNesting},
+	}, issueCodes(issues))
+}
+
+func TestLint_MissingTitle(t *testing.T) {
+	issues := Lint(map[string]interface{}{
+		"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+	}, nil, LintOptions{})
+
+	assert.Equal(t, map[string][]string{"name": {IssueMissingTitle}}, issueCodes(issues))
+}
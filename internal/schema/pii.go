@@ -0,0 +1,20 @@
+package schema
+
+// PIIKey marks a top-level field as personally identifiable information. Answers to PII fields
+// are stored encrypted and left out of filters and statistics.
+const PIIKey = "pii"
+
+// PIIFields returns the names of the top-level fields of a schema marked as PII
+func PIIFields(s interface{}) map[string]bool {
+	root, _ := Normalize(s).(map[string]interface{})
+	properties, _ := root["properties"].(map[string]interface{})
+
+	fields := make(map[string]bool)
+	for name, property := range properties {
+		p, _ := property.(map[string]interface{})
+		if pii, _ := p[PIIKey].(bool); pii {
+			fields[name] = true
+		}
+	}
+	return fields
+}
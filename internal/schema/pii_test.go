@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPIIFields(t *testing.T) {
+	s := primitive.D{
+		{Key: "type", Value: "object"},
+		{Key: "properties", Value: primitive.D{
+			{Key: "name", Value: primitive.D{{Key: "type", Value: "string"}, {Key: "pii", Value: true}}},
+			{Key: "email", Value: primitive.D{{Key: "type", Value: "string"}, {Key: "format", Value: "email"}, {Key: "pii", Value: true}}},
+			{Key: "rating", Value: primitive.D{{Key: "type", Value: "integer"}, {Key: "pii", Value: false}}},
+			{Key: "comment", Value: primitive.D{{Key: "type", Value: "string"}}},
+		}},
+	}
+
+	assert.Equal(t, map[string]bool{"name": true, "email": true}, PIIFields(s))
+	assert.Empty(t, PIIFields(nil))
+}
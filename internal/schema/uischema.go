@@ -0,0 +1,157 @@
+package schema
+
+import (
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UISchema keywords
+const (
+	UIOrderKey    = "ui:order"
+	UIWidgetKey   = "ui:widget"
+	UIReadonlyKey = "ui:readonly"
+)
+
+// DefaultFormatWidgets maps JSON Schema string formats to the widget rendered for them
+var DefaultFormatWidgets = map[string]string{
+	"email":     "email",
+	"uri":       "uri",
+	"date":      "date",
+	"date-time": "datetime",
+	"time":      "time",
+	"color":     "color",
+	"password":  "password",
+	"data-url":  "file",
+	FileFormat:  "file",
+}
+
+// GenerateUISchema derives a default UISchema from a JSON Schema document.
+// Fields are ordered as they appear in the schema; since JSON objects decoded into Go maps
+// lose their key order, a numeric "propertyOrder" keyword is honored and remaining fields
+// are ordered by name. Widgets are picked from the field format using DefaultFormatWidgets
+// merged with the given overrides, where an empty widget disables the mapping for a format.
+func GenerateUISchema(s interface{}, formatWidgets map[string]string) map[string]interface{} {
+	widgets := make(map[string]string, len(DefaultFormatWidgets)+len(formatWidgets))
+	for format, widget := range DefaultFormatWidgets {
+		widgets[format] = widget
+	}
+	for format, widget := range formatWidgets {
+		if widget == "" {
+			delete(widgets, format)
+			continue
+		}
+		widgets[format] = widget
+	}
+
+	return generateObjectUISchema(s, widgets)
+}
+
+func generateObjectUISchema(s interface{}, widgets map[string]string) map[string]interface{} {
+	uiSchema := make(map[string]interface{})
+
+	var properties interface{}
+	switch v := s.(type) {
+	case primitive.D:
+		for _, elem := range v {
+			if elem.Key == "properties" {
+				properties = elem.Value
+			}
+		}
+	default:
+		if m, ok := Normalize(s).(map[string]interface{}); ok {
+			properties = m["properties"]
+		}
+	}
+
+	names, fields := orderedProperties(properties)
+	if len(names) == 0 {
+		return uiSchema
+	}
+
+	order := make([]interface{}, len(names))
+	for i, name := range names {
+		order[i] = name
+
+		if fieldUISchema := generateFieldUISchema(fields[name], widgets); len(fieldUISchema) > 0 {
+			uiSchema[name] = fieldUISchema
+		}
+	}
+	uiSchema[UIOrderKey] = order
+
+	return uiSchema
+}
+
+func generateFieldUISchema(field interface{}, widgets map[string]string) map[string]interface{} {
+	fieldMap, ok := Normalize(field).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if _, ok := fieldMap["properties"]; ok {
+		return generateObjectUISchema(field, widgets)
+	}
+
+	var fieldUISchema map[string]interface{}
+	if format, ok := fieldMap["format"].(string); ok {
+		if widget, ok := widgets[format]; ok {
+			fieldUISchema = map[string]interface{}{UIWidgetKey: widget}
+		}
+	}
+	// Computed values are shown to respondents but cannot be answered
+	if _, ok := fieldMap[ComputedKey]; ok {
+		if fieldUISchema == nil {
+			fieldUISchema = make(map[string]interface{})
+		}
+		fieldUISchema[UIReadonlyKey] = true
+	}
+	return fieldUISchema
+}
+
+// orderedProperties returns the property names of a "properties" keyword in display order
+// together with the field schemas keyed by name
+func orderedProperties(properties interface{}) ([]string, map[string]interface{}) {
+	fields := make(map[string]interface{})
+
+	if d, ok := properties.(primitive.D); ok {
+		names := make([]string, 0, len(d))
+		for _, elem := range d {
+			names = append(names, elem.Key)
+			fields[elem.Key] = elem.Value
+		}
+		return names, fields
+	}
+
+	m, ok := Normalize(properties).(map[string]interface{})
+	if !ok {
+		return nil, fields
+	}
+
+	names := make([]string, 0, len(m))
+	for name, field := range m {
+		names = append(names, name)
+		fields[name] = field
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		oi, hasI := propertyOrder(fields[names[i]])
+		oj, hasJ := propertyOrder(fields[names[j]])
+		switch {
+		case hasI && hasJ && oi != oj:
+			return oi < oj
+		case hasI != hasJ:
+			return hasI
+		default:
+			return names[i] < names[j]
+		}
+	})
+	return names, fields
+}
+
+func propertyOrder(field interface{}) (float64, bool) {
+	fieldMap, ok := field.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	return ToFloat(fieldMap["propertyOrder"])
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestGenerateUISchema(t *testing.T) {
+	uiSchema := GenerateUISchema(testSchema(), nil)
+
+	assert.Equal(t, []interface{}{"age", "email", "name", "size", "tags"}, uiSchema[UIOrderKey])
+	assert.Equal(t, map[string]interface{}{UIWidgetKey: "email"}, uiSchema["email"])
+	assert.NotContains(t, uiSchema, "name")
+}
+
+func TestGenerateUISchema_PropertyOrder(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"zip":     map[string]interface{}{"type": "string"},
+			"name":    map[string]interface{}{"type": "string", "propertyOrder": 1},
+			"birth":   map[string]interface{}{"type": "string", "format": "date", "propertyOrder": 2},
+			"address": map[string]interface{}{"type": "string"},
+		},
+	}
+
+	uiSchema := GenerateUISchema(s, nil)
+
+	assert.Equal(t, []interface{}{"name", "birth", "address", "zip"}, uiSchema[UIOrderKey])
+	assert.Equal(t, map[string]interface{}{UIWidgetKey: "date"}, uiSchema["birth"])
+}
+
+func TestGenerateUISchema_MongoDocumentOrder(t *testing.T) {
+	s := primitive.D{
+		{Key: "type", Value: "object"},
+		{Key: "properties", Value: primitive.D{
+			{Key: "name", Value: primitive.D{{Key: "type", Value: "string"}}},
+			{Key: "address", Value: primitive.D{
+				{Key: "type", Value: "object"},
+				{Key: "properties", Value: primitive.D{
+					{Key: "street", Value: primitive.D{{Key: "type", Value: "string"}}},
+					{Key: "city", Value: primitive.D{{Key: "type", Value: "string"}}},
+				}},
+			}},
+		}},
+	}
+
+	uiSchema := GenerateUISchema(s, nil)
+
+	assert.Equal(t, []interface{}{"name", "address"}, uiSchema[UIOrderKey])
+	assert.Equal(t, map[string]interface{}{
+		UIOrderKey: []interface{}{"street", "city"},
+	}, uiSchema["address"])
+}
+
+func TestGenerateUISchema_FormatWidgetOverrides(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"email":   map[string]interface{}{"type": "string", "format": "email"},
+			"website": map[string]interface{}{"type": "string", "format": "uri"},
+			"phone":   map[string]interface{}{"type": "string", "format": "phone"},
+		},
+	}
+
+	uiSchema := GenerateUISchema(s, map[string]string{"email": "", "phone": "tel"})
+
+	assert.NotContains(t, uiSchema, "email")
+	assert.Equal(t, map[string]interface{}{UIWidgetKey: "uri"}, uiSchema["website"])
+	assert.Equal(t, map[string]interface{}{UIWidgetKey: "tel"}, uiSchema["phone"])
+}
+
+func TestGenerateUISchema_NoProperties(t *testing.T) {
+	assert.Empty(t, GenerateUISchema(map[string]interface{}{"type": "string"}, nil))
+	assert.Empty(t, GenerateUISchema(nil, nil))
+}
+
+func TestGenerateUISchema_ComputedFieldsReadonly(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"quantity": map[string]interface{}{"type": "integer"},
+			"total":    map[string]interface{}{"type": "number", "computed": "quantity * 2"},
+		},
+	}
+
+	uiSchema := GenerateUISchema(s, nil)
+
+	assert.NotContains(t, uiSchema, "quantity")
+	assert.Equal(t, map[string]interface{}{UIReadonlyKey: true}, uiSchema["total"])
+}
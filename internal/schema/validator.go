@@ -0,0 +1,316 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	apperrors "github.com/arwoosa/form/internal/errors"
+)
+
+// Validate checks data against a JSON Schema document and returns every violation found.
+// Only the keywords used by the form builder are supported; unknown keywords are ignored.
+func Validate(schema, data interface{}) []*apperrors.ValidationError {
+	s, ok := Normalize(schema).(map[string]interface{})
+	if !ok {
+		return []*apperrors.ValidationError{apperrors.NewValidationError("schema", "schema must be an object")}
+	}
+
+	var errs []*apperrors.ValidationError
+	validateValue(s, Normalize(data), "", &errs)
+	return errs
+}
+
+// Normalize converts MongoDB primitive containers into plain maps and slices so that
+// schemas and answers loaded from the database can be walked like decoded JSON.
+func Normalize(data interface{}) interface{} {
+	switch v := data.(type) {
+	case primitive.D:
+		result := make(map[string]interface{}, len(v))
+		for _, elem := range v {
+			result[elem.Key] = Normalize(elem.Value)
+		}
+		return result
+	case primitive.M:
+		result := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			result[key] = Normalize(value)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			result[key] = Normalize(value)
+		}
+		return result
+	case primitive.A:
+		result := make([]interface{}, len(v))
+		for i, elem := range v {
+			result[i] = Normalize(elem)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, elem := range v {
+			result[i] = Normalize(elem)
+		}
+		return result
+	case []string:
+		result := make([]interface{}, len(v))
+		for i, elem := range v {
+			result[i] = elem
+		}
+		return result
+	default:
+		return data
+	}
+}
+
+// validateValue validates a single value against a (sub)schema, appending violations to errs
+func validateValue(s map[string]interface{}, value interface{}, path string, errs *[]*apperrors.ValidationError) {
+	fail := func(format string, args ...interface{}) {
+		*errs = append(*errs, apperrors.NewValidationError(fieldName(path), fmt.Sprintf(format, args...)))
+	}
+
+	if types := schemaTypes(s); len(types) > 0 && !matchesAnyType(types, value) {
+		fail("must be of type %s", strings.Join(types, " or "))
+		return
+	}
+
+	if enum, ok := s["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		fail("must be one of the allowed values")
+	}
+	if constant, ok := s["const"]; ok && !equalValues(constant, value) {
+		fail("must be equal to the constant value")
+	}
+
+	switch v := value.(type) {
+	case string:
+		validateString(s, v, fail)
+	case map[string]interface{}:
+		validateObject(s, v, path, errs)
+	case []interface{}:
+		validateArray(s, v, path, errs, fail)
+	default:
+		if n, ok := ToFloat(value); ok {
+			validateNumber(s, n, fail)
+		}
+	}
+}
+
+func validateString(s map[string]interface{}, v string, fail func(string, ...interface{})) {
+	length := len([]rune(v))
+	if minLength, ok := ToFloat(s["minLength"]); ok && float64(length) < minLength {
+		fail("must be at least %d characters", int(minLength))
+	}
+	if maxLength, ok := ToFloat(s["maxLength"]); ok && float64(length) > maxLength {
+		fail("must be at most %d characters", int(maxLength))
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err == nil && !re.MatchString(v) {
+			fail("does not match pattern %s", pattern)
+		}
+	}
+	if format, ok := s["format"].(string); ok && !matchesFormat(format, v) {
+		fail("must be a valid %s", format)
+	}
+}
+
+func validateNumber(s map[string]interface{}, n float64, fail func(string, ...interface{})) {
+	if minimum, ok := ToFloat(s["minimum"]); ok && n < minimum {
+		fail("must be greater than or equal to %v", minimum)
+	}
+	if maximum, ok := ToFloat(s["maximum"]); ok && n > maximum {
+		fail("must be less than or equal to %v", maximum)
+	}
+	if exclusiveMinimum, ok := ToFloat(s["exclusiveMinimum"]); ok && n <= exclusiveMinimum {
+		fail("must be greater than %v", exclusiveMinimum)
+	}
+	if exclusiveMaximum, ok := ToFloat(s["exclusiveMaximum"]); ok && n >= exclusiveMaximum {
+		fail("must be less than %v", exclusiveMaximum)
+	}
+}
+
+func validateObject(s map[string]interface{}, v map[string]interface{}, path string, errs *[]*apperrors.ValidationError) {
+	properties, _ := s["properties"].(map[string]interface{})
+
+	if required, ok := s["required"].([]interface{}); ok {
+		for _, r := range required {
+			name, ok := r.(string)
+			if !ok {
+				continue
+			}
+			if answer, exists := v[name]; !exists || answer == nil {
+				*errs = append(*errs, apperrors.NewValidationError(joinPath(path, name), "is required"))
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		answer := v[key]
+		propSchema, ok := properties[key].(map[string]interface{})
+		if !ok {
+			if additional, ok := s["additionalProperties"].(bool); ok && !additional {
+				*errs = append(*errs, apperrors.NewValidationError(joinPath(path, key), "is not allowed"))
+			}
+			continue
+		}
+		if answer == nil {
+			continue
+		}
+		validateValue(propSchema, answer, joinPath(path, key), errs)
+	}
+}
+
+func validateArray(s map[string]interface{}, v []interface{}, path string, errs *[]*apperrors.ValidationError, fail func(string, ...interface{})) {
+	if minItems, ok := ToFloat(s["minItems"]); ok && float64(len(v)) < minItems {
+		fail("must contain at least %d items", int(minItems))
+	}
+	if maxItems, ok := ToFloat(s["maxItems"]); ok && float64(len(v)) > maxItems {
+		fail("must contain at most %d items", int(maxItems))
+	}
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		for i, item := range v {
+			validateValue(items, item, fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+}
+
+// schemaTypes returns the declared type(s) of a schema
+func schemaTypes(s map[string]interface{}) []string {
+	switch t := s["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		types := make([]string, 0, len(t))
+		for _, elem := range t {
+			if name, ok := elem.(string); ok {
+				types = append(types, name)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+func matchesAnyType(types []string, value interface{}) bool {
+	for _, t := range types {
+		if matchesType(t, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(t string, value interface{}) bool {
+	switch t {
+	case "null":
+		return value == nil
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "number":
+		_, ok := ToFloat(value)
+		return ok
+	case "integer":
+		n, ok := ToFloat(value)
+		return ok && n == math.Trunc(n)
+	default:
+		return true
+	}
+}
+
+func matchesFormat(format, v string) bool {
+	switch format {
+	case "email":
+		addr, err := mail.ParseAddress(v)
+		return err == nil && addr.Address == v
+	case "date":
+		_, err := time.Parse(time.DateOnly, v)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, v)
+		return err == nil
+	case "uri":
+		u, err := url.Parse(v)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	case FileFormat:
+		return primitive.IsValidObjectID(v)
+	default:
+		return true
+	}
+}
+
+// ToFloat converts any numeric value to float64
+func ToFloat(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if equalValues(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalValues(a, b interface{}) bool {
+	if fa, ok := ToFloat(a); ok {
+		fb, ok := ToFloat(b)
+		return ok && fa == fb
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func fieldName(path string) string {
+	if path == "" {
+		return "answers"
+	}
+	return path
+}
@@ -0,0 +1,145 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func testSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":      "string",
+				"minLength": 2,
+				"maxLength": 10,
+			},
+			"email": map[string]interface{}{
+				"type":   "string",
+				"format": "email",
+			},
+			"age": map[string]interface{}{
+				"type":    "integer",
+				"minimum": 0,
+				"maximum": 150,
+			},
+			"size": map[string]interface{}{
+				"type": "string",
+				"enum": []interface{}{"S", "M", "L"},
+			},
+			"tags": map[string]interface{}{
+				"type":     "array",
+				"maxItems": 2,
+				"items":    map[string]interface{}{"type": "string"},
+			},
+		},
+		"required": []interface{}{"name", "email"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           map[string]interface{}
+		expectedFields []string
+	}{
+		{
+			name: "valid answers",
+			data: map[string]interface{}{
+				"name":  "Alice",
+				"email": "alice@example.com",
+				"age":   float64(30),
+				"size":  "M",
+				"tags":  []interface{}{"a", "b"},
+			},
+		},
+		{
+			name:           "missing required fields",
+			data:           map[string]interface{}{},
+			expectedFields: []string{"name", "email"},
+		},
+		{
+			name: "wrong types",
+			data: map[string]interface{}{
+				"name":  float64(1),
+				"email": "alice@example.com",
+				"age":   1.5,
+			},
+			expectedFields: []string{"age", "name"},
+		},
+		{
+			name: "constraint violations",
+			data: map[string]interface{}{
+				"name":  "A",
+				"email": "not-an-email",
+				"age":   float64(200),
+				"size":  "XL",
+				"tags":  []interface{}{"a", "b", float64(3)},
+			},
+			expectedFields: []string{"age", "email", "name", "size", "tags", "tags[2]"},
+		},
+		{
+			name: "unknown fields are allowed by default",
+			data: map[string]interface{}{
+				"name":  "Alice",
+				"email": "alice@example.com",
+				"other": "value",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate(testSchema(), tt.data)
+
+			fields := make([]string, len(errs))
+			for i, err := range errs {
+				fields[i] = err.Field
+			}
+			assert.ElementsMatch(t, tt.expectedFields, fields)
+		})
+	}
+}
+
+func TestValidate_AdditionalPropertiesFalse(t *testing.T) {
+	s := testSchema()
+	s["additionalProperties"] = false
+
+	errs := Validate(s, map[string]interface{}{
+		"name":  "Alice",
+		"email": "alice@example.com",
+		"other": "value",
+	})
+
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "other", errs[0].Field)
+	assert.Equal(t, "validation error in other: is not allowed", errs[0].Error())
+}
+
+func TestValidate_MongoTypes(t *testing.T) {
+	s := primitive.D{
+		{Key: "type", Value: "object"},
+		{Key: "properties", Value: primitive.D{
+			{Key: "count", Value: primitive.D{
+				{Key: "type", Value: "integer"},
+				{Key: "minimum", Value: int32(1)},
+			}},
+		}},
+		{Key: "required", Value: primitive.A{"count"}},
+	}
+
+	assert.Empty(t, Validate(s, map[string]interface{}{"count": int64(3)}))
+
+	errs := Validate(s, map[string]interface{}{"count": int32(0)})
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "count", errs[0].Field)
+}
+
+func TestValidate_InvalidSchema(t *testing.T) {
+	errs := Validate("not a schema", map[string]interface{}{})
+
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "schema", errs[0].Field)
+}
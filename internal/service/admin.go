@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/arwoosa/form/conf"
+)
+
+// isAdmin reports whether the user is a configured platform administrator
+func isAdmin(config *conf.AppConfig, userID string) bool {
+	if config == nil || config.AdminConfig == nil || userID == "" {
+		return false
+	}
+	return slices.Contains(config.AdminConfig.UserIDs, userID)
+}
+
+// isPlatformAdmin reports whether the user is a configured platform administrator or holds the
+// platform admin relation in Keto
+func isPlatformAdmin(ctx context.Context, config *conf.AppConfig, checkRelation relationCheckFunc, userID string) (bool, error) {
+	if isAdmin(config, userID) {
+		return true, nil
+	}
+	if config == nil || config.AdminConfig == nil || config.AdminConfig.KetoNamespace == "" || userID == "" {
+		return false, nil
+	}
+
+	cfg := config.AdminConfig
+	return checkRelation(ctx, cfg.KetoNamespace, cfg.KetoObject, cfg.KetoRelation, "User", userID)
+}
+
+// authorizePlatformAdmin rejects callers who are not platform admins, recording the attempt
+func authorizePlatformAdmin(ctx context.Context, config *conf.AppConfig, checkRelation relationCheckFunc, userID, action, merchantID string) error {
+	ok, err := isPlatformAdmin(ctx, config, checkRelation, userID)
+	if err != nil {
+		log.Error("Failed to check platform admin role", log.Err(err), log.String("user_id", userID))
+		return ErrInternalError
+	}
+	if !ok {
+		log.Warn("Audit: admin access denied",
+			log.String("audit", "admin_access_denied"),
+			log.String("user_id", userID),
+			log.String("action", action),
+			log.String("merchant_id", merchantID))
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// auditAdminAccess records a platform admin reading another merchant's data
+func auditAdminAccess(adminID, action, merchantID, resourceID string) {
+	log.Info("Audit: admin access to merchant data",
+		log.String("audit", "admin_access"),
+		log.String("admin_id", adminID),
+		log.String("action", action),
+		log.String("merchant_id", merchantID),
+		log.String("resource_id", resourceID))
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/kms"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// AnswerEncryption encrypts the answers to PII fields before they are stored, using envelope
+// encryption: each merchant has a data key, stored wrapped by the KMS, and unwrapped data keys
+// are only kept in memory.
+type AnswerEncryption struct {
+	keyRepo repository.MerchantKeyRepository
+	kms     kms.KMS
+
+	mu   sync.Mutex
+	keys map[primitive.ObjectID][]byte // Unwrapped data keys by ID
+}
+
+// NewAnswerEncryption creates the encryption of PII answers
+func NewAnswerEncryption(keyRepo repository.MerchantKeyRepository, keys kms.KMS) *AnswerEncryption {
+	return &AnswerEncryption{
+		keyRepo: keyRepo,
+		kms:     keys,
+		keys:    make(map[primitive.ObjectID][]byte),
+	}
+}
+
+// seal returns the submission as it is stored, with the answers to the PII fields of the schema
+// moved to EncryptedAnswers; the submission itself is left unchanged. Without encryption
+// configured, answers to PII fields are refused rather than stored in clear text.
+func (e *AnswerEncryption) seal(ctx context.Context, formSchema interface{}, submission *models.FormSubmission) (*models.FormSubmission, error) {
+	answers, _ := schema.Normalize(submission.Answers).(map[string]interface{})
+	var fields []string
+	for field := range schema.PIIFields(formSchema) {
+		if _, ok := answers[field]; ok {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return submission, nil
+	}
+	if e == nil {
+		return nil, ErrEncryptionNotConfigured
+	}
+
+	keyID, dataKey, err := e.merchantKey(ctx, submission.MerchantID)
+	if err != nil {
+		log.Error("Failed to get merchant data key", log.Err(err), log.String("merchant_id", submission.MerchantID))
+		return nil, ErrInternalError
+	}
+
+	stored := *submission
+	stored.KeyID = keyID
+	stored.EncryptedAnswers = make(map[string][]byte, len(fields))
+	for _, field := range fields {
+		plaintext, err := json.Marshal(answers[field])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		ciphertext, err := kms.Encrypt(dataKey, plaintext, answerContext(submission.ID, field))
+		if err != nil {
+			log.Error("Failed to encrypt answer", log.Err(err), log.String("field", field))
+			return nil, ErrInternalError
+		}
+		stored.EncryptedAnswers[field] = ciphertext
+		// Normalize copied the answers, so the submission keeps the clear text
+		delete(answers, field)
+	}
+	stored.Answers = answers
+	return &stored, nil
+}
+
+// open decrypts the encrypted answers of a stored submission back into its answers
+func (e *AnswerEncryption) open(ctx context.Context, submission *models.FormSubmission) error {
+	if len(submission.EncryptedAnswers) == 0 {
+		return nil
+	}
+	if e == nil {
+		return ErrEncryptionNotConfigured
+	}
+
+	dataKey, err := e.dataKey(ctx, submission.KeyID)
+	if err != nil {
+		log.Error("Failed to get merchant data key", log.Err(err), log.String("key_id", submission.KeyID.Hex()))
+		return ErrInternalError
+	}
+
+	answers, _ := schema.Normalize(submission.Answers).(map[string]interface{})
+	if answers == nil {
+		answers = make(map[string]interface{}, len(submission.EncryptedAnswers))
+	}
+	for field, ciphertext := range submission.EncryptedAnswers {
+		plaintext, err := kms.Decrypt(dataKey, ciphertext, answerContext(submission.ID, field))
+		if err != nil {
+			log.Error("Failed to decrypt answer", log.Err(err),
+				log.String("submission_id", submission.ID.Hex()),
+				log.String("field", field))
+			return ErrInternalError
+		}
+		var value interface{}
+		if err := json.Unmarshal(plaintext, &value); err != nil {
+			log.Error("Failed to decode decrypted answer", log.Err(err), log.String("field", field))
+			return ErrInternalError
+		}
+		answers[field] = value
+	}
+	submission.Answers = answers
+	submission.EncryptedAnswers = nil
+	return nil
+}
+
+// merchantKey returns the latest data key of a merchant, creating the first one on demand.
+// Concurrent first submissions may each create a key; every submission records the key it used.
+func (e *AnswerEncryption) merchantKey(ctx context.Context, merchantID string) (primitive.ObjectID, []byte, error) {
+	key, err := e.keyRepo.FindLatest(ctx, merchantID)
+	if err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+	if key != nil {
+		dataKey, err := e.unwrap(ctx, key)
+		return key.ID, dataKey, err
+	}
+
+	generated, err := e.kms.GenerateDataKey(ctx)
+	if err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+	key = &models.MerchantKey{
+		MerchantID:  merchantID,
+		MasterKeyID: generated.MasterKeyID,
+		WrappedKey:  generated.Wrapped,
+	}
+	if err := e.keyRepo.Create(ctx, key); err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+	e.cache(key.ID, generated.Plaintext)
+
+	log.Info("Merchant data key created", log.String("merchant_id", merchantID), log.String("key_id", key.ID.Hex()))
+	return key.ID, generated.Plaintext, nil
+}
+
+// dataKey returns the unwrapped data key with the ID
+func (e *AnswerEncryption) dataKey(ctx context.Context, keyID primitive.ObjectID) ([]byte, error) {
+	e.mu.Lock()
+	dataKey, ok := e.keys[keyID]
+	e.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+
+	key, err := e.keyRepo.FindByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return e.unwrap(ctx, key)
+}
+
+// unwrap asks the KMS for the plaintext of a stored data key, unless it is cached
+func (e *AnswerEncryption) unwrap(ctx context.Context, key *models.MerchantKey) ([]byte, error) {
+	e.mu.Lock()
+	dataKey, ok := e.keys[key.ID]
+	e.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+
+	dataKey, err := e.kms.Decrypt(ctx, key.MasterKeyID, key.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	e.cache(key.ID, dataKey)
+	return dataKey, nil
+}
+
+func (e *AnswerEncryption) cache(keyID primitive.ObjectID, dataKey []byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.keys[keyID] = dataKey
+}
+
+// answerContext binds an encrypted answer to its submission and field, so ciphertexts cannot be
+// moved to another submission or field
+func answerContext(submissionID primitive.ObjectID, field string) []byte {
+	return []byte(submissionID.Hex() + "/" + field)
+}
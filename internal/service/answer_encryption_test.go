@@ -0,0 +1,138 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/dao/memory"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/kms"
+	"github.com/arwoosa/form/internal/models"
+)
+
+var piiSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"email":  map[string]interface{}{"type": "string", "pii": true},
+		"rating": map[string]interface{}{"type": "integer", "enum": []interface{}{1, 2, 3}},
+	},
+}
+
+func setupAnswerEncryption(t *testing.T) (*AnswerEncryption, repository.FormSubmissionRepository) {
+	store := memory.NewStore()
+	keys, err := kms.NewLocal("master-1", bytes.Repeat([]byte{7}, kms.DataKeySize))
+	require.NoError(t, err)
+	return NewAnswerEncryption(memory.NewMerchantKeyRepository(store), keys), memory.NewFormSubmissionRepository(store)
+}
+
+func TestAnswerEncryption_SealAndOpen(t *testing.T) {
+	ctx := context.Background()
+	encryption, submissions := setupAnswerEncryption(t)
+	formID := primitive.NewObjectID()
+	submission := &models.FormSubmission{
+		ID:         primitive.NewObjectID(),
+		FormID:     formID,
+		MerchantID: "merchant123",
+		Answers:    map[string]interface{}{"email": "alice@example.com", "rating": 3},
+	}
+
+	stored, err := encryption.seal(ctx, piiSchema, submission)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"rating": 3}, stored.Answers)
+	assert.Contains(t, stored.EncryptedAnswers, "email")
+	assert.NotContains(t, string(stored.EncryptedAnswers["email"]), "alice")
+	assert.Equal(t, "alice@example.com", submission.Answers.(map[string]interface{})["email"])
+	require.NoError(t, submissions.Create(ctx, stored))
+
+	// Another instance with an empty key cache reads the stored answers
+	other := NewAnswerEncryption(encryption.keyRepo, encryption.kms)
+	found, _, err := submissions.Find(ctx, &models.SubmissionQueryOptions{FormID: formID, MerchantID: "merchant123", Page: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.NoError(t, other.open(ctx, found[0]))
+	assert.Equal(t, "alice@example.com", found[0].Answers.(map[string]interface{})["email"])
+	assert.Nil(t, found[0].EncryptedAnswers)
+
+	// Later submissions of the merchant reuse its data key
+	second, err := encryption.seal(ctx, piiSchema, &models.FormSubmission{
+		ID: primitive.NewObjectID(), MerchantID: "merchant123", Answers: map[string]interface{}{"email": "bob@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, stored.KeyID, second.KeyID)
+}
+
+func TestAnswerEncryption_BoundToSubmission(t *testing.T) {
+	ctx := context.Background()
+	encryption, _ := setupAnswerEncryption(t)
+
+	stored, err := encryption.seal(ctx, piiSchema, &models.FormSubmission{
+		ID: primitive.NewObjectID(), MerchantID: "merchant123", Answers: map[string]interface{}{"email": "alice@example.com"},
+	})
+	require.NoError(t, err)
+
+	stored.ID = primitive.NewObjectID()
+	assert.Equal(t, ErrInternalError, encryption.open(ctx, stored))
+}
+
+func TestAnswerEncryption_NotConfigured(t *testing.T) {
+	ctx := context.Background()
+	var encryption *AnswerEncryption
+
+	_, err := encryption.seal(ctx, piiSchema, &models.FormSubmission{Answers: map[string]interface{}{"email": "alice@example.com"}})
+	assert.Equal(t, ErrEncryptionNotConfigured, err)
+
+	// Forms without PII answers are unaffected
+	submission := &models.FormSubmission{Answers: map[string]interface{}{"rating": 1}}
+	stored, err := encryption.seal(ctx, piiSchema, submission)
+	require.NoError(t, err)
+	assert.Same(t, submission, stored)
+	assert.NoError(t, encryption.open(ctx, submission))
+}
+
+func TestStatsFields_SkipsPII(t *testing.T) {
+	choiceFields, numericFields, allFields, _ := statsFields(piiSchema)
+
+	assert.Equal(t, []string{"rating"}, choiceFields)
+	assert.Empty(t, numericFields)
+	assert.Equal(t, []string{"rating"}, allFields)
+}
+
+func TestStatsFields_RequiredPII(t *testing.T) {
+	ctx := context.Background()
+	encryption, submissions := setupAnswerEncryption(t)
+	formSchema := map[string]interface{}{
+		"type":       "object",
+		"properties": piiSchema["properties"],
+		"required":   []interface{}{"email", "rating"},
+	}
+	_, _, _, requiredFields := statsFields(formSchema)
+	assert.Equal(t, []string{"rating"}, requiredFields)
+	assert.Equal(t, []string{"email"}, requiredPIIFields(formSchema))
+
+	// Responses count as complete only when they hold an encrypted answer to the PII field
+	formID := primitive.NewObjectID()
+	for _, answers := range []map[string]interface{}{
+		{"email": "jane@example.com", "rating": 2},
+		{"rating": 3},
+	} {
+		submission := &models.FormSubmission{ID: primitive.NewObjectID(), FormID: formID, MerchantID: "merchant123", Answers: answers}
+		stored, err := encryption.seal(ctx, formSchema, submission)
+		require.NoError(t, err)
+		require.NoError(t, submissions.Create(ctx, stored))
+	}
+
+	stats, err := submissions.AggregateStats(ctx, &models.FormResponseStatsQuery{
+		FormID:                  formID,
+		MerchantID:              "merchant123",
+		CompleteFields:          requiredFields,
+		CompleteEncryptedFields: requiredPIIFields(formSchema),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.TotalResponses)
+	assert.Equal(t, int64(1), stats.CompleteResponses)
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/checkin"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// defaultCheckInTokenTTL is used when neither the request nor the configuration sets a lifetime
+const defaultCheckInTokenTTL = 30 * 24 * time.Hour
+
+// maxSessionIDLength matches the session IDs check-ins accept
+const maxSessionIDLength = 100
+
+// AttendanceService records check-ins of registered respondents at event sessions. A response to
+// a form is a registration; sessions are identified by the event service's session IDs.
+type AttendanceService struct {
+	attendanceRepo repository.AttendanceRepository
+	submissionRepo repository.FormSubmissionRepository
+	formRepo       repository.FormRepository
+	config         *conf.AppConfig
+}
+
+// NewAttendanceService creates a new attendance service
+func NewAttendanceService(attendanceRepo repository.AttendanceRepository, submissionRepo repository.FormSubmissionRepository, formRepo repository.FormRepository, config *conf.AppConfig) *AttendanceService {
+	return &AttendanceService{
+		attendanceRepo: attendanceRepo,
+		submissionRepo: submissionRepo,
+		formRepo:       formRepo,
+		config:         config,
+	}
+}
+
+// CheckIn records that the respondent of a submission checked in to a session. Checking in again
+// is not an error: the first check-in is returned and reported as a duplicate.
+func (s *AttendanceService) CheckIn(ctx context.Context, input *models.CheckInInput) (*models.CheckInResult, error) {
+	if err := validate.Struct(input); err != nil {
+		log.Error("CheckIn validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	form, err := s.merchantForm(ctx, input.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.checkIn(ctx, form, input.SubmissionID, input.SessionID, input.CheckedInBy)
+}
+
+// IssueCheckInToken issues the signed check-in token of a registration for a session, to be
+// rendered as a QR code and scanned at the door
+func (s *AttendanceService) IssueCheckInToken(ctx context.Context, input *models.IssueCheckInTokenInput) (*models.CheckInToken, error) {
+	if err := validate.Struct(input); err != nil {
+		log.Error("IssueCheckInToken validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	secret, err := s.tokenSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := s.merchantForm(ctx, input.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	submission, err := s.registration(ctx, form, input.SubmissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := input.TTL
+	if ttl == 0 {
+		ttl = defaultCheckInTokenTTL
+		if s.config != nil && s.config.CheckInConfig != nil && s.config.CheckInConfig.TTL > 0 {
+			ttl = s.config.CheckInConfig.TTL
+		}
+	}
+	// Tokens carry second precision
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+
+	token := checkin.Sign(secret, checkin.Claims{
+		SubmissionID: submission.ID,
+		FormID:       form.ID,
+		SessionID:    input.SessionID,
+		ExpiresAt:    expiresAt,
+	})
+
+	return &models.CheckInToken{
+		Token:        token,
+		SubmissionID: submission.ID,
+		SessionID:    input.SessionID,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// RedeemCheckInToken verifies a scanned check-in token and checks its registration in to the
+// session it was issued for. A token checks in once; scanning it again reports a duplicate.
+func (s *AttendanceService) RedeemCheckInToken(ctx context.Context, input *models.RedeemCheckInTokenInput) (*models.CheckInResult, error) {
+	if err := validate.Struct(input); err != nil {
+		log.Error("RedeemCheckInToken validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	secret, err := s.tokenSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := checkin.Parse(secret, input.Token, time.Now())
+	if err != nil {
+		log.Warn("Rejected check-in token", log.Err(err))
+		return nil, ErrInvalidCheckInToken
+	}
+	if input.SessionID != "" && claims.SessionID != input.SessionID {
+		return nil, ErrInvalidCheckInToken
+	}
+
+	form, err := s.merchantForm(ctx, claims.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.checkIn(ctx, form, claims.SubmissionID, claims.SessionID, input.CheckedInBy)
+}
+
+// GetSessionAttendance counts the attendees checked in to a session against the form's
+// registrations and response quota. Those two are form-wide: sessions are owned by the event
+// service, which also holds their capacity, so an unknown session ID reports no check-ins.
+func (s *AttendanceService) GetSessionAttendance(ctx context.Context, formID primitive.ObjectID, sessionID, merchantID string) (*models.SessionAttendance, error) {
+	if sessionID == "" || len(sessionID) > maxSessionIDLength {
+		return nil, fmt.Errorf("%w: session_id is required and at most %d characters", ErrInvalidInput, maxSessionIDLength)
+	}
+
+	form, err := s.merchantForm(ctx, formID, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedIn, err := s.attendanceRepo.CountBySession(ctx, form.ID, sessionID)
+	if err != nil {
+		log.Error("Failed to count attendees", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+	responses, err := s.submissionRepo.CountByFormID(ctx, form.ID)
+	if err != nil {
+		log.Error("Failed to count registrations", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+
+	return &models.SessionAttendance{
+		FormID:           form.ID,
+		SessionID:        sessionID,
+		CheckedIn:        checkedIn,
+		FormResponses:    responses,
+		FormMaxResponses: form.MaxResponses,
+	}, nil
+}
+
+// checkIn records the attendance of a registration of the form at a session
+func (s *AttendanceService) checkIn(ctx context.Context, form *models.Form, submissionID primitive.ObjectID, sessionID, checkedInBy string) (*models.CheckInResult, error) {
+	submission, err := s.registration(ctx, form, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendance := &models.Attendance{
+		ID:           primitive.NewObjectID(),
+		MerchantID:   form.MerchantID,
+		FormID:       form.ID,
+		EventID:      form.EventID,
+		SessionID:    sessionID,
+		SubmissionID: submission.ID,
+		CheckedInBy:  checkedInBy,
+	}
+	attendance.SetCheckedInAt(time.Now())
+
+	existing, err := s.attendanceRepo.CheckIn(ctx, attendance)
+	if err != nil {
+		log.Error("Failed to record check-in", log.Err(err), log.String("submission_id", submission.ID.Hex()))
+		return nil, ErrInternalError
+	}
+	if existing != nil {
+		return &models.CheckInResult{Attendance: existing, AlreadyCheckedIn: true}, nil
+	}
+
+	log.Info("Attendee checked in",
+		log.String("form_id", form.ID.Hex()),
+		log.String("session_id", sessionID),
+		log.String("submission_id", submission.ID.Hex()))
+
+	return &models.CheckInResult{Attendance: attendance}, nil
+}
+
+// registration returns a submission of the form
+func (s *AttendanceService) registration(ctx context.Context, form *models.Form, submissionID primitive.ObjectID) (*models.FormSubmission, error) {
+	submission, err := s.submissionRepo.FindByID(ctx, submissionID)
+	if err != nil {
+		log.Error("Failed to get submission", log.Err(err), log.String("submission_id", submissionID.Hex()))
+		return nil, ErrSubmissionNotFound
+	}
+	if submission.FormID != form.ID {
+		return nil, ErrSubmissionNotFound
+	}
+	return submission, nil
+}
+
+// merchantForm returns a form of the merchant
+func (s *AttendanceService) merchantForm(ctx context.Context, formID primitive.ObjectID, merchantID string) (*models.Form, error) {
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+	return form, nil
+}
+
+func (s *AttendanceService) tokenSecret() ([]byte, error) {
+	if s.config == nil || s.config.CheckInConfig == nil || s.config.CheckInConfig.Secret == "" {
+		log.Error("Check-in secret is not configured")
+		return nil, ErrInternalError
+	}
+	return []byte(s.config.CheckInConfig.Secret), nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/checkin"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock AttendanceRepository
+type MockAttendanceRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttendanceRepository) CheckIn(ctx context.Context, attendance *models.Attendance) (*models.Attendance, error) {
+	args := m.Called(ctx, attendance)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Attendance), args.Error(1)
+}
+
+func (m *MockAttendanceRepository) CountBySession(ctx context.Context, formID primitive.ObjectID, sessionID string) (int64, error) {
+	args := m.Called(ctx, formID, sessionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Test setup helper for AttendanceService
+func setupAttendanceService() (*AttendanceService, *MockAttendanceRepository, *MockFormSubmissionRepository, *MockFormRepository) {
+	mockAttendanceRepo := &MockAttendanceRepository{}
+	mockSubmissionRepo := &MockFormSubmissionRepository{}
+	mockFormRepo := &MockFormRepository{}
+	config := &conf.AppConfig{
+		CheckInConfig: &conf.CheckInConfig{Secret: "test-secret", TTL: time.Hour},
+	}
+	service := NewAttendanceService(mockAttendanceRepo, mockSubmissionRepo, mockFormRepo, config)
+	return service, mockAttendanceRepo, mockSubmissionRepo, mockFormRepo
+}
+
+func createTestCheckInInput(form *models.Form, submission *models.FormSubmission) *models.CheckInInput {
+	return &models.CheckInInput{
+		FormID:       form.ID,
+		SubmissionID: submission.ID,
+		SessionID:    "session-1",
+		MerchantID:   "merchant123",
+		CheckedInBy:  "staff1",
+	}
+}
+
+func TestAttendanceService_CheckIn_Success(t *testing.T) {
+	service, mockAttendanceRepo, mockSubmissionRepo, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	submission := &models.FormSubmission{ID: primitive.NewObjectID(), FormID: form.ID}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("FindByID", ctx, submission.ID).Return(submission, nil)
+	mockAttendanceRepo.On("CheckIn", ctx, mock.MatchedBy(func(a *models.Attendance) bool {
+		return a.SubmissionID == submission.ID && a.SessionID == "session-1" && a.CheckedInBy == "staff1"
+	})).Return(nil, nil)
+
+	result, err := service.CheckIn(ctx, createTestCheckInInput(form, submission))
+
+	require.NoError(t, err)
+	assert.False(t, result.AlreadyCheckedIn)
+	assert.Equal(t, form.ID, result.Attendance.FormID)
+	assert.False(t, result.Attendance.GetCheckedInAt().IsZero())
+	mockAttendanceRepo.AssertExpectations(t)
+}
+
+func TestAttendanceService_CheckIn_Duplicate(t *testing.T) {
+	service, mockAttendanceRepo, mockSubmissionRepo, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	submission := &models.FormSubmission{ID: primitive.NewObjectID(), FormID: form.ID}
+	existing := &models.Attendance{ID: primitive.NewObjectID(), FormID: form.ID, SubmissionID: submission.ID, SessionID: "session-1", CheckedInBy: "staff0"}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("FindByID", ctx, submission.ID).Return(submission, nil)
+	mockAttendanceRepo.On("CheckIn", ctx, mock.Anything).Return(existing, nil)
+
+	result, err := service.CheckIn(ctx, createTestCheckInInput(form, submission))
+
+	require.NoError(t, err)
+	assert.True(t, result.AlreadyCheckedIn)
+	assert.Equal(t, existing, result.Attendance)
+}
+
+func TestAttendanceService_CheckIn_SubmissionOfAnotherForm(t *testing.T) {
+	service, mockAttendanceRepo, mockSubmissionRepo, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	submission := &models.FormSubmission{ID: primitive.NewObjectID(), FormID: primitive.NewObjectID()}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("FindByID", ctx, submission.ID).Return(submission, nil)
+
+	_, err := service.CheckIn(ctx, createTestCheckInInput(form, submission))
+
+	assert.ErrorIs(t, err, ErrSubmissionNotFound)
+	mockAttendanceRepo.AssertNotCalled(t, "CheckIn", mock.Anything, mock.Anything)
+}
+
+func TestAttendanceService_GetSessionAttendance(t *testing.T) {
+	service, mockAttendanceRepo, mockSubmissionRepo, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.MaxResponses = 50
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockAttendanceRepo.On("CountBySession", ctx, form.ID, "session-1").Return(int64(12), nil)
+	mockSubmissionRepo.On("CountByFormID", ctx, form.ID).Return(int64(40), nil)
+
+	attendance, err := service.GetSessionAttendance(ctx, form.ID, "session-1", "merchant123")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), attendance.CheckedIn)
+	assert.Equal(t, int64(40), attendance.FormResponses)
+	assert.Equal(t, 50, attendance.FormMaxResponses)
+
+	_, err = service.GetSessionAttendance(ctx, form.ID, "session-1", "other-merchant")
+	assert.ErrorIs(t, err, ErrFormNotFound)
+}
+
+func TestAttendanceService_IssueAndRedeemCheckInToken(t *testing.T) {
+	service, mockAttendanceRepo, mockSubmissionRepo, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	submission := &models.FormSubmission{ID: primitive.NewObjectID(), FormID: form.ID}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("FindByID", ctx, submission.ID).Return(submission, nil)
+	mockAttendanceRepo.On("CheckIn", ctx, mock.MatchedBy(func(a *models.Attendance) bool {
+		return a.SubmissionID == submission.ID && a.SessionID == "session-1" && a.CheckedInBy == "staff1"
+	})).Return(nil, nil)
+
+	token, err := service.IssueCheckInToken(ctx, &models.IssueCheckInTokenInput{
+		FormID:       form.ID,
+		SubmissionID: submission.ID,
+		SessionID:    "session-1",
+		MerchantID:   "merchant123",
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Second)
+
+	result, err := service.RedeemCheckInToken(ctx, &models.RedeemCheckInTokenInput{
+		Token:       token.Token,
+		SessionID:   "session-1",
+		MerchantID:  "merchant123",
+		CheckedInBy: "staff1",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.AlreadyCheckedIn)
+	mockAttendanceRepo.AssertExpectations(t)
+}
+
+func TestAttendanceService_RedeemCheckInToken_Rejected(t *testing.T) {
+	service, mockAttendanceRepo, _, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	claims := checkin.Claims{
+		SubmissionID: primitive.NewObjectID(),
+		FormID:       form.ID,
+		SessionID:    "session-1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+	expired := claims
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		token     string
+		sessionID string
+		merchant  string
+		expected  error
+	}{
+		{"Wrong secret", checkin.Sign([]byte("other"), claims), "", "merchant123", ErrInvalidCheckInToken},
+		{"Expired", checkin.Sign([]byte("test-secret"), expired), "", "merchant123", ErrInvalidCheckInToken},
+		{"Other session", checkin.Sign([]byte("test-secret"), claims), "session-2", "merchant123", ErrInvalidCheckInToken},
+		{"Other merchant", checkin.Sign([]byte("test-secret"), claims), "", "other-merchant", ErrFormNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.RedeemCheckInToken(ctx, &models.RedeemCheckInTokenInput{
+				Token:       tt.token,
+				SessionID:   tt.sessionID,
+				MerchantID:  tt.merchant,
+				CheckedInBy: "staff1",
+			})
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+	mockAttendanceRepo.AssertNotCalled(t, "CheckIn", mock.Anything, mock.Anything)
+}
+
+func TestAttendanceService_GetSessionAttendance_InvalidSession(t *testing.T) {
+	service, mockAttendanceRepo, _, mockFormRepo := setupAttendanceService()
+	ctx := context.Background()
+
+	_, err := service.GetSessionAttendance(ctx, primitive.NewObjectID(), "", "merchant123")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = service.GetSessionAttendance(ctx, primitive.NewObjectID(), strings.Repeat("s", 101), "merchant123")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	mockFormRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	mockAttendanceRepo.AssertNotCalled(t, "CountBySession", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// relationUsersFunc lists the users directly holding a Keto relation on an object
+type relationUsersFunc func(ctx context.Context, namespace, object, relation string) ([]string, error)
+
+// userRoleFunc sets the single role a user directly holds on a Keto object
+type userRoleFunc func(ctx context.Context, namespace, object, userID string, role models.CollaboratorRole) error
+
+// removeUserRoleFunc removes the editor or viewer role a user directly holds on a Keto object
+type removeUserRoleFunc func(ctx context.Context, namespace, object, userID string) error
+
+// CollaboratorService shares forms with other users of the merchant by granting Keto roles
+type CollaboratorService struct {
+	formRepo      repository.FormRepository
+	config        *conf.AppConfig
+	checkRelation relationCheckFunc
+	relationUsers relationUsersFunc
+	setUserRole   userRoleFunc
+	removeRole    removeUserRoleFunc
+}
+
+// NewCollaboratorService creates a new collaborator service
+func NewCollaboratorService(formRepo repository.FormRepository, config *conf.AppConfig) *CollaboratorService {
+	return &CollaboratorService{
+		formRepo:      formRepo,
+		config:        config,
+		checkRelation: relation.Check,
+		relationUsers: ketoRelationUsers,
+		setUserRole:   ketoSetUserRole,
+		removeRole:    ketoRemoveUserRole,
+	}
+}
+
+// ShareForm grants a user the editor or viewer role on a form, replacing any role previously
+// shared with them. Only the form owner may share it.
+func (s *CollaboratorService) ShareForm(ctx context.Context, input *models.ShareFormInput) ([]models.Collaborator, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("ShareForm validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if input.UserID == input.SharedBy {
+		return nil, fmt.Errorf("%w: the owner's role cannot be changed", ErrInvalidInput)
+	}
+
+	form, err := s.findForm(ctx, input.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.checkRelation(ctx, "Form", form.ID.Hex(), string(models.CollaboratorRoleOwner), "User", input.SharedBy)
+	if err != nil {
+		log.Error("Failed to check form owner", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+	if !owner {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := s.setUserRole(ctx, "Form", form.ID.Hex(), input.UserID, input.Role); err != nil {
+		log.Error("Failed to share form", log.Err(err),
+			log.String("form_id", form.ID.Hex()),
+			log.String("user_id", input.UserID))
+		metrics.KetoWriteFailures.WithLabelValues("Form", "share").Inc()
+		return nil, ErrInternalError
+	}
+
+	log.Info("Form shared",
+		log.String("form_id", form.ID.Hex()),
+		log.String("user_id", input.UserID),
+		log.String("role", string(input.Role)),
+		log.String("shared_by", input.SharedBy))
+	return s.collaborators(ctx, form.ID)
+}
+
+// UnshareForm removes the editor or viewer role a form is shared with a user. Only the form owner
+// may unshare it.
+func (s *CollaboratorService) UnshareForm(ctx context.Context, input *models.UnshareFormInput) ([]models.Collaborator, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("UnshareForm validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if input.UserID == input.UnsharedBy {
+		return nil, fmt.Errorf("%w: the owner's role cannot be changed", ErrInvalidInput)
+	}
+
+	form, err := s.findForm(ctx, input.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.checkRelation(ctx, "Form", form.ID.Hex(), string(models.CollaboratorRoleOwner), "User", input.UnsharedBy)
+	if err != nil {
+		log.Error("Failed to check form owner", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+	if !owner {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := s.removeRole(ctx, "Form", form.ID.Hex(), input.UserID); err != nil {
+		log.Error("Failed to unshare form", log.Err(err),
+			log.String("form_id", form.ID.Hex()),
+			log.String("user_id", input.UserID))
+		metrics.KetoWriteFailures.WithLabelValues("Form", "unshare").Inc()
+		return nil, ErrInternalError
+	}
+
+	log.Info("Form unshared",
+		log.String("form_id", form.ID.Hex()),
+		log.String("user_id", input.UserID),
+		log.String("unshared_by", input.UnsharedBy))
+	return s.collaborators(ctx, form.ID)
+}
+
+// ListFormCollaborators lists the users holding a role on a form with their most privileged role
+func (s *CollaboratorService) ListFormCollaborators(ctx context.Context, formID primitive.ObjectID, merchantID string) ([]models.Collaborator, error) {
+	form, err := s.findForm(ctx, formID, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.collaborators(ctx, form.ID)
+}
+
+// findForm loads a form of the merchant
+func (s *CollaboratorService) findForm(ctx context.Context, formID primitive.ObjectID, merchantID string) (*models.Form, error) {
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+	return form, nil
+}
+
+// collaborators reads the users of each role on a form; a user listed under several roles is
+// reported once with the most privileged
+func (s *CollaboratorService) collaborators(ctx context.Context, formID primitive.ObjectID) ([]models.Collaborator, error) {
+	seen := make(map[string]bool)
+	collaborators := []models.Collaborator{}
+	for _, role := range models.CollaboratorRoles {
+		users, err := s.relationUsers(ctx, "Form", formID.Hex(), string(role))
+		if err != nil {
+			log.Error("Failed to list form collaborators", log.Err(err),
+				log.String("form_id", formID.Hex()),
+				log.String("role", string(role)))
+			return nil, ErrInternalError
+		}
+		for _, userID := range users {
+			if seen[userID] {
+				continue
+			}
+			seen[userID] = true
+			collaborators = append(collaborators, models.Collaborator{UserID: userID, Role: role})
+		}
+	}
+	return collaborators, nil
+}
+
+// ketoRelationUsers lists the users of the User namespace directly related to an object, skipping
+// the subject sets that derive one role from another
+func ketoRelationUsers(ctx context.Context, namespace, object, rel string) ([]string, error) {
+	resp, err := relation.QuerySubjectByObjectRelation(ctx, namespace, object, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []string
+	for _, set := range resp.SubjectSets {
+		if set.Namespace == "User" {
+			users = append(users, set.Object)
+		}
+	}
+	return users, nil
+}
+
+// ketoSetUserRole removes the editor or viewer tuple a user directly holds, then grants the role.
+// The removal comes first because a role implied by an existing one is not written again.
+func ketoSetUserRole(ctx context.Context, namespace, object, userID string, role models.CollaboratorRole) error {
+	tuples := relation.NewTupleBuilder()
+	for _, shared := range []models.CollaboratorRole{models.CollaboratorRoleEditor, models.CollaboratorRoleViewer} {
+		if shared != role {
+			tuples.AppendDeleteTupleWithSubjectSet(namespace, object, string(shared), "User", userID)
+		}
+	}
+	if err := relation.WriteTuple(ctx, tuples); err != nil {
+		return err
+	}
+
+	grant := relation.RoleViewer
+	if role == models.CollaboratorRoleEditor {
+		grant = relation.RoleEditor
+	}
+	return relation.AddUserResourceRole(ctx, userID, namespace, object, grant)
+}
+
+// ketoRemoveUserRole deletes the editor and viewer tuples a user directly holds
+func ketoRemoveUserRole(ctx context.Context, namespace, object, userID string) error {
+	tuples := relation.NewTupleBuilder()
+	for _, shared := range []models.CollaboratorRole{models.CollaboratorRoleEditor, models.CollaboratorRoleViewer} {
+		tuples.AppendDeleteTupleWithSubjectSet(namespace, object, string(shared), "User", userID)
+	}
+	return relation.WriteTuple(ctx, tuples)
+}
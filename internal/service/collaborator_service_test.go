@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Test setup helper for CollaboratorService; roles holds the users directly related to the form
+// per role, as Keto would
+func setupCollaboratorService(roles map[models.CollaboratorRole][]string) (*CollaboratorService, *MockFormRepository) {
+	mockFormRepo := &MockFormRepository{}
+	service := NewCollaboratorService(mockFormRepo, &conf.AppConfig{})
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		return slices.Contains(roles[models.CollaboratorRole(relation)], subjectObject), nil
+	}
+	service.relationUsers = func(ctx context.Context, namespace, object, relation string) ([]string, error) {
+		return roles[models.CollaboratorRole(relation)], nil
+	}
+	service.setUserRole = func(ctx context.Context, namespace, object, userID string, role models.CollaboratorRole) error {
+		for _, shared := range []models.CollaboratorRole{models.CollaboratorRoleEditor, models.CollaboratorRoleViewer} {
+			roles[shared] = slices.DeleteFunc(roles[shared], func(u string) bool { return u == userID })
+		}
+		roles[role] = append(roles[role], userID)
+		return nil
+	}
+	service.removeRole = func(ctx context.Context, namespace, object, userID string) error {
+		for _, shared := range []models.CollaboratorRole{models.CollaboratorRoleEditor, models.CollaboratorRoleViewer} {
+			roles[shared] = slices.DeleteFunc(roles[shared], func(u string) bool { return u == userID })
+		}
+		return nil
+	}
+	return service, mockFormRepo
+}
+
+func TestCollaboratorService_ShareForm_Success(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner: {"user123"},
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	collaborators, err := service.ShareForm(ctx, &models.ShareFormInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		UserID:     "user456",
+		Role:       models.CollaboratorRoleEditor,
+		SharedBy:   "user123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Collaborator{
+		{UserID: "user123", Role: models.CollaboratorRoleOwner},
+		{UserID: "user456", Role: models.CollaboratorRoleEditor},
+	}, collaborators)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestCollaboratorService_ShareForm_ChangesRole(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner:  {"user123"},
+		models.CollaboratorRoleEditor: {"user456"},
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	collaborators, err := service.ShareForm(ctx, &models.ShareFormInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		UserID:     "user456",
+		Role:       models.CollaboratorRoleViewer,
+		SharedBy:   "user123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Collaborator{
+		{UserID: "user123", Role: models.CollaboratorRoleOwner},
+		{UserID: "user456", Role: models.CollaboratorRoleViewer},
+	}, collaborators)
+}
+
+func TestCollaboratorService_ShareForm_NotOwner(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner:  {"user123"},
+		models.CollaboratorRoleEditor: {"user456"},
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	_, err := service.ShareForm(ctx, &models.ShareFormInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		UserID:     "user789",
+		Role:       models.CollaboratorRoleEditor,
+		SharedBy:   "user456",
+	})
+
+	assert.Equal(t, ErrPermissionDenied, err)
+}
+
+func TestCollaboratorService_ShareForm_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, _ := setupCollaboratorService(map[models.CollaboratorRole][]string{})
+
+	tests := []struct {
+		name  string
+		input *models.ShareFormInput
+	}{
+		{
+			name:  "owner role",
+			input: &models.ShareFormInput{FormID: form.ID, MerchantID: "merchant123", UserID: "user456", Role: models.CollaboratorRoleOwner, SharedBy: "user123"},
+		},
+		{
+			name:  "missing user",
+			input: &models.ShareFormInput{FormID: form.ID, MerchantID: "merchant123", Role: models.CollaboratorRoleViewer, SharedBy: "user123"},
+		},
+		{
+			name:  "sharing with self",
+			input: &models.ShareFormInput{FormID: form.ID, MerchantID: "merchant123", UserID: "user123", Role: models.CollaboratorRoleViewer, SharedBy: "user123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ShareForm(ctx, tt.input)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		})
+	}
+}
+
+func TestCollaboratorService_ShareForm_KetoError(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner: {"user123"},
+	})
+	service.setUserRole = func(ctx context.Context, namespace, object, userID string, role models.CollaboratorRole) error {
+		return errors.New("keto unavailable")
+	}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	_, err := service.ShareForm(ctx, &models.ShareFormInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		UserID:     "user456",
+		Role:       models.CollaboratorRoleViewer,
+		SharedBy:   "user123",
+	})
+
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestCollaboratorService_UnshareForm_Success(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner:  {"user123"},
+		models.CollaboratorRoleEditor: {"user456"},
+		models.CollaboratorRoleViewer: {"user456", "user789"},
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	collaborators, err := service.UnshareForm(ctx, &models.UnshareFormInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		UserID:     "user456",
+		UnsharedBy: "user123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Collaborator{
+		{UserID: "user123", Role: models.CollaboratorRoleOwner},
+		{UserID: "user789", Role: models.CollaboratorRoleViewer},
+	}, collaborators)
+}
+
+func TestCollaboratorService_UnshareForm_Rejected(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner:  {"user123"},
+		models.CollaboratorRoleEditor: {"user456"},
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	// Editors cannot remove other collaborators
+	_, err := service.UnshareForm(ctx, &models.UnshareFormInput{
+		FormID: form.ID, MerchantID: "merchant123", UserID: "user789", UnsharedBy: "user456",
+	})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	// The owner cannot remove themselves
+	_, err = service.UnshareForm(ctx, &models.UnshareFormInput{
+		FormID: form.ID, MerchantID: "merchant123", UserID: "user123", UnsharedBy: "user123",
+	})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	service.removeRole = func(ctx context.Context, namespace, object, userID string) error {
+		return errors.New("keto unavailable")
+	}
+	_, err = service.UnshareForm(ctx, &models.UnshareFormInput{
+		FormID: form.ID, MerchantID: "merchant123", UserID: "user456", UnsharedBy: "user123",
+	})
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestCollaboratorService_ListFormCollaborators_MostPrivilegedRole(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{
+		models.CollaboratorRoleOwner:  {"user123"},
+		models.CollaboratorRoleEditor: {"user456"},
+		models.CollaboratorRoleViewer: {"user456", "user789"},
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	collaborators, err := service.ListFormCollaborators(ctx, form.ID, "merchant123")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Collaborator{
+		{UserID: "user123", Role: models.CollaboratorRoleOwner},
+		{UserID: "user456", Role: models.CollaboratorRoleEditor},
+		{UserID: "user789", Role: models.CollaboratorRoleViewer},
+	}, collaborators)
+}
+
+func TestCollaboratorService_ListFormCollaborators_OtherMerchant(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupCollaboratorService(map[models.CollaboratorRole][]string{})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	_, err := service.ListFormCollaborators(ctx, form.ID, "merchant456")
+
+	assert.Equal(t, ErrFormNotFound, err)
+}
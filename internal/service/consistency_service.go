@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+const (
+	// consistencyPageSize is the number of forms loaded per page while checking an event
+	consistencyPageSize = 100
+	// maxConsistencyForms caps the number of forms checked for a single event
+	maxConsistencyForms = 1000
+)
+
+// relationCheckFunc checks whether a Keto relation tuple exists
+type relationCheckFunc func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error)
+
+// ConsistencyService verifies that data referencing an event is consistent across resources
+type ConsistencyService struct {
+	formRepo      repository.FormRepository
+	config        *conf.AppConfig
+	checkRelation relationCheckFunc
+}
+
+// NewConsistencyService creates a new consistency service
+func NewConsistencyService(formRepo repository.FormRepository, config *conf.AppConfig) *ConsistencyService {
+	return &ConsistencyService{
+		formRepo:      formRepo,
+		config:        config,
+		checkRelation: relation.Check,
+	}
+}
+
+// CheckEventConsistency verifies the forms attached to an event: each must reference the event
+// and merchant correctly, have a valid status and schema, and keep its Keto owner tuple.
+// Sessions and publish requirements belong to the event service and are reported as skipped.
+func (s *ConsistencyService) CheckEventConsistency(ctx context.Context, eventID primitive.ObjectID, merchantID, userID string) (*models.EventConsistencyReport, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "check_event_consistency", merchantID); err != nil {
+		return nil, err
+	}
+	if eventID.IsZero() || merchantID == "" {
+		return nil, fmt.Errorf("%w: event_id and merchant_id are required", ErrInvalidInput)
+	}
+
+	report := &models.EventConsistencyReport{
+		EventID:    eventID,
+		MerchantID: merchantID,
+	}
+
+	var forms []*models.Form
+	for page := 1; len(forms) < maxConsistencyForms; page++ {
+		pageForms, total, err := s.formRepo.FindByEventID(ctx, eventID, merchantID, page, consistencyPageSize)
+		if err != nil {
+			log.Error("Failed to list forms for consistency check", log.Err(err), log.String("event_id", eventID.Hex()))
+			return nil, ErrInternalError
+		}
+		forms = append(forms, pageForms...)
+		if len(pageForms) < consistencyPageSize || int64(len(forms)) >= total {
+			break
+		}
+	}
+	report.FormCount = len(forms)
+
+	for _, form := range forms {
+		report.Checks = append(report.Checks, s.checkFormReference(form, eventID, merchantID))
+		report.Checks = append(report.Checks, s.checkFormOwnerTuple(ctx, form))
+	}
+	if len(forms) >= maxConsistencyForms {
+		report.Checks = append(report.Checks, models.ConsistencyCheck{
+			Name:    "form_limit",
+			Status:  models.CheckStatusSkipped,
+			Message: fmt.Sprintf("only the first %d forms were checked", maxConsistencyForms),
+		})
+	}
+
+	for _, name := range []string{"sessions", "session_extents", "publish_requirements"} {
+		report.Checks = append(report.Checks, models.ConsistencyCheck{
+			Name:    name,
+			Status:  models.CheckStatusSkipped,
+			Message: "event data is not managed by the form service",
+		})
+	}
+
+	report.Healthy = true
+	for _, check := range report.Checks {
+		if check.Status == models.CheckStatusFailed {
+			report.Healthy = false
+			break
+		}
+	}
+
+	log.Info("Event consistency checked",
+		log.String("event_id", eventID.Hex()),
+		log.Int("forms", report.FormCount),
+		log.Bool("healthy", report.Healthy))
+
+	return report, nil
+}
+
+// checkFormReference verifies that a form references the event and merchant correctly
+func (s *ConsistencyService) checkFormReference(form *models.Form, eventID primitive.ObjectID, merchantID string) models.ConsistencyCheck {
+	check := models.ConsistencyCheck{
+		Name:       "form_reference",
+		Status:     models.CheckStatusOK,
+		ResourceID: form.ID.Hex(),
+	}
+
+	switch {
+	case !form.HasEventID() || *form.EventID != eventID:
+		check.Message = "form does not reference the event"
+	case form.MerchantID != merchantID:
+		check.Message = fmt.Sprintf("form belongs to merchant %q", form.MerchantID)
+	case !form.CurrentStatus().IsValid():
+		check.Message = fmt.Sprintf("form has unknown status %q", form.Status)
+	case form.Schema == nil:
+		check.Message = "form has no schema"
+	default:
+		return check
+	}
+
+	check.Status = models.CheckStatusFailed
+	return check
+}
+
+// checkFormOwnerTuple verifies that the form creator still holds the Keto owner relation
+func (s *ConsistencyService) checkFormOwnerTuple(ctx context.Context, form *models.Form) models.ConsistencyCheck {
+	check := models.ConsistencyCheck{
+		Name:       "form_owner_tuple",
+		Status:     models.CheckStatusOK,
+		ResourceID: form.ID.Hex(),
+	}
+
+	ok, err := s.checkRelation(ctx, "Form", form.ID.Hex(), string(relation.RoleOwner), "User", form.CreatedBy)
+	switch {
+	case err != nil:
+		check.Status = models.CheckStatusFailed
+		check.Message = fmt.Sprintf("failed to check owner tuple: %v", err)
+	case !ok:
+		check.Status = models.CheckStatusFailed
+		check.Message = fmt.Sprintf("owner tuple missing for user %q", form.CreatedBy)
+	}
+
+	return check
+}
+
+// CheckReferentialIntegrity scans all merchants for forms referencing templates that no longer
+// exist, removing the references when repair is set. Admin only.
+func (s *ConsistencyService) CheckReferentialIntegrity(ctx context.Context, repair bool, userID string) (*models.IntegrityReport, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "check_referential_integrity", ""); err != nil {
+		return nil, err
+	}
+	return s.ScanReferentialIntegrity(ctx, repair)
+}
+
+// ScanReferentialIntegrity runs the referential integrity scan without an admin check, for
+// maintenance commands that already have database access. Forms are scanned in batches of
+// consistencyPageSize, up to maxConsistencyForms per run.
+func (s *ConsistencyService) ScanReferentialIntegrity(ctx context.Context, repair bool) (*models.IntegrityReport, error) {
+	report := &models.IntegrityReport{}
+
+	// Detaching clears the reference of every form using the template, so each is repaired once
+	detached := make(map[string]bool)
+	afterID := primitive.NilObjectID
+	for report.Found < maxConsistencyForms {
+		forms, err := s.formRepo.FindWithMissingTemplate(ctx, afterID, consistencyPageSize)
+		if err != nil {
+			log.Error("Failed to scan forms for missing templates", log.Err(err))
+			return nil, ErrInternalError
+		}
+
+		for _, form := range forms {
+			report.Found++
+			check := models.ConsistencyCheck{
+				Name:       "form_template_reference",
+				Status:     models.CheckStatusFailed,
+				ResourceID: form.ID.Hex(),
+				Message:    fmt.Sprintf("template %s does not exist", form.TemplateID.Hex()),
+			}
+
+			key := form.MerchantID + "/" + form.TemplateID.Hex()
+			if repair && !detached[key] {
+				count, err := s.formRepo.DetachTemplate(ctx, *form.TemplateID, form.MerchantID)
+				if err != nil {
+					log.Error("Failed to detach forms from missing template", log.Err(err), log.String("template_id", form.TemplateID.Hex()))
+				} else {
+					detached[key] = true
+					report.Repaired += int(count)
+				}
+			}
+			if detached[key] {
+				check.Status = models.CheckStatusRepaired
+				check.Message += "; template reference removed"
+			}
+
+			report.Checks = append(report.Checks, check)
+		}
+
+		if len(forms) < consistencyPageSize {
+			break
+		}
+		afterID = forms[len(forms)-1].ID
+	}
+	if report.Found >= maxConsistencyForms {
+		report.Checks = append(report.Checks, models.ConsistencyCheck{
+			Name:    "form_limit",
+			Status:  models.CheckStatusSkipped,
+			Message: fmt.Sprintf("stopped after %d forms; run the scan again to continue", maxConsistencyForms),
+		})
+	}
+
+	report.Checks = append(report.Checks,
+		models.ConsistencyCheck{
+			Name:    "form_event_reference",
+			Status:  models.CheckStatusSkipped,
+			Message: "events are not managed by the form service",
+		},
+		models.ConsistencyCheck{
+			Name:    "orphaned_sessions",
+			Status:  models.CheckStatusSkipped,
+			Message: "sessions are not managed by the form service",
+		},
+		models.ConsistencyCheck{
+			Name:    "orphaned_tuples",
+			Status:  models.CheckStatusSkipped,
+			Message: "Keto tuples cannot be listed by namespace; deleted forms and templates remove their tuples",
+		},
+	)
+
+	report.Healthy = true
+	for _, check := range report.Checks {
+		if check.Status == models.CheckStatusFailed {
+			report.Healthy = false
+			break
+		}
+	}
+
+	log.Info("Referential integrity checked",
+		log.Int("found", report.Found),
+		log.Int("repaired", report.Repaired),
+		log.Bool("healthy", report.Healthy))
+
+	return report, nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Test setup helper for ConsistencyService
+func setupConsistencyService(ownerTuples map[string]bool) (*ConsistencyService, *MockFormRepository) {
+	mockFormRepo := &MockFormRepository{}
+	config := &conf.AppConfig{
+		AdminConfig: &conf.AdminConfig{
+			UserIDs: []string{"admin1"},
+		},
+	}
+	service := NewConsistencyService(mockFormRepo, config)
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		if object == "error" {
+			return false, errors.New("keto unavailable")
+		}
+		return ownerTuples[object], nil
+	}
+	return service, mockFormRepo
+}
+
+func checksByName(report *models.EventConsistencyReport, name string) []models.ConsistencyCheck {
+	var checks []models.ConsistencyCheck
+	for _, check := range report.Checks {
+		if check.Name == name {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+func TestConsistencyService_CheckEventConsistency_Healthy(t *testing.T) {
+	ctx := context.Background()
+	form := createTestForm()
+	service, mockFormRepo := setupConsistencyService(map[string]bool{form.ID.Hex(): true})
+
+	mockFormRepo.On("FindByEventID", ctx, *form.EventID, "merchant123", 1, consistencyPageSize).Return([]*models.Form{form}, int64(1), nil)
+
+	report, err := service.CheckEventConsistency(ctx, *form.EventID, "merchant123", "admin1")
+
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy)
+	assert.Equal(t, 1, report.FormCount)
+	assert.Equal(t, models.CheckStatusOK, checksByName(report, "form_reference")[0].Status)
+	assert.Equal(t, models.CheckStatusOK, checksByName(report, "form_owner_tuple")[0].Status)
+	assert.Equal(t, models.CheckStatusSkipped, checksByName(report, "sessions")[0].Status)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestConsistencyService_CheckEventConsistency_Failures(t *testing.T) {
+	ctx := context.Background()
+	eventID := primitive.NewObjectID()
+	otherEventID := primitive.NewObjectID()
+	wrongEvent := createTestForm()
+	wrongEvent.EventID = &otherEventID
+	missingTuple := createTestForm()
+	missingTuple.EventID = &eventID
+	service, mockFormRepo := setupConsistencyService(map[string]bool{wrongEvent.ID.Hex(): true})
+
+	mockFormRepo.On("FindByEventID", ctx, eventID, "merchant123", 1, consistencyPageSize).Return([]*models.Form{wrongEvent, missingTuple}, int64(2), nil)
+
+	report, err := service.CheckEventConsistency(ctx, eventID, "merchant123", "admin1")
+
+	assert.NoError(t, err)
+	assert.False(t, report.Healthy)
+
+	references := checksByName(report, "form_reference")
+	assert.Equal(t, models.CheckStatusFailed, references[0].Status)
+	assert.Equal(t, models.CheckStatusOK, references[1].Status)
+
+	tuples := checksByName(report, "form_owner_tuple")
+	assert.Equal(t, models.CheckStatusOK, tuples[0].Status)
+	assert.Equal(t, models.CheckStatusFailed, tuples[1].Status)
+	assert.Equal(t, missingTuple.ID.Hex(), tuples[1].ResourceID)
+}
+
+func TestConsistencyService_CheckEventConsistency_NotAdmin(t *testing.T) {
+	service, _ := setupConsistencyService(nil)
+
+	report, err := service.CheckEventConsistency(context.Background(), primitive.NewObjectID(), "merchant123", "user123")
+
+	assert.Nil(t, report)
+	assert.Equal(t, ErrPermissionDenied, err)
+}
+
+func TestConsistencyService_CheckEventConsistency_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	eventID := primitive.NewObjectID()
+	service, mockFormRepo := setupConsistencyService(nil)
+
+	mockFormRepo.On("FindByEventID", ctx, eventID, "merchant123", 1, consistencyPageSize).Return(([]*models.Form)(nil), int64(0), errors.New("database error"))
+
+	report, err := service.CheckEventConsistency(ctx, eventID, "merchant123", "admin1")
+
+	assert.Nil(t, report)
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func createDanglingTemplateForms(templateID primitive.ObjectID, count int) []*models.Form {
+	forms := make([]*models.Form, count)
+	for i := range forms {
+		forms[i] = createTestForm()
+		forms[i].TemplateID = &templateID
+	}
+	return forms
+}
+
+func TestConsistencyService_CheckReferentialIntegrity_Report(t *testing.T) {
+	service, mockFormRepo := setupConsistencyService(nil)
+	ctx := context.Background()
+	forms := createDanglingTemplateForms(primitive.NewObjectID(), 2)
+
+	mockFormRepo.On("FindWithMissingTemplate", ctx, primitive.NilObjectID, consistencyPageSize).Return(forms, nil)
+
+	report, err := service.CheckReferentialIntegrity(ctx, false, "admin1")
+
+	assert.NoError(t, err)
+	assert.False(t, report.Healthy)
+	assert.Equal(t, 2, report.Found)
+	assert.Equal(t, 0, report.Repaired)
+	assert.Equal(t, models.CheckStatusFailed, report.Checks[0].Status)
+	assert.Equal(t, forms[1].ID.Hex(), report.Checks[1].ResourceID)
+	mockFormRepo.AssertNotCalled(t, "DetachTemplate")
+}
+
+func TestConsistencyService_CheckReferentialIntegrity_Repair(t *testing.T) {
+	service, mockFormRepo := setupConsistencyService(nil)
+	ctx := context.Background()
+	templateID := primitive.NewObjectID()
+	firstBatch := createDanglingTemplateForms(templateID, consistencyPageSize)
+	lastID := firstBatch[len(firstBatch)-1].ID
+	secondBatch := createDanglingTemplateForms(primitive.NewObjectID(), 1)
+
+	mockFormRepo.On("FindWithMissingTemplate", ctx, primitive.NilObjectID, consistencyPageSize).Return(firstBatch, nil)
+	mockFormRepo.On("FindWithMissingTemplate", ctx, lastID, consistencyPageSize).Return(secondBatch, nil)
+	// Each template is detached once for the whole merchant
+	mockFormRepo.On("DetachTemplate", ctx, templateID, "merchant123").Return(int64(consistencyPageSize), nil).Once()
+	mockFormRepo.On("DetachTemplate", ctx, *secondBatch[0].TemplateID, "merchant123").Return(int64(1), nil).Once()
+
+	report, err := service.CheckReferentialIntegrity(ctx, true, "admin1")
+
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy)
+	assert.Equal(t, consistencyPageSize+1, report.Found)
+	assert.Equal(t, consistencyPageSize+1, report.Repaired)
+	assert.Equal(t, models.CheckStatusRepaired, report.Checks[0].Status)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestConsistencyService_CheckReferentialIntegrity_NotAdmin(t *testing.T) {
+	service, mockFormRepo := setupConsistencyService(nil)
+
+	report, err := service.CheckReferentialIntegrity(context.Background(), true, "user123")
+
+	assert.Nil(t, report)
+	assert.Equal(t, ErrPermissionDenied, err)
+	mockFormRepo.AssertNotCalled(t, "FindWithMissingTemplate")
+}
+
+func TestConsistencyService_CheckReferentialIntegrity_KetoAdmin(t *testing.T) {
+	// The Keto stub grants the platform admin relation on the "platform" object
+	service, mockFormRepo := setupConsistencyService(map[string]bool{"platform": true})
+	service.config.AdminConfig.KetoNamespace = "Platform"
+	service.config.AdminConfig.KetoObject = "platform"
+	service.config.AdminConfig.KetoRelation = "admin"
+	ctx := context.Background()
+
+	mockFormRepo.On("FindWithMissingTemplate", ctx, primitive.NilObjectID, consistencyPageSize).Return([]*models.Form{}, nil)
+
+	report, err := service.CheckReferentialIntegrity(ctx, false, "support1")
+
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy)
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+	"github.com/arwoosa/vulpes/validate"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/moderation"
+)
+
+// ContentRulesService applies the content rules of a merchant to the field titles and
+// descriptions of the forms and templates it saves. Merchants use the platform rules unless a
+// platform operator set rules of their own.
+type ContentRulesService struct {
+	rulesRepo     repository.ContentRulesRepository
+	config        *conf.AppConfig
+	checkRelation relationCheckFunc
+}
+
+// NewContentRulesService creates a new content rules service
+func NewContentRulesService(rulesRepo repository.ContentRulesRepository, config *conf.AppConfig) *ContentRulesService {
+	return &ContentRulesService{
+		rulesRepo:     rulesRepo,
+		config:        config,
+		checkRelation: relation.Check,
+	}
+}
+
+// ApplyToSchema checks the labels of a schema against the merchant's rules. It returns the
+// schema with links and blocked terms removed when the rules strip them, and an invalid input
+// error naming the first offending label when they reject them. A nil service applies no rules.
+func (s *ContentRulesService) ApplyToSchema(ctx context.Context, merchantID string, formSchema interface{}) (interface{}, error) {
+	if s == nil {
+		return formSchema, nil
+	}
+
+	overrides, err := s.overrides(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	rules := s.resolve(merchantID, overrides)
+
+	filter := moderation.NewFilter(moderation.Rules{BlockURLs: rules.BlockURLs, BlockedTerms: rules.BlockedTerms})
+	if filter.Empty() {
+		return formSchema, nil
+	}
+	if rules.Action == models.ContentActionStrip {
+		return filter.StripSchema(formSchema), nil
+	}
+
+	violations := filter.CheckSchema(formSchema)
+	if len(violations) == 0 {
+		return formSchema, nil
+	}
+	v := violations[0]
+	field := v.Field
+	if field == "" {
+		field = "schema"
+	}
+	reason := "a link"
+	if v.Reason == moderation.ReasonBlockedTerm {
+		reason = "a blocked term"
+	}
+	return nil, fmt.Errorf("%w: %s of %s contains %s", ErrInvalidInput, v.Keyword, field, reason)
+}
+
+// GetMerchantContentRules returns the content rules applied to a merchant (admin only)
+func (s *ContentRulesService) GetMerchantContentRules(ctx context.Context, merchantID, userID string) (*models.EffectiveContentRules, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "get_content_rules", merchantID); err != nil {
+		return nil, err
+	}
+
+	overrides, err := s.overrides(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.resolve(merchantID, overrides), nil
+}
+
+// SetMerchantContentRules creates or replaces a merchant's content rules (admin only)
+func (s *ContentRulesService) SetMerchantContentRules(ctx context.Context, input *models.SetMerchantContentRulesInput) (*models.EffectiveContentRules, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, input.UpdatedBy, "set_content_rules", input.MerchantID); err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("SetMerchantContentRules validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	terms := make([]string, 0, len(input.BlockedTerms))
+	for _, term := range input.BlockedTerms {
+		if term = strings.TrimSpace(term); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	rules := &models.MerchantContentRules{
+		MerchantID:   input.MerchantID,
+		Action:       input.Action,
+		BlockURLs:    input.BlockURLs,
+		BlockedTerms: terms,
+		UpdatedBy:    input.UpdatedBy,
+	}
+	if err := s.rulesRepo.Upsert(ctx, rules); err != nil {
+		log.Error("Failed to save merchant content rules", log.Err(err), log.String("merchant_id", input.MerchantID))
+		return nil, ErrInternalError
+	}
+
+	log.Info("Merchant content rules updated",
+		log.String("merchant_id", input.MerchantID),
+		log.String("action", string(input.Action)),
+		log.Int("blocked_terms", len(terms)),
+		log.String("updated_by", input.UpdatedBy))
+
+	return s.resolve(input.MerchantID, rules), nil
+}
+
+// overrides returns the rules a merchant has of its own, or nil
+func (s *ContentRulesService) overrides(ctx context.Context, merchantID string) (*models.MerchantContentRules, error) {
+	rules, err := s.rulesRepo.FindByMerchantID(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to get merchant content rules", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+	return rules, nil
+}
+
+// resolve returns the merchant's own rules, or the platform rules when it has none
+func (s *ContentRulesService) resolve(merchantID string, overrides *models.MerchantContentRules) *models.EffectiveContentRules {
+	if overrides != nil {
+		return &models.EffectiveContentRules{
+			MerchantID:   merchantID,
+			Action:       overrides.Action,
+			BlockURLs:    overrides.BlockURLs,
+			BlockedTerms: overrides.BlockedTerms,
+			Overrides:    overrides,
+		}
+	}
+
+	rules := &models.EffectiveContentRules{MerchantID: merchantID, Action: models.ContentActionReject}
+	if s.config != nil && s.config.ContentRulesConfig != nil {
+		if s.config.ContentRulesConfig.Action != "" {
+			rules.Action = models.ContentAction(s.config.ContentRulesConfig.Action)
+		}
+		rules.BlockURLs = s.config.ContentRulesConfig.BlockURLs
+		rules.BlockedTerms = s.config.ContentRulesConfig.BlockedTerms
+	}
+	return rules
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock ContentRulesRepository
+type MockContentRulesRepository struct {
+	mock.Mock
+}
+
+func (m *MockContentRulesRepository) FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantContentRules, error) {
+	args := m.Called(ctx, merchantID)
+	return args.Get(0).(*models.MerchantContentRules), args.Error(1)
+}
+
+func (m *MockContentRulesRepository) Upsert(ctx context.Context, rules *models.MerchantContentRules) error {
+	args := m.Called(ctx, rules)
+	return args.Error(0)
+}
+
+// Test setup helper for ContentRulesService
+func setupContentRulesService() (*ContentRulesService, *MockContentRulesRepository) {
+	mockRulesRepo := &MockContentRulesRepository{}
+	config := &conf.AppConfig{
+		ContentRulesConfig: &conf.ContentRulesConfig{
+			Action:       "reject",
+			BlockURLs:    true,
+			BlockedTerms: []string{"scam"},
+		},
+		AdminConfig: &conf.AdminConfig{
+			UserIDs: []string{"admin1"},
+		},
+	}
+	return NewContentRulesService(mockRulesRepo, config), mockRulesRepo
+}
+
+func contentRulesSchema(title string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string", "title": title},
+		},
+	}
+}
+
+func TestContentRulesService_ApplyToSchema_Reject(t *testing.T) {
+	service, mockRulesRepo := setupContentRulesService()
+	ctx := context.Background()
+
+	mockRulesRepo.On("FindByMerchantID", ctx, "merchant123").Return((*models.MerchantContentRules)(nil), nil)
+
+	_, err := service.ApplyToSchema(ctx, "merchant123", contentRulesSchema("Visit https://example.com"))
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), "title of name contains a link")
+
+	_, err = service.ApplyToSchema(ctx, "merchant123", contentRulesSchema("Not a SCAM"))
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Contains(t, err.Error(), "a blocked term")
+
+	schema, err := service.ApplyToSchema(ctx, "merchant123", contentRulesSchema("Full name"))
+	require.NoError(t, err)
+	assert.Equal(t, contentRulesSchema("Full name"), schema)
+}
+
+func TestContentRulesService_ApplyToSchema_MerchantStrips(t *testing.T) {
+	service, mockRulesRepo := setupContentRulesService()
+	ctx := context.Background()
+
+	// The merchant's rules replace the platform rules: links are allowed, terms are stripped
+	mockRulesRepo.On("FindByMerchantID", ctx, "merchant123").Return(&models.MerchantContentRules{
+		MerchantID:   "merchant123",
+		Action:       models.ContentActionStrip,
+		BlockedTerms: []string{"free"},
+	}, nil)
+
+	schema, err := service.ApplyToSchema(ctx, "merchant123", contentRulesSchema("Your free ticket at www.example.com"))
+
+	require.NoError(t, err)
+	properties := schema.(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Equal(t, "Your ticket at www.example.com", properties["name"].(map[string]interface{})["title"])
+}
+
+func TestContentRulesService_ApplyToSchema_NilService(t *testing.T) {
+	var service *ContentRulesService
+
+	schema, err := service.ApplyToSchema(context.Background(), "merchant123", contentRulesSchema("https://example.com"))
+
+	require.NoError(t, err)
+	assert.Equal(t, contentRulesSchema("https://example.com"), schema)
+}
+
+func TestContentRulesService_ApplyToSchema_RepositoryError(t *testing.T) {
+	service, mockRulesRepo := setupContentRulesService()
+	ctx := context.Background()
+
+	mockRulesRepo.On("FindByMerchantID", ctx, "merchant123").Return((*models.MerchantContentRules)(nil), errors.New("database error"))
+
+	_, err := service.ApplyToSchema(ctx, "merchant123", contentRulesSchema("Full name"))
+
+	assert.ErrorIs(t, err, ErrInternalError)
+}
+
+func TestContentRulesService_GetMerchantContentRules(t *testing.T) {
+	service, mockRulesRepo := setupContentRulesService()
+	ctx := context.Background()
+
+	_, err := service.GetMerchantContentRules(ctx, "merchant123", "user1")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	mockRulesRepo.On("FindByMerchantID", ctx, "merchant123").Return((*models.MerchantContentRules)(nil), nil).Once()
+
+	rules, err := service.GetMerchantContentRules(ctx, "merchant123", "admin1")
+
+	require.NoError(t, err)
+	assert.Equal(t, models.ContentActionReject, rules.Action)
+	assert.True(t, rules.BlockURLs)
+	assert.Equal(t, []string{"scam"}, rules.BlockedTerms)
+	assert.Nil(t, rules.Overrides)
+	mockRulesRepo.AssertExpectations(t)
+}
+
+func TestContentRulesService_GetMerchantContentRules_KetoAdmin(t *testing.T) {
+	service, mockRulesRepo := setupContentRulesService()
+	service.config.AdminConfig.KetoNamespace = "Platform"
+	service.config.AdminConfig.KetoObject = "form"
+	service.config.AdminConfig.KetoRelation = "admin"
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		return namespace == "Platform" && object == "form" && relation == "admin" && subjectObject == "support1", nil
+	}
+	ctx := context.Background()
+
+	mockRulesRepo.On("FindByMerchantID", ctx, "merchant123").Return((*models.MerchantContentRules)(nil), nil).Once()
+
+	_, err := service.GetMerchantContentRules(ctx, "merchant123", "support1")
+	assert.NoError(t, err)
+
+	_, err = service.GetMerchantContentRules(ctx, "merchant123", "user1")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	mockRulesRepo.AssertExpectations(t)
+}
+
+func TestContentRulesService_SetMerchantContentRules(t *testing.T) {
+	service, mockRulesRepo := setupContentRulesService()
+	ctx := context.Background()
+
+	input := &models.SetMerchantContentRulesInput{
+		MerchantID:   "merchant123",
+		Action:       models.ContentActionStrip,
+		BlockedTerms: []string{" free ", "  "},
+		UpdatedBy:    "user1",
+	}
+	_, err := service.SetMerchantContentRules(ctx, input)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	input.UpdatedBy = "admin1"
+	input.Action = "hide"
+	_, err = service.SetMerchantContentRules(ctx, input)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	input.Action = models.ContentActionStrip
+	mockRulesRepo.On("Upsert", ctx, mock.MatchedBy(func(r *models.MerchantContentRules) bool {
+		return r.MerchantID == "merchant123" && len(r.BlockedTerms) == 1 && r.BlockedTerms[0] == "free"
+	})).Return(nil).Once()
+
+	rules, err := service.SetMerchantContentRules(ctx, input)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.ContentActionStrip, rules.Action)
+	assert.False(t, rules.BlockURLs)
+	assert.NotNil(t, rules.Overrides)
+	mockRulesRepo.AssertExpectations(t)
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arwoosa/form/internal/bus"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// domainEventSchemaVersion is the payload version of the published form events
+const domainEventSchemaVersion = 1
+
+// EventOutbox records domain events in the outbox collection, in the same transaction as the
+// change they describe. The outbox relay publishes them to the message bus afterwards, so an
+// event is neither lost while the bus is unavailable nor published for a write that failed.
+type EventOutbox struct {
+	outboxRepo repository.OutboxRepository
+	tx         repository.TransactionRunner
+}
+
+// NewEventOutbox creates a new event outbox
+func NewEventOutbox(outboxRepo repository.OutboxRepository, tx repository.TransactionRunner) *EventOutbox {
+	return &EventOutbox{
+		outboxRepo: outboxRepo,
+		tx:         tx,
+	}
+}
+
+// store runs write and records the event in one transaction. A nil outbox only runs write.
+func (o *EventOutbox) store(ctx context.Context, write func(ctx context.Context) error, eventType, merchantID string, data any) error {
+	if o == nil {
+		return write(ctx)
+	}
+
+	envelope, err := bus.NewEnvelope(eventType, domainEventSchemaVersion, merchantID, data)
+	if err != nil {
+		return err
+	}
+
+	return o.tx.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return o.outboxRepo.Add(ctx, &models.OutboxEvent{
+			EventID:       envelope.ID,
+			Type:          envelope.Type,
+			SchemaVersion: envelope.SchemaVersion,
+			MerchantID:    envelope.MerchantID,
+			OccurredAt:    envelope.OccurredAt,
+			Data:          string(envelope.Data),
+		})
+	})
+}
@@ -10,21 +10,66 @@ import (
 
 var (
 	// Common errors
-	ErrUnauthorized    = errors.New("unauthorized access")
-	ErrNotFound        = errors.New("resource not found")
-	ErrInvalidInput    = errors.New("invalid input")
-	ErrInternalError   = errors.New("internal server error")
-	ErrInvalidObjectID = errors.New("invalid object id")
+	ErrUnauthorized     = errors.New("unauthorized access")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrNotFound         = errors.New("resource not found")
+	ErrInvalidInput     = errors.New("invalid input")
+	ErrInternalError    = errors.New("internal server error")
+	ErrInvalidObjectID  = errors.New("invalid object id")
 
 	// Template-specific errors
 	ErrTemplateNotFound      = errors.New("form template not found")
 	ErrTemplateLimitExceeded = errors.New("template limit exceeded for merchant")
 	ErrTemplateNameExists    = errors.New("template name already exists")
+	ErrTemplateInUse         = errors.New("form template is used by forms")
+
+	// Template package errors
+	ErrInvalidTemplatePackage        = errors.New("invalid template package")
+	ErrTemplatePackagesNotConfigured = errors.New("template packages are not configured")
 
 	// Form-specific errors
 	ErrFormNotFound        = errors.New("form not found")
 	ErrFormInvalidTemplate = errors.New("invalid form template reference")
 	ErrFormInvalidEvent    = errors.New("invalid event reference")
+	ErrFormHasNoTemplate   = errors.New("form is not based on a template")
+	ErrFormSchemaLocked    = errors.New("form schema cannot be changed once published")
+	ErrFormInvalidStatus   = errors.New("invalid form status transition")
+	ErrFormNotAccepting    = errors.New("form is not accepting submissions")
+	ErrFormQuotaExceeded   = errors.New("form response quota exceeded")
+	ErrFormNotInviteOnly   = errors.New("form is not invite-only")
+
+	// Event-specific errors
+	ErrEventFormLimitExceeded = errors.New("form limit exceeded for event")
+
+	// Invitation-specific errors
+	ErrInvitationRequired = errors.New("invitation token required")
+	ErrInvalidInvitation  = errors.New("invalid or expired invitation")
+	ErrInvitationUsed     = errors.New("invitation already used")
+
+	// Check-in errors
+	ErrInvalidCheckInToken = errors.New("invalid or expired check-in token")
+
+	// Submission-specific errors
+	ErrSubmissionNotFound      = errors.New("form submission not found")
+	ErrSchemaVersionNotFound   = errors.New("form schema version not found")
+	ErrImportBatchTooLarge     = errors.New("import batch exceeds maximum size")
+	ErrEncryptionNotConfigured = errors.New("PII field encryption is not configured")
+
+	// Spam protection errors
+	ErrSpamDetected        = errors.New("submission rejected as spam")
+	ErrCaptchaFailed       = errors.New("captcha verification failed")
+	ErrCaptchaUnavailable  = errors.New("captcha verification unavailable")
+	ErrSubmissionThrottled = errors.New("too many submissions from this address")
+
+	// File-specific errors
+	ErrInvalidFile          = errors.New("invalid file")
+	ErrStorageNotConfigured = errors.New("file uploads are not configured")
+
+	// Field block errors
+	ErrFieldBlockNotFound = errors.New("field block not found")
+
+	// Purge-specific errors
+	ErrPurgeNotFound = errors.New("merchant purge not found")
 )
 
 // ToGRPCError converts service errors to gRPC status errors
@@ -36,14 +81,25 @@ func ToGRPCError(err error) error {
 	switch err {
 	case ErrUnauthorized:
 		return status.Error(codes.Unauthenticated, err.Error())
-	case ErrNotFound, ErrTemplateNotFound, ErrFormNotFound:
+	case ErrPermissionDenied, ErrInvitationRequired, ErrInvalidInvitation, ErrSpamDetected, ErrCaptchaFailed,
+		ErrInvalidCheckInToken:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case ErrNotFound, ErrTemplateNotFound, ErrFormNotFound, ErrSchemaVersionNotFound, ErrPurgeNotFound,
+		ErrFieldBlockNotFound, ErrSubmissionNotFound:
 		return status.Error(codes.NotFound, err.Error())
-	case ErrInvalidInput, ErrFormInvalidTemplate, ErrFormInvalidEvent, ErrInvalidObjectID:
+	case ErrInvalidInput, ErrFormInvalidTemplate, ErrFormInvalidEvent, ErrInvalidObjectID, ErrImportBatchTooLarge,
+		ErrInvalidFile, ErrInvalidTemplatePackage:
 		return status.Error(codes.InvalidArgument, err.Error())
-	case ErrTemplateLimitExceeded:
+	case ErrTemplateLimitExceeded, ErrFormQuotaExceeded, ErrEventFormLimitExceeded, ErrSubmissionThrottled:
 		return status.Error(codes.ResourceExhausted, err.Error())
 	case ErrTemplateNameExists:
 		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrFormHasNoTemplate, ErrFormSchemaLocked, ErrFormInvalidStatus, ErrFormNotAccepting,
+		ErrFormNotInviteOnly, ErrInvitationUsed, ErrStorageNotConfigured, ErrTemplateInUse, ErrEncryptionNotConfigured,
+		ErrTemplatePackagesNotConfigured:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrCaptchaUnavailable:
+		return status.Error(codes.Unavailable, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
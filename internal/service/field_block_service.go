@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// FieldBlockService manages the field block library of merchants and expands the block
+// references of form and template schemas
+type FieldBlockService struct {
+	blockRepo repository.FieldBlockRepository
+	config    *conf.AppConfig
+}
+
+// NewFieldBlockService creates a new field block service
+func NewFieldBlockService(blockRepo repository.FieldBlockRepository, config *conf.AppConfig) *FieldBlockService {
+	return &FieldBlockService{
+		blockRepo: blockRepo,
+		config:    config,
+	}
+}
+
+// CreateBlock saves a new field block to the library of a merchant
+func (s *FieldBlockService) CreateBlock(ctx context.Context, input *models.CreateFieldBlockInput) (*models.FieldBlock, error) {
+	if err := validate.Struct(input); err != nil {
+		log.Error("CreateBlock validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if err := schema.CheckBlock(input.Schema); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	block := &models.FieldBlock{
+		ID:          primitive.NewObjectID(),
+		MerchantID:  input.MerchantID,
+		Name:        input.Name,
+		Description: input.Description,
+		Schema:      input.Schema,
+		CreatedBy:   input.CreatedBy,
+		UpdatedBy:   input.CreatedBy,
+	}
+	if err := s.blockRepo.Create(ctx, block); err != nil {
+		log.Error("Failed to create field block", log.Err(err))
+		return nil, ErrInternalError
+	}
+
+	log.Info("Field block created successfully",
+		log.String("block_id", block.ID.Hex()),
+		log.String("name", block.Name),
+		log.String("merchant_id", block.MerchantID))
+
+	return block, nil
+}
+
+// GetBlock retrieves a field block of a merchant
+func (s *FieldBlockService) GetBlock(ctx context.Context, blockID primitive.ObjectID, merchantID string) (*models.FieldBlock, error) {
+	block, err := s.blockRepo.FindByID(ctx, blockID)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && block.MerchantID != merchantID) {
+		return nil, ErrFieldBlockNotFound
+	}
+	if err != nil {
+		log.Error("Failed to get field block", log.Err(err), log.String("block_id", blockID.Hex()))
+		return nil, ErrInternalError
+	}
+	return block, nil
+}
+
+// ListBlocks retrieves the field blocks of a merchant with pagination
+func (s *FieldBlockService) ListBlocks(ctx context.Context, options *models.FieldBlockQueryOptions) ([]*models.FieldBlock, int64, error) {
+	// Set default pagination if not provided
+	if options.Page <= 0 {
+		options.Page = 1
+	}
+	if options.PageSize <= 0 {
+		options.PageSize = s.config.PaginationConfig.DefaultPageSize
+	}
+	if options.PageSize > s.config.PaginationConfig.MaxPageSize {
+		options.PageSize = s.config.PaginationConfig.MaxPageSize
+	}
+
+	blocks, count, err := s.blockRepo.FindByMerchantID(ctx, options)
+	if err != nil {
+		log.Error("Failed to list field blocks", log.Err(err))
+		return nil, 0, ErrInternalError
+	}
+
+	return blocks, count, nil
+}
+
+// UpdateBlock updates a field block. Forms and templates already using the block keep the
+// questions they were saved with.
+func (s *FieldBlockService) UpdateBlock(ctx context.Context, input *models.UpdateFieldBlockInput) (*models.FieldBlock, error) {
+	if err := validate.Struct(input); err != nil {
+		log.Error("UpdateBlock validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if err := schema.CheckBlock(input.Schema); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	existing, err := s.GetBlock(ctx, input.ID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing.Name = input.Name
+	existing.Description = input.Description
+	existing.Schema = input.Schema
+	existing.UpdatedBy = input.UpdatedBy
+	if err := s.blockRepo.Update(ctx, existing); err != nil {
+		log.Error("Failed to update field block", log.Err(err))
+		return nil, ErrInternalError
+	}
+
+	log.Info("Field block updated successfully",
+		log.String("block_id", existing.ID.Hex()),
+		log.String("name", existing.Name))
+
+	return existing, nil
+}
+
+// DeleteBlock deletes a field block of a merchant. Forms and templates already using the block
+// keep its questions.
+func (s *FieldBlockService) DeleteBlock(ctx context.Context, blockID primitive.ObjectID, merchantID string) error {
+	if _, err := s.GetBlock(ctx, blockID, merchantID); err != nil {
+		return err
+	}
+
+	if err := s.blockRepo.Delete(ctx, blockID); err != nil {
+		log.Error("Failed to delete field block", log.Err(err))
+		return ErrInternalError
+	}
+
+	log.Info("Field block deleted successfully",
+		log.String("block_id", blockID.Hex()))
+
+	return nil
+}
+
+// ExpandSchema replaces the block references of a form or template schema with the questions of
+// the merchant's blocks. The block name becomes the title of the expanded field unless the
+// reference or the block sets one. A schema without references is returned unchanged.
+func (s *FieldBlockService) ExpandSchema(ctx context.Context, merchantID string, formSchema interface{}) (interface{}, error) {
+	ids, err := schema.BlockRefs(formSchema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if len(ids) == 0 {
+		return formSchema, nil
+	}
+
+	blocks := make(map[string]interface{}, len(ids))
+	for _, id := range ids {
+		blockID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unknown field block %q", ErrInvalidInput, id)
+		}
+		block, err := s.GetBlock(ctx, blockID, merchantID)
+		if errors.Is(err, ErrFieldBlockNotFound) {
+			return nil, fmt.Errorf("%w: unknown field block %q", ErrInvalidInput, id)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		blockSchema, _ := schema.Normalize(block.Schema).(map[string]interface{})
+		if _, ok := blockSchema["title"]; !ok && blockSchema != nil {
+			blockSchema["title"] = block.Name
+		}
+		blocks[id] = blockSchema
+	}
+
+	expanded, err := schema.ExpandBlocks(formSchema, blocks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return expanded, nil
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// MockFieldBlockRepository is a mock implementation of FieldBlockRepository
+type MockFieldBlockRepository struct {
+	mock.Mock
+}
+
+func (m *MockFieldBlockRepository) Create(ctx context.Context, block *models.FieldBlock) error {
+	args := m.Called(ctx, block)
+	return args.Error(0)
+}
+
+func (m *MockFieldBlockRepository) FindByID(ctx context.Context, blockID primitive.ObjectID) (*models.FieldBlock, error) {
+	args := m.Called(ctx, blockID)
+	block, _ := args.Get(0).(*models.FieldBlock)
+	return block, args.Error(1)
+}
+
+func (m *MockFieldBlockRepository) FindByMerchantID(ctx context.Context, options *models.FieldBlockQueryOptions) ([]*models.FieldBlock, int64, error) {
+	args := m.Called(ctx, options)
+	return args.Get(0).([]*models.FieldBlock), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFieldBlockRepository) Update(ctx context.Context, block *models.FieldBlock) error {
+	args := m.Called(ctx, block)
+	return args.Error(0)
+}
+
+func (m *MockFieldBlockRepository) Delete(ctx context.Context, blockID primitive.ObjectID) error {
+	args := m.Called(ctx, blockID)
+	return args.Error(0)
+}
+
+func setupFieldBlockService() (*FieldBlockService, *MockFieldBlockRepository) {
+	mockBlockRepo := &MockFieldBlockRepository{}
+	config := &conf.AppConfig{
+		PaginationConfig: &conf.PaginationConfig{
+			DefaultPageSize: 20,
+			MaxPageSize:     100,
+		},
+	}
+	return NewFieldBlockService(mockBlockRepo, config), mockBlockRepo
+}
+
+func createTestFieldBlock() *models.FieldBlock {
+	return &models.FieldBlock{
+		ID:         primitive.NewObjectID(),
+		MerchantID: "merchant123",
+		Name:       "Emergency contact",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"name":  map[string]interface{}{"type": "string"},
+				"phone": map[string]interface{}{"type": "string"},
+			},
+			"required": []interface{}{"name", "phone"},
+		},
+		CreatedBy: "user123",
+		UpdatedBy: "user123",
+	}
+}
+
+func TestFieldBlockService_CreateBlock(t *testing.T) {
+	service, mockBlockRepo := setupFieldBlockService()
+	ctx := context.Background()
+	block := createTestFieldBlock()
+
+	mockBlockRepo.On("Create", ctx, mock.AnythingOfType("*models.FieldBlock")).Return(nil)
+
+	created, err := service.CreateBlock(ctx, &models.CreateFieldBlockInput{
+		Name:       block.Name,
+		Schema:     block.Schema,
+		CreatedBy:  "user123",
+		MerchantID: "merchant123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Emergency contact", created.Name)
+	assert.Equal(t, "merchant123", created.MerchantID)
+	mockBlockRepo.AssertExpectations(t)
+}
+
+func TestFieldBlockService_CreateBlock_InvalidSchema(t *testing.T) {
+	service, mockBlockRepo := setupFieldBlockService()
+	ctx := context.Background()
+
+	tests := map[string]interface{}{
+		"no properties": map[string]interface{}{"type": "object"},
+		"not an object": map[string]interface{}{"type": "string", "properties": map[string]interface{}{"a": map[string]interface{}{}}},
+		"nested block": map[string]interface{}{"properties": map[string]interface{}{
+			"contact": map[string]interface{}{schema.BlockKey: primitive.NewObjectID().Hex()},
+		}},
+	}
+	for name, blockSchema := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := service.CreateBlock(ctx, &models.CreateFieldBlockInput{
+				Name:       "Block",
+				Schema:     blockSchema,
+				CreatedBy:  "user123",
+				MerchantID: "merchant123",
+			})
+
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		})
+	}
+	mockBlockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFieldBlockService_GetBlock_OtherMerchant(t *testing.T) {
+	service, mockBlockRepo := setupFieldBlockService()
+	ctx := context.Background()
+	block := createTestFieldBlock()
+
+	mockBlockRepo.On("FindByID", ctx, block.ID).Return(block, nil)
+
+	_, err := service.GetBlock(ctx, block.ID, "other-merchant")
+
+	assert.Equal(t, ErrFieldBlockNotFound, err)
+}
+
+func TestFieldBlockService_DeleteBlock_NotFound(t *testing.T) {
+	service, mockBlockRepo := setupFieldBlockService()
+	ctx := context.Background()
+	blockID := primitive.NewObjectID()
+
+	mockBlockRepo.On("FindByID", ctx, blockID).Return(nil, mongo.ErrNoDocuments)
+
+	err := service.DeleteBlock(ctx, blockID, "merchant123")
+
+	assert.Equal(t, ErrFieldBlockNotFound, err)
+	mockBlockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestFieldBlockService_ExpandSchema(t *testing.T) {
+	service, mockBlockRepo := setupFieldBlockService()
+	ctx := context.Background()
+	block := createTestFieldBlock()
+
+	mockBlockRepo.On("FindByID", ctx, block.ID).Return(block, nil)
+
+	expanded, err := service.ExpandSchema(ctx, "merchant123", map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"attendee":  map[string]interface{}{"type": "string"},
+			"emergency": map[string]interface{}{schema.BlockKey: block.ID.Hex()},
+			"secondary": map[string]interface{}{schema.BlockKey: block.ID.Hex(), "title": "Second contact"},
+		},
+	})
+
+	require.NoError(t, err)
+	properties := expanded.(map[string]interface{})["properties"].(map[string]interface{})
+	emergency := properties["emergency"].(map[string]interface{})
+	assert.Equal(t, "object", emergency["type"])
+	assert.Equal(t, "Emergency contact", emergency["title"])
+	assert.Equal(t, []interface{}{"name", "phone"}, emergency["required"])
+	assert.NotContains(t, emergency, schema.BlockKey)
+	assert.Equal(t, "Second contact", properties["secondary"].(map[string]interface{})["title"])
+	mockBlockRepo.AssertNumberOfCalls(t, "FindByID", 1)
+}
+
+func TestFieldBlockService_ExpandSchema_UnknownBlock(t *testing.T) {
+	service, mockBlockRepo := setupFieldBlockService()
+	ctx := context.Background()
+	block := createTestFieldBlock()
+
+	mockBlockRepo.On("FindByID", ctx, block.ID).Return(block, nil)
+
+	for _, id := range []string{"not-an-id", block.ID.Hex()} {
+		_, err := service.ExpandSchema(ctx, "other-merchant", map[string]interface{}{
+			"properties": map[string]interface{}{
+				"emergency": map[string]interface{}{schema.BlockKey: id},
+			},
+		})
+
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	}
+}
+
+func TestFormService_CreateForm_ExpandsFieldBlocks(t *testing.T) {
+	mockFormRepo := &MockFormRepository{}
+	blocks, mockBlockRepo := setupFieldBlockService()
+	config := &conf.AppConfig{}
+	service := NewFormService(mockFormRepo, &MockFormTemplateRepository{}, &MockFormSubmissionRepository{}, newDefaultLimitsService(config), nil, nil, nil, blocks, nil, config)
+	ctx := context.Background()
+	block := createTestFieldBlock()
+	input := createTestCreateFormInput()
+	input.EventID = nil
+	input.UISchema = nil
+	input.Schema = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"emergency": map[string]interface{}{schema.BlockKey: block.ID.Hex()},
+		},
+	}
+
+	mockBlockRepo.On("FindByID", ctx, block.ID).Return(block, nil)
+	// Keto is not available in tests, so the saved form is inspected and the save failed
+	var saved *models.Form
+	mockFormRepo.On("Create", ctx, mock.MatchedBy(func(form *models.Form) bool {
+		saved = form
+		return true
+	})).Return(errors.New("database error"))
+
+	_, err := service.CreateForm(ctx, input)
+
+	assert.Equal(t, ErrInternalError, err)
+	require.NotNil(t, saved)
+	properties := saved.Schema.(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Contains(t, properties["emergency"], "properties")
+	assert.Contains(t, saved.UISchema, "emergency")
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// FilterIndexService recommends and creates indexes for frequently filtered answer fields
+type FilterIndexService struct {
+	usageRepo      repository.FilterUsageRepository
+	submissionRepo repository.FormSubmissionRepository
+	config         *conf.AppConfig
+	checkRelation  relationCheckFunc
+}
+
+// NewFilterIndexService creates a new filter index service
+func NewFilterIndexService(usageRepo repository.FilterUsageRepository, submissionRepo repository.FormSubmissionRepository, config *conf.AppConfig) *FilterIndexService {
+	return &FilterIndexService{
+		usageRepo:      usageRepo,
+		submissionRepo: submissionRepo,
+		config:         config,
+		checkRelation:  relation.Check,
+	}
+}
+
+// GetReport lists answer fields filtered often enough to warrant an index. When automatic
+// creation is enabled, missing indexes are created, most used first, up to the configured cap.
+func (s *FilterIndexService) GetReport(ctx context.Context, userID string) (*models.FilterIndexReport, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "get_filter_index_report", ""); err != nil {
+		return nil, err
+	}
+
+	report := &models.FilterIndexReport{}
+	if s.config.SubmissionIndexConfig != nil {
+		report.MinFilterUsage = s.config.SubmissionIndexConfig.MinFilterUsage
+		report.AutoCreate = s.config.SubmissionIndexConfig.AutoCreate
+		report.MaxAutoIndexes = s.config.SubmissionIndexConfig.MaxAutoIndexes
+	}
+
+	usages, err := s.usageRepo.ListFrequentFields(ctx, report.MinFilterUsage)
+	if err != nil {
+		log.Error("Failed to list submission filter usage", log.Err(err))
+		return nil, ErrInternalError
+	}
+
+	indexedFields, err := s.submissionRepo.ListAnswerIndexes(ctx)
+	if err != nil {
+		log.Error("Failed to list submission answer indexes", log.Err(err))
+		return nil, ErrInternalError
+	}
+	indexed := make(map[string]bool, len(indexedFields))
+	for _, field := range indexedFields {
+		indexed[field] = true
+	}
+	report.AutoIndexCount = len(indexedFields)
+
+	for _, usage := range usages {
+		recommendation := models.FilterIndexRecommendation{
+			Field:         usage.Field,
+			UsageCount:    usage.UsageCount,
+			MerchantCount: usage.MerchantCount,
+			Indexed:       indexed[usage.Field],
+		}
+
+		if !recommendation.Indexed && report.AutoCreate && report.AutoIndexCount < report.MaxAutoIndexes {
+			if err := s.submissionRepo.CreateAnswerIndex(ctx, usage.Field); err != nil {
+				log.Error("Failed to create submission answer index", log.Err(err), log.String("field", usage.Field))
+			} else {
+				log.Info("Submission answer index created",
+					log.String("field", usage.Field),
+					log.Int64("usage_count", usage.UsageCount))
+				recommendation.Indexed = true
+				recommendation.Created = true
+				report.AutoIndexCount++
+			}
+		}
+
+		report.Recommendations = append(report.Recommendations, recommendation)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Test setup helper for FilterIndexService
+func setupFilterIndexService(autoCreate bool) (*FilterIndexService, *MockFilterUsageRepository, *MockFormSubmissionRepository) {
+	mockUsageRepo := &MockFilterUsageRepository{}
+	mockSubmissionRepo := &MockFormSubmissionRepository{}
+	config := &conf.AppConfig{
+		SubmissionIndexConfig: &conf.SubmissionIndexConfig{
+			MinFilterUsage: 10,
+			AutoCreate:     autoCreate,
+			MaxAutoIndexes: 2,
+		},
+		AdminConfig: &conf.AdminConfig{
+			UserIDs: []string{"admin1"},
+		},
+	}
+	service := NewFilterIndexService(mockUsageRepo, mockSubmissionRepo, config)
+	return service, mockUsageRepo, mockSubmissionRepo
+}
+
+func createTestFilterFieldUsages() []*models.FilterFieldUsage {
+	return []*models.FilterFieldUsage{
+		{Field: "email", UsageCount: 50, MerchantCount: 3},
+		{Field: "size", UsageCount: 30, MerchantCount: 2},
+		{Field: "city", UsageCount: 20, MerchantCount: 1},
+	}
+}
+
+func TestFilterIndexService_GetReport_Recommendations(t *testing.T) {
+	service, mockUsageRepo, mockSubmissionRepo := setupFilterIndexService(false)
+	ctx := context.Background()
+
+	mockUsageRepo.On("ListFrequentFields", ctx, int64(10)).Return(createTestFilterFieldUsages(), nil)
+	mockSubmissionRepo.On("ListAnswerIndexes", ctx).Return([]string{"size"}, nil)
+
+	report, err := service.GetReport(ctx, "admin1")
+
+	assert.NoError(t, err)
+	assert.Len(t, report.Recommendations, 3)
+	assert.False(t, report.Recommendations[0].Indexed)
+	assert.True(t, report.Recommendations[1].Indexed)
+	assert.False(t, report.Recommendations[1].Created)
+	assert.Equal(t, 1, report.AutoIndexCount)
+	mockSubmissionRepo.AssertNotCalled(t, "CreateAnswerIndex", mock.Anything, mock.Anything)
+}
+
+func TestFilterIndexService_GetReport_AutoCreateUpToCap(t *testing.T) {
+	service, mockUsageRepo, mockSubmissionRepo := setupFilterIndexService(true)
+	ctx := context.Background()
+
+	mockUsageRepo.On("ListFrequentFields", ctx, int64(10)).Return(createTestFilterFieldUsages(), nil)
+	mockSubmissionRepo.On("ListAnswerIndexes", ctx).Return([]string{"size"}, nil)
+	mockSubmissionRepo.On("CreateAnswerIndex", ctx, "email").Return(nil)
+
+	report, err := service.GetReport(ctx, "admin1")
+
+	assert.NoError(t, err)
+	assert.True(t, report.Recommendations[0].Created)
+	assert.False(t, report.Recommendations[2].Indexed)
+	assert.Equal(t, 2, report.AutoIndexCount)
+	mockSubmissionRepo.AssertNumberOfCalls(t, "CreateAnswerIndex", 1)
+}
+
+func TestFilterIndexService_GetReport_CreateIndexError(t *testing.T) {
+	service, mockUsageRepo, mockSubmissionRepo := setupFilterIndexService(true)
+	ctx := context.Background()
+
+	mockUsageRepo.On("ListFrequentFields", ctx, int64(10)).Return(createTestFilterFieldUsages()[:1], nil)
+	mockSubmissionRepo.On("ListAnswerIndexes", ctx).Return([]string{}, nil)
+	mockSubmissionRepo.On("CreateAnswerIndex", ctx, "email").Return(errors.New("database error"))
+
+	report, err := service.GetReport(ctx, "admin1")
+
+	assert.NoError(t, err)
+	assert.False(t, report.Recommendations[0].Indexed)
+	assert.Equal(t, 0, report.AutoIndexCount)
+}
+
+func TestFilterIndexService_GetReport_NotAdmin(t *testing.T) {
+	service, _, _ := setupFilterIndexService(false)
+
+	report, err := service.GetReport(context.Background(), "user123")
+
+	assert.Nil(t, report)
+	assert.Equal(t, ErrPermissionDenied, err)
+}
+
+func TestFilterIndexService_GetReport_KetoAdmin(t *testing.T) {
+	service, mockUsageRepo, mockSubmissionRepo := setupFilterIndexService(false)
+	service.config.AdminConfig.KetoNamespace = "Platform"
+	service.config.AdminConfig.KetoObject = "form"
+	service.config.AdminConfig.KetoRelation = "admin"
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		return namespace == "Platform" && object == "form" && relation == "admin" && subjectObject == "support1", nil
+	}
+	ctx := context.Background()
+
+	mockUsageRepo.On("ListFrequentFields", ctx, int64(10)).Return([]*models.FilterFieldUsage{}, nil)
+	mockSubmissionRepo.On("ListAnswerIndexes", ctx).Return([]string{}, nil)
+
+	_, err := service.GetReport(ctx, "support1")
+	assert.NoError(t, err)
+}
+
+func TestFilterIndexService_GetReport_RepositoryError(t *testing.T) {
+	service, mockUsageRepo, _ := setupFilterIndexService(false)
+	ctx := context.Background()
+
+	mockUsageRepo.On("ListFrequentFields", ctx, int64(10)).Return(([]*models.FilterFieldUsage)(nil), errors.New("database error"))
+
+	report, err := service.GetReport(ctx, "admin1")
+
+	assert.Nil(t, report)
+	assert.Equal(t, ErrInternalError, err)
+}
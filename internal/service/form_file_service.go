@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
+	"github.com/arwoosa/form/internal/storage"
+)
+
+// Defaults used when the storage configuration does not set a value
+const (
+	defaultUploadURLTTL   = 15 * time.Minute
+	defaultPendingFileTTL = 24 * time.Hour
+)
+
+// FormFileService issues pre-signed upload URLs for file fields and links uploaded files to submissions
+type FormFileService struct {
+	fileRepo repository.FormFileRepository
+	formRepo repository.FormRepository
+	storage  storage.Storage // nil when file uploads are not configured
+	config   *conf.AppConfig
+}
+
+// NewFormFileService creates a new form file service
+func NewFormFileService(fileRepo repository.FormFileRepository, formRepo repository.FormRepository, store storage.Storage, config *conf.AppConfig) *FormFileService {
+	return &FormFileService{
+		fileRepo: fileRepo,
+		formRepo: formRepo,
+		storage:  store,
+		config:   config,
+	}
+}
+
+// RequestUploadURL validates a file against the constraints of a form's file field and returns a
+// pre-signed URL the client uploads it to. The file stays pending until it is referenced by a
+// submission and is removed if it never is.
+func (s *FormFileService) RequestUploadURL(ctx context.Context, input *models.RequestUploadURLInput) (*models.FileUpload, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("RequestUploadURL validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	if s.storage == nil {
+		return nil, ErrStorageNotConfigured
+	}
+
+	form, err := s.formRepo.FindByID(ctx, input.FormID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", input.FormID.Hex()))
+		return nil, ErrFormNotFound
+	}
+
+	now := time.Now()
+	if !form.AcceptsSubmissionsAt(now) {
+		return nil, ErrFormNotAccepting
+	}
+	uploadedBy := input.UploadedBy
+	switch form.CurrentSubmissionMode() {
+	case models.SubmissionModeAnonymous:
+		uploadedBy = ""
+	case models.SubmissionModeAuthenticated:
+		if uploadedBy == "" {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	field, ok := schema.FileFields(form.Schema)[input.Field]
+	if !ok {
+		return nil, fmt.Errorf("%w: field %q is not a file field", ErrInvalidInput, input.Field)
+	}
+	if maxSize := s.maxSize(field); maxSize > 0 && input.Size > maxSize {
+		return nil, fmt.Errorf("%w: file exceeds the maximum size of %d bytes", ErrInvalidFile, maxSize)
+	}
+	if !schema.Accepts(s.accept(field), input.ContentType) {
+		return nil, fmt.Errorf("%w: content type %q is not allowed", ErrInvalidFile, input.ContentType)
+	}
+
+	file := &models.FileReference{
+		ID:          primitive.NewObjectID(),
+		FormID:      form.ID,
+		MerchantID:  form.MerchantID,
+		Field:       input.Field,
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Status:      models.FileStatusPending,
+		UploadedBy:  uploadedBy,
+	}
+	file.Key = fmt.Sprintf("forms/%s/%s", form.ID.Hex(), file.ID.Hex())
+	file.SetExpiresAt(now.Add(s.pendingTTL()))
+
+	request, err := s.storage.PresignPut(ctx, file.Key, file.ContentType, file.Size, s.uploadURLTTL())
+	if err != nil {
+		log.Error("Failed to presign upload", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+
+	if err := s.fileRepo.Create(ctx, file); err != nil {
+		log.Error("Failed to create file reference", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+
+	return &models.FileUpload{
+		File:      file,
+		URL:       request.URL,
+		Method:    request.Method,
+		Headers:   request.Headers,
+		ExpiresAt: request.ExpiresAt,
+	}, nil
+}
+
+// attach links the files referenced by the answers to a submission. Every file must be a pending
+// upload for the same form and field that is present in storage. The returned function unlinks the
+// files when the submission cannot be stored.
+func (s *FormFileService) attach(ctx context.Context, form *models.Form, answers map[string]interface{}, submissionID primitive.ObjectID) (func(), error) {
+	noop := func() {}
+
+	referenced, err := referencedFiles(form, answers)
+	if err != nil || len(referenced) == 0 {
+		return noop, err
+	}
+
+	fileIDs := make([]primitive.ObjectID, 0, len(referenced))
+	for fileID := range referenced {
+		fileIDs = append(fileIDs, fileID)
+	}
+	files, err := s.fileRepo.FindByIDs(ctx, fileIDs)
+	if err != nil {
+		log.Error("Failed to get file references", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+	found := make(map[primitive.ObjectID]*models.FileReference, len(files))
+	for _, file := range files {
+		found[file.ID] = file
+	}
+
+	for fileID, field := range referenced {
+		file, ok := found[fileID]
+		if !ok || file.FormID != form.ID || file.Field != field || file.Status != models.FileStatusPending {
+			return nil, fmt.Errorf("%w: %s is not an available upload for field %q", ErrInvalidFile, fileID.Hex(), field)
+		}
+		if s.storage == nil {
+			return nil, ErrStorageNotConfigured
+		}
+
+		info, err := s.storage.Stat(ctx, file.Key)
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, fmt.Errorf("%w: %s has not been uploaded", ErrInvalidFile, fileID.Hex())
+		}
+		if err != nil {
+			log.Error("Failed to stat uploaded file", log.Err(err), log.String("file_id", fileID.Hex()))
+			return nil, ErrInternalError
+		}
+		if info.Size != file.Size {
+			return nil, fmt.Errorf("%w: %s does not match the declared size", ErrInvalidFile, fileID.Hex())
+		}
+	}
+
+	release := func() {
+		if err := s.fileRepo.Detach(ctx, submissionID, time.Now().Add(s.pendingTTL())); err != nil {
+			log.Error("Failed to detach files", log.Err(err), log.String("submission_id", submissionID.Hex()))
+		}
+	}
+
+	attached, err := s.fileRepo.Attach(ctx, fileIDs, submissionID)
+	if err != nil {
+		log.Error("Failed to attach files", log.Err(err), log.String("submission_id", submissionID.Hex()))
+		return nil, ErrInternalError
+	}
+	if attached != int64(len(fileIDs)) {
+		// Another submission claimed one of the files in the meantime
+		release()
+		return nil, fmt.Errorf("%w: a file is already attached to another submission", ErrInvalidFile)
+	}
+
+	return release, nil
+}
+
+// referencedFiles returns the IDs of the files referenced by the answers with the field referencing them
+func referencedFiles(form *models.Form, answers map[string]interface{}) (map[primitive.ObjectID]string, error) {
+	referenced := make(map[primitive.ObjectID]string)
+	for name := range schema.FileFields(form.Schema) {
+		var values []interface{}
+		switch v := schema.Normalize(answers[name]).(type) {
+		case string:
+			values = []interface{}{v}
+		case []interface{}:
+			values = v
+		}
+
+		for _, value := range values {
+			hex, _ := value.(string)
+			fileID, err := primitive.ObjectIDFromHex(hex)
+			if err != nil {
+				return nil, fmt.Errorf("%w: field %q must reference uploaded files", ErrInvalidInput, name)
+			}
+			if other, ok := referenced[fileID]; ok && other != name {
+				return nil, fmt.Errorf("%w: %s is referenced by more than one field", ErrInvalidFile, hex)
+			}
+			referenced[fileID] = name
+		}
+	}
+	return referenced, nil
+}
+
+// maxSize returns the size limit of a file field, bounded by the configured platform maximum
+func (s *FormFileService) maxSize(field schema.FileField) int64 {
+	var platformMax int64
+	if s.config != nil && s.config.StorageConfig != nil {
+		platformMax = s.config.StorageConfig.MaxFileSize
+	}
+	if field.MaxSize > 0 && (platformMax <= 0 || field.MaxSize < platformMax) {
+		return field.MaxSize
+	}
+	return platformMax
+}
+
+// accept returns the MIME types a file field accepts, falling back to the configured defaults
+func (s *FormFileService) accept(field schema.FileField) []string {
+	if len(field.Accept) > 0 {
+		return field.Accept
+	}
+	if s.config != nil && s.config.StorageConfig != nil {
+		return s.config.StorageConfig.AllowedContentTypes
+	}
+	return nil
+}
+
+func (s *FormFileService) uploadURLTTL() time.Duration {
+	if s.config != nil && s.config.StorageConfig != nil && s.config.StorageConfig.UploadURLTTL > 0 {
+		return s.config.StorageConfig.UploadURLTTL
+	}
+	return defaultUploadURLTTL
+}
+
+func (s *FormFileService) pendingTTL() time.Duration {
+	if s.config != nil && s.config.StorageConfig != nil && s.config.StorageConfig.PendingFileTTL > 0 {
+		return s.config.StorageConfig.PendingFileTTL
+	}
+	return defaultPendingFileTTL
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/storage"
+)
+
+// Mock FormFileRepository
+type MockFormFileRepository struct {
+	mock.Mock
+}
+
+func (m *MockFormFileRepository) Create(ctx context.Context, file *models.FileReference) error {
+	args := m.Called(ctx, file)
+	return args.Error(0)
+}
+
+func (m *MockFormFileRepository) FindByIDs(ctx context.Context, fileIDs []primitive.ObjectID) ([]*models.FileReference, error) {
+	args := m.Called(ctx, fileIDs)
+	return args.Get(0).([]*models.FileReference), args.Error(1)
+}
+
+func (m *MockFormFileRepository) Attach(ctx context.Context, fileIDs []primitive.ObjectID, submissionID primitive.ObjectID) (int64, error) {
+	args := m.Called(ctx, fileIDs, submissionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFormFileRepository) Detach(ctx context.Context, submissionID primitive.ObjectID, expiresAt time.Time) error {
+	args := m.Called(ctx, submissionID, expiresAt)
+	return args.Error(0)
+}
+
+// fakeStorage is an in-memory storage.Storage holding the sizes of uploaded objects
+type fakeStorage struct {
+	objects map[string]int64
+}
+
+func (f *fakeStorage) PresignPut(_ context.Context, key, contentType string, size int64, expires time.Duration) (*storage.PresignedRequest, error) {
+	return &storage.PresignedRequest{
+		URL:       "https://bucket.example.com/" + key,
+		Method:    "PUT",
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: time.Now().Add(expires),
+	}, nil
+}
+
+func (f *fakeStorage) Stat(_ context.Context, key string) (*storage.ObjectInfo, error) {
+	size, ok := f.objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return &storage.ObjectInfo{Size: size}, nil
+}
+
+func (f *fakeStorage) Delete(_ context.Context, key string) error {
+	delete(f.objects, key)
+	return nil
+}
+
+// Test setup helper for FormFileService
+func setupFormFileService() (*FormFileService, *MockFormFileRepository, *MockFormRepository, *fakeStorage) {
+	mockFileRepo := &MockFormFileRepository{}
+	mockFormRepo := &MockFormRepository{}
+	store := &fakeStorage{objects: map[string]int64{}}
+	config := &conf.AppConfig{
+		StorageConfig: &conf.StorageConfig{
+			MaxFileSize:         5000,
+			AllowedContentTypes: []string{"image/*"},
+		},
+	}
+	service := NewFormFileService(mockFileRepo, mockFormRepo, store, config)
+	return service, mockFileRepo, mockFormRepo, store
+}
+
+func createTestFileForm() *models.Form {
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.Schema = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"resume": map[string]interface{}{
+				"type":    "string",
+				"format":  "file",
+				"maxSize": 1000,
+				"accept":  []interface{}{"application/pdf"},
+			},
+			"photos": map[string]interface{}{
+				"type":  "array",
+				"items": map[string]interface{}{"type": "string", "format": "file"},
+			},
+		},
+	}
+	return form
+}
+
+func createTestUploadInput(formID primitive.ObjectID) *models.RequestUploadURLInput {
+	return &models.RequestUploadURLInput{
+		FormID:      formID,
+		Field:       "resume",
+		FileName:    "resume.pdf",
+		ContentType: "application/pdf",
+		Size:        800,
+		UploadedBy:  "user123",
+	}
+}
+
+func TestFormFileService_RequestUploadURL_Success(t *testing.T) {
+	service, mockFileRepo, mockFormRepo, _ := setupFormFileService()
+	ctx := context.Background()
+	form := createTestFileForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFileRepo.On("Create", ctx, mock.MatchedBy(func(f *models.FileReference) bool {
+		return f.FormID == form.ID && f.Field == "resume" && f.Status == models.FileStatusPending && f.ExpiresAt != nil
+	})).Return(nil)
+
+	upload, err := service.RequestUploadURL(ctx, createTestUploadInput(form.ID))
+
+	require.NoError(t, err)
+	assert.Equal(t, "PUT", upload.Method)
+	assert.Equal(t, "https://bucket.example.com/"+upload.File.Key, upload.URL)
+	assert.Equal(t, "forms/"+form.ID.Hex()+"/"+upload.File.ID.Hex(), upload.File.Key)
+	mockFileRepo.AssertExpectations(t)
+}
+
+func TestFormFileService_RequestUploadURL_Rejected(t *testing.T) {
+	tests := map[string]struct {
+		modify func(input *models.RequestUploadURLInput)
+		err    error
+	}{
+		"not a file field":   {func(in *models.RequestUploadURLInput) { in.Field = "email" }, ErrInvalidInput},
+		"field size limit":   {func(in *models.RequestUploadURLInput) { in.Size = 1001 }, ErrInvalidFile},
+		"field content type": {func(in *models.RequestUploadURLInput) { in.ContentType = "image/png" }, ErrInvalidFile},
+		"platform size limit": {func(in *models.RequestUploadURLInput) {
+			in.Field, in.ContentType, in.Size = "photos", "image/png", 5001
+		}, ErrInvalidFile},
+		"platform type": {func(in *models.RequestUploadURLInput) { in.Field = "photos" }, ErrInvalidFile},
+		"not signed in": {func(in *models.RequestUploadURLInput) { in.UploadedBy = "" }, ErrUnauthorized},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service, mockFileRepo, mockFormRepo, _ := setupFormFileService()
+			ctx := context.Background()
+			form := createTestFileForm()
+			input := createTestUploadInput(form.ID)
+			tt.modify(input)
+
+			mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+			upload, err := service.RequestUploadURL(ctx, input)
+
+			assert.Nil(t, upload)
+			assert.ErrorIs(t, err, tt.err)
+			mockFileRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFormFileService_RequestUploadURL_NotConfigured(t *testing.T) {
+	service := NewFormFileService(&MockFormFileRepository{}, &MockFormRepository{}, nil, &conf.AppConfig{})
+
+	upload, err := service.RequestUploadURL(context.Background(), createTestUploadInput(primitive.NewObjectID()))
+
+	assert.Nil(t, upload)
+	assert.Equal(t, ErrStorageNotConfigured, err)
+}
+
+func TestFormFileService_attach_Success(t *testing.T) {
+	service, mockFileRepo, _, store := setupFormFileService()
+	ctx := context.Background()
+	form := createTestFileForm()
+	submissionID := primitive.NewObjectID()
+	file := &models.FileReference{
+		ID: primitive.NewObjectID(), FormID: form.ID, Field: "resume", Key: "forms/a/b", Size: 800, Status: models.FileStatusPending,
+	}
+	store.objects[file.Key] = 800
+
+	mockFileRepo.On("FindByIDs", ctx, []primitive.ObjectID{file.ID}).Return([]*models.FileReference{file}, nil)
+	mockFileRepo.On("Attach", ctx, []primitive.ObjectID{file.ID}, submissionID).Return(int64(1), nil)
+	mockFileRepo.On("Detach", ctx, submissionID, mock.AnythingOfType("time.Time")).Return(nil)
+
+	release, err := service.attach(ctx, form, map[string]interface{}{"resume": file.ID.Hex()}, submissionID)
+	require.NoError(t, err)
+
+	release()
+	mockFileRepo.AssertExpectations(t)
+}
+
+func TestFormFileService_attach_Rejected(t *testing.T) {
+	tests := map[string]struct {
+		modify   func(file *models.FileReference, store *fakeStorage)
+		attached int64
+	}{
+		"other field":   {modify: func(f *models.FileReference, _ *fakeStorage) { f.Field = "photos" }},
+		"other form":    {modify: func(f *models.FileReference, _ *fakeStorage) { f.FormID = primitive.NewObjectID() }},
+		"already used":  {modify: func(f *models.FileReference, _ *fakeStorage) { f.Status = models.FileStatusAttached }},
+		"not uploaded":  {modify: func(f *models.FileReference, s *fakeStorage) { delete(s.objects, f.Key) }},
+		"size mismatch": {modify: func(f *models.FileReference, s *fakeStorage) { s.objects[f.Key] = 10 }},
+		"claimed":       {modify: func(*models.FileReference, *fakeStorage) {}, attached: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service, mockFileRepo, _, store := setupFormFileService()
+			ctx := context.Background()
+			form := createTestFileForm()
+			submissionID := primitive.NewObjectID()
+			file := &models.FileReference{
+				ID: primitive.NewObjectID(), FormID: form.ID, Field: "resume", Key: "forms/a/b", Size: 800, Status: models.FileStatusPending,
+			}
+			store.objects[file.Key] = 800
+			tt.modify(file, store)
+
+			mockFileRepo.On("FindByIDs", ctx, []primitive.ObjectID{file.ID}).Return([]*models.FileReference{file}, nil)
+			mockFileRepo.On("Attach", ctx, []primitive.ObjectID{file.ID}, submissionID).Return(tt.attached, nil).Maybe()
+			mockFileRepo.On("Detach", ctx, submissionID, mock.AnythingOfType("time.Time")).Return(nil).Maybe()
+
+			release, err := service.attach(ctx, form, map[string]interface{}{"resume": file.ID.Hex()}, submissionID)
+
+			assert.Nil(t, release)
+			assert.ErrorIs(t, err, ErrInvalidFile)
+		})
+	}
+}
+
+func TestFormFileService_attach_NoFiles(t *testing.T) {
+	service, mockFileRepo, _, _ := setupFormFileService()
+
+	release, err := service.attach(context.Background(), createTestSubmissionForm(), map[string]interface{}{"email": "a@b.c"}, primitive.NewObjectID())
+
+	require.NoError(t, err)
+	assert.NotNil(t, release)
+	mockFileRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+}
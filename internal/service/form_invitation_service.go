@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/invitation"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// defaultInvitationTTL is used when neither the request nor the configuration sets a lifetime
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// FormInvitationService issues and redeems one-time invitations for invite-only forms
+type FormInvitationService struct {
+	invitationRepo repository.FormInvitationRepository
+	formRepo       repository.FormRepository
+	config         *conf.AppConfig
+}
+
+// NewFormInvitationService creates a new form invitation service
+func NewFormInvitationService(invitationRepo repository.FormInvitationRepository, formRepo repository.FormRepository, config *conf.AppConfig) *FormInvitationService {
+	return &FormInvitationService{
+		invitationRepo: invitationRepo,
+		formRepo:       formRepo,
+		config:         config,
+	}
+}
+
+// CreateInvitations issues a batch of signed one-time invitations for an invite-only form
+func (s *FormInvitationService) CreateInvitations(ctx context.Context, input *models.CreateFormInvitationsInput) ([]*models.IssuedFormInvitation, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("CreateInvitations validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	secret, err := s.secret()
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := s.formRepo.FindByID(ctx, input.FormID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", input.FormID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != input.MerchantID {
+		return nil, ErrFormNotFound
+	}
+	if form.CurrentSubmissionMode() != models.SubmissionModeInviteOnly {
+		return nil, ErrFormNotInviteOnly
+	}
+
+	ttl := input.TTL
+	if ttl == 0 {
+		ttl = defaultInvitationTTL
+		if s.config != nil && s.config.InvitationConfig != nil && s.config.InvitationConfig.TTL > 0 {
+			ttl = s.config.InvitationConfig.TTL
+		}
+	}
+	// Tokens carry second precision, so the stored expiry is truncated to match
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+
+	invitations := make([]*models.FormInvitation, input.Count)
+	for i := range invitations {
+		invitations[i] = &models.FormInvitation{
+			ID:         primitive.NewObjectID(),
+			FormID:     form.ID,
+			MerchantID: form.MerchantID,
+			CreatedBy:  input.CreatedBy,
+		}
+		invitations[i].SetExpiresAt(expiresAt)
+	}
+
+	if err := s.invitationRepo.CreateMany(ctx, invitations); err != nil {
+		log.Error("Failed to create invitations", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+
+	issued := make([]*models.IssuedFormInvitation, len(invitations))
+	for i, inv := range invitations {
+		token := invitation.Sign(secret, invitation.Claims{
+			InvitationID: inv.ID,
+			FormID:       inv.FormID,
+			ExpiresAt:    expiresAt,
+		})
+		issued[i] = &models.IssuedFormInvitation{
+			Invitation: inv,
+			Token:      token,
+			Link:       s.link(form.ID, token),
+		}
+	}
+
+	log.Info("Form invitations created",
+		log.String("form_id", form.ID.Hex()),
+		log.Int("count", len(issued)))
+
+	return issued, nil
+}
+
+// redeem verifies an invitation token for a form and marks the invitation as used.
+// The returned function makes the invitation available again when the submission cannot be stored.
+func (s *FormInvitationService) redeem(ctx context.Context, form *models.Form, token, usedBy string) (func(), error) {
+	if token == "" {
+		return nil, ErrInvitationRequired
+	}
+
+	secret, err := s.secret()
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := invitation.Parse(secret, token, time.Now())
+	if err != nil {
+		log.Warn("Rejected invitation token", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInvalidInvitation
+	}
+	if claims.FormID != form.ID {
+		return nil, ErrInvalidInvitation
+	}
+
+	ok, err := s.invitationRepo.Redeem(ctx, claims.InvitationID, form.ID, usedBy, time.Now())
+	if err != nil {
+		log.Error("Failed to redeem invitation", log.Err(err), log.String("invitation_id", claims.InvitationID.Hex()))
+		return nil, ErrInternalError
+	}
+	if !ok {
+		return nil, ErrInvitationUsed
+	}
+
+	release := func() {
+		if err := s.invitationRepo.Release(ctx, claims.InvitationID); err != nil {
+			log.Error("Failed to release invitation", log.Err(err), log.String("invitation_id", claims.InvitationID.Hex()))
+		}
+	}
+	return release, nil
+}
+
+func (s *FormInvitationService) secret() ([]byte, error) {
+	if s.config == nil || s.config.InvitationConfig == nil || s.config.InvitationConfig.Secret == "" {
+		log.Error("Invitation secret is not configured")
+		return nil, ErrInternalError
+	}
+	return []byte(s.config.InvitationConfig.Secret), nil
+}
+
+func (s *FormInvitationService) link(formID primitive.ObjectID, token string) string {
+	if s.config == nil || s.config.InvitationConfig == nil || s.config.InvitationConfig.LinkBaseURL == "" {
+		return ""
+	}
+	baseURL := strings.TrimSuffix(s.config.InvitationConfig.LinkBaseURL, "/")
+	return fmt.Sprintf("%s/forms/%s?invitation=%s", baseURL, formID.Hex(), url.QueryEscape(token))
+}
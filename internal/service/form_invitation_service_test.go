@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/invitation"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock FormInvitationRepository
+type MockFormInvitationRepository struct {
+	mock.Mock
+}
+
+func (m *MockFormInvitationRepository) CreateMany(ctx context.Context, invitations []*models.FormInvitation) error {
+	args := m.Called(ctx, invitations)
+	return args.Error(0)
+}
+
+func (m *MockFormInvitationRepository) Redeem(ctx context.Context, invitationID, formID primitive.ObjectID, usedBy string, usedAt time.Time) (bool, error) {
+	args := m.Called(ctx, invitationID, formID, usedBy, usedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFormInvitationRepository) Release(ctx context.Context, invitationID primitive.ObjectID) error {
+	args := m.Called(ctx, invitationID)
+	return args.Error(0)
+}
+
+// Test setup helper for FormInvitationService
+func setupFormInvitationService() (*FormInvitationService, *MockFormInvitationRepository, *MockFormRepository) {
+	mockInvitationRepo := &MockFormInvitationRepository{}
+	mockFormRepo := &MockFormRepository{}
+	config := &conf.AppConfig{
+		InvitationConfig: &conf.InvitationConfig{
+			Secret:      "test-secret",
+			TTL:         24 * time.Hour,
+			LinkBaseURL: "https://forms.example.com/",
+		},
+	}
+	service := NewFormInvitationService(mockInvitationRepo, mockFormRepo, config)
+	return service, mockInvitationRepo, mockFormRepo
+}
+
+func createTestInviteOnlyForm() *models.Form {
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.SubmissionMode = models.SubmissionModeInviteOnly
+	return form
+}
+
+func TestFormInvitationService_CreateInvitations_Success(t *testing.T) {
+	service, mockInvitationRepo, mockFormRepo := setupFormInvitationService()
+	ctx := context.Background()
+	form := createTestInviteOnlyForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockInvitationRepo.On("CreateMany", ctx, mock.MatchedBy(func(invitations []*models.FormInvitation) bool {
+		return len(invitations) == 2 && invitations[0].FormID == form.ID && invitations[0].CreatedBy == "user456"
+	})).Return(nil)
+
+	issued, err := service.CreateInvitations(ctx, &models.CreateFormInvitationsInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Count:      2,
+		CreatedBy:  "user456",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, issued, 2)
+	assert.NotEqual(t, issued[0].Token, issued[1].Token)
+	assert.True(t, strings.HasPrefix(issued[0].Link, "https://forms.example.com/forms/"+form.ID.Hex()+"?invitation="))
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), issued[0].Invitation.GetExpiresAt(), time.Minute)
+
+	claims, err := invitation.Parse([]byte("test-secret"), issued[0].Token, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, issued[0].Invitation.ID, claims.InvitationID)
+}
+
+func TestFormInvitationService_CreateInvitations_NotInviteOnly(t *testing.T) {
+	service, mockInvitationRepo, mockFormRepo := setupFormInvitationService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	issued, err := service.CreateInvitations(ctx, &models.CreateFormInvitationsInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Count:      1,
+		CreatedBy:  "user456",
+	})
+
+	assert.Nil(t, issued)
+	assert.Equal(t, ErrFormNotInviteOnly, err)
+	mockInvitationRepo.AssertNotCalled(t, "CreateMany", mock.Anything, mock.Anything)
+}
+
+func TestFormInvitationService_CreateInvitations_MissingSecret(t *testing.T) {
+	service, _, mockFormRepo := setupFormInvitationService()
+	service.config.InvitationConfig.Secret = ""
+	ctx := context.Background()
+
+	issued, err := service.CreateInvitations(ctx, &models.CreateFormInvitationsInput{
+		FormID:     primitive.NewObjectID(),
+		MerchantID: "merchant123",
+		Count:      1,
+		CreatedBy:  "user456",
+	})
+
+	assert.Nil(t, issued)
+	assert.Equal(t, ErrInternalError, err)
+	mockFormRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_SubmitResponse_InviteOnly(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	mockInvitationRepo := service.invitations.invitationRepo.(*MockFormInvitationRepository)
+	ctx := context.Background()
+	form := createTestInviteOnlyForm()
+	invitationID := primitive.NewObjectID()
+	input := createTestSubmitFormResponseInput(form.ID)
+	input.SubmittedBy = ""
+	input.InvitationToken = invitation.Sign([]byte("test-secret"), invitation.Claims{
+		InvitationID: invitationID,
+		FormID:       form.ID,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockInvitationRepo.On("Redeem", ctx, invitationID, form.ID, "", mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.AnythingOfType("*models.FormSubmission")).Return(nil)
+
+	result, err := service.SubmitResponse(ctx, input)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	mockInvitationRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_SubmitResponse_InvitationErrors(t *testing.T) {
+	form := createTestInviteOnlyForm()
+	validToken := func(formID primitive.ObjectID, expiresAt time.Time) string {
+		return invitation.Sign([]byte("test-secret"), invitation.Claims{
+			InvitationID: primitive.NewObjectID(),
+			FormID:       formID,
+			ExpiresAt:    expiresAt,
+		})
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		redeemed bool
+		expected error
+	}{
+		{"Missing token", "", false, ErrInvitationRequired},
+		{"Tampered token", validToken(form.ID, time.Now().Add(time.Hour)) + "x", false, ErrInvalidInvitation},
+		{"Expired token", validToken(form.ID, time.Now().Add(-time.Hour)), false, ErrInvalidInvitation},
+		{"Other form", validToken(primitive.NewObjectID(), time.Now().Add(time.Hour)), false, ErrInvalidInvitation},
+		{"Already used", validToken(form.ID, time.Now().Add(time.Hour)), true, ErrInvitationUsed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+			mockInvitationRepo := service.invitations.invitationRepo.(*MockFormInvitationRepository)
+			ctx := context.Background()
+			input := createTestSubmitFormResponseInput(form.ID)
+			input.InvitationToken = tt.token
+
+			mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+			if tt.redeemed {
+				mockInvitationRepo.On("Redeem", ctx, mock.Anything, form.ID, "user456", mock.Anything).Return(false, nil)
+			}
+
+			result, err := service.SubmitResponse(ctx, input)
+
+			assert.Nil(t, result)
+			assert.Equal(t, tt.expected, err)
+			mockSubmissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFormSubmissionService_SubmitResponse_InviteOnlyCreateErrorReleasesInvitation(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	mockInvitationRepo := service.invitations.invitationRepo.(*MockFormInvitationRepository)
+	ctx := context.Background()
+	form := createTestInviteOnlyForm()
+	invitationID := primitive.NewObjectID()
+	input := createTestSubmitFormResponseInput(form.ID)
+	input.InvitationToken = invitation.Sign([]byte("test-secret"), invitation.Claims{
+		InvitationID: invitationID,
+		FormID:       form.ID,
+		ExpiresAt:    time.Now().Add(time.Hour),
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockInvitationRepo.On("Redeem", ctx, invitationID, form.ID, "user456", mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.AnythingOfType("*models.FormSubmission")).Return(errors.New("database error"))
+	mockInvitationRepo.On("Release", ctx, invitationID).Return(nil)
+
+	result, err := service.SubmitResponse(ctx, input)
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInternalError, err)
+	mockInvitationRepo.AssertExpectations(t)
+}
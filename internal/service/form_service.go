@@ -3,30 +3,58 @@ package service
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/arwoosa/vulpes/log"
 	"github.com/arwoosa/vulpes/relation"
 	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/bus"
+	"github.com/arwoosa/form/internal/cache"
 	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/metrics"
 	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
 )
 
 // FormService handles form business logic
 type FormService struct {
-	formRepo     repository.FormRepository
-	templateRepo repository.FormTemplateRepository
-	config       *conf.AppConfig
+	formRepo       repository.FormRepository
+	templateRepo   repository.FormTemplateRepository
+	submissionRepo repository.FormSubmissionRepository
+	limits         *LimitsService
+	publicForms    cache.Store
+	events         *EventOutbox
+	spam           *SpamGuard
+	blocks         *FieldBlockService
+	content        *ContentRulesService
+	config         *conf.AppConfig
+
+	checkRelation relationCheckFunc
 }
 
-// NewFormService creates a new form service
-func NewFormService(formRepo repository.FormRepository, templateRepo repository.FormTemplateRepository, config *conf.AppConfig) *FormService {
+// NewFormService creates a new form service. publicForms caches public form reads and events
+// records form changes for publishing; both may be nil. spam checks the spam protection settings
+// of forms against the defenses the deployment supports, blocks expands the field block
+// references of form schemas and content checks their labels.
+func NewFormService(formRepo repository.FormRepository, templateRepo repository.FormTemplateRepository, submissionRepo repository.FormSubmissionRepository, limits *LimitsService, publicForms cache.Store, events *EventOutbox, spam *SpamGuard, blocks *FieldBlockService, content *ContentRulesService, config *conf.AppConfig) *FormService {
 	return &FormService{
-		formRepo:     formRepo,
-		templateRepo: templateRepo,
-		config:       config,
+		formRepo:       formRepo,
+		templateRepo:   templateRepo,
+		submissionRepo: submissionRepo,
+		limits:         limits,
+		publicForms:    publicForms,
+		events:         events,
+		spam:           spam,
+		blocks:         blocks,
+		content:        content,
+		config:         config,
+
+		checkRelation: relation.Check,
 	}
 }
 
@@ -38,15 +66,57 @@ func (s *FormService) CreateForm(ctx context.Context, input *models.CreateFormIn
 		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
 	}
 
+	// Check form limit for the event
+	if input.EventID != nil && !input.EventID.IsZero() {
+		if err := s.checkEventFormLimit(ctx, *input.EventID, input.MerchantID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create form model
 	form := &models.Form{
-		ID:         primitive.NewObjectID(),
-		EventID:    input.EventID,
-		MerchantID: input.MerchantID,
-		Schema:     input.Schema,
-		UISchema:   input.UISchema,
-		CreatedBy:  input.CreatedBy,
-		UpdatedBy:  input.CreatedBy,
+		ID:            primitive.NewObjectID(),
+		EventID:       input.EventID,
+		MerchantID:    input.MerchantID,
+		Schema:        input.Schema,
+		UISchema:      input.UISchema,
+		SchemaVersion: 1,
+		Status:        models.FormStatusDraft,
+		CreatedBy:     input.CreatedBy,
+		UpdatedBy:     input.CreatedBy,
+	}
+
+	// Link to the source template, taking its schemas when none are provided
+	if input.TemplateID != nil && !input.TemplateID.IsZero() {
+		template, err := s.templateRepo.FindByID(ctx, *input.TemplateID)
+		if err != nil || template.MerchantID != input.MerchantID {
+			log.Error("Invalid template reference for form", log.Err(err), log.String("template_id", input.TemplateID.Hex()))
+			return nil, ErrFormInvalidTemplate
+		}
+
+		form.TemplateID = input.TemplateID
+		form.TemplateVersion = template.CurrentVersion()
+		if form.Schema == nil {
+			form.Schema = template.Schema
+		}
+		if form.UISchema == nil {
+			form.UISchema = template.UISchema
+		}
+	}
+
+	expanded, err := s.blocks.ExpandSchema(ctx, form.MerchantID, form.Schema)
+	if err != nil {
+		return nil, err
+	}
+	form.Schema, err = s.content.ApplyToSchema(ctx, form.MerchantID, expanded)
+	if err != nil {
+		return nil, err
+	}
+
+	// Avoid storing an empty UI Schema that does not match the schema
+	form.UISchema = defaultUISchema(s.config, form.Schema, form.UISchema)
+	if err := checkSchemaExtensions(form.Schema, form.UISchema); err != nil {
+		return nil, err
 	}
 
 	// Save to repository
@@ -58,6 +128,7 @@ func (s *FormService) CreateForm(ctx context.Context, input *models.CreateFormIn
 	// Add Keto relation tuple for form owner
 	if err := relation.AddUserResourceRole(ctx, input.CreatedBy, "Form", form.ID.Hex(), relation.RoleOwner); err != nil {
 		log.Error("Failed to create Keto relation tuple for form", log.Err(err))
+		metrics.KetoWriteFailures.WithLabelValues("Form", "add_owner").Inc()
 		// Rollback: delete the created form since Keto operation failed
 		if deleteErr := s.formRepo.Delete(ctx, form.ID); deleteErr != nil {
 			log.Error("Failed to rollback form creation", log.Err(deleteErr))
@@ -83,6 +154,70 @@ func (s *FormService) GetForm(ctx context.Context, formID primitive.ObjectID) (*
 	return form, nil
 }
 
+// GetFormsByIDs retrieves several forms with a single query. The result has one entry per
+// requested ID, in the requested order, with nil for forms that do not exist. Forms of other
+// merchants are reported as not existing.
+func (s *FormService) GetFormsByIDs(ctx context.Context, formIDs []primitive.ObjectID, merchantID string) ([]*models.Form, error) {
+	if merchantID == "" {
+		return nil, ErrUnauthorized
+	}
+	if len(formIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one form ID is required", ErrInvalidInput)
+	}
+	if len(formIDs) > s.config.PaginationConfig.MaxPageSize {
+		return nil, fmt.Errorf("%w: at most %d form IDs can be requested at once", ErrInvalidInput, s.config.PaginationConfig.MaxPageSize)
+	}
+
+	unique := make([]primitive.ObjectID, 0, len(formIDs))
+	seen := make(map[primitive.ObjectID]bool, len(formIDs))
+	for _, formID := range formIDs {
+		if !seen[formID] {
+			seen[formID] = true
+			unique = append(unique, formID)
+		}
+	}
+
+	found, err := s.formRepo.FindByIDs(ctx, unique)
+	if err != nil {
+		log.Error("Failed to get forms by IDs", log.Err(err), log.Int("count", len(unique)))
+		return nil, ErrInternalError
+	}
+
+	byID := make(map[primitive.ObjectID]*models.Form, len(found))
+	for _, form := range found {
+		if form.MerchantID == merchantID {
+			byID[form.ID] = form
+		}
+	}
+
+	forms := make([]*models.Form, len(formIDs))
+	for i, formID := range formIDs {
+		forms[i] = byID[formID]
+	}
+	return forms, nil
+}
+
+// GetPublicForm retrieves a published event form for public rendering. Unpublished forms and
+// forms not attached to an event are reported as not found so their existence is not revealed.
+func (s *FormService) GetPublicForm(ctx context.Context, formID primitive.ObjectID) (*models.Form, error) {
+	if form, ok := s.cachedPublicForm(ctx, formID); ok {
+		return form, nil
+	}
+
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get public form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+
+	if !form.HasEventID() || form.CurrentStatus() != models.FormStatusPublished {
+		return nil, ErrFormNotFound
+	}
+
+	s.cachePublicForm(ctx, form)
+	return form, nil
+}
+
 // ListForms retrieves forms with pagination and optional filters
 func (s *FormService) ListForms(ctx context.Context, options *models.FormQueryOptions) ([]*models.Form, int64, error) {
 	// Set default pagination if not provided
@@ -95,6 +230,9 @@ func (s *FormService) ListForms(ctx context.Context, options *models.FormQueryOp
 	if options.PageSize > s.config.PaginationConfig.MaxPageSize {
 		options.PageSize = s.config.PaginationConfig.MaxPageSize
 	}
+	if options.Count == "" {
+		options.Count = configuredCountMode(s.config)
+	}
 
 	forms, count, err := s.formRepo.Find(ctx, options)
 	if err != nil {
@@ -105,6 +243,14 @@ func (s *FormService) ListForms(ctx context.Context, options *models.FormQueryOp
 	return forms, count, nil
 }
 
+// configuredCountMode returns the count mode of list queries selected in the configuration
+func configuredCountMode(config *conf.AppConfig) models.CountMode {
+	if config == nil || config.PaginationConfig == nil || config.PaginationConfig.CountMode == "" {
+		return models.CountExact
+	}
+	return models.CountMode(config.PaginationConfig.CountMode)
+}
+
 // UpdateForm updates an existing form
 func (s *FormService) UpdateForm(ctx context.Context, input *models.UpdateFormInput) (*models.Form, error) {
 	// Validate input
@@ -120,16 +266,54 @@ func (s *FormService) UpdateForm(ctx context.Context, input *models.UpdateFormIn
 		return nil, ErrFormNotFound
 	}
 
+	input.Schema, err = s.blocks.ExpandSchema(ctx, existing.MerchantID, input.Schema)
+	if err != nil {
+		return nil, err
+	}
+	input.Schema, err = s.content.ApplyToSchema(ctx, existing.MerchantID, input.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaChanged := !reflect.DeepEqual(schema.Normalize(existing.Schema), schema.Normalize(input.Schema))
+	if schemaChanged {
+		// Published and closed forms may already have submissions relying on the schema
+		if existing.IsSchemaLocked() {
+			return nil, ErrFormSchemaLocked
+		}
+
+		// Archive the schema being replaced so submissions can still be validated against it
+		currentVersion := existing.CurrentSchemaVersion()
+		if err := s.formRepo.SaveSchemaVersion(ctx, &models.FormSchemaVersion{
+			FormID:     existing.ID,
+			MerchantID: existing.MerchantID,
+			Version:    currentVersion,
+			Schema:     existing.Schema,
+			UISchema:   existing.UISchema,
+			CreatedAt:  existing.UpdatedAt,
+			CreatedBy:  existing.UpdatedBy,
+		}); err != nil {
+			log.Error("Failed to archive form schema version", log.Err(err), log.String("form_id", existing.ID.Hex()))
+			return nil, ErrInternalError
+		}
+
+		existing.Schema = input.Schema
+		existing.SchemaVersion = currentVersion + 1
+	}
+
 	// Update form fields
-	existing.Schema = input.Schema
 	existing.UISchema = input.UISchema
 	existing.UpdatedBy = input.UpdatedBy
+	if err := checkSchemaExtensions(existing.Schema, existing.UISchema); err != nil {
+		return nil, err
+	}
 
 	// Save updates
-	if err := s.formRepo.Update(ctx, existing); err != nil {
+	if err := s.updateWithEvent(ctx, existing, bus.TypeFormUpdated); err != nil {
 		log.Error("Failed to update form", log.Err(err))
 		return nil, ErrInternalError
 	}
+	s.invalidatePublicForm(ctx, existing.ID)
 
 	log.Info("Form updated successfully",
 		log.String("form_id", existing.ID.Hex()))
@@ -152,6 +336,7 @@ func (s *FormService) DeleteForm(ctx context.Context, formID primitive.ObjectID)
 	// Delete Keto relation tuples first (best effort)
 	if err := relation.DeleteObjectId(ctx, "Form", formID.Hex()); err != nil {
 		log.Error("Failed to delete Keto relation tuples for form - continuing with deletion", log.Err(err))
+		metrics.KetoWriteFailures.WithLabelValues("Form", "delete_object").Inc()
 		// Don't return here - continue with database cleanup to avoid data inconsistency
 	}
 
@@ -160,6 +345,7 @@ func (s *FormService) DeleteForm(ctx context.Context, formID primitive.ObjectID)
 		log.Error("Failed to delete form", log.Err(err))
 		return ErrInternalError
 	}
+	s.invalidatePublicForm(ctx, formID)
 
 	log.Info("Form deleted successfully",
 		log.String("form_id", formID.Hex()))
@@ -167,6 +353,343 @@ func (s *FormService) DeleteForm(ctx context.Context, formID primitive.ObjectID)
 	return nil
 }
 
+// PublishForm publishes a form, locking its schema and opening it for submissions
+func (s *FormService) PublishForm(ctx context.Context, formID primitive.ObjectID, merchantID, updatedBy string) (*models.Form, error) {
+	return s.transitionStatus(ctx, formID, merchantID, models.FormStatusPublished, updatedBy)
+}
+
+// CloseForm closes a published form so it stops accepting submissions
+func (s *FormService) CloseForm(ctx context.Context, formID primitive.ObjectID, merchantID, updatedBy string) (*models.Form, error) {
+	return s.transitionStatus(ctx, formID, merchantID, models.FormStatusClosed, updatedBy)
+}
+
+// SetSchedule sets or clears the access window of a form. Submissions are only accepted between
+// openAt and closeAt, and published forms are closed automatically once closeAt has passed.
+func (s *FormService) SetSchedule(ctx context.Context, formID primitive.ObjectID, merchantID string, openAt, closeAt *time.Time, updatedBy string) (*models.Form, error) {
+	if openAt != nil && closeAt != nil && !openAt.Before(*closeAt) {
+		return nil, fmt.Errorf("%w: open_at must be before close_at", ErrInvalidInput)
+	}
+
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+
+	form.OpenAt = openAt
+	form.CloseAt = closeAt
+	form.UpdatedBy = updatedBy
+
+	if err := s.updateWithEvent(ctx, form, bus.TypeFormUpdated); err != nil {
+		log.Error("Failed to update form schedule", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrInternalError
+	}
+	s.invalidatePublicForm(ctx, formID)
+
+	log.Info("Form schedule updated",
+		log.String("form_id", formID.Hex()))
+
+	return form, nil
+}
+
+// SetQuotas sets the maximum number of responses a form accepts in total and per user.
+// A limit of 0 removes the quota.
+func (s *FormService) SetQuotas(ctx context.Context, formID primitive.ObjectID, merchantID string, maxResponses, maxPerUser int, updatedBy string) (*models.Form, error) {
+	if maxResponses < 0 || maxPerUser < 0 {
+		return nil, fmt.Errorf("%w: response quotas cannot be negative", ErrInvalidInput)
+	}
+
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+
+	// Counters only move while a quota is set, so they are brought up to the stored responses
+	// before the quota applies
+	if maxResponses > 0 || maxPerUser > 0 {
+		if err := s.submissionRepo.SeedResponseCounters(ctx, formID); err != nil {
+			log.Error("Failed to seed response counters", log.Err(err), log.String("form_id", formID.Hex()))
+			return nil, ErrInternalError
+		}
+	}
+
+	form.MaxResponses = maxResponses
+	form.MaxPerUser = maxPerUser
+	form.UpdatedBy = updatedBy
+
+	if err := s.updateWithEvent(ctx, form, bus.TypeFormUpdated); err != nil {
+		log.Error("Failed to update form quotas", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrInternalError
+	}
+	s.invalidatePublicForm(ctx, formID)
+
+	log.Info("Form quotas updated",
+		log.String("form_id", formID.Hex()),
+		log.Int("max_responses", maxResponses),
+		log.Int("max_responses_per_user", maxPerUser))
+
+	return form, nil
+}
+
+// SetRetention sets how many days a form keeps its responses and what happens to them
+// afterwards. 0 days keeps them forever; an empty action deletes them.
+func (s *FormService) SetRetention(ctx context.Context, formID primitive.ObjectID, merchantID string, days int, action models.RetentionAction, updatedBy string) (*models.Form, error) {
+	if days < 0 {
+		return nil, fmt.Errorf("%w: retention days cannot be negative", ErrInvalidInput)
+	}
+	if action != "" && !action.IsValid() {
+		return nil, fmt.Errorf("%w: unknown retention action %q", ErrInvalidInput, action)
+	}
+
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+
+	form.RetentionDays = days
+	form.RetentionAction = action
+	form.UpdatedBy = updatedBy
+
+	if err := s.updateWithEvent(ctx, form, bus.TypeFormUpdated); err != nil {
+		log.Error("Failed to update form retention", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrInternalError
+	}
+
+	log.Info("Form retention updated",
+		log.String("form_id", formID.Hex()),
+		log.Int("retention_days", days),
+		log.String("retention_action", string(form.CurrentRetentionAction())))
+
+	return form, nil
+}
+
+// SetSpamProtection sets the defenses a form applies to submitted responses; a nil or empty
+// protection turns them off
+func (s *FormService) SetSpamProtection(ctx context.Context, formID primitive.ObjectID, merchantID string, protection *models.SpamProtection, updatedBy string) (*models.Form, error) {
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+
+	if !protection.Enabled() {
+		protection = nil
+	} else if err := s.spam.validate(form, protection); err != nil {
+		return nil, err
+	}
+
+	form.SpamProtection = protection
+	form.UpdatedBy = updatedBy
+
+	if err := s.updateWithEvent(ctx, form, bus.TypeFormUpdated); err != nil {
+		log.Error("Failed to update form spam protection", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrInternalError
+	}
+	s.invalidatePublicForm(ctx, formID)
+
+	log.Info("Form spam protection updated",
+		log.String("form_id", formID.Hex()),
+		log.Bool("enabled", protection != nil))
+
+	return form, nil
+}
+
+// SetSubmissionMode sets who may submit responses to a form
+func (s *FormService) SetSubmissionMode(ctx context.Context, formID primitive.ObjectID, merchantID string, mode models.SubmissionMode, updatedBy string) (*models.Form, error) {
+	if !mode.IsValid() {
+		return nil, fmt.Errorf("%w: unknown submission mode %q", ErrInvalidInput, mode)
+	}
+
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+
+	form.SubmissionMode = mode
+	form.UpdatedBy = updatedBy
+
+	if err := s.updateWithEvent(ctx, form, bus.TypeFormUpdated); err != nil {
+		log.Error("Failed to update form submission mode", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrInternalError
+	}
+	s.invalidatePublicForm(ctx, formID)
+
+	log.Info("Form submission mode updated",
+		log.String("form_id", formID.Hex()),
+		log.String("submission_mode", string(mode)))
+
+	return form, nil
+}
+
+// transitionStatus moves a form to the target status if its lifecycle allows it
+func (s *FormService) transitionStatus(ctx context.Context, formID primitive.ObjectID, merchantID string, target models.FormStatus, updatedBy string) (*models.Form, error) {
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+
+	if !form.CanTransitionTo(target) {
+		log.Warn("Invalid form status transition",
+			log.String("form_id", formID.Hex()),
+			log.String("from", string(form.CurrentStatus())),
+			log.String("to", string(target)))
+		return nil, ErrFormInvalidStatus
+	}
+
+	previous := form.CurrentStatus()
+	form.Status = target
+	form.UpdatedBy = updatedBy
+
+	eventType := bus.TypeFormClosed
+	if target == models.FormStatusPublished {
+		eventType = bus.TypeFormPublished
+	}
+	if err := s.updateWithEvent(ctx, form, eventType); err != nil {
+		log.Error("Failed to update form status", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrInternalError
+	}
+	s.invalidatePublicForm(ctx, formID)
+
+	log.Info("Form status changed",
+		log.String("form_id", formID.Hex()),
+		log.String("from", string(previous)),
+		log.String("to", string(target)))
+
+	return form, nil
+}
+
+// CompareFormToTemplate compares a form's current schema with the latest version of its source template
+func (s *FormService) CompareFormToTemplate(ctx context.Context, formID primitive.ObjectID, merchantID string) (*models.FormTemplateComparison, error) {
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+	if form.MerchantID != merchantID {
+		return nil, ErrFormNotFound
+	}
+	if !form.HasTemplateID() {
+		return nil, ErrFormHasNoTemplate
+	}
+
+	template, err := s.templateRepo.FindByID(ctx, *form.TemplateID)
+	if err != nil {
+		log.Error("Failed to get source template", log.Err(err), log.String("template_id", form.TemplateID.Hex()))
+		return nil, ErrTemplateNotFound
+	}
+
+	comparison := &models.FormTemplateComparison{
+		FormID:          form.ID,
+		TemplateID:      template.ID,
+		FormVersion:     form.TemplateVersion,
+		TemplateVersion: template.CurrentVersion(),
+		Changes:         schema.Diff(form.Schema, template.Schema),
+	}
+	if behind := comparison.TemplateVersion - comparison.FormVersion; behind > 0 {
+		comparison.VersionsBehind = behind
+	}
+
+	return comparison, nil
+}
+
+// CompareSchemas compares the schemas of two forms or templates of a merchant, or two versions of
+// the same form or template, e.g. to review the changes before syncing a form with its template
+func (s *FormService) CompareSchemas(ctx context.Context, from, to models.SchemaSource, merchantID string) (*models.SchemaComparison, error) {
+	fromSchema, fromVersion, err := s.sourceSchema(ctx, from, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	toSchema, toVersion, err := s.sourceSchema(ctx, to, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	from.Version = fromVersion
+	to.Version = toVersion
+	return &models.SchemaComparison{
+		From:    from,
+		To:      to,
+		Changes: schema.Diff(fromSchema, toSchema),
+	}, nil
+}
+
+// sourceSchema returns the schema of a form or template of the merchant at the requested
+// version, defaulting to the current one, with the version it resolved to
+func (s *FormService) sourceSchema(ctx context.Context, source models.SchemaSource, merchantID string) (interface{}, int, error) {
+	if source.FormID.IsZero() == source.TemplateID.IsZero() {
+		return nil, 0, fmt.Errorf("%w: set either a form or a template to compare", ErrInvalidInput)
+	}
+	if source.Version < 0 {
+		return nil, 0, fmt.Errorf("%w: version must not be negative", ErrInvalidInput)
+	}
+
+	if source.IsForm() {
+		form, err := s.formRepo.FindByID(ctx, source.FormID)
+		if err != nil || form.MerchantID != merchantID {
+			log.Error("Failed to get form", log.Err(err), log.String("form_id", source.FormID.Hex()))
+			return nil, 0, ErrFormNotFound
+		}
+		currentVersion := form.CurrentSchemaVersion()
+		if source.Version == 0 || source.Version == currentVersion {
+			return form.Schema, currentVersion, nil
+		}
+		if source.Version > currentVersion {
+			return nil, 0, ErrSchemaVersionNotFound
+		}
+		schemaVersion, err := s.formRepo.FindSchemaVersion(ctx, form.ID, source.Version)
+		if err != nil {
+			log.Error("Failed to get form schema version", log.Err(err),
+				log.String("form_id", form.ID.Hex()),
+				log.Int("version", source.Version))
+			return nil, 0, ErrSchemaVersionNotFound
+		}
+		return schemaVersion.Schema, source.Version, nil
+	}
+
+	template, err := s.templateRepo.FindByID(ctx, source.TemplateID)
+	if err != nil || template.MerchantID != merchantID {
+		log.Error("Failed to get template", log.Err(err), log.String("template_id", source.TemplateID.Hex()))
+		return nil, 0, ErrTemplateNotFound
+	}
+	currentVersion := template.CurrentVersion()
+	if source.Version == 0 || source.Version == currentVersion {
+		return template.Schema, currentVersion, nil
+	}
+	if source.Version > currentVersion {
+		return nil, 0, ErrSchemaVersionNotFound
+	}
+	templateVersion, err := s.templateRepo.FindVersion(ctx, template.ID, source.Version)
+	if err != nil {
+		log.Error("Failed to get template version", log.Err(err),
+			log.String("template_id", template.ID.Hex()),
+			log.Int("version", source.Version))
+		return nil, 0, ErrSchemaVersionNotFound
+	}
+	return templateVersion.Schema, source.Version, nil
+}
+
 // ListFormsByEvent retrieves forms associated with an event
 func (s *FormService) ListFormsByEvent(ctx context.Context, eventID primitive.ObjectID, merchantID string, page, pageSize int) ([]*models.Form, int64, error) {
 	// Set default pagination
@@ -210,3 +733,137 @@ func (s *FormService) ListFormsByTemplate(ctx context.Context, templateID primit
 
 	return forms, count, nil
 }
+
+// AdminListForms lists the forms of the merchant in options for a platform admin, regardless of
+// the admin's own merchant. Every access is audited.
+func (s *FormService) AdminListForms(ctx context.Context, options *models.FormQueryOptions, adminID string) ([]*models.Form, int64, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, adminID, "list_forms", options.MerchantID); err != nil {
+		return nil, 0, err
+	}
+	if options.MerchantID == "" {
+		return nil, 0, fmt.Errorf("%w: merchant_id is required", ErrInvalidInput)
+	}
+
+	auditAdminAccess(adminID, "list_forms", options.MerchantID, "")
+	return s.ListForms(ctx, options)
+}
+
+// AdminGetForm gets a form of any merchant for a platform admin. Every access is audited.
+func (s *FormService) AdminGetForm(ctx context.Context, formID primitive.ObjectID, adminID string) (*models.Form, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, adminID, "get_form", ""); err != nil {
+		return nil, err
+	}
+
+	form, err := s.GetForm(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	auditAdminAccess(adminID, "get_form", form.MerchantID, form.ID.Hex())
+	return form, nil
+}
+
+// checkEventFormLimit validates if the merchant can attach another form to an event
+func (s *FormService) checkEventFormLimit(ctx context.Context, eventID primitive.ObjectID, merchantID string) error {
+	limits, err := s.limits.GetLimits(ctx, merchantID)
+	if err != nil {
+		return err
+	}
+	if limits.MaxFormsPerEvent <= 0 {
+		return nil
+	}
+
+	_, count, err := s.formRepo.FindByEventID(ctx, eventID, merchantID, 1, 1)
+	if err != nil {
+		log.Error("Failed to count forms of event", log.Err(err))
+		return ErrInternalError
+	}
+
+	if count >= int64(limits.MaxFormsPerEvent) {
+		log.Warn("Event form limit exceeded",
+			log.String("merchant_id", merchantID),
+			log.String("event_id", eventID.Hex()),
+			log.Int64("current_count", count),
+			log.Int("limit", limits.MaxFormsPerEvent))
+		return ErrEventFormLimitExceeded
+	}
+
+	return nil
+}
+
+// publicFormCacheKey is the cache key of a published form served to the public
+func publicFormCacheKey(formID primitive.ObjectID) string {
+	return "public_form:" + formID.Hex()
+}
+
+// cachedPublicForm returns the cached public form, if any. Cache failures are logged and
+// treated as misses so public reads fall back to MongoDB.
+func (s *FormService) cachedPublicForm(ctx context.Context, formID primitive.ObjectID) (*models.Form, bool) {
+	if s.publicForms == nil {
+		return nil, false
+	}
+
+	data, ok, err := s.publicForms.Get(ctx, publicFormCacheKey(formID))
+	if err != nil {
+		log.Warn("Failed to read public form cache", log.Err(err), log.String("form_id", formID.Hex()))
+		metrics.ObserveCache("public_form", false)
+		return nil, false
+	}
+	metrics.ObserveCache("public_form", ok)
+	if !ok {
+		return nil, false
+	}
+
+	var form models.Form
+	if err := bson.Unmarshal(data, &form); err != nil {
+		log.Warn("Failed to decode cached public form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, false
+	}
+	return &form, true
+}
+
+// cachePublicForm caches a published form for public reads
+func (s *FormService) cachePublicForm(ctx context.Context, form *models.Form) {
+	if s.publicForms == nil {
+		return
+	}
+
+	data, err := bson.Marshal(form)
+	if err != nil {
+		log.Warn("Failed to encode public form for caching", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return
+	}
+	if err := s.publicForms.Set(ctx, publicFormCacheKey(form.ID), data, s.config.CacheConfig.PublicFormTTL); err != nil {
+		log.Warn("Failed to cache public form", log.Err(err), log.String("form_id", form.ID.Hex()))
+	}
+}
+
+// invalidatePublicForm drops a changed form from the public cache. A failure leaves the cached
+// copy to expire after cache.public_form_ttl.
+func (s *FormService) invalidatePublicForm(ctx context.Context, formID primitive.ObjectID) {
+	if s.publicForms == nil {
+		return
+	}
+	if err := s.publicForms.Delete(ctx, publicFormCacheKey(formID)); err != nil {
+		log.Warn("Failed to invalidate public form cache", log.Err(err), log.String("form_id", formID.Hex()))
+	}
+}
+
+// updateWithEvent stores the form and records the event describing the change in the outbox
+func (s *FormService) updateWithEvent(ctx context.Context, form *models.Form, eventType string) error {
+	data := bus.FormEvent{
+		FormID:        form.ID.Hex(),
+		Status:        string(form.CurrentStatus()),
+		SchemaVersion: form.CurrentSchemaVersion(),
+		OpenAt:        form.OpenAt,
+		CloseAt:       form.CloseAt,
+		UpdatedBy:     form.UpdatedBy,
+	}
+	if form.EventID != nil {
+		data.EventID = form.EventID.Hex()
+	}
+
+	return s.events.store(ctx, func(ctx context.Context) error {
+		return s.formRepo.Update(ctx, form)
+	}, eventType, form.MerchantID, data)
+}
@@ -2,15 +2,19 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/bus"
 	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
 )
 
 // Mock FormRepository
@@ -28,6 +32,11 @@ func (m *MockFormRepository) FindByID(ctx context.Context, formID primitive.Obje
 	return args.Get(0).(*models.Form), args.Error(1)
 }
 
+func (m *MockFormRepository) FindByIDs(ctx context.Context, formIDs []primitive.ObjectID) ([]*models.Form, error) {
+	args := m.Called(ctx, formIDs)
+	return args.Get(0).([]*models.Form), args.Error(1)
+}
+
 func (m *MockFormRepository) Find(ctx context.Context, options *models.FormQueryOptions) ([]*models.Form, int64, error) {
 	args := m.Called(ctx, options)
 	return args.Get(0).([]*models.Form), args.Get(1).(int64), args.Error(2)
@@ -63,6 +72,36 @@ func (m *MockFormRepository) CountByTemplateID(ctx context.Context, templateID p
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockFormRepository) FindWithMissingTemplate(ctx context.Context, afterID primitive.ObjectID, limit int) ([]*models.Form, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).([]*models.Form), args.Error(1)
+}
+
+func (m *MockFormRepository) DetachTemplate(ctx context.Context, templateID primitive.ObjectID, merchantID string) (int64, error) {
+	args := m.Called(ctx, templateID, merchantID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFormRepository) SaveSchemaVersion(ctx context.Context, version *models.FormSchemaVersion) error {
+	args := m.Called(ctx, version)
+	return args.Error(0)
+}
+
+func (m *MockFormRepository) CloseExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFormRepository) CountByStatus(ctx context.Context, merchantID string) (map[models.FormStatus]int64, error) {
+	args := m.Called(ctx, merchantID)
+	return args.Get(0).(map[models.FormStatus]int64), args.Error(1)
+}
+
+func (m *MockFormRepository) FindSchemaVersion(ctx context.Context, formID primitive.ObjectID, version int) (*models.FormSchemaVersion, error) {
+	args := m.Called(ctx, formID, version)
+	return args.Get(0).(*models.FormSchemaVersion), args.Error(1)
+}
+
 // Mock FormTemplateRepository
 type MockFormTemplateRepository struct {
 	mock.Mock
@@ -108,6 +147,22 @@ func (m *MockFormTemplateRepository) Duplicate(ctx context.Context, sourceID pri
 	return args.Get(0).(*models.FormTemplate), args.Error(1)
 }
 
+func (m *MockFormTemplateRepository) Archive(ctx context.Context, templateID primitive.ObjectID, archivedBy string) error {
+	args := m.Called(ctx, templateID, archivedBy)
+	return args.Error(0)
+}
+
+func (m *MockFormTemplateRepository) SaveVersion(ctx context.Context, version *models.FormTemplateVersion) error {
+	args := m.Called(ctx, version)
+	return args.Error(0)
+}
+
+func (m *MockFormTemplateRepository) FindVersion(ctx context.Context, templateID primitive.ObjectID, version int) (*models.FormTemplateVersion, error) {
+	args := m.Called(ctx, templateID, version)
+	templateVersion, _ := args.Get(0).(*models.FormTemplateVersion)
+	return templateVersion, args.Error(1)
+}
+
 // Test setup helper
 func setupFormService() (*FormService, *MockFormRepository, *MockFormTemplateRepository, *conf.AppConfig) {
 	mockFormRepo := &MockFormRepository{}
@@ -118,7 +173,7 @@ func setupFormService() (*FormService, *MockFormRepository, *MockFormTemplateRep
 			MaxPageSize:     100,
 		},
 	}
-	service := NewFormService(mockFormRepo, mockTemplateRepo, config)
+	service := NewFormService(mockFormRepo, mockTemplateRepo, &MockFormSubmissionRepository{}, newDefaultLimitsService(config), nil, nil, nil, NewFieldBlockService(&MockFieldBlockRepository{}, config), nil, config)
 	return service, mockFormRepo, mockTemplateRepo, config
 }
 
@@ -195,6 +250,23 @@ func TestFormService_CreateForm_ValidationError(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid input")
 }
 
+func TestFormService_CreateForm_InvalidConditions(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	input := createTestCreateFormInput()
+	input.UISchema = map[string]interface{}{
+		schema.ConditionsKey: []interface{}{
+			map[string]interface{}{"field": "allergies", "when": []interface{}{map[string]interface{}{"field": "has_allergies", "equals": "yes"}}},
+		},
+	}
+
+	form, err := service.CreateForm(ctx, input)
+
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Nil(t, form)
+	mockFormRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
 func TestFormService_CreateForm_RepositoryError(t *testing.T) {
 	service, mockFormRepo, _, _ := setupFormService()
 	ctx := context.Background()
@@ -211,6 +283,57 @@ func TestFormService_CreateForm_RepositoryError(t *testing.T) {
 	mockFormRepo.AssertExpectations(t)
 }
 
+func TestFormService_CreateForm_EventFormLimitExceeded(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	ctx := context.Background()
+	input := createTestCreateFormInput()
+	config.BusinessRulesConfig = &conf.BusinessRulesConfig{MaxFormsPerEvent: 2}
+
+	mockFormRepo.On("FindByEventID", ctx, *input.EventID, input.MerchantID, 1, 1).Return([]*models.Form{createTestForm()}, int64(2), nil)
+
+	form, err := service.CreateForm(ctx, input)
+
+	assert.Nil(t, form)
+	assert.Equal(t, ErrEventFormLimitExceeded, err)
+	mockFormRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormService_checkEventFormLimit_MerchantOverride(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	ctx := context.Background()
+	eventID := primitive.NewObjectID()
+	config.BusinessRulesConfig = &conf.BusinessRulesConfig{MaxFormsPerEvent: 2}
+	service.limits.store("merchant123", &models.MerchantLimits{MerchantID: "merchant123", MaxFormsPerEvent: 10})
+
+	mockFormRepo.On("FindByEventID", ctx, eventID, "merchant123", 1, 1).Return([]*models.Form{createTestForm()}, int64(2), nil)
+
+	err := service.checkEventFormLimit(ctx, eventID, "merchant123")
+
+	assert.NoError(t, err)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormService_CreateForm_InvalidTemplate(t *testing.T) {
+	service, mockFormRepo, mockTemplateRepo, _ := setupFormService()
+	ctx := context.Background()
+	input := createTestCreateFormInput()
+	templateID := primitive.NewObjectID()
+	input.TemplateID = &templateID
+
+	mockTemplateRepo.On("FindByID", ctx, templateID).Return(&models.FormTemplate{
+		ID:         templateID,
+		MerchantID: "other-merchant",
+	}, nil)
+
+	form, err := service.CreateForm(ctx, input)
+
+	assert.Nil(t, form)
+	assert.Equal(t, ErrFormInvalidTemplate, err)
+
+	mockTemplateRepo.AssertExpectations(t)
+	mockFormRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
 func TestFormService_GetForm_Success(t *testing.T) {
 	service, mockFormRepo, _, _ := setupFormService()
 	ctx := context.Background()
@@ -246,6 +369,159 @@ func TestFormService_GetForm_NotFound(t *testing.T) {
 	mockFormRepo.AssertExpectations(t)
 }
 
+func TestFormService_GetFormsByIDs_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	first := createTestForm()
+	second := createTestForm()
+	missing := primitive.NewObjectID()
+
+	// Duplicates are queried once; the database returns forms in its own order
+	mockFormRepo.On("FindByIDs", ctx, []primitive.ObjectID{second.ID, missing, first.ID}).
+		Return([]*models.Form{first, second}, nil)
+
+	forms, err := service.GetFormsByIDs(ctx, []primitive.ObjectID{second.ID, missing, first.ID, second.ID}, first.MerchantID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Form{second, nil, first, second}, forms)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormService_GetFormsByIDs_OtherMerchant(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	own := createTestForm()
+	other := createTestForm()
+	other.MerchantID = "merchant456"
+
+	mockFormRepo.On("FindByIDs", ctx, []primitive.ObjectID{own.ID, other.ID}).Return([]*models.Form{own, other}, nil)
+
+	forms, err := service.GetFormsByIDs(ctx, []primitive.ObjectID{own.ID, other.ID}, own.MerchantID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.Form{own, nil}, forms)
+}
+
+func TestFormService_GetFormsByIDs_NoMerchant(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+
+	// Callers without a merchant must not see every merchant's forms
+	forms, err := service.GetFormsByIDs(ctx, []primitive.ObjectID{primitive.NewObjectID()}, "")
+
+	assert.Nil(t, forms)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	mockFormRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+}
+
+func TestFormService_GetFormsByIDs_InvalidInput(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	ctx := context.Background()
+
+	forms, err := service.GetFormsByIDs(ctx, nil, "merchant123")
+	assert.Nil(t, forms)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	tooMany := make([]primitive.ObjectID, config.PaginationConfig.MaxPageSize+1)
+	forms, err = service.GetFormsByIDs(ctx, tooMany, "merchant123")
+	assert.Nil(t, forms)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	mockFormRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+}
+
+func TestFormService_GetPublicForm_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+	form.Status = models.FormStatusPublished
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.GetPublicForm(ctx, form.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, form, result)
+}
+
+func TestFormService_GetPublicForm_NotPublic(t *testing.T) {
+	tests := map[string]func(form *models.Form){
+		"draft":    func(form *models.Form) { form.Status = models.FormStatusDraft },
+		"closed":   func(form *models.Form) { form.Status = models.FormStatusClosed },
+		"no event": func(form *models.Form) { form.Status = models.FormStatusPublished; form.EventID = nil },
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			service, mockFormRepo, _, _ := setupFormService()
+			ctx := context.Background()
+			form := createTestForm()
+			modify(form)
+
+			mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+			result, err := service.GetPublicForm(ctx, form.ID)
+
+			assert.Nil(t, result)
+			assert.Equal(t, ErrFormNotFound, err)
+		})
+	}
+}
+
+// memoryCacheStore is an in-memory cache.Store for tests
+type memoryCacheStore struct {
+	values map[string][]byte
+}
+
+func (m *memoryCacheStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	value, ok := m.values[key]
+	return value, ok, nil
+}
+
+func (m *memoryCacheStore) Set(_ context.Context, key string, value []byte, _ time.Duration) error {
+	m.values[key] = value
+	return nil
+}
+
+func (m *memoryCacheStore) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		delete(m.values, key)
+	}
+	return nil
+}
+
+func TestFormService_GetPublicForm_Cached(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	config.CacheConfig = &conf.CacheConfig{PublicFormTTL: time.Minute}
+	store := &memoryCacheStore{values: map[string][]byte{}}
+	service.publicForms = store
+	ctx := context.Background()
+	form := createTestForm()
+	form.Status = models.FormStatusPublished
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil).Once()
+
+	first, err := service.GetPublicForm(ctx, form.ID)
+	assert.NoError(t, err)
+	assert.Contains(t, store.values, publicFormCacheKey(form.ID))
+
+	// The second read is served from the cache
+	second, err := service.GetPublicForm(ctx, form.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, first.EventID, second.EventID)
+	assert.Equal(t, models.FormStatusPublished, second.Status)
+	mockFormRepo.AssertNumberOfCalls(t, "FindByID", 1)
+
+	// Closing the form invalidates the cached copy
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil).Once()
+	mockFormRepo.On("Update", ctx, form).Return(nil)
+
+	_, err = service.CloseForm(ctx, form.ID, form.MerchantID, "user123")
+	assert.NoError(t, err)
+	assert.NotContains(t, store.values, publicFormCacheKey(form.ID))
+}
+
 func TestFormService_ListForms_Success(t *testing.T) {
 	service, mockFormRepo, _, _ := setupFormService()
 	ctx := context.Background()
@@ -351,7 +627,15 @@ func TestFormService_UpdateForm_Success(t *testing.T) {
 	existingForm := createTestForm()
 	existingForm.ID = input.ID
 
+	existingForm.SchemaVersion = 2
+	originalSchema := existingForm.Schema
+
 	mockFormRepo.On("FindByID", ctx, input.ID).Return(existingForm, nil)
+	mockFormRepo.On("SaveSchemaVersion", ctx, mock.MatchedBy(func(version *models.FormSchemaVersion) bool {
+		return version.FormID == input.ID &&
+			version.Version == 2 &&
+			assert.ObjectsAreEqual(originalSchema, version.Schema)
+	})).Return(nil)
 	mockFormRepo.On("Update", ctx, mock.MatchedBy(func(form *models.Form) bool {
 		schema, ok := form.Schema.(map[string]interface{})
 		return ok && form.ID == input.ID &&
@@ -367,10 +651,70 @@ func TestFormService_UpdateForm_Success(t *testing.T) {
 	assert.Equal(t, input.Schema, form.Schema)
 	assert.Equal(t, input.UISchema, form.UISchema)
 	assert.Equal(t, input.UpdatedBy, form.UpdatedBy)
+	assert.Equal(t, 3, form.SchemaVersion)
 
 	mockFormRepo.AssertExpectations(t)
 }
 
+func TestFormService_UpdateForm_ArchiveVersionError(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	input := createTestUpdateFormInput()
+	existingForm := createTestForm()
+	existingForm.ID = input.ID
+
+	mockFormRepo.On("FindByID", ctx, input.ID).Return(existingForm, nil)
+	mockFormRepo.On("SaveSchemaVersion", ctx, mock.AnythingOfType("*models.FormSchemaVersion")).Return(errors.New("database error"))
+
+	form, err := service.UpdateForm(ctx, input)
+
+	assert.Error(t, err)
+	assert.Nil(t, form)
+	assert.Equal(t, ErrInternalError, err)
+
+	mockFormRepo.AssertExpectations(t)
+	mockFormRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestFormService_UpdateForm_SchemaLocked(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	input := createTestUpdateFormInput()
+	existingForm := createTestForm()
+	existingForm.ID = input.ID
+	existingForm.Status = models.FormStatusPublished
+
+	mockFormRepo.On("FindByID", ctx, input.ID).Return(existingForm, nil)
+
+	form, err := service.UpdateForm(ctx, input)
+
+	assert.Nil(t, form)
+	assert.Equal(t, ErrFormSchemaLocked, err)
+	mockFormRepo.AssertNotCalled(t, "SaveSchemaVersion", mock.Anything, mock.Anything)
+	mockFormRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestFormService_UpdateForm_PublishedUISchemaOnly(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	input := createTestUpdateFormInput()
+	existingForm := createTestForm()
+	existingForm.ID = input.ID
+	existingForm.Status = models.FormStatusPublished
+	existingForm.SchemaVersion = 2
+	input.Schema = existingForm.Schema
+
+	mockFormRepo.On("FindByID", ctx, input.ID).Return(existingForm, nil)
+	mockFormRepo.On("Update", ctx, mock.AnythingOfType("*models.Form")).Return(nil)
+
+	form, err := service.UpdateForm(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, input.UISchema, form.UISchema)
+	assert.Equal(t, 2, form.SchemaVersion)
+	mockFormRepo.AssertNotCalled(t, "SaveSchemaVersion", mock.Anything, mock.Anything)
+}
+
 func TestFormService_UpdateForm_ValidationError(t *testing.T) {
 	service, _, _, _ := setupFormService()
 	ctx := context.Background()
@@ -411,6 +755,7 @@ func TestFormService_UpdateForm_RepositoryError(t *testing.T) {
 	existingForm.ID = input.ID
 
 	mockFormRepo.On("FindByID", ctx, input.ID).Return(existingForm, nil)
+	mockFormRepo.On("SaveSchemaVersion", ctx, mock.AnythingOfType("*models.FormSchemaVersion")).Return(nil)
 	mockFormRepo.On("Update", ctx, mock.AnythingOfType("*models.Form")).Return(errors.New("database error"))
 
 	form, err := service.UpdateForm(ctx, input)
@@ -556,3 +901,529 @@ func TestFormService_ListFormsByTemplate_RepositoryError(t *testing.T) {
 	assert.Equal(t, ErrInternalError, err)
 	mockFormRepo.AssertExpectations(t)
 }
+
+func TestFormService_AdminListForms_ConfiguredAdmin(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	config.AdminConfig = &conf.AdminConfig{UserIDs: []string{"support1"}}
+	ctx := context.Background()
+
+	options := &models.FormQueryOptions{MerchantID: "merchant456", Page: 1, PageSize: 10}
+	expectedForms := []*models.Form{createTestForm()}
+	mockFormRepo.On("Find", ctx, options).Return(expectedForms, int64(1), nil)
+
+	forms, count, err := service.AdminListForms(ctx, options, "support1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedForms, forms)
+	assert.Equal(t, int64(1), count)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormService_AdminListForms_KetoAdmin(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	config.AdminConfig = &conf.AdminConfig{KetoNamespace: "Platform", KetoObject: "form", KetoRelation: "admin"}
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		return namespace == "Platform" && object == "form" && relation == "admin" && subjectObject == "support1", nil
+	}
+	ctx := context.Background()
+
+	options := &models.FormQueryOptions{MerchantID: "merchant456", Page: 1, PageSize: 10}
+	mockFormRepo.On("Find", ctx, options).Return([]*models.Form{}, int64(0), nil)
+
+	_, _, err := service.AdminListForms(ctx, options, "support1")
+	assert.NoError(t, err)
+
+	_, _, err = service.AdminListForms(ctx, options, "user123")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	mockFormRepo.AssertNumberOfCalls(t, "Find", 1)
+}
+
+func TestFormService_AdminListForms_KetoError(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	config.AdminConfig = &conf.AdminConfig{KetoNamespace: "Platform", KetoObject: "form", KetoRelation: "admin"}
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		return false, errors.New("keto unavailable")
+	}
+
+	_, _, err := service.AdminListForms(context.Background(), &models.FormQueryOptions{MerchantID: "merchant456"}, "support1")
+
+	assert.ErrorIs(t, err, ErrInternalError)
+	mockFormRepo.AssertNotCalled(t, "Find")
+}
+
+func TestFormService_AdminGetForm(t *testing.T) {
+	service, mockFormRepo, _, config := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	_, err := service.AdminGetForm(ctx, form.ID, "support1")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	config.AdminConfig = &conf.AdminConfig{UserIDs: []string{"support1"}}
+	result, err := service.AdminGetForm(ctx, form.ID, "support1")
+	assert.NoError(t, err)
+	assert.Equal(t, form, result)
+	mockFormRepo.AssertNumberOfCalls(t, "FindByID", 1)
+}
+
+func TestFormService_CompareFormToTemplate_Success(t *testing.T) {
+	service, mockFormRepo, mockTemplateRepo, _ := setupFormService()
+	ctx := context.Background()
+	templateID := primitive.NewObjectID()
+	form := createTestForm()
+	form.TemplateID = &templateID
+	form.TemplateVersion = 1
+	form.Schema = map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+	}
+	template := &models.FormTemplate{
+		ID:         templateID,
+		MerchantID: "merchant123",
+		Version:    3,
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"name":  map[string]interface{}{"type": "string"},
+				"email": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockTemplateRepo.On("FindByID", ctx, templateID).Return(template, nil)
+
+	comparison, err := service.CompareFormToTemplate(ctx, form.ID, "merchant123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, form.ID, comparison.FormID)
+	assert.Equal(t, templateID, comparison.TemplateID)
+	assert.Equal(t, 1, comparison.FormVersion)
+	assert.Equal(t, 3, comparison.TemplateVersion)
+	assert.Equal(t, 2, comparison.VersionsBehind)
+	assert.Len(t, comparison.Changes, 1)
+	assert.Equal(t, "email", comparison.Changes[0].Field)
+
+	mockFormRepo.AssertExpectations(t)
+	mockTemplateRepo.AssertExpectations(t)
+}
+
+func TestFormService_CompareFormToTemplate_NoTemplate(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	comparison, err := service.CompareFormToTemplate(ctx, form.ID, "merchant123")
+
+	assert.Nil(t, comparison)
+	assert.Equal(t, ErrFormHasNoTemplate, err)
+}
+
+func TestFormService_CompareFormToTemplate_OtherMerchant(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	comparison, err := service.CompareFormToTemplate(ctx, form.ID, "other-merchant")
+
+	assert.Nil(t, comparison)
+	assert.Equal(t, ErrFormNotFound, err)
+}
+
+func TestFormService_CompareSchemas_TemplateVersions(t *testing.T) {
+	service, _, mockTemplateRepo, _ := setupFormService()
+	ctx := context.Background()
+	template := createTestFormTemplate()
+	template.Version = 3
+	template.Schema = map[string]interface{}{
+		"properties": map[string]interface{}{"age": map[string]interface{}{"type": "integer"}},
+		"required":   []interface{}{"age"},
+	}
+
+	mockTemplateRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+	mockTemplateRepo.On("FindVersion", ctx, template.ID, 1).Return(&models.FormTemplateVersion{
+		TemplateID: template.ID,
+		Version:    1,
+		Schema: map[string]interface{}{
+			"properties": map[string]interface{}{
+				"age":  map[string]interface{}{"type": "string"},
+				"name": map[string]interface{}{"type": "string"},
+			},
+		},
+	}, nil)
+
+	comparison, err := service.CompareSchemas(ctx,
+		models.SchemaSource{TemplateID: template.ID, Version: 1},
+		models.SchemaSource{TemplateID: template.ID},
+		"merchant123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, comparison.From.Version)
+	assert.Equal(t, 3, comparison.To.Version)
+	assert.Len(t, comparison.Changes, 2)
+	assert.Equal(t, "age", comparison.Changes[0].Field)
+	assert.True(t, comparison.Changes[0].TypeChanged())
+	assert.True(t, comparison.Changes[0].RequiredChanged())
+	assert.Equal(t, schema.ChangeRemoved, comparison.Changes[1].Type)
+}
+
+func TestFormService_CompareSchemas_FormAndTemplate(t *testing.T) {
+	service, mockFormRepo, mockTemplateRepo, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+	template := createTestFormTemplate()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockTemplateRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+
+	comparison, err := service.CompareSchemas(ctx,
+		models.SchemaSource{FormID: form.ID},
+		models.SchemaSource{TemplateID: template.ID},
+		"merchant123")
+
+	assert.NoError(t, err)
+	assert.True(t, comparison.From.IsForm())
+	assert.Equal(t, 1, comparison.From.Version)
+	assert.Empty(t, comparison.Changes)
+}
+
+func TestFormService_CompareSchemas_InvalidSource(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	tests := []struct {
+		name     string
+		from     models.SchemaSource
+		expected error
+	}{
+		{name: "no source", from: models.SchemaSource{}, expected: ErrInvalidInput},
+		{name: "both sources", from: models.SchemaSource{FormID: form.ID, TemplateID: primitive.NewObjectID()}, expected: ErrInvalidInput},
+		{name: "future version", from: models.SchemaSource{FormID: form.ID, Version: 5}, expected: ErrSchemaVersionNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CompareSchemas(ctx, tt.from, models.SchemaSource{FormID: form.ID}, "merchant123")
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	_, err := service.CompareSchemas(ctx, models.SchemaSource{FormID: form.ID}, models.SchemaSource{FormID: form.ID}, "other-merchant")
+	assert.Equal(t, ErrFormNotFound, err)
+}
+
+func TestFormService_CompareFormToTemplate_TemplateNotFound(t *testing.T) {
+	service, mockFormRepo, mockTemplateRepo, _ := setupFormService()
+	ctx := context.Background()
+	templateID := primitive.NewObjectID()
+	form := createTestForm()
+	form.TemplateID = &templateID
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockTemplateRepo.On("FindByID", ctx, templateID).Return((*models.FormTemplate)(nil), errors.New("not found"))
+
+	comparison, err := service.CompareFormToTemplate(ctx, form.ID, "merchant123")
+
+	assert.Nil(t, comparison)
+	assert.Equal(t, ErrTemplateNotFound, err)
+}
+
+func TestFormService_PublishForm_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.MatchedBy(func(f *models.Form) bool {
+		return f.Status == models.FormStatusPublished && f.UpdatedBy == "user456"
+	})).Return(nil)
+
+	result, err := service.PublishForm(ctx, form.ID, "merchant123", "user456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.FormStatusPublished, result.Status)
+	mockFormRepo.AssertExpectations(t)
+}
+
+// memoryOutboxRepository is an in-memory repository.OutboxRepository for tests
+type memoryOutboxRepository struct {
+	events []*models.OutboxEvent
+}
+
+func (m *memoryOutboxRepository) Add(_ context.Context, event *models.OutboxEvent) error {
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *memoryOutboxRepository) Lease(context.Context, string, time.Time, time.Duration) (*models.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (m *memoryOutboxRepository) MarkPublished(context.Context, primitive.ObjectID, string, time.Time, time.Time) error {
+	return nil
+}
+
+func (m *memoryOutboxRepository) Retry(context.Context, primitive.ObjectID, string, string, time.Time) error {
+	return nil
+}
+
+// immediateTransactions runs transaction functions without a database transaction
+type immediateTransactions struct{}
+
+func (immediateTransactions) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestFormService_PublishForm_RecordsEvent(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	outbox := &memoryOutboxRepository{}
+	service.events = NewEventOutbox(outbox, immediateTransactions{})
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.AnythingOfType("*models.Form")).Return(nil)
+
+	_, err := service.PublishForm(ctx, form.ID, "merchant123", "user456")
+	assert.NoError(t, err)
+
+	if assert.Len(t, outbox.events, 1) {
+		event := outbox.events[0]
+		assert.Equal(t, bus.TypeFormPublished, event.Type)
+		assert.Equal(t, "merchant123", event.MerchantID)
+		assert.NotEmpty(t, event.EventID)
+
+		var data bus.FormEvent
+		assert.NoError(t, json.Unmarshal([]byte(event.Data), &data))
+		assert.Equal(t, form.ID.Hex(), data.FormID)
+		assert.Equal(t, string(models.FormStatusPublished), data.Status)
+		assert.Equal(t, "user456", data.UpdatedBy)
+	}
+}
+
+func TestFormService_PublishForm_NoEventWhenUpdateFails(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	outbox := &memoryOutboxRepository{}
+	service.events = NewEventOutbox(outbox, immediateTransactions{})
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.AnythingOfType("*models.Form")).Return(errors.New("database error"))
+
+	_, err := service.PublishForm(ctx, form.ID, "merchant123", "user456")
+	assert.Equal(t, ErrInternalError, err)
+	assert.Empty(t, outbox.events)
+}
+
+func TestFormService_CloseForm_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+	form.Status = models.FormStatusPublished
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.AnythingOfType("*models.Form")).Return(nil)
+
+	result, err := service.CloseForm(ctx, form.ID, "merchant123", "user456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.FormStatusClosed, result.Status)
+	assert.False(t, result.AcceptsSubmissions())
+}
+
+func TestFormService_CloseForm_InvalidTransition(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.CloseForm(ctx, form.ID, "merchant123", "user456")
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrFormInvalidStatus, err)
+	mockFormRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestFormService_PublishForm_OtherMerchant(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.PublishForm(ctx, form.ID, "other-merchant", "user456")
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrFormNotFound, err)
+}
+
+func TestFormService_PublishForm_RepositoryError(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.AnythingOfType("*models.Form")).Return(errors.New("database error"))
+
+	result, err := service.PublishForm(ctx, form.ID, "merchant123", "user456")
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestFormService_SetSchedule_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+	openAt := time.Now().Add(time.Hour)
+	closeAt := openAt.Add(24 * time.Hour)
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.MatchedBy(func(f *models.Form) bool {
+		return f.OpenAt.Equal(openAt) && f.CloseAt.Equal(closeAt) && f.UpdatedBy == "user456"
+	})).Return(nil)
+
+	result, err := service.SetSchedule(ctx, form.ID, "merchant123", &openAt, &closeAt, "user456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &closeAt, result.CloseAt)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormService_SetSchedule_InvalidWindow(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	openAt := time.Now()
+	closeAt := openAt.Add(-time.Hour)
+
+	result, err := service.SetSchedule(ctx, primitive.NewObjectID(), "merchant123", &openAt, &closeAt, "user456")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockFormRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestFormService_SetSchedule_OtherMerchant(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.SetSchedule(ctx, form.ID, "other-merchant", nil, nil, "user456")
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrFormNotFound, err)
+	mockFormRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestFormService_SetQuotas_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	mockSubmissionRepo := service.submissionRepo.(*MockFormSubmissionRepository)
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("SeedResponseCounters", ctx, form.ID).Return(nil).Once()
+	mockFormRepo.On("Update", ctx, mock.MatchedBy(func(f *models.Form) bool {
+		return f.MaxResponses == 100 && f.MaxPerUser == 2 && f.UpdatedBy == "user456"
+	})).Return(nil)
+
+	result, err := service.SetQuotas(ctx, form.ID, "merchant123", 100, 2, "user456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, result.MaxResponses)
+	mockFormRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormService_SetQuotas_SeedError(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	mockSubmissionRepo := service.submissionRepo.(*MockFormSubmissionRepository)
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("SeedResponseCounters", ctx, form.ID).Return(errors.New("database error"))
+
+	result, err := service.SetQuotas(ctx, form.ID, "merchant123", 100, 0, "user456")
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInternalError, err)
+	mockFormRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestFormService_SetQuotas_Negative(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+
+	result, err := service.SetQuotas(ctx, primitive.NewObjectID(), "merchant123", -1, 0, "user456")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockFormRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestFormService_SetRetention_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.MatchedBy(func(f *models.Form) bool {
+		return f.RetentionDays == 90 && f.RetentionAction == models.RetentionActionAnonymize && f.UpdatedBy == "user456"
+	})).Return(nil)
+
+	result, err := service.SetRetention(ctx, form.ID, "merchant123", 90, models.RetentionActionAnonymize, "user456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 90, result.RetentionDays)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormService_SetRetention_Invalid(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+
+	_, err := service.SetRetention(ctx, primitive.NewObjectID(), "merchant123", -1, "", "user456")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, err = service.SetRetention(ctx, primitive.NewObjectID(), "merchant123", 30, "archive", "user456")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockFormRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestFormService_SetSubmissionMode_Success(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.MatchedBy(func(f *models.Form) bool {
+		return f.SubmissionMode == models.SubmissionModeInviteOnly
+	})).Return(nil)
+
+	result, err := service.SetSubmissionMode(ctx, form.ID, "merchant123", models.SubmissionModeInviteOnly, "user456")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.SubmissionModeInviteOnly, result.CurrentSubmissionMode())
+}
+
+func TestFormService_SetSubmissionMode_Invalid(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	ctx := context.Background()
+
+	result, err := service.SetSubmissionMode(ctx, primitive.NewObjectID(), "merchant123", models.SubmissionMode("public"), "user456")
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockFormRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
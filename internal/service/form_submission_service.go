@@ -0,0 +1,605 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/bus"
+	"github.com/arwoosa/form/internal/dao/repository"
+	apperrors "github.com/arwoosa/form/internal/errors"
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// FormSubmissionService handles form submission business logic
+type FormSubmissionService struct {
+	submissionRepo repository.FormSubmissionRepository
+	formRepo       repository.FormRepository
+	usageRepo      repository.FilterUsageRepository
+	invitations    *FormInvitationService
+	files          *FormFileService
+	events         *EventOutbox
+	encryption     *AnswerEncryption
+	spam           *SpamGuard
+	config         *conf.AppConfig
+}
+
+// NewFormSubmissionService creates a new form submission service
+func NewFormSubmissionService(submissionRepo repository.FormSubmissionRepository, formRepo repository.FormRepository, usageRepo repository.FilterUsageRepository, invitations *FormInvitationService, files *FormFileService, events *EventOutbox, encryption *AnswerEncryption, spam *SpamGuard, config *conf.AppConfig) *FormSubmissionService {
+	return &FormSubmissionService{
+		submissionRepo: submissionRepo,
+		formRepo:       formRepo,
+		usageRepo:      usageRepo,
+		invitations:    invitations,
+		files:          files,
+		events:         events,
+		encryption:     encryption,
+		spam:           spam,
+		config:         config,
+	}
+}
+
+// SubmitResponse records a response to a published form within its access window.
+// Its computed fields are evaluated, then the answers are validated against the form's current
+// schema and the conditions of its UI Schema. Authenticated forms require a signed-in user,
+// invite-only forms a valid invitation token, and anonymous forms drop the submitter's
+// identity. Responses rejected by the form's spam protection are not recorded.
+func (s *FormSubmissionService) SubmitResponse(ctx context.Context, input *models.SubmitFormResponseInput) (*models.FormSubmission, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("SubmitResponse validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	form, err := s.formRepo.FindByID(ctx, input.FormID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", input.FormID.Hex()))
+		return nil, ErrFormNotFound
+	}
+
+	now := time.Now()
+	if !form.AcceptsSubmissionsAt(now) {
+		return nil, ErrFormNotAccepting
+	}
+
+	submittedBy := input.SubmittedBy
+	switch form.CurrentSubmissionMode() {
+	case models.SubmissionModeAnonymous:
+		submittedBy = ""
+	case models.SubmissionModeAuthenticated:
+		if submittedBy == "" {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	if err := s.spam.check(ctx, form, input); err != nil {
+		return nil, err
+	}
+
+	// Computed fields are never taken from the respondent
+	if err := schema.ApplyComputed(form.Schema, input.Answers); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if validationErrs := schema.ValidateWithConditions(form.Schema, form.UISchema, input.Answers); len(validationErrs) > 0 {
+		messages := make([]string, len(validationErrs))
+		for i, validationErr := range validationErrs {
+			messages[i] = validationErr.Error()
+		}
+		return nil, fmt.Errorf("%w: %s", ErrInvalidInput, strings.Join(messages, "; "))
+	}
+
+	submission := &models.FormSubmission{
+		ID:            primitive.NewObjectID(),
+		FormID:        form.ID,
+		MerchantID:    form.MerchantID,
+		SchemaVersion: form.CurrentSchemaVersion(),
+		Answers:       input.Answers,
+		Source:        models.SubmissionSourceWeb,
+		SubmittedBy:   submittedBy,
+		CreatedBy:     submittedBy,
+	}
+	submission.SetSubmittedAt(now)
+
+	stored, err := s.encryption.seal(ctx, form.Schema, submission)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseQuotas, err := s.reserveQuotas(ctx, form, submittedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseInvitation := func() {}
+	if form.CurrentSubmissionMode() == models.SubmissionModeInviteOnly {
+		releaseInvitation, err = s.invitations.redeem(ctx, form, input.InvitationToken, submittedBy)
+		if err != nil {
+			releaseQuotas()
+			return nil, err
+		}
+	}
+
+	releaseFiles, err := s.files.attach(ctx, form, input.Answers, submission.ID)
+	if err != nil {
+		releaseInvitation()
+		releaseQuotas()
+		return nil, err
+	}
+
+	event := bus.ResponseSubmittedEvent{
+		SubmissionID:  submission.ID.Hex(),
+		FormID:        form.ID.Hex(),
+		SchemaVersion: submission.SchemaVersion,
+		SubmittedBy:   submission.SubmittedBy,
+		SubmittedAt:   submission.GetSubmittedAt(),
+	}
+	if form.EventID != nil {
+		event.EventID = form.EventID.Hex()
+	}
+	if err := s.events.store(ctx, func(ctx context.Context) error {
+		return s.submissionRepo.Create(ctx, stored)
+	}, bus.TypeResponseSubmitted, form.MerchantID, event); err != nil {
+		log.Error("Failed to create submission", log.Err(err), log.String("form_id", form.ID.Hex()))
+		releaseFiles()
+		releaseInvitation()
+		releaseQuotas()
+		return nil, ErrInternalError
+	}
+	submission.CreatedAt = stored.CreatedAt
+
+	metrics.FormSubmissions.WithLabelValues(form.MerchantID, models.SubmissionSourceWeb).Inc()
+
+	log.Info("Form response submitted",
+		log.String("form_id", form.ID.Hex()),
+		log.String("submission_id", submission.ID.Hex()))
+
+	return submission, nil
+}
+
+// reserveQuotas reserves a response slot against the form's per-user and total quotas.
+// The returned function gives the reserved slots back when the submission cannot be stored.
+func (s *FormSubmissionService) reserveQuotas(ctx context.Context, form *models.Form, userID string) (func(), error) {
+	var reserved []string
+	release := func() {
+		for _, counterUserID := range reserved {
+			if err := s.submissionRepo.ReleaseResponse(ctx, form.ID, counterUserID); err != nil {
+				log.Error("Failed to release response quota", log.Err(err), log.String("form_id", form.ID.Hex()))
+			}
+		}
+	}
+
+	reserve := func(counterUserID string, limit int, message string) error {
+		ok, err := s.submissionRepo.ReserveResponse(ctx, form.ID, counterUserID, limit)
+		if err != nil {
+			log.Error("Failed to reserve response quota", log.Err(err), log.String("form_id", form.ID.Hex()))
+			return ErrInternalError
+		}
+		if !ok {
+			return apperrors.NewBusinessError(apperrors.ErrorCodeQuotaExceeded, message, ErrFormQuotaExceeded)
+		}
+		reserved = append(reserved, counterUserID)
+		return nil
+	}
+
+	if form.MaxPerUser > 0 && userID != "" {
+		if err := reserve(userID, form.MaxPerUser, "maximum number of responses per user reached"); err != nil {
+			return nil, err
+		}
+	}
+	if form.MaxResponses > 0 {
+		if err := reserve("", form.MaxResponses, "maximum number of responses reached"); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// ImportSubmissions imports a batch of historical submissions into a form.
+// Each submission gets the computed fields of the selected schema version and is validated
+// against it; invalid submissions are reported back to the caller while the valid ones are
+// imported.
+func (s *FormSubmissionService) ImportSubmissions(ctx context.Context, input *models.ImportSubmissionsInput) (*models.ImportSubmissionsResult, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("ImportSubmissions validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	// Check batch size limit
+	if s.config != nil && s.config.BusinessRulesConfig != nil {
+		maxBatchSize := s.config.BusinessRulesConfig.MaxImportBatchSize
+		if maxBatchSize > 0 && len(input.Submissions) > maxBatchSize {
+			log.Warn("Import batch too large",
+				log.String("form_id", input.FormID.Hex()),
+				log.Int("batch_size", len(input.Submissions)),
+				log.Int("limit", maxBatchSize))
+			return nil, ErrImportBatchTooLarge
+		}
+	}
+
+	form, err := s.getMerchantForm(ctx, input.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	formSchema, version, err := s.resolveSchema(ctx, form, input.SchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ImportSubmissionsResult{
+		SchemaVersion: version,
+	}
+
+	now := time.Now()
+	submissions := make([]*models.FormSubmission, 0, len(input.Submissions))
+	for i, item := range input.Submissions {
+		if item.SubmittedAt.After(now) {
+			result.Rejected = append(result.Rejected, models.RejectedSubmission{
+				Index:  i,
+				Errors: []string{"submitted_at cannot be in the future"},
+			})
+			continue
+		}
+
+		if err := schema.ApplyComputed(formSchema, item.Answers); err != nil {
+			result.Rejected = append(result.Rejected, models.RejectedSubmission{
+				Index:  i,
+				Errors: []string{err.Error()},
+			})
+			continue
+		}
+		if validationErrs := schema.Validate(formSchema, item.Answers); len(validationErrs) > 0 {
+			messages := make([]string, len(validationErrs))
+			for j, validationErr := range validationErrs {
+				messages[j] = validationErr.Error()
+			}
+			result.Rejected = append(result.Rejected, models.RejectedSubmission{
+				Index:  i,
+				Errors: messages,
+			})
+			continue
+		}
+
+		submission := &models.FormSubmission{
+			ID:            primitive.NewObjectID(),
+			FormID:        form.ID,
+			MerchantID:    form.MerchantID,
+			SchemaVersion: version,
+			Answers:       item.Answers,
+			Source:        models.SubmissionSourceImport,
+			ExternalID:    item.ExternalID,
+			SubmittedBy:   item.SubmittedBy,
+			CreatedBy:     input.ImportedBy,
+		}
+		submission.SetSubmittedAt(item.SubmittedAt)
+		stored, err := s.encryption.seal(ctx, formSchema, submission)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, stored)
+	}
+
+	if len(submissions) > 0 {
+		if err := s.submissionRepo.CreateMany(ctx, submissions); err != nil {
+			log.Error("Failed to import submissions", log.Err(err), log.String("form_id", form.ID.Hex()))
+			return nil, ErrInternalError
+		}
+		// Imported responses count toward the quotas like submitted ones. They are stored by
+		// then, so a failure leaves the counters for the next seeding rather than the import.
+		if form.MaxResponses > 0 || form.MaxPerUser > 0 {
+			if err := s.submissionRepo.SeedResponseCounters(ctx, form.ID); err != nil {
+				log.Error("Failed to count imported responses toward quotas", log.Err(err), log.String("form_id", form.ID.Hex()))
+			}
+		}
+	}
+	result.ImportedCount = len(submissions)
+	metrics.FormSubmissions.WithLabelValues(form.MerchantID, models.SubmissionSourceImport).Add(float64(result.ImportedCount))
+
+	log.Info("Submissions imported successfully",
+		log.String("form_id", form.ID.Hex()),
+		log.Int("schema_version", version),
+		log.Int("imported", result.ImportedCount),
+		log.Int("rejected", len(result.Rejected)))
+
+	return result, nil
+}
+
+// ListSubmissions retrieves a form's submissions, optionally filtered by answer values.
+// Filters are limited to the fields of the form's current schema, and each use is recorded
+// so that frequently filtered fields can be recommended for indexing.
+func (s *FormSubmissionService) ListSubmissions(ctx context.Context, options *models.SubmissionQueryOptions) ([]*models.FormSubmission, int64, error) {
+	// Set default pagination if not provided
+	if options.Page <= 0 {
+		options.Page = 1
+	}
+	if options.PageSize <= 0 {
+		options.PageSize = s.config.PaginationConfig.DefaultPageSize
+	}
+	if options.PageSize > s.config.PaginationConfig.MaxPageSize {
+		options.PageSize = s.config.PaginationConfig.MaxPageSize
+	}
+
+	// Validate input
+	if err := validate.Struct(options); err != nil {
+		log.Error("ListSubmissions validation failed", log.Err(err))
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	form, err := s.getMerchantForm(ctx, options.FormID, options.MerchantID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filterFields, err := checkFilters(form, options.Filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	submissions, count, err := s.submissionRepo.Find(ctx, options)
+	if err != nil {
+		log.Error("Failed to list submissions", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, 0, ErrInternalError
+	}
+	for _, submission := range submissions {
+		if err := s.encryption.open(ctx, submission); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// Usage tracking is best effort and never fails the request
+	if len(filterFields) > 0 {
+		if err := s.usageRepo.Record(ctx, options.MerchantID, filterFields); err != nil {
+			log.Warn("Failed to record submission filter usage", log.Err(err), log.String("form_id", form.ID.Hex()))
+		}
+	}
+
+	return submissions, count, nil
+}
+
+// defaultStreamBatchSize is used when no stream batch size is configured
+const defaultStreamBatchSize = 500
+
+// StreamSubmissions sends every submission of a form matching the options to send, one at a time.
+// Submissions are read through a batched cursor, so exports are bounded neither by the maximum
+// page size nor by memory. Streaming stops at the first error returned by send, which is returned
+// as is.
+func (s *FormSubmissionService) StreamSubmissions(ctx context.Context, options *models.SubmissionStreamOptions, send func(*models.FormSubmission) error) error {
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultStreamBatchSize
+		if s.config != nil && s.config.PaginationConfig != nil && s.config.PaginationConfig.StreamBatchSize > 0 {
+			options.BatchSize = s.config.PaginationConfig.StreamBatchSize
+		}
+	}
+
+	// Validate input
+	if err := validate.Struct(options); err != nil {
+		log.Error("StreamSubmissions validation failed", log.Err(err))
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if options.From != nil && options.To != nil && !options.From.Before(*options.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+
+	form, err := s.getMerchantForm(ctx, options.FormID, options.MerchantID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := checkFilters(form, options.Filters); err != nil {
+		return err
+	}
+
+	var sent int64
+	var sendErr error
+	var openErr error
+	err = s.submissionRepo.Stream(ctx, options, func(submission *models.FormSubmission) error {
+		if openErr = s.encryption.open(ctx, submission); openErr != nil {
+			return openErr
+		}
+		if sendErr = send(submission); sendErr != nil {
+			return sendErr
+		}
+		sent++
+		return nil
+	})
+	if sendErr != nil {
+		// The caller knows whether send failed in the transport or before it, so it logs and maps the error
+		return sendErr
+	}
+	if openErr != nil {
+		return openErr
+	}
+	if err != nil {
+		log.Error("Failed to stream submissions", log.Err(err), log.String("form_id", form.ID.Hex()), log.Int64("sent", sent))
+		return ErrInternalError
+	}
+
+	log.Info("Submissions streamed",
+		log.String("form_id", form.ID.Hex()),
+		log.Int64("count", sent))
+
+	return nil
+}
+
+// checkFilters verifies that only fields of the form's current schema are filtered on, with
+// plain values so a filter cannot carry query operators, and returns the filtered fields in
+// sorted order
+func checkFilters(form *models.Form, filters map[string]interface{}) ([]string, error) {
+	_, _, filterableFields, _ := statsFields(form.Schema)
+	filterable := make(map[string]bool, len(filterableFields))
+	for _, field := range filterableFields {
+		filterable[field] = true
+	}
+	filterFields := make([]string, 0, len(filters))
+	for field, value := range filters {
+		if !filterable[field] {
+			return nil, fmt.Errorf("%w: field %q cannot be filtered on", ErrInvalidInput, field)
+		}
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return nil, fmt.Errorf("%w: filter of field %q must be a single value", ErrInvalidInput, field)
+		}
+		filterFields = append(filterFields, field)
+	}
+	sort.Strings(filterFields)
+	return filterFields, nil
+}
+
+// GetFormResponseStats aggregates statistics over a form's submissions: counts per choice for
+// enum and boolean fields, summaries for numeric fields, daily submission counts and completion rate
+func (s *FormSubmissionService) GetFormResponseStats(ctx context.Context, input *models.FormResponseStatsInput) (*models.FormResponseStats, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("GetFormResponseStats validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+
+	form, err := s.getMerchantForm(ctx, input.FormID, input.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &models.FormResponseStatsQuery{
+		FormID:     form.ID,
+		MerchantID: form.MerchantID,
+		From:       input.From,
+		To:         input.To,
+	}
+	if s.config != nil {
+		query.TimeZone = s.config.TimeZone
+	}
+	query.ChoiceFields, query.NumericFields, _, query.CompleteFields = statsFields(form.Schema)
+	query.CompleteEncryptedFields = requiredPIIFields(form.Schema)
+
+	stats, err := s.submissionRepo.AggregateStats(ctx, query)
+	if err != nil {
+		log.Error("Failed to aggregate form response stats", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil, ErrInternalError
+	}
+
+	return stats, nil
+}
+
+// statsFields classifies the top-level fields of a schema for response statistics; a response is
+// complete when it answers every required field. PII fields are left out, as their answers are
+// stored encrypted (see requiredPIIFields), and so are fields whose names cannot be used in a
+// document path, which are then never required for a response to be complete.
+func statsFields(formSchema interface{}) (choiceFields, numericFields, allFields, requiredFields []string) {
+	s, _ := schema.Normalize(formSchema).(map[string]interface{})
+	properties, _ := s["properties"].(map[string]interface{})
+	piiFields := schema.PIIFields(formSchema)
+
+	required := make(map[string]bool)
+	requiredList, _ := s["required"].([]interface{})
+	for _, name := range requiredList {
+		if name, ok := name.(string); ok {
+			required[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		// PII answers are stored encrypted, so they cannot be aggregated or filtered on
+		if !queryableField(name) || piiFields[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field, _ := properties[name].(map[string]interface{})
+		allFields = append(allFields, name)
+		if required[name] {
+			requiredFields = append(requiredFields, name)
+		}
+
+		fieldType, _ := field["type"].(string)
+		items, _ := field["items"].(map[string]interface{})
+		switch {
+		case field["enum"] != nil, fieldType == "boolean", fieldType == "array" && items["enum"] != nil:
+			choiceFields = append(choiceFields, name)
+		case fieldType == "number", fieldType == "integer":
+			numericFields = append(numericFields, name)
+		}
+	}
+
+	return choiceFields, numericFields, allFields, requiredFields
+}
+
+// requiredPIIFields returns the required PII fields of a schema in sorted order. Their answers
+// are stored encrypted, so a response answers one when it holds its encrypted answer.
+func requiredPIIFields(formSchema interface{}) []string {
+	s, _ := schema.Normalize(formSchema).(map[string]interface{})
+	piiFields := schema.PIIFields(formSchema)
+
+	var fields []string
+	requiredList, _ := s["required"].([]interface{})
+	for _, name := range requiredList {
+		if name, ok := name.(string); ok && piiFields[name] && queryableField(name) {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// queryableField reports whether a field name can be used in a document path; other fields are
+// left out of the aggregations
+func queryableField(name string) bool {
+	return name != "" && !strings.Contains(name, ".") && !strings.HasPrefix(name, "$")
+}
+
+// getMerchantForm loads a form and ensures it belongs to the given merchant
+func (s *FormSubmissionService) getMerchantForm(ctx context.Context, formID primitive.ObjectID, merchantID string) (*models.Form, error) {
+	form, err := s.formRepo.FindByID(ctx, formID)
+	if err != nil {
+		log.Error("Failed to get form", log.Err(err), log.String("form_id", formID.Hex()))
+		return nil, ErrFormNotFound
+	}
+
+	if form.MerchantID != merchantID {
+		log.Warn("Form does not belong to merchant",
+			log.String("form_id", formID.Hex()),
+			log.String("merchant_id", merchantID))
+		return nil, ErrFormNotFound
+	}
+
+	return form, nil
+}
+
+// resolveSchema returns the schema for the requested version, defaulting to the current one
+func (s *FormSubmissionService) resolveSchema(ctx context.Context, form *models.Form, version int) (interface{}, int, error) {
+	currentVersion := form.CurrentSchemaVersion()
+	if version == 0 || version == currentVersion {
+		return form.Schema, currentVersion, nil
+	}
+	if version > currentVersion {
+		return nil, 0, ErrSchemaVersionNotFound
+	}
+
+	schemaVersion, err := s.formRepo.FindSchemaVersion(ctx, form.ID, version)
+	if err != nil {
+		log.Error("Failed to get form schema version", log.Err(err),
+			log.String("form_id", form.ID.Hex()),
+			log.Int("version", version))
+		return nil, 0, ErrSchemaVersionNotFound
+	}
+
+	return schemaVersion.Schema, version, nil
+}
@@ -0,0 +1,884 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	apperrors "github.com/arwoosa/form/internal/errors"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock FormSubmissionRepository
+type MockFormSubmissionRepository struct {
+	mock.Mock
+}
+
+func (m *MockFormSubmissionRepository) Create(ctx context.Context, submission *models.FormSubmission) error {
+	args := m.Called(ctx, submission)
+	return args.Error(0)
+}
+
+func (m *MockFormSubmissionRepository) FindByID(ctx context.Context, submissionID primitive.ObjectID) (*models.FormSubmission, error) {
+	args := m.Called(ctx, submissionID)
+	submission, _ := args.Get(0).(*models.FormSubmission)
+	return submission, args.Error(1)
+}
+
+func (m *MockFormSubmissionRepository) ReserveResponse(ctx context.Context, formID primitive.ObjectID, userID string, limit int) (bool, error) {
+	args := m.Called(ctx, formID, userID, limit)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFormSubmissionRepository) ReleaseResponse(ctx context.Context, formID primitive.ObjectID, userID string) error {
+	args := m.Called(ctx, formID, userID)
+	return args.Error(0)
+}
+
+func (m *MockFormSubmissionRepository) SeedResponseCounters(ctx context.Context, formID primitive.ObjectID) error {
+	args := m.Called(ctx, formID)
+	return args.Error(0)
+}
+
+func (m *MockFormSubmissionRepository) CreateMany(ctx context.Context, submissions []*models.FormSubmission) error {
+	args := m.Called(ctx, submissions)
+	return args.Error(0)
+}
+
+func (m *MockFormSubmissionRepository) Stream(ctx context.Context, options *models.SubmissionStreamOptions, fn func(*models.FormSubmission) error) error {
+	args := m.Called(ctx, options, fn)
+	// Submissions to stream are passed as the second return value
+	if submissions, ok := args.Get(1).([]*models.FormSubmission); ok {
+		for _, submission := range submissions {
+			if err := fn(submission); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(0)
+}
+
+func (m *MockFormSubmissionRepository) AggregateStats(ctx context.Context, query *models.FormResponseStatsQuery) (*models.FormResponseStats, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(*models.FormResponseStats), args.Error(1)
+}
+
+func (m *MockFormSubmissionRepository) Find(ctx context.Context, options *models.SubmissionQueryOptions) ([]*models.FormSubmission, int64, error) {
+	args := m.Called(ctx, options)
+	return args.Get(0).([]*models.FormSubmission), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFormSubmissionRepository) ListAnswerIndexes(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockFormSubmissionRepository) CreateAnswerIndex(ctx context.Context, field string) error {
+	args := m.Called(ctx, field)
+	return args.Error(0)
+}
+
+func (m *MockFormSubmissionRepository) CountByMerchantID(ctx context.Context, merchantID string) (int64, error) {
+	args := m.Called(ctx, merchantID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFormSubmissionRepository) CountByFormID(ctx context.Context, formID primitive.ObjectID) (int64, error) {
+	args := m.Called(ctx, formID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Mock FilterUsageRepository
+type MockFilterUsageRepository struct {
+	mock.Mock
+}
+
+func (m *MockFilterUsageRepository) Record(ctx context.Context, merchantID string, fields []string) error {
+	args := m.Called(ctx, merchantID, fields)
+	return args.Error(0)
+}
+
+func (m *MockFilterUsageRepository) ListFrequentFields(ctx context.Context, minCount int64) ([]*models.FilterFieldUsage, error) {
+	args := m.Called(ctx, minCount)
+	return args.Get(0).([]*models.FilterFieldUsage), args.Error(1)
+}
+
+// Test setup helper for FormSubmissionService
+func setupFormSubmissionService() (*FormSubmissionService, *MockFormSubmissionRepository, *MockFormRepository, *MockFilterUsageRepository) {
+	mockSubmissionRepo := &MockFormSubmissionRepository{}
+	mockFormRepo := &MockFormRepository{}
+	mockUsageRepo := &MockFilterUsageRepository{}
+	config := &conf.AppConfig{
+		TimeZone: "Asia/Taipei",
+		PaginationConfig: &conf.PaginationConfig{
+			DefaultPageSize: 20,
+			MaxPageSize:     100,
+		},
+		BusinessRulesConfig: &conf.BusinessRulesConfig{
+			MaxImportBatchSize: 2,
+		},
+		InvitationConfig: &conf.InvitationConfig{
+			Secret: "test-secret",
+		},
+	}
+	invitationService := NewFormInvitationService(&MockFormInvitationRepository{}, mockFormRepo, config)
+	fileService := NewFormFileService(&MockFormFileRepository{}, mockFormRepo, &fakeStorage{}, config)
+	service := NewFormSubmissionService(mockSubmissionRepo, mockFormRepo, mockUsageRepo, invitationService, fileService, nil, nil, nil, config)
+	return service, mockSubmissionRepo, mockFormRepo, mockUsageRepo
+}
+
+// Test data helpers for submissions
+func createTestSubmissionForm() *models.Form {
+	return &models.Form{
+		ID:         primitive.NewObjectID(),
+		MerchantID: "merchant123",
+		Schema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"email": map[string]interface{}{"type": "string", "format": "email"},
+			},
+			"required": []interface{}{"email"},
+		},
+		SchemaVersion: 2,
+		CreatedBy:     "user123",
+	}
+}
+
+func createTestImportSubmissionsInput(formID primitive.ObjectID) *models.ImportSubmissionsInput {
+	return &models.ImportSubmissionsInput{
+		FormID:     formID,
+		MerchantID: "merchant123",
+		Submissions: []models.ImportSubmissionInput{
+			{
+				Answers:     map[string]interface{}{"email": "alice@example.com"},
+				SubmittedAt: time.Now().Add(-48 * time.Hour),
+				SubmittedBy: "legacy-user-1",
+				ExternalID:  "ext-1",
+			},
+		},
+		ImportedBy: "user456",
+	}
+}
+
+func TestFormSubmissionService_ImportSubmissions_Success(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	input := createTestImportSubmissionsInput(form.ID)
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("CreateMany", ctx, mock.MatchedBy(func(submissions []*models.FormSubmission) bool {
+		return len(submissions) == 1 &&
+			submissions[0].FormID == form.ID &&
+			submissions[0].SchemaVersion == 2 &&
+			submissions[0].Source == models.SubmissionSourceImport &&
+			submissions[0].SubmittedBy == "legacy-user-1" &&
+			submissions[0].ExternalID == "ext-1" &&
+			submissions[0].CreatedBy == "user456"
+	})).Return(nil)
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.SchemaVersion)
+	assert.Equal(t, 1, result.ImportedCount)
+	assert.Empty(t, result.Rejected)
+
+	mockFormRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_ImportSubmissions_CountsTowardQuotas(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.MaxResponses = 100
+	input := createTestImportSubmissionsInput(form.ID)
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("CreateMany", ctx, mock.Anything).Return(nil)
+	mockSubmissionRepo.On("SeedResponseCounters", ctx, form.ID).Return(nil).Once()
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.ImportedCount)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_ImportSubmissions_HistoricalVersion(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	input := createTestImportSubmissionsInput(form.ID)
+	input.SchemaVersion = 1
+	input.Submissions[0].Answers = map[string]interface{}{"phone": "12345"}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("FindSchemaVersion", ctx, form.ID, 1).Return(&models.FormSchemaVersion{
+		FormID:  form.ID,
+		Version: 1,
+		Schema: map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"phone"},
+		},
+	}, nil)
+	mockSubmissionRepo.On("CreateMany", ctx, mock.MatchedBy(func(submissions []*models.FormSubmission) bool {
+		return len(submissions) == 1 && submissions[0].SchemaVersion == 1
+	})).Return(nil)
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.SchemaVersion)
+	assert.Equal(t, 1, result.ImportedCount)
+
+	mockFormRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_ImportSubmissions_PartialRejection(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	input := createTestImportSubmissionsInput(form.ID)
+	input.Submissions = append(input.Submissions, models.ImportSubmissionInput{
+		Answers:     map[string]interface{}{"email": "not-an-email"},
+		SubmittedAt: time.Now().Add(-time.Hour),
+	})
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("CreateMany", ctx, mock.MatchedBy(func(submissions []*models.FormSubmission) bool {
+		return len(submissions) == 1
+	})).Return(nil)
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.ImportedCount)
+	assert.Len(t, result.Rejected, 1)
+	assert.Equal(t, 1, result.Rejected[0].Index)
+	assert.Contains(t, result.Rejected[0].Errors[0], "email")
+
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_ImportSubmissions_FutureTimestamp(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	input := createTestImportSubmissionsInput(form.ID)
+	input.Submissions[0].SubmittedAt = time.Now().Add(time.Hour)
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.ImportedCount)
+	assert.Len(t, result.Rejected, 1)
+
+	mockSubmissionRepo.AssertNotCalled(t, "CreateMany", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_ImportSubmissions_BatchTooLarge(t *testing.T) {
+	service, _, _, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	input := createTestImportSubmissionsInput(primitive.NewObjectID())
+	input.Submissions = append(input.Submissions, input.Submissions[0], input.Submissions[0])
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrImportBatchTooLarge, err)
+}
+
+func TestFormSubmissionService_ImportSubmissions_ValidationError(t *testing.T) {
+	service, _, _, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	input := createTestImportSubmissionsInput(primitive.NewObjectID())
+	input.Submissions = nil
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestFormSubmissionService_ImportSubmissions_OtherMerchant(t *testing.T) {
+	service, _, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.MerchantID = "other-merchant"
+	input := createTestImportSubmissionsInput(form.ID)
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrFormNotFound, err)
+}
+
+func TestFormSubmissionService_ImportSubmissions_UnknownVersion(t *testing.T) {
+	service, _, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	input := createTestImportSubmissionsInput(form.ID)
+	input.SchemaVersion = 5
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrSchemaVersionNotFound, err)
+}
+
+func TestFormSubmissionService_ImportSubmissions_RepositoryError(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	input := createTestImportSubmissionsInput(form.ID)
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("CreateMany", ctx, mock.Anything).Return(errors.New("database error"))
+
+	result, err := service.ImportSubmissions(ctx, input)
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestFormSubmissionService_GetFormResponseStats_Success(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Schema = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"size":     map[string]interface{}{"type": "string", "enum": []interface{}{"S", "M", "L"}},
+			"toppings": map[string]interface{}{"type": "array", "items": map[string]interface{}{"enum": []interface{}{"cheese", "ham"}}},
+			"vegan":    map[string]interface{}{"type": "boolean"},
+			"age":      map[string]interface{}{"type": "integer"},
+			"comment":  map[string]interface{}{"type": "string"},
+			"a.b":      map[string]interface{}{"type": "number"},
+		},
+		"required": []interface{}{"size", "age", "a.b"},
+	}
+	from := time.Now().Add(-7 * 24 * time.Hour)
+	expected := &models.FormResponseStats{FormID: form.ID, TotalResponses: 4, CompleteResponses: 3, CompletionRate: 0.75}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("AggregateStats", ctx, &models.FormResponseStatsQuery{
+		FormID:         form.ID,
+		MerchantID:     "merchant123",
+		From:           &from,
+		TimeZone:       "Asia/Taipei",
+		ChoiceFields:   []string{"size", "toppings", "vegan"},
+		NumericFields:  []string{"age"},
+		CompleteFields: []string{"age", "size"},
+	}).Return(expected, nil)
+
+	stats, err := service.GetFormResponseStats(ctx, &models.FormResponseStatsInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		From:       &from,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, stats)
+
+	mockFormRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_GetFormResponseStats_InvalidRange(t *testing.T) {
+	service, _, _, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	stats, err := service.GetFormResponseStats(ctx, &models.FormResponseStatsInput{
+		FormID:     primitive.NewObjectID(),
+		MerchantID: "merchant123",
+		From:       &from,
+		To:         &to,
+	})
+
+	assert.Nil(t, stats)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestFormSubmissionService_GetFormResponseStats_OtherMerchant(t *testing.T) {
+	service, _, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	stats, err := service.GetFormResponseStats(ctx, &models.FormResponseStatsInput{
+		FormID:     form.ID,
+		MerchantID: "other-merchant",
+	})
+
+	assert.Nil(t, stats)
+	assert.Equal(t, ErrFormNotFound, err)
+}
+
+func TestFormSubmissionService_GetFormResponseStats_RepositoryError(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("AggregateStats", ctx, mock.Anything).Return((*models.FormResponseStats)(nil), errors.New("database error"))
+
+	stats, err := service.GetFormResponseStats(ctx, &models.FormResponseStatsInput{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+	})
+
+	assert.Nil(t, stats)
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestFormSubmissionService_ListSubmissions_Success(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, mockUsageRepo := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	options := &models.SubmissionQueryOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Filters:    map[string]interface{}{"email": "alice@example.com"},
+	}
+	expected := []*models.FormSubmission{{ID: primitive.NewObjectID(), FormID: form.ID}}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Find", ctx, mock.MatchedBy(func(opts *models.SubmissionQueryOptions) bool {
+		return opts.Page == 1 && opts.PageSize == 20
+	})).Return(expected, int64(1), nil)
+	mockUsageRepo.On("Record", ctx, "merchant123", []string{"email"}).Return(nil)
+
+	submissions, count, err := service.ListSubmissions(ctx, options)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, submissions)
+	assert.Equal(t, int64(1), count)
+
+	mockFormRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+	mockUsageRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_ListSubmissions_WithoutFilters(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, mockUsageRepo := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Find", ctx, mock.Anything).Return([]*models.FormSubmission{}, int64(0), nil)
+
+	_, _, err := service.ListSubmissions(ctx, &models.SubmissionQueryOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		PageSize:   500,
+	})
+
+	assert.NoError(t, err)
+	mockUsageRepo.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything)
+	mockSubmissionRepo.AssertCalled(t, "Find", ctx, mock.MatchedBy(func(opts *models.SubmissionQueryOptions) bool {
+		return opts.PageSize == 100
+	}))
+}
+
+func TestFormSubmissionService_ListSubmissions_UnknownFilterField(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	submissions, _, err := service.ListSubmissions(ctx, &models.SubmissionQueryOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Filters:    map[string]interface{}{"$where": "1"},
+	})
+
+	assert.Nil(t, submissions)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockSubmissionRepo.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_ListSubmissions_OperatorFilter(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	for _, value := range []interface{}{
+		map[string]interface{}{"$ne": nil},
+		map[string]interface{}{"$regex": ".*"},
+		[]interface{}{"alice@example.com"},
+	} {
+		submissions, _, err := service.ListSubmissions(ctx, &models.SubmissionQueryOptions{
+			FormID:     form.ID,
+			MerchantID: "merchant123",
+			Filters:    map[string]interface{}{"email": value},
+		})
+
+		assert.Nil(t, submissions)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	}
+	mockSubmissionRepo.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_ListSubmissions_UsageRecordError(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, mockUsageRepo := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Find", ctx, mock.Anything).Return([]*models.FormSubmission{}, int64(0), nil)
+	mockUsageRepo.On("Record", ctx, "merchant123", []string{"email"}).Return(errors.New("database error"))
+
+	_, _, err := service.ListSubmissions(ctx, &models.SubmissionQueryOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Filters:    map[string]interface{}{"email": "alice@example.com"},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestFormSubmissionService_SubmitResponse_Success(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.MatchedBy(func(submission *models.FormSubmission) bool {
+		return submission.FormID == form.ID &&
+			submission.MerchantID == "merchant123" &&
+			submission.SchemaVersion == 2 &&
+			submission.Source == models.SubmissionSourceWeb &&
+			submission.SubmittedBy == "user456"
+	})).Return(nil)
+
+	result, err := service.SubmitResponse(ctx, &models.SubmitFormResponseInput{
+		FormID:      form.ID,
+		Answers:     map[string]interface{}{"email": "alice@example.com"},
+		SubmittedBy: "user456",
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, result.SubmittedAt.Time().IsZero())
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_SubmitResponse_OutsideWindow(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	openAt := time.Now().Add(time.Hour)
+	form.OpenAt = &openAt
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.SubmitResponse(ctx, &models.SubmitFormResponseInput{
+		FormID:      form.ID,
+		Answers:     map[string]interface{}{"email": "alice@example.com"},
+		SubmittedBy: "user456",
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrFormNotAccepting, err)
+	mockSubmissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_SubmitResponse_DraftForm(t *testing.T) {
+	service, _, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.SubmitResponse(ctx, &models.SubmitFormResponseInput{
+		FormID:      form.ID,
+		Answers:     map[string]interface{}{"email": "alice@example.com"},
+		SubmittedBy: "user456",
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrFormNotAccepting, err)
+}
+
+func TestFormSubmissionService_SubmitResponse_InvalidAnswers(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.SubmitResponse(ctx, &models.SubmitFormResponseInput{
+		FormID:      form.ID,
+		Answers:     map[string]interface{}{"email": 42},
+		SubmittedBy: "user456",
+	})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockSubmissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_SubmitResponse_ComputedFields(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.Schema = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"quantity": map[string]interface{}{"type": "integer"},
+			"price":    map[string]interface{}{"type": "number"},
+			"total":    map[string]interface{}{"type": "number", "computed": "quantity * price"},
+		},
+	}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.MatchedBy(func(submission *models.FormSubmission) bool {
+		return submission.Answers.(map[string]interface{})["total"] == 37.5
+	})).Return(nil)
+
+	_, err := service.SubmitResponse(ctx, &models.SubmitFormResponseInput{
+		FormID:      form.ID,
+		Answers:     map[string]interface{}{"quantity": 3.0, "price": 12.5, "total": 1.0},
+		SubmittedBy: "user456",
+	})
+
+	assert.NoError(t, err)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func createTestSubmitFormResponseInput(formID primitive.ObjectID) *models.SubmitFormResponseInput {
+	return &models.SubmitFormResponseInput{
+		FormID:      formID,
+		Answers:     map[string]interface{}{"email": "alice@example.com"},
+		SubmittedBy: "user456",
+	}
+}
+
+func TestFormSubmissionService_SubmitResponse_WithinQuotas(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.MaxResponses = 100
+	form.MaxPerUser = 1
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("ReserveResponse", ctx, form.ID, "user456", 1).Return(true, nil)
+	mockSubmissionRepo.On("ReserveResponse", ctx, form.ID, "", 100).Return(true, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.AnythingOfType("*models.FormSubmission")).Return(nil)
+
+	result, err := service.SubmitResponse(ctx, createTestSubmitFormResponseInput(form.ID))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	mockSubmissionRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertNotCalled(t, "ReleaseResponse", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_SubmitResponse_PerUserQuotaExceeded(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.MaxResponses = 100
+	form.MaxPerUser = 1
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("ReserveResponse", ctx, form.ID, "user456", 1).Return(false, nil)
+
+	result, err := service.SubmitResponse(ctx, createTestSubmitFormResponseInput(form.ID))
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrFormQuotaExceeded)
+	var businessErr *apperrors.BusinessError
+	assert.ErrorAs(t, err, &businessErr)
+	assert.Equal(t, apperrors.ErrorCodeQuotaExceeded, businessErr.Code)
+	mockSubmissionRepo.AssertNotCalled(t, "ReserveResponse", ctx, form.ID, "", 100)
+	mockSubmissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_SubmitResponse_TotalQuotaExceededReleasesUserSlot(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.MaxResponses = 100
+	form.MaxPerUser = 1
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("ReserveResponse", ctx, form.ID, "user456", 1).Return(true, nil)
+	mockSubmissionRepo.On("ReserveResponse", ctx, form.ID, "", 100).Return(false, nil)
+	mockSubmissionRepo.On("ReleaseResponse", ctx, form.ID, "user456").Return(nil)
+
+	result, err := service.SubmitResponse(ctx, createTestSubmitFormResponseInput(form.ID))
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrFormQuotaExceeded)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_SubmitResponse_CreateErrorReleasesQuota(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.MaxResponses = 100
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("ReserveResponse", ctx, form.ID, "", 100).Return(true, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.AnythingOfType("*models.FormSubmission")).Return(errors.New("database error"))
+	mockSubmissionRepo.On("ReleaseResponse", ctx, form.ID, "").Return(nil)
+
+	result, err := service.SubmitResponse(ctx, createTestSubmitFormResponseInput(form.ID))
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInternalError, err)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_SubmitResponse_AuthenticatedRequiresUser(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	input := createTestSubmitFormResponseInput(form.ID)
+	input.SubmittedBy = ""
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	result, err := service.SubmitResponse(ctx, input)
+
+	assert.Nil(t, result)
+	assert.Equal(t, ErrUnauthorized, err)
+	mockSubmissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormSubmissionService_SubmitResponse_AnonymousDropsIdentity(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	form.Status = models.FormStatusPublished
+	form.SubmissionMode = models.SubmissionModeAnonymous
+	form.MaxPerUser = 1
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Create", ctx, mock.MatchedBy(func(submission *models.FormSubmission) bool {
+		return submission.SubmittedBy == "" && submission.CreatedBy == ""
+	})).Return(nil)
+
+	result, err := service.SubmitResponse(ctx, createTestSubmitFormResponseInput(form.ID))
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	mockSubmissionRepo.AssertNotCalled(t, "ReserveResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestFormSubmissionService_StreamSubmissions_Success(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	service.config.PaginationConfig.StreamBatchSize = 250
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	submissions := []*models.FormSubmission{
+		{ID: primitive.NewObjectID(), FormID: form.ID},
+		{ID: primitive.NewObjectID(), FormID: form.ID},
+	}
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Stream", ctx, mock.MatchedBy(func(options *models.SubmissionStreamOptions) bool {
+		return options.BatchSize == 250 && options.Filters["email"] == "alice@example.com"
+	}), mock.Anything).Return(nil, submissions)
+
+	var received []*models.FormSubmission
+	err := service.StreamSubmissions(ctx, &models.SubmissionStreamOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Filters:    map[string]interface{}{"email": "alice@example.com"},
+	}, func(submission *models.FormSubmission) error {
+		received = append(received, submission)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, submissions, received)
+}
+
+func TestFormSubmissionService_StreamSubmissions_SendError(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+	sendErr := errors.New("stream closed")
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Stream", ctx, mock.Anything, mock.Anything).Return(nil, []*models.FormSubmission{{ID: primitive.NewObjectID()}})
+
+	err := service.StreamSubmissions(ctx, &models.SubmissionStreamOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+	}, func(*models.FormSubmission) error {
+		return sendErr
+	})
+
+	assert.Equal(t, sendErr, err)
+}
+
+func TestFormSubmissionService_StreamSubmissions_RepositoryError(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockSubmissionRepo.On("Stream", ctx, mock.Anything, mock.Anything).Return(errors.New("cursor error"), nil)
+
+	err := service.StreamSubmissions(ctx, &models.SubmissionStreamOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+	}, func(*models.FormSubmission) error {
+		return nil
+	})
+
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestFormSubmissionService_StreamSubmissions_UnknownFilterField(t *testing.T) {
+	service, mockSubmissionRepo, mockFormRepo, _ := setupFormSubmissionService()
+	ctx := context.Background()
+	form := createTestSubmissionForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+
+	err := service.StreamSubmissions(ctx, &models.SubmissionStreamOptions{
+		FormID:     form.ID,
+		MerchantID: "merchant123",
+		Filters:    map[string]interface{}{"password": "secret"},
+	}, func(*models.FormSubmission) error {
+		return nil
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	mockSubmissionRepo.AssertNotCalled(t, "Stream", mock.Anything, mock.Anything, mock.Anything)
+}
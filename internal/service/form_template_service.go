@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/arwoosa/vulpes/log"
 	"github.com/arwoosa/vulpes/relation"
@@ -10,20 +11,35 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/bundle"
 	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/importer"
+	"github.com/arwoosa/form/internal/metrics"
 	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
 )
 
 // FormTemplateService handles form template business logic
 type FormTemplateService struct {
 	templateRepo repository.FormTemplateRepository
+	formRepo     repository.FormRepository
+	limits       *LimitsService
+	blocks       *FieldBlockService
+	content      *ContentRulesService
+	importers    *importer.Registry
 	config       *conf.AppConfig
 }
 
-// NewFormTemplateService creates a new form template service
-func NewFormTemplateService(templateRepo repository.FormTemplateRepository, config *conf.AppConfig) *FormTemplateService {
+// NewFormTemplateService creates a new form template service. content checks the labels of saved
+// schemas, and importers converts the forms exported from other form builders.
+func NewFormTemplateService(templateRepo repository.FormTemplateRepository, formRepo repository.FormRepository, limits *LimitsService, blocks *FieldBlockService, content *ContentRulesService, importers *importer.Registry, config *conf.AppConfig) *FormTemplateService {
 	return &FormTemplateService{
 		templateRepo: templateRepo,
+		formRepo:     formRepo,
+		limits:       limits,
+		blocks:       blocks,
+		content:      content,
+		importers:    importers,
 		config:       config,
 	}
 }
@@ -35,6 +51,17 @@ func (s *FormTemplateService) CreateTemplate(ctx context.Context, input *models.
 		log.Error("CreateTemplate validation failed", log.Err(err))
 		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
 	}
+	expanded, err := s.blocks.ExpandSchema(ctx, input.MerchantID, input.Schema)
+	if err != nil {
+		return nil, err
+	}
+	input.Schema, err = s.content.ApplyToSchema(ctx, input.MerchantID, expanded)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchemaExtensions(input.Schema, input.UISchema); err != nil {
+		return nil, err
+	}
 
 	// Check template limit for merchant
 	if err := s.checkTemplateLimit(ctx, input.MerchantID); err != nil {
@@ -47,7 +74,8 @@ func (s *FormTemplateService) CreateTemplate(ctx context.Context, input *models.
 		Name:       input.Name,
 		MerchantID: input.MerchantID,
 		Schema:     input.Schema,
-		UISchema:   input.UISchema,
+		UISchema:   defaultUISchema(s.config, input.Schema, input.UISchema),
+		Version:    1,
 		CreatedBy:  input.CreatedBy,
 		UpdatedBy:  input.CreatedBy,
 	}
@@ -61,6 +89,7 @@ func (s *FormTemplateService) CreateTemplate(ctx context.Context, input *models.
 	// Add Keto relation tuple for template owner
 	if err := relation.AddUserResourceRole(ctx, input.CreatedBy, "FormTemplate", template.ID.Hex(), relation.RoleOwner); err != nil {
 		log.Error("Failed to create Keto relation tuple for template", log.Err(err))
+		metrics.KetoWriteFailures.WithLabelValues("FormTemplate", "add_owner").Inc()
 		// Rollback: delete the created template since Keto operation failed
 		if deleteErr := s.templateRepo.Delete(ctx, template.ID); deleteErr != nil {
 			log.Error("Failed to rollback template creation", log.Err(deleteErr))
@@ -76,6 +105,139 @@ func (s *FormTemplateService) CreateTemplate(ctx context.Context, input *models.
 	return template, nil
 }
 
+// ImportForm converts a form exported from another form builder and creates a template from it,
+// named after the form unless input names it. Questions without an equivalent are left out and
+// reported. A dry run only converts the form.
+func (s *FormTemplateService) ImportForm(ctx context.Context, input *models.ImportFormInput) (*models.FormImport, error) {
+	if err := validate.Struct(input); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	converted, err := s.importers.Convert(input.Format, input.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if properties, _ := converted.Schema["properties"].(map[string]interface{}); len(properties) == 0 {
+		return nil, fmt.Errorf("%w: the form has no supported questions", ErrInvalidInput)
+	}
+
+	result := &models.FormImport{
+		Schema:      converted.Schema,
+		UISchema:    converted.UISchema,
+		Unsupported: converted.Unsupported,
+	}
+	if input.DryRun {
+		return result, nil
+	}
+
+	name := input.Name
+	if name == "" {
+		name = converted.Title
+	}
+	if name == "" {
+		name = "Imported form"
+	}
+	if runes := []rune(name); len(runes) > 100 {
+		name = string(runes[:100])
+	}
+
+	result.Template, err = s.CreateTemplate(ctx, &models.CreateFormTemplateInput{
+		Name:       name,
+		Schema:     converted.Schema,
+		UISchema:   converted.UISchema,
+		CreatedBy:  input.CreatedBy,
+		MerchantID: input.MerchantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Form imported",
+		log.String("template_id", result.Template.ID.Hex()),
+		log.String("format", input.Format),
+		log.Int("unsupported", len(result.Unsupported)))
+	return result, nil
+}
+
+// ExportTemplate encodes a template of the merchant as a package signed with
+// template_package.signing_secret, to be imported in another environment or by another merchant.
+// Field blocks are expanded when templates are saved, so the package holds every question.
+func (s *FormTemplateService) ExportTemplate(ctx context.Context, templateID primitive.ObjectID, merchantID string) ([]byte, error) {
+	secret, err := s.packageSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if template.MerchantID != merchantID {
+		return nil, ErrTemplateNotFound
+	}
+
+	data, err := bundle.Seal(secret, &bundle.Template{
+		Name:             template.Name,
+		SourceTemplateID: template.ID.Hex(),
+		TemplateVersion:  template.CurrentVersion(),
+		Schema:           schema.Normalize(template.Schema),
+		UISchema:         schema.Normalize(template.UISchema),
+		ExportedAt:       time.Now().UTC(),
+	})
+	if err != nil {
+		log.Error("Failed to seal template package", log.Err(err), log.String("template_id", templateID.Hex()))
+		return nil, ErrInternalError
+	}
+	return data, nil
+}
+
+// ImportTemplate creates a template of the merchant from a package made by ExportTemplate.
+// Packages that were changed or signed with another secret are rejected.
+func (s *FormTemplateService) ImportTemplate(ctx context.Context, input *models.ImportTemplateInput) (*models.FormTemplate, error) {
+	if err := validate.Struct(input); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	secret, err := s.packageSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	packaged, err := bundle.Open(secret, input.Package)
+	if err != nil {
+		log.Warn("Rejected template package", log.Err(err), log.String("merchant_id", input.MerchantID))
+		return nil, ErrInvalidTemplatePackage
+	}
+
+	name := input.Name
+	if name == "" {
+		name = packaged.Name
+	}
+	template, err := s.CreateTemplate(ctx, &models.CreateFormTemplateInput{
+		Name:       name,
+		Schema:     packaged.Schema,
+		UISchema:   packaged.UISchema,
+		CreatedBy:  input.CreatedBy,
+		MerchantID: input.MerchantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Template imported",
+		log.String("template_id", template.ID.Hex()),
+		log.String("source_template_id", packaged.SourceTemplateID),
+		log.String("merchant_id", template.MerchantID))
+	return template, nil
+}
+
+// packageSecret returns the key signing template packages
+func (s *FormTemplateService) packageSecret() ([]byte, error) {
+	if s.config == nil || s.config.TemplatePackageConfig == nil || s.config.TemplatePackageConfig.SigningSecret == "" {
+		return nil, ErrTemplatePackagesNotConfigured
+	}
+	return []byte(s.config.TemplatePackageConfig.SigningSecret), nil
+}
+
 // GetTemplate retrieves a form template by ID
 func (s *FormTemplateService) GetTemplate(ctx context.Context, templateID primitive.ObjectID) (*models.FormTemplate, error) {
 	template, err := s.templateRepo.FindByID(ctx, templateID)
@@ -99,6 +261,9 @@ func (s *FormTemplateService) ListTemplates(ctx context.Context, options *models
 	if options.PageSize > s.config.PaginationConfig.MaxPageSize {
 		options.PageSize = s.config.PaginationConfig.MaxPageSize
 	}
+	if options.Count == "" {
+		options.Count = configuredCountMode(s.config)
+	}
 
 	templates, count, err := s.templateRepo.FindByMerchantID(ctx, options)
 	if err != nil {
@@ -124,10 +289,37 @@ func (s *FormTemplateService) UpdateTemplate(ctx context.Context, input *models.
 		return nil, ErrTemplateNotFound
 	}
 
+	input.Schema, err = s.blocks.ExpandSchema(ctx, existing.MerchantID, input.Schema)
+	if err != nil {
+		return nil, err
+	}
+	input.Schema, err = s.content.ApplyToSchema(ctx, existing.MerchantID, input.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchemaExtensions(input.Schema, input.UISchema); err != nil {
+		return nil, err
+	}
+
+	// Keep the version being replaced so it can still be compared with later versions
+	if err := s.templateRepo.SaveVersion(ctx, &models.FormTemplateVersion{
+		TemplateID: existing.ID,
+		MerchantID: existing.MerchantID,
+		Version:    existing.CurrentVersion(),
+		Schema:     existing.Schema,
+		UISchema:   existing.UISchema,
+		CreatedAt:  existing.UpdatedAt,
+		CreatedBy:  existing.UpdatedBy,
+	}); err != nil {
+		log.Error("Failed to archive template version", log.Err(err), log.String("template_id", existing.ID.Hex()))
+		return nil, ErrInternalError
+	}
+
 	// Update template fields
 	existing.Name = input.Name
 	existing.Schema = input.Schema
 	existing.UISchema = input.UISchema
+	existing.Version = existing.CurrentVersion() + 1
 	existing.UpdatedBy = input.UpdatedBy
 
 	// Save updates
@@ -144,20 +336,48 @@ func (s *FormTemplateService) UpdateTemplate(ctx context.Context, input *models.
 }
 
 // DeleteTemplate deletes a form template
-func (s *FormTemplateService) DeleteTemplate(ctx context.Context, templateID primitive.ObjectID) error {
-	// Check if template exists
-	exists, err := s.templateRepo.Exists(ctx, templateID)
+func (s *FormTemplateService) DeleteTemplate(ctx context.Context, templateID primitive.ObjectID, deletedBy string) error {
+	template, err := s.templateRepo.FindByID(ctx, templateID)
 	if err != nil {
-		log.Error("Failed to check template existence", log.Err(err))
+		log.Error("Template not found for deletion", log.Err(err), log.String("template_id", templateID.Hex()))
+		return ErrTemplateNotFound
+	}
+
+	// Forms created from the template are handled according to business_rules.template_delete_policy
+	inUse, err := s.formRepo.CountByTemplateID(ctx, templateID, template.MerchantID)
+	if err != nil {
+		log.Error("Failed to count forms using template", log.Err(err), log.String("template_id", templateID.Hex()))
 		return ErrInternalError
 	}
-	if !exists {
-		return ErrTemplateNotFound
+	if inUse > 0 {
+		switch s.deletePolicy() {
+		case models.TemplateDeleteArchive:
+			if err := s.templateRepo.Archive(ctx, templateID, deletedBy); err != nil {
+				log.Error("Failed to archive template", log.Err(err), log.String("template_id", templateID.Hex()))
+				return ErrInternalError
+			}
+			log.Info("Template in use archived instead of deleted",
+				log.String("template_id", templateID.Hex()),
+				log.Int64("forms", inUse))
+			return nil
+		case models.TemplateDeleteDetach:
+			detached, err := s.formRepo.DetachTemplate(ctx, templateID, template.MerchantID)
+			if err != nil {
+				log.Error("Failed to detach forms from template", log.Err(err), log.String("template_id", templateID.Hex()))
+				return ErrInternalError
+			}
+			log.Info("Forms detached from deleted template",
+				log.String("template_id", templateID.Hex()),
+				log.Int64("forms", detached))
+		default:
+			return ErrTemplateInUse
+		}
 	}
 
 	// Delete Keto relation tuples first (best effort)
 	if err := relation.DeleteObjectId(ctx, "FormTemplate", templateID.Hex()); err != nil {
 		log.Error("Failed to delete Keto relation tuples for template - continuing with deletion", log.Err(err))
+		metrics.KetoWriteFailures.WithLabelValues("FormTemplate", "delete_object").Inc()
 		// Don't return here - continue with database cleanup to avoid data inconsistency
 	}
 
@@ -196,6 +416,7 @@ func (s *FormTemplateService) DuplicateTemplate(ctx context.Context, input *mode
 	// Add Keto relation tuple for duplicated template owner
 	if err := relation.AddUserResourceRole(ctx, input.CreatedBy, "FormTemplate", duplicate.ID.Hex(), relation.RoleOwner); err != nil {
 		log.Error("Failed to create Keto relation tuple for duplicated template", log.Err(err))
+		metrics.KetoWriteFailures.WithLabelValues("FormTemplate", "add_owner").Inc()
 		// Rollback: delete the duplicated template since Keto operation failed
 		if deleteErr := s.templateRepo.Delete(ctx, duplicate.ID); deleteErr != nil {
 			log.Error("Failed to rollback template duplication", log.Err(deleteErr))
@@ -211,6 +432,41 @@ func (s *FormTemplateService) DuplicateTemplate(ctx context.Context, input *mode
 	return duplicate, nil
 }
 
+// GenerateUISchema derives a default UI Schema for the given JSON Schema
+func (s *FormTemplateService) GenerateUISchema(schema interface{}) (map[string]interface{}, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("%w: schema is required", ErrInvalidInput)
+	}
+
+	return generateUISchema(s.config, schema), nil
+}
+
+// ValidateSchema checks a JSON Schema and its UI Schema the way forms and templates are checked
+// when saved, and lints them for best practices so the form builder can guide merchants
+func (s *FormTemplateService) ValidateSchema(formSchema, uiSchema interface{}) (*models.SchemaValidation, error) {
+	if formSchema == nil {
+		return nil, fmt.Errorf("%w: schema is required", ErrInvalidInput)
+	}
+
+	result := &models.SchemaValidation{Errors: schema.CheckStructure(formSchema)}
+	if result.Valid() {
+		if _, err := schema.BlockRefs(formSchema); err != nil {
+			result.Errors = append(result.Errors, schema.Issue{Code: schema.IssueInvalidSchema, Message: err.Error()})
+		}
+		if err := checkSchemaExtensions(formSchema, uiSchema); err != nil {
+			result.Errors = append(result.Errors, schema.Issue{Code: schema.IssueInvalidSchema, Message: err.Error()})
+		}
+	}
+
+	var opts schema.LintOptions
+	if s.config != nil && s.config.SchemaLintConfig != nil {
+		opts.MaxNestingDepth = s.config.SchemaLintConfig.MaxNestingDepth
+		opts.MaxRequiredFields = s.config.SchemaLintConfig.MaxRequiredFields
+	}
+	result.Warnings = schema.Lint(formSchema, uiSchema, opts)
+	return result, nil
+}
+
 // checkTemplateLimit validates if merchant can create more templates
 func (s *FormTemplateService) checkTemplateLimit(ctx context.Context, merchantID string) error {
 	count, err := s.templateRepo.CountByMerchantID(ctx, merchantID)
@@ -219,13 +475,31 @@ func (s *FormTemplateService) checkTemplateLimit(ctx context.Context, merchantID
 		return ErrInternalError
 	}
 
-	if count >= int64(s.config.BusinessRulesConfig.MaxTemplatesPerMerchant) {
+	limits, err := s.limits.GetLimits(ctx, merchantID)
+	if err != nil {
+		return err
+	}
+
+	if count >= int64(limits.MaxTemplates) {
 		log.Warn("Template limit exceeded",
 			log.String("merchant_id", merchantID),
 			log.Int64("current_count", count),
-			log.Int("limit", s.config.BusinessRulesConfig.MaxTemplatesPerMerchant))
+			log.Int("limit", limits.MaxTemplates))
 		return ErrTemplateLimitExceeded
 	}
 
 	return nil
 }
+
+// deletePolicy returns the configured policy for deleting templates used by forms
+func (s *FormTemplateService) deletePolicy() models.TemplateDeletePolicy {
+	if s.config.BusinessRulesConfig == nil {
+		return models.TemplateDeleteBlock
+	}
+
+	policy := models.TemplateDeletePolicy(s.config.BusinessRulesConfig.TemplateDeletePolicy)
+	if !policy.IsValid() {
+		return models.TemplateDeleteBlock
+	}
+	return policy
+}
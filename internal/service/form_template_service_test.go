@@ -10,7 +10,9 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/importer"
 	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
 )
 
 // Test setup helper for FormTemplateService
@@ -25,7 +27,7 @@ func setupFormTemplateService() (*FormTemplateService, *MockFormTemplateReposito
 			MaxTemplatesPerMerchant: 10,
 		},
 	}
-	service := NewFormTemplateService(mockTemplateRepo, config)
+	service := NewFormTemplateService(mockTemplateRepo, &MockFormRepository{}, newDefaultLimitsService(config), NewFieldBlockService(&MockFieldBlockRepository{}, config), nil, importer.NewRegistry(), config)
 	return service, mockTemplateRepo, config
 }
 
@@ -222,6 +224,28 @@ func TestFormTemplateService_ListTemplates_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestFormTemplateService_ListTemplates_CountMode(t *testing.T) {
+	service, mockRepo, config := setupFormTemplateService()
+	ctx := context.Background()
+	config.PaginationConfig.CountMode = "facet"
+
+	mockRepo.On("FindByMerchantID", ctx, mock.MatchedBy(func(opts *models.FormTemplateQueryOptions) bool {
+		return opts.Count == models.CountFacet
+	})).Return([]*models.FormTemplate{}, int64(0), nil).Once()
+	mockRepo.On("FindByMerchantID", ctx, mock.MatchedBy(func(opts *models.FormTemplateQueryOptions) bool {
+		return opts.Count == models.CountNone
+	})).Return([]*models.FormTemplate{}, int64(0), nil).Once()
+
+	_, _, err := service.ListTemplates(ctx, &models.FormTemplateQueryOptions{MerchantID: "merchant123"})
+	assert.NoError(t, err)
+
+	// A count mode requested by the caller is kept
+	_, _, err = service.ListTemplates(ctx, &models.FormTemplateQueryOptions{MerchantID: "merchant123", Count: models.CountNone})
+	assert.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestFormTemplateService_ListTemplates_WithDefaults(t *testing.T) {
 	service, mockRepo, config := setupFormTemplateService()
 	ctx := context.Background()
@@ -235,7 +259,7 @@ func TestFormTemplateService_ListTemplates_WithDefaults(t *testing.T) {
 	expectedCount := int64(0)
 
 	mockRepo.On("FindByMerchantID", ctx, mock.MatchedBy(func(opts *models.FormTemplateQueryOptions) bool {
-		return opts.Page == 1 && opts.PageSize == config.PaginationConfig.DefaultPageSize
+		return opts.Page == 1 && opts.PageSize == config.PaginationConfig.DefaultPageSize && opts.Count == models.CountExact
 	})).Return(expectedTemplates, expectedCount, nil)
 
 	templates, count, err := service.ListTemplates(ctx, options)
@@ -256,6 +280,9 @@ func TestFormTemplateService_UpdateTemplate_Success(t *testing.T) {
 	existingTemplate.ID = input.ID
 
 	mockRepo.On("FindByID", ctx, input.ID).Return(existingTemplate, nil)
+	mockRepo.On("SaveVersion", ctx, mock.MatchedBy(func(version *models.FormTemplateVersion) bool {
+		return version.TemplateID == input.ID && version.Version == 1 && version.Schema != nil
+	})).Return(nil)
 	mockRepo.On("Update", ctx, mock.MatchedBy(func(template *models.FormTemplate) bool {
 		return template.ID == input.ID &&
 			template.Name == input.Name &&
@@ -271,6 +298,7 @@ func TestFormTemplateService_UpdateTemplate_Success(t *testing.T) {
 	assert.Equal(t, input.Schema, template.Schema)
 	assert.Equal(t, input.UISchema, template.UISchema)
 	assert.Equal(t, input.UpdatedBy, template.UpdatedBy)
+	assert.Equal(t, 2, template.Version)
 
 	mockRepo.AssertExpectations(t)
 }
@@ -309,15 +337,24 @@ func TestFormTemplateService_UpdateTemplate_TemplateNotFound(t *testing.T) {
 }
 
 // DeleteTemplate Tests
+func setupTemplateDeletion(policy string) (*FormTemplateService, *MockFormTemplateRepository, *MockFormRepository) {
+	service, mockRepo, config := setupFormTemplateService()
+	config.BusinessRulesConfig.TemplateDeletePolicy = policy
+	mockFormRepo := &MockFormRepository{}
+	service.formRepo = mockFormRepo
+	return service, mockRepo, mockFormRepo
+}
+
 func TestFormTemplateService_DeleteTemplate_Success(t *testing.T) {
-	service, mockRepo, _ := setupFormTemplateService()
+	service, mockRepo, mockFormRepo := setupTemplateDeletion("")
 	ctx := context.Background()
-	templateID := primitive.NewObjectID()
+	template := createTestFormTemplate()
 
-	mockRepo.On("Exists", ctx, templateID).Return(true, nil)
-	mockRepo.On("Delete", ctx, templateID).Return(nil)
+	mockRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+	mockFormRepo.On("CountByTemplateID", ctx, template.ID, template.MerchantID).Return(int64(0), nil)
+	mockRepo.On("Delete", ctx, template.ID).Return(nil)
 
-	err := service.DeleteTemplate(ctx, templateID)
+	err := service.DeleteTemplate(ctx, template.ID, "user123")
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -328,15 +365,66 @@ func TestFormTemplateService_DeleteTemplate_NotFound(t *testing.T) {
 	ctx := context.Background()
 	templateID := primitive.NewObjectID()
 
-	mockRepo.On("Exists", ctx, templateID).Return(false, nil)
+	mockRepo.On("FindByID", ctx, templateID).Return((*models.FormTemplate)(nil), errors.New("not found"))
 
-	err := service.DeleteTemplate(ctx, templateID)
+	err := service.DeleteTemplate(ctx, templateID, "user123")
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrTemplateNotFound, err)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestFormTemplateService_DeleteTemplate_InUseBlocked(t *testing.T) {
+	for _, policy := range []string{"", "block", "unknown"} {
+		t.Run(policy, func(t *testing.T) {
+			service, mockRepo, mockFormRepo := setupTemplateDeletion(policy)
+			ctx := context.Background()
+			template := createTestFormTemplate()
+
+			mockRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+			mockFormRepo.On("CountByTemplateID", ctx, template.ID, template.MerchantID).Return(int64(2), nil)
+
+			err := service.DeleteTemplate(ctx, template.ID, "user123")
+
+			assert.Equal(t, ErrTemplateInUse, err)
+			mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestFormTemplateService_DeleteTemplate_InUseDetached(t *testing.T) {
+	service, mockRepo, mockFormRepo := setupTemplateDeletion("detach")
+	ctx := context.Background()
+	template := createTestFormTemplate()
+
+	mockRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+	mockFormRepo.On("CountByTemplateID", ctx, template.ID, template.MerchantID).Return(int64(2), nil)
+	mockFormRepo.On("DetachTemplate", ctx, template.ID, template.MerchantID).Return(int64(2), nil)
+	mockRepo.On("Delete", ctx, template.ID).Return(nil)
+
+	err := service.DeleteTemplate(ctx, template.ID, "user123")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockFormRepo.AssertExpectations(t)
+}
+
+func TestFormTemplateService_DeleteTemplate_InUseArchived(t *testing.T) {
+	service, mockRepo, mockFormRepo := setupTemplateDeletion("archive")
+	ctx := context.Background()
+	template := createTestFormTemplate()
+
+	mockRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+	mockFormRepo.On("CountByTemplateID", ctx, template.ID, template.MerchantID).Return(int64(2), nil)
+	mockRepo.On("Archive", ctx, template.ID, "user123").Return(nil)
+
+	err := service.DeleteTemplate(ctx, template.ID, "user123")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
 // DuplicateTemplate Tests
 func TestFormTemplateService_DuplicateTemplate_Success(t *testing.T) {
 	service, mockRepo, _ := setupFormTemplateService()
@@ -401,6 +489,20 @@ func TestFormTemplateService_checkTemplateLimit_LimitExceeded(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestFormTemplateService_checkTemplateLimit_MerchantOverride(t *testing.T) {
+	service, mockRepo, config := setupFormTemplateService()
+	ctx := context.Background()
+	merchantID := "merchant123"
+	service.limits.store(merchantID, &models.MerchantLimits{MerchantID: merchantID, MaxTemplates: 20})
+
+	mockRepo.On("CountByMerchantID", ctx, merchantID).Return(int64(config.BusinessRulesConfig.MaxTemplatesPerMerchant), nil)
+
+	err := service.checkTemplateLimit(ctx, merchantID)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestFormTemplateService_checkTemplateLimit_CountError(t *testing.T) {
 	service, mockRepo, _ := setupFormTemplateService()
 	ctx := context.Background()
@@ -414,3 +516,187 @@ func TestFormTemplateService_checkTemplateLimit_CountError(t *testing.T) {
 	assert.Equal(t, ErrInternalError, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestFormTemplateService_ValidateSchema(t *testing.T) {
+	service, _, config := setupFormTemplateService()
+	config.SchemaLintConfig = &conf.SchemaLintConfig{MaxRequiredFields: 1}
+
+	result, err := service.ValidateSchema(map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":  map[string]interface{}{"type": "string", "title": "Name"},
+			"email": map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"name", "email"},
+	}, map[string]interface{}{"phone": map[string]interface{}{"ui:widget": "tel"}})
+
+	assert.NoError(t, err)
+	assert.True(t, result.Valid())
+	var codes []string
+	for _, warning := range result.Warnings {
+		codes = append(codes, warning.Code)
+	}
+	assert.ElementsMatch(t, []string{schema.IssueTooManyRequired, schema.IssueMissingTitle, schema.IssueUnusedUISchema}, codes)
+}
+
+func TestFormTemplateService_ValidateSchema_Errors(t *testing.T) {
+	service, _, _ := setupFormTemplateService()
+
+	tests := map[string]interface{}{
+		"unknown type": map[string]interface{}{"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "text"},
+		}},
+		"invalid block reference": map[string]interface{}{"properties": map[string]interface{}{
+			"contact": map[string]interface{}{schema.BlockKey: 1},
+		}},
+	}
+	for name, formSchema := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := service.ValidateSchema(formSchema, nil)
+
+			assert.NoError(t, err)
+			assert.False(t, result.Valid())
+			assert.Equal(t, schema.IssueInvalidSchema, result.Errors[0].Code)
+		})
+	}
+
+	_, err := service.ValidateSchema(nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestFormTemplateService_ImportForm_DryRun(t *testing.T) {
+	service, mockRepo, _ := setupFormTemplateService()
+	ctx := context.Background()
+
+	result, err := service.ImportForm(ctx, &models.ImportFormInput{
+		Format:     importer.FormatTypeform,
+		Data:       []byte(`{"title": "Signup", "fields": [{"id": "f1", "title": "Name", "type": "short_text"}, {"id": "f2", "title": "Pay", "type": "payment"}]}`),
+		DryRun:     true,
+		CreatedBy:  "user123",
+		MerchantID: "merchant123",
+	})
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Template)
+	assert.Contains(t, result.Schema.(map[string]interface{})["properties"], "name")
+	assert.Equal(t, []importer.Unsupported{{ID: "f2", Title: "Pay", Type: "payment"}}, result.Unsupported)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormTemplateService_ImportForm_CreatesTemplate(t *testing.T) {
+	service, mockRepo, _ := setupFormTemplateService()
+	ctx := context.Background()
+
+	mockRepo.On("CountByMerchantID", ctx, "merchant123").Return(int64(0), nil)
+	// Keto is not available in tests, so the saved template is inspected and the save failed
+	var saved *models.FormTemplate
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(template *models.FormTemplate) bool {
+		saved = template
+		return true
+	})).Return(errors.New("database error"))
+
+	_, err := service.ImportForm(ctx, &models.ImportFormInput{
+		Format:     importer.FormatGoogleForms,
+		Data:       []byte(`{"info": {"title": "Registration"}, "items": [{"title": "Email", "questionItem": {"question": {"questionId": "q1", "required": true, "textQuestion": {}}}}]}`),
+		CreatedBy:  "user123",
+		MerchantID: "merchant123",
+	})
+
+	assert.Equal(t, ErrInternalError, err)
+	if assert.NotNil(t, saved) {
+		assert.Equal(t, "Registration", saved.Name)
+		assert.Equal(t, []interface{}{"email"}, saved.Schema.(map[string]interface{})["required"])
+		assert.Equal(t, []interface{}{"email"}, saved.UISchema.(map[string]interface{})["ui:order"])
+	}
+}
+
+func TestFormTemplateService_ImportForm_InvalidInput(t *testing.T) {
+	service, mockRepo, _ := setupFormTemplateService()
+	ctx := context.Background()
+
+	tests := map[string]*models.ImportFormInput{
+		"unknown format":  {Format: "surveymonkey", Data: []byte(`{}`)},
+		"invalid export":  {Format: importer.FormatTypeform, Data: []byte(`[]`)},
+		"no questions":    {Format: importer.FormatTypeform, Data: []byte(`{"title": "Empty", "fields": [{"id": "f1", "type": "payment"}]}`)},
+		"missing payload": {Format: importer.FormatTypeform},
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			input.CreatedBy = "user123"
+			input.MerchantID = "merchant123"
+
+			_, err := service.ImportForm(ctx, input)
+
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		})
+	}
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFormTemplateService_ExportImportTemplate(t *testing.T) {
+	service, mockRepo, config := setupFormTemplateService()
+	config.TemplatePackageConfig = &conf.TemplatePackageConfig{SigningSecret: "secret"}
+	ctx := context.Background()
+	template := createTestFormTemplate()
+	template.Version = 4
+
+	mockRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+
+	data, err := service.ExportTemplate(ctx, template.ID, "merchant123")
+	assert.NoError(t, err)
+
+	// Another merchant imports the package; Keto is not available in tests, so the saved
+	// template is inspected and the save failed
+	mockRepo.On("CountByMerchantID", ctx, "merchant456").Return(int64(0), nil)
+	var saved *models.FormTemplate
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(t *models.FormTemplate) bool {
+		saved = t
+		return true
+	})).Return(errors.New("database error"))
+
+	_, err = service.ImportTemplate(ctx, &models.ImportTemplateInput{
+		Package:    data,
+		CreatedBy:  "user456",
+		MerchantID: "merchant456",
+	})
+
+	assert.Equal(t, ErrInternalError, err)
+	if assert.NotNil(t, saved) {
+		assert.NotEqual(t, template.ID, saved.ID)
+		assert.Equal(t, "Test Template", saved.Name)
+		assert.Equal(t, "merchant456", saved.MerchantID)
+		assert.Equal(t, 1, saved.Version)
+		assert.Equal(t, map[string]interface{}{"type": "object", "properties": map[string]interface{}{}}, saved.Schema)
+	}
+}
+
+func TestFormTemplateService_ExportTemplate_OtherMerchant(t *testing.T) {
+	service, mockRepo, config := setupFormTemplateService()
+	config.TemplatePackageConfig = &conf.TemplatePackageConfig{SigningSecret: "secret"}
+	ctx := context.Background()
+	template := createTestFormTemplate()
+
+	mockRepo.On("FindByID", ctx, template.ID).Return(template, nil)
+
+	_, err := service.ExportTemplate(ctx, template.ID, "other-merchant")
+
+	assert.Equal(t, ErrTemplateNotFound, err)
+}
+
+func TestFormTemplateService_ImportTemplate_Rejected(t *testing.T) {
+	service, mockRepo, config := setupFormTemplateService()
+	ctx := context.Background()
+	input := &models.ImportTemplateInput{
+		Package:    []byte(`{"format": "arwoosa.form.template", "version": 1, "content": {"name": "Forged", "schema": {}}, "signature": "AAAA"}`),
+		CreatedBy:  "user123",
+		MerchantID: "merchant123",
+	}
+
+	_, err := service.ImportTemplate(ctx, input)
+	assert.Equal(t, ErrTemplatePackagesNotConfigured, err)
+
+	config.TemplatePackageConfig = &conf.TemplatePackageConfig{SigningSecret: "secret"}
+	_, err = service.ImportTemplate(ctx, input)
+	assert.Equal(t, ErrInvalidTemplatePackage, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/arwoosa/vulpes/ezgrpc"
 	"github.com/arwoosa/vulpes/log"
@@ -14,22 +15,50 @@ import (
 	pb "github.com/arwoosa/form/gen/pb/form"
 	"github.com/arwoosa/form/internal/helper"
 	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/ratelimit"
+	"github.com/arwoosa/form/internal/schema"
 )
 
 // GRPCFormServer implements the FormService gRPC interface
 type GRPCFormServer struct {
 	pb.UnimplementedFormServiceServer
-	templateService *FormTemplateService
-	formService     *FormService
-	configService   *ConfigService
+	templateService     *FormTemplateService
+	formService         *FormService
+	configService       *ConfigService
+	submissionService   *FormSubmissionService
+	filterIndexService  *FilterIndexService
+	consistencyService  *ConsistencyService
+	invitationService   *FormInvitationService
+	limitsService       *LimitsService
+	contentService      *ContentRulesService
+	usageService        *UsageService
+	fileService         *FormFileService
+	collaboratorService *CollaboratorService
+	purgeService        *MerchantPurgeService
+	userDataService     *UserDataService
+	blockService        *FieldBlockService
+	attendanceService   *AttendanceService
 }
 
 // NewGRPCFormServer creates a new gRPC form server
-func NewGRPCFormServer(templateService *FormTemplateService, formService *FormService, configService *ConfigService) *GRPCFormServer {
+func NewGRPCFormServer(templateService *FormTemplateService, formService *FormService, configService *ConfigService, submissionService *FormSubmissionService, filterIndexService *FilterIndexService, consistencyService *ConsistencyService, invitationService *FormInvitationService, limitsService *LimitsService, contentService *ContentRulesService, usageService *UsageService, fileService *FormFileService, collaboratorService *CollaboratorService, purgeService *MerchantPurgeService, userDataService *UserDataService, blockService *FieldBlockService, attendanceService *AttendanceService) *GRPCFormServer {
 	return &GRPCFormServer{
-		templateService: templateService,
-		formService:     formService,
-		configService:   configService,
+		templateService:     templateService,
+		formService:         formService,
+		configService:       configService,
+		submissionService:   submissionService,
+		filterIndexService:  filterIndexService,
+		consistencyService:  consistencyService,
+		invitationService:   invitationService,
+		limitsService:       limitsService,
+		contentService:      contentService,
+		usageService:        usageService,
+		fileService:         fileService,
+		collaboratorService: collaboratorService,
+		purgeService:        purgeService,
+		userDataService:     userDataService,
+		blockService:        blockService,
+		attendanceService:   attendanceService,
 	}
 }
 
@@ -88,151 +117,1664 @@ func (s *GRPCFormServer) ListFormTemplates(ctx context.Context, req *pb.ListForm
 		PageSize:   int(req.PageSize),
 		SortBy:     req.SortBy,
 		SortOrder:  req.SortOrder,
+		View:       models.ListView(req.View),
+	}
+	if req.SkipCount {
+		options.Count = models.CountNone
+	}
+
+	templates, totalCount, err := s.templateService.ListTemplates(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert templates to protobuf
+	pbTemplates := make([]*pb.FormTemplate, len(templates))
+	for i, template := range templates {
+		pbTemplate, err := s.convertFormTemplateToProto(template)
+		if err != nil {
+			log.Error("Failed to convert template to protobuf", log.Err(err))
+			return nil, err
+		}
+		pbTemplates[i] = pbTemplate
+	}
+
+	// Calculate pagination
+	pagination := &common.Pagination{
+		Page:     helper.SafeInt32FromInt(options.Page),
+		PageSize: helper.SafeInt32FromInt(options.PageSize),
+		HasMore:  int64(options.Page)*int64(options.PageSize) < totalCount,
+	}
+	if options.Count != models.CountNone {
+		pagination.TotalCount = helper.SafeInt32FromInt64(totalCount)
+		pagination.TotalPages = helper.SafeInt32FromInt64((totalCount + int64(options.PageSize) - 1) / int64(options.PageSize))
+	}
+
+	return &pb.ListFormTemplatesResponse{
+		Templates:  pbTemplates,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetFormTemplate gets a form template by ID
+func (s *GRPCFormServer) GetFormTemplate(ctx context.Context, req *common.ID) (*pb.FormTemplate, error) {
+	templateID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	template, err := s.templateService.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormTemplateToProto(template)
+}
+
+// UpdateFormTemplate updates a form template
+func (s *GRPCFormServer) UpdateFormTemplate(ctx context.Context, req *pb.UpdateFormTemplateRequest) (*pb.FormTemplate, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templateID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	// Convert request to service input
+	input := &models.UpdateFormTemplateInput{
+		ID:        templateID,
+		Name:      req.Name,
+		UpdatedBy: user.ID,
+	}
+
+	// Convert schema if provided
+	if req.Schema != nil {
+		input.Schema = req.Schema.AsMap()
+	}
+
+	// Convert UI schema if provided
+	if req.Uischema != nil {
+		input.UISchema = req.Uischema.AsMap()
+	}
+
+	template, err := s.templateService.UpdateTemplate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormTemplateToProto(template)
+}
+
+// DeleteFormTemplate deletes a form template
+func (s *GRPCFormServer) DeleteFormTemplate(ctx context.Context, req *common.ID) (*emptypb.Empty, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templateID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	var deletedBy string
+	if user != nil {
+		deletedBy = user.ID
+	}
+
+	err = s.templateService.DeleteTemplate(ctx, templateID, deletedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DuplicateFormTemplate duplicates a form template
+func (s *GRPCFormServer) DuplicateFormTemplate(ctx context.Context, req *pb.DuplicateFormTemplateRequest) (*pb.DuplicateFormTemplateResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	input := &models.DuplicateFormTemplateInput{
+		SourceID:   sourceID,
+		NameSuffix: " copy",
+		CreatedBy:  user.ID,
+		MerchantID: user.Merchant,
+	}
+
+	template, err := s.templateService.DuplicateTemplate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplate, err := s.convertFormTemplateToProto(template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DuplicateFormTemplateResponse{
+		Template: pbTemplate,
+	}, nil
+}
+
+// ExportTemplate exports a form template as a signed package
+func (s *GRPCFormServer) ExportTemplate(ctx context.Context, req *common.ID) (*pb.ExportTemplateResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templateID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	data, err := s.templateService.ExportTemplate(ctx, templateID, user.Merchant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportTemplateResponse{
+		Package: string(data),
+	}, nil
+}
+
+// ImportTemplate creates a form template from an exported package
+func (s *GRPCFormServer) ImportTemplate(ctx context.Context, req *pb.ImportTemplateRequest) (*pb.ImportTemplateResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.templateService.ImportTemplate(ctx, &models.ImportTemplateInput{
+		Package:    []byte(req.Package),
+		Name:       req.Name,
+		CreatedBy:  user.ID,
+		MerchantID: user.Merchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplate, err := s.convertFormTemplateToProto(template)
+	if err != nil {
+		log.Error("Failed to convert template to protobuf", log.Err(err))
+		return nil, err
+	}
+
+	return &pb.ImportTemplateResponse{
+		Template: pbTemplate,
+	}, nil
+}
+
+// ImportForm imports a form exported from another form builder as a template
+func (s *GRPCFormServer) ImportForm(ctx context.Context, req *pb.ImportFormRequest) (*pb.ImportFormResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Export == nil {
+		return nil, ErrInvalidInput
+	}
+
+	data, err := req.Export.MarshalJSON()
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+
+	imported, err := s.templateService.ImportForm(ctx, &models.ImportFormInput{
+		Format:     req.Format,
+		Data:       data,
+		Name:       req.Name,
+		DryRun:     req.DryRun,
+		CreatedBy:  user.ID,
+		MerchantID: user.Merchant,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ImportFormResponse{
+		Unsupported: make([]*pb.UnsupportedQuestion, len(imported.Unsupported)),
+	}
+	for i, question := range imported.Unsupported {
+		resp.Unsupported[i] = &pb.UnsupportedQuestion{Id: question.ID, Title: question.Title, Type: question.Type}
+	}
+	if resp.Schema, err = structpb.NewStruct(s.convertMongoDataToMap(imported.Schema)); err != nil {
+		log.Error("Failed to convert schema to protobuf", log.Err(err))
+		return nil, err
+	}
+	if resp.Uischema, err = structpb.NewStruct(s.convertMongoDataToMap(imported.UISchema)); err != nil {
+		log.Error("Failed to convert UI schema to protobuf", log.Err(err))
+		return nil, err
+	}
+	if imported.Template != nil {
+		if resp.Template, err = s.convertFormTemplateToProto(imported.Template); err != nil {
+			log.Error("Failed to convert template to protobuf", log.Err(err))
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// CreateFieldBlock saves a field block to the library of the caller's merchant
+func (s *GRPCFormServer) CreateFieldBlock(ctx context.Context, req *pb.CreateFieldBlockRequest) (*pb.FieldBlock, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &models.CreateFieldBlockInput{
+		Name:        req.Name,
+		Description: req.Description,
+		MerchantID:  user.Merchant,
+		CreatedBy:   user.ID,
+	}
+	if req.Schema != nil {
+		input.Schema = req.Schema.AsMap()
+	}
+
+	block, err := s.blockService.CreateBlock(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFieldBlockToProto(block)
+}
+
+// ListFieldBlocks lists the field blocks of the caller's merchant with pagination
+func (s *GRPCFormServer) ListFieldBlocks(ctx context.Context, req *pb.ListFieldBlocksRequest) (*pb.ListFieldBlocksResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.FieldBlockQueryOptions{
+		MerchantID: user.Merchant,
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		SortBy:     req.SortBy,
+		SortOrder:  req.SortOrder,
+		View:       models.ListView(req.View),
+	}
+
+	blocks, totalCount, err := s.blockService.ListBlocks(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pbBlocks := make([]*pb.FieldBlock, len(blocks))
+	for i, block := range blocks {
+		pbBlock, err := s.convertFieldBlockToProto(block)
+		if err != nil {
+			log.Error("Failed to convert field block to protobuf", log.Err(err))
+			return nil, err
+		}
+		pbBlocks[i] = pbBlock
+	}
+
+	totalPages := (totalCount + int64(options.PageSize) - 1) / int64(options.PageSize)
+
+	return &pb.ListFieldBlocksResponse{
+		Blocks: pbBlocks,
+		Pagination: &common.Pagination{
+			Page:       helper.SafeInt32FromInt(options.Page),
+			PageSize:   helper.SafeInt32FromInt(options.PageSize),
+			TotalCount: helper.SafeInt32FromInt64(totalCount),
+			TotalPages: helper.SafeInt32FromInt64(totalPages),
+			HasMore:    int64(options.Page)*int64(options.PageSize) < totalCount,
+		},
+	}, nil
+}
+
+// GetFieldBlock gets a field block of the caller's merchant by ID
+func (s *GRPCFormServer) GetFieldBlock(ctx context.Context, req *common.ID) (*pb.FieldBlock, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	block, err := s.blockService.GetBlock(ctx, blockID, user.Merchant)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFieldBlockToProto(block)
+}
+
+// UpdateFieldBlock updates a field block of the caller's merchant
+func (s *GRPCFormServer) UpdateFieldBlock(ctx context.Context, req *pb.UpdateFieldBlockRequest) (*pb.FieldBlock, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	input := &models.UpdateFieldBlockInput{
+		ID:          blockID,
+		Name:        req.Name,
+		Description: req.Description,
+		MerchantID:  user.Merchant,
+		UpdatedBy:   user.ID,
+	}
+	if req.Schema != nil {
+		input.Schema = req.Schema.AsMap()
+	}
+
+	block, err := s.blockService.UpdateBlock(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFieldBlockToProto(block)
+}
+
+// DeleteFieldBlock deletes a field block of the caller's merchant
+func (s *GRPCFormServer) DeleteFieldBlock(ctx context.Context, req *common.ID) (*emptypb.Empty, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	if err := s.blockService.DeleteBlock(ctx, blockID, user.Merchant); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetConfig returns configuration settings for the frontend
+func (s *GRPCFormServer) GetConfig(ctx context.Context, req *emptypb.Empty) (*pb.ConfigResponse, error) {
+	businessConfig, err := s.configService.GetBusinessConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfigResponse{
+		MaxTemplatesPerMerchant: helper.SafeInt32FromInt(businessConfig.MaxTemplatesPerMerchant),
+	}, nil
+}
+
+// SubmitFormResponse submits a response to a published form
+func (s *GRPCFormServer) SubmitFormResponse(ctx context.Context, req *pb.SubmitFormResponseRequest) (*pb.FormSubmission, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	input := &models.SubmitFormResponseInput{
+		FormID:          formID,
+		InvitationToken: req.InvitationToken,
+		SubmissionToken: req.SubmissionToken,
+		CaptchaToken:    req.CaptchaToken,
+		ClientIP:        ratelimit.ClientIP(ctx),
+	}
+	// Anonymous and invite-only forms accept submissions without a signed-in user
+	if user != nil {
+		input.SubmittedBy = user.ID
+	}
+	if req.Answers != nil {
+		input.Answers = req.Answers.AsMap()
+	}
+
+	submission, err := s.submissionService.SubmitResponse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormSubmissionToProto(submission)
+}
+
+// RequestUploadURL issues a pre-signed URL for uploading a file to a form's file field
+func (s *GRPCFormServer) RequestUploadURL(ctx context.Context, req *pb.RequestUploadURLRequest) (*pb.UploadURL, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	input := &models.RequestUploadURLInput{
+		FormID:      formID,
+		Field:       req.Field,
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+	}
+	// Anonymous forms accept uploads without a signed-in user
+	if user != nil {
+		input.UploadedBy = user.ID
+	}
+
+	upload, err := s.fileService.RequestUploadURL(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UploadURL{
+		FileId:    upload.File.ID.Hex(),
+		UploadUrl: upload.URL,
+		Method:    upload.Method,
+		Headers:   upload.Headers,
+		ExpiresAt: timestamppb.New(upload.ExpiresAt),
+	}, nil
+}
+
+// ImportSubmissions imports historical submissions into a form
+func (s *GRPCFormServer) ImportSubmissions(ctx context.Context, req *pb.ImportSubmissionsRequest) (*pb.ImportSubmissionsResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	// Convert request to service input
+	input := &models.ImportSubmissionsInput{
+		FormID:        formID,
+		MerchantID:    user.Merchant,
+		SchemaVersion: int(req.SchemaVersion),
+		Submissions:   make([]models.ImportSubmissionInput, len(req.Submissions)),
+		ImportedBy:    user.ID,
+	}
+	for i, record := range req.Submissions {
+		input.Submissions[i] = models.ImportSubmissionInput{
+			Answers:     record.Answers.AsMap(),
+			SubmittedAt: record.SubmittedAt.AsTime(),
+			SubmittedBy: record.SubmittedBy,
+			ExternalID:  record.ExternalId,
+		}
+	}
+
+	result, err := s.submissionService.ImportSubmissions(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected := make([]*pb.RejectedSubmission, len(result.Rejected))
+	for i, r := range result.Rejected {
+		rejected[i] = &pb.RejectedSubmission{
+			Index:  helper.SafeInt32FromInt(r.Index),
+			Errors: r.Errors,
+		}
+	}
+
+	return &pb.ImportSubmissionsResponse{
+		SchemaVersion: helper.SafeInt32FromInt(result.SchemaVersion),
+		ImportedCount: helper.SafeInt32FromInt(result.ImportedCount),
+		Rejected:      rejected,
+	}, nil
+}
+
+// ListSubmissions lists a form's submissions, optionally filtered by answer values
+func (s *GRPCFormServer) ListSubmissions(ctx context.Context, req *pb.ListSubmissionsRequest) (*pb.ListSubmissionsResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	// Convert request to service options
+	options := &models.SubmissionQueryOptions{
+		FormID:     formID,
+		MerchantID: user.Merchant,
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		SortOrder:  req.SortOrder,
+	}
+	if req.Filters != nil {
+		options.Filters = req.Filters.AsMap()
+	}
+
+	submissions, totalCount, err := s.submissionService.ListSubmissions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert submissions to protobuf
+	pbSubmissions := make([]*pb.FormSubmission, len(submissions))
+	for i, submission := range submissions {
+		pbSubmission, err := s.convertFormSubmissionToProto(submission)
+		if err != nil {
+			log.Error("Failed to convert submission to protobuf", log.Err(err))
+			return nil, err
+		}
+		pbSubmissions[i] = pbSubmission
+	}
+
+	// Calculate pagination
+	totalPages := (totalCount + int64(options.PageSize) - 1) / int64(options.PageSize)
+
+	return &pb.ListSubmissionsResponse{
+		Submissions: pbSubmissions,
+		Pagination: &common.Pagination{
+			Page:       helper.SafeInt32FromInt(options.Page),
+			PageSize:   helper.SafeInt32FromInt(options.PageSize),
+			TotalCount: helper.SafeInt32FromInt64(totalCount),
+			TotalPages: helper.SafeInt32FromInt64(totalPages),
+			HasMore:    int64(options.Page)*int64(options.PageSize) < totalCount,
+		},
+	}, nil
+}
+
+// GetSubmissionIndexReport reports frequently filtered answer fields with index recommendations
+func (s *GRPCFormServer) GetSubmissionIndexReport(ctx context.Context, _ *emptypb.Empty) (*pb.SubmissionIndexReport, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.filterIndexService.GetReport(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*pb.SubmissionIndexRecommendation, len(report.Recommendations))
+	for i, r := range report.Recommendations {
+		recommendations[i] = &pb.SubmissionIndexRecommendation{
+			Field:         r.Field,
+			UsageCount:    r.UsageCount,
+			MerchantCount: r.MerchantCount,
+			Indexed:       r.Indexed,
+			Created:       r.Created,
+		}
+	}
+
+	return &pb.SubmissionIndexReport{
+		MinFilterUsage:  report.MinFilterUsage,
+		AutoCreate:      report.AutoCreate,
+		MaxAutoIndexes:  helper.SafeInt32FromInt(report.MaxAutoIndexes),
+		AutoIndexCount:  helper.SafeInt32FromInt(report.AutoIndexCount),
+		Recommendations: recommendations,
+	}, nil
+}
+
+// CheckEventConsistency verifies that the forms attached to an event are consistent across resources
+func (s *GRPCFormServer) CheckEventConsistency(ctx context.Context, req *pb.CheckEventConsistencyRequest) (*pb.EventConsistencyReport, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventID, err := primitive.ObjectIDFromHex(req.EventId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	report, err := s.consistencyService.CheckEventConsistency(ctx, eventID, req.MerchantId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EventConsistencyReport{
+		EventId:    report.EventID.Hex(),
+		MerchantId: report.MerchantID,
+		FormCount:  helper.SafeInt32FromInt(report.FormCount),
+		Healthy:    report.Healthy,
+		Checks:     s.convertConsistencyChecksToProto(report.Checks),
+	}, nil
+}
+
+// CheckReferentialIntegrity scans for dangling references, optionally repairing them
+func (s *GRPCFormServer) CheckReferentialIntegrity(ctx context.Context, req *pb.CheckReferentialIntegrityRequest) (*pb.IntegrityReport, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	report, err := s.consistencyService.CheckReferentialIntegrity(ctx, req.Repair, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.IntegrityReport{
+		Found:    helper.SafeInt32FromInt(report.Found),
+		Repaired: helper.SafeInt32FromInt(report.Repaired),
+		Healthy:  report.Healthy,
+		Checks:   s.convertConsistencyChecksToProto(report.Checks),
+	}, nil
+}
+
+// GetMerchantUsage gets the caller's merchant usage with its effective limits
+func (s *GRPCFormServer) GetMerchantUsage(ctx context.Context, _ *emptypb.Empty) (*pb.MerchantUsage, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.usageService.GetMerchantUsage(ctx, user.Merchant)
+	if err != nil {
+		return nil, err
+	}
+
+	formsByStatus := make(map[string]int64, len(usage.FormsByStatus))
+	for status, count := range usage.FormsByStatus {
+		formsByStatus[string(status)] = count
+	}
+
+	return &pb.MerchantUsage{
+		MerchantId:    usage.MerchantID,
+		Templates:     usage.Templates,
+		Forms:         usage.Forms,
+		FormsByStatus: formsByStatus,
+		Responses:     usage.Responses,
+		Limits:        s.convertMerchantLimitsToProto(usage.Limits),
+		GeneratedAt:   timestamppb.New(usage.GeneratedAt),
+	}, nil
+}
+
+// ListMerchantLimits lists merchants with limit overrides and their effective limits
+func (s *GRPCFormServer) ListMerchantLimits(ctx context.Context, req *pb.ListMerchantLimitsRequest) (*pb.ListMerchantLimitsResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.MerchantLimitsQueryOptions{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	}
+
+	limits, totalCount, err := s.limitsService.ListMerchantLimits(ctx, options, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbLimits := make([]*pb.MerchantLimits, len(limits))
+	for i, l := range limits {
+		pbLimits[i] = s.convertMerchantLimitsToProto(l)
+	}
+
+	// Calculate pagination
+	totalPages := (totalCount + int64(options.PageSize) - 1) / int64(options.PageSize)
+
+	return &pb.ListMerchantLimitsResponse{
+		Limits: pbLimits,
+		Pagination: &common.Pagination{
+			Page:       helper.SafeInt32FromInt(options.Page),
+			PageSize:   helper.SafeInt32FromInt(options.PageSize),
+			TotalCount: helper.SafeInt32FromInt64(totalCount),
+			TotalPages: helper.SafeInt32FromInt64(totalPages),
+			HasMore:    int64(options.Page)*int64(options.PageSize) < totalCount,
+		},
+	}, nil
+}
+
+// GetMerchantLimits gets a merchant's effective limits and overrides
+func (s *GRPCFormServer) GetMerchantLimits(ctx context.Context, req *pb.GetMerchantLimitsRequest) (*pb.MerchantLimits, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := s.limitsService.GetMerchantLimits(ctx, req.MerchantId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertMerchantLimitsToProto(limits), nil
+}
+
+// SetMerchantLimits sets a merchant's overrides of the platform limits
+func (s *GRPCFormServer) SetMerchantLimits(ctx context.Context, req *pb.SetMerchantLimitsRequest) (*pb.MerchantLimits, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := s.limitsService.SetMerchantLimits(ctx, &models.SetMerchantLimitsInput{
+		MerchantID:       req.MerchantId,
+		MaxTemplates:     int(req.MaxTemplates),
+		MaxFormsPerEvent: int(req.MaxFormsPerEvent),
+		UpdatedBy:        user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertMerchantLimitsToProto(limits), nil
+}
+
+// GetMerchantContentRules gets the content rules applied to a merchant
+func (s *GRPCFormServer) GetMerchantContentRules(ctx context.Context, req *pb.GetMerchantLimitsRequest) (*pb.MerchantContentRules, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.contentService.GetMerchantContentRules(ctx, req.MerchantId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertContentRulesToProto(rules), nil
+}
+
+// SetMerchantContentRules replaces the platform content rules for a merchant
+func (s *GRPCFormServer) SetMerchantContentRules(ctx context.Context, req *pb.SetMerchantContentRulesRequest) (*pb.MerchantContentRules, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.contentService.SetMerchantContentRules(ctx, &models.SetMerchantContentRulesInput{
+		MerchantID:   req.MerchantId,
+		Action:       models.ContentAction(req.Action),
+		BlockURLs:    req.BlockUrls,
+		BlockedTerms: req.BlockedTerms,
+		UpdatedBy:    user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertContentRulesToProto(rules), nil
+}
+
+func convertContentRulesToProto(rules *models.EffectiveContentRules) *pb.MerchantContentRules {
+	pbRules := &pb.MerchantContentRules{
+		MerchantId:    rules.MerchantID,
+		Action:        string(rules.Action),
+		BlockUrls:     rules.BlockURLs,
+		BlockedTerms:  rules.BlockedTerms,
+		PlatformRules: rules.Overrides == nil,
+	}
+	if o := rules.Overrides; o != nil {
+		pbRules.UpdatedAt = timestamppb.New(o.GetUpdatedAt())
+		pbRules.UpdatedBy = o.UpdatedBy
+	}
+	return pbRules
+}
+
+// DeleteMerchantLimits removes a merchant's overrides
+func (s *GRPCFormServer) DeleteMerchantLimits(ctx context.Context, req *pb.GetMerchantLimitsRequest) (*emptypb.Empty, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.limitsService.DeleteMerchantLimits(ctx, req.MerchantId, user.ID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// AdminListForms lists a merchant's forms for support staff, bypassing the caller's merchant
+func (s *GRPCFormServer) AdminListForms(ctx context.Context, req *pb.AdminListFormsRequest) (*pb.AdminListFormsResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &models.FormQueryOptions{
+		MerchantID: req.MerchantId,
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		SortBy:     req.SortBy,
+		SortOrder:  req.SortOrder,
+	}
+	if req.EventId != "" {
+		eventID, err := primitive.ObjectIDFromHex(req.EventId)
+		if err != nil {
+			return nil, ErrInvalidObjectID
+		}
+		options.EventID = &eventID
+	}
+
+	forms, totalCount, err := s.formService.AdminListForms(ctx, options, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbForms := make([]*pb.Form, len(forms))
+	for i, form := range forms {
+		pbForm, err := s.convertFormToProto(form)
+		if err != nil {
+			return nil, err
+		}
+		pbForms[i] = pbForm
+	}
+
+	// Calculate pagination
+	totalPages := (totalCount + int64(options.PageSize) - 1) / int64(options.PageSize)
+
+	return &pb.AdminListFormsResponse{
+		Forms: pbForms,
+		Pagination: &common.Pagination{
+			Page:       helper.SafeInt32FromInt(options.Page),
+			PageSize:   helper.SafeInt32FromInt(options.PageSize),
+			TotalCount: helper.SafeInt32FromInt64(totalCount),
+			TotalPages: helper.SafeInt32FromInt64(totalPages),
+			HasMore:    int64(options.Page)*int64(options.PageSize) < totalCount,
+		},
+	}, nil
+}
+
+// AdminGetForm gets a form of any merchant for support staff
+func (s *GRPCFormServer) AdminGetForm(ctx context.Context, req *common.ID) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.AdminGetForm(ctx, formID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// PurgeMerchantData starts deleting all data of a merchant in the background
+func (s *GRPCFormServer) PurgeMerchantData(ctx context.Context, req *pb.PurgeMerchantDataRequest) (*pb.MerchantPurge, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	purge, err := s.purgeService.PurgeMerchantData(ctx, req.MerchantId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertMerchantPurgeToProto(purge), nil
+}
+
+// GetMerchantPurge reports the progress of a merchant data purge
+func (s *GRPCFormServer) GetMerchantPurge(ctx context.Context, req *common.ID) (*pb.MerchantPurge, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	purgeID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	purge, err := s.purgeService.GetMerchantPurge(ctx, purgeID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertMerchantPurgeToProto(purge), nil
+}
+
+// ExportUserData exports every document referring to a user
+func (s *GRPCFormServer) ExportUserData(ctx context.Context, req *pb.UserDataRequest) (*pb.UserDataExport, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := s.userDataService.ExportUserData(ctx, req.UserId, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make(map[string]interface{}, len(export.Collections))
+	for name, documents := range export.Collections {
+		values := make([]interface{}, len(documents))
+		for i, document := range documents {
+			values[i] = document
+		}
+		collections[name] = values
+	}
+	pbCollections, err := structpb.NewStruct(collections)
+	if err != nil {
+		log.Error("Failed to convert user data export to protobuf", log.Err(err))
+		return nil, err
+	}
+
+	return &pb.UserDataExport{
+		UserId:      export.UserID,
+		ExportedAt:  timestamppb.New(export.ExportedAt),
+		Collections: pbCollections,
+	}, nil
+}
+
+// EraseUserData erases a user by pseudonymizing the documents referring to them
+func (s *GRPCFormServer) EraseUserData(ctx context.Context, req *pb.EraseUserDataRequest) (*pb.UserDataErasure, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	erasure, err := s.userDataService.EraseUserData(ctx, &models.EraseUserDataInput{
+		UserID:          req.UserId,
+		RequestedBy:     user.ID,
+		DeleteResponses: req.DeleteResponses,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]*pb.ErasedCollection, len(erasure.Collections))
+	for i, c := range erasure.Collections {
+		collections[i] = &pb.ErasedCollection{
+			Collection:    c.Collection,
+			Pseudonymized: c.Pseudonymized,
+			Deleted:       c.Deleted,
+		}
+	}
+
+	return &pb.UserDataErasure{
+		UserId:      erasure.UserID,
+		Pseudonym:   erasure.Pseudonym,
+		Collections: collections,
+	}, nil
+}
+
+// StreamFormResponses streams all of a form's submissions matching the request
+func (s *GRPCFormServer) StreamFormResponses(req *pb.StreamFormResponsesRequest, stream pb.FormService_StreamFormResponsesServer) error {
+	ctx := stream.Context()
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return ErrInvalidObjectID
+	}
+
+	options := &models.SubmissionStreamOptions{
+		FormID:     formID,
+		MerchantID: user.Merchant,
+		SortOrder:  req.SortOrder,
+	}
+	if req.Filters != nil {
+		options.Filters = req.Filters.AsMap()
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		options.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		options.To = &to
+	}
+
+	var sent int64
+	var convertErr, sendErr error
+	err = s.submissionService.StreamSubmissions(ctx, options, func(submission *models.FormSubmission) error {
+		pbSubmission, err := s.convertFormSubmissionToProto(submission)
+		if err != nil {
+			convertErr = err
+			return err
+		}
+		if sendErr = stream.Send(pbSubmission); sendErr != nil {
+			return sendErr
+		}
+		sent++
+		return nil
+	})
+	switch {
+	case convertErr != nil:
+		log.Error("Failed to convert submission to protobuf", log.Err(convertErr), log.String("form_id", req.FormId), log.Int64("sent", sent))
+		return ErrInternalError
+	case sendErr != nil:
+		// The client went away or the transport failed; there is nothing to map
+		log.Warn("Submission stream interrupted", log.Err(sendErr), log.String("form_id", req.FormId), log.Int64("sent", sent))
+		return sendErr
+	}
+	return err
+}
+
+// GetFormResponseStats gets aggregated statistics over a form's responses
+func (s *GRPCFormServer) GetFormResponseStats(ctx context.Context, req *pb.GetFormResponseStatsRequest) (*pb.FormResponseStats, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	// Convert request to service input
+	input := &models.FormResponseStatsInput{
+		FormID:     formID,
+		MerchantID: user.Merchant,
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		input.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		input.To = &to
+	}
+
+	stats, err := s.submissionService.GetFormResponseStats(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormResponseStatsToProto(stats), nil
+}
+
+// GetFormsByIDs gets several of the caller's merchant's forms by ID, reporting the IDs that were
+// not found
+func (s *GRPCFormServer) GetFormsByIDs(ctx context.Context, req *pb.GetFormsByIDsRequest) (*pb.GetFormsByIDsResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.Merchant == "" {
+		return nil, ErrUnauthorized
+	}
+
+	formIDs := make([]primitive.ObjectID, len(req.Ids))
+	for i, id := range req.Ids {
+		formID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, ErrInvalidObjectID
+		}
+		formIDs[i] = formID
+	}
+
+	forms, err := s.formService.GetFormsByIDs(ctx, formIDs, user.Merchant)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.FormLookup, len(forms))
+	for i, form := range forms {
+		results[i] = &pb.FormLookup{Id: req.Ids[i]}
+		if form == nil {
+			continue
+		}
+
+		pbForm, err := s.convertFormToProto(form)
+		if err != nil {
+			log.Error("Failed to convert form to protobuf", log.Err(err))
+			return nil, err
+		}
+		results[i].Found = true
+		results[i].Form = pbForm
+	}
+
+	return &pb.GetFormsByIDsResponse{Results: results}, nil
+}
+
+// PublishForm publishes a form, locking its schema and opening it for submissions
+func (s *GRPCFormServer) PublishForm(ctx context.Context, req *common.ID) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.PublishForm(ctx, formID, user.Merchant, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// CloseForm closes a form so it stops accepting submissions
+func (s *GRPCFormServer) CloseForm(ctx context.Context, req *common.ID) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.CloseForm(ctx, formID, user.Merchant, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// SetFormSchedule sets or clears the time window in which a form accepts submissions
+func (s *GRPCFormServer) SetFormSchedule(ctx context.Context, req *pb.SetFormScheduleRequest) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	var openAt, closeAt *time.Time
+	if req.OpenAt != nil {
+		t := req.OpenAt.AsTime()
+		openAt = &t
+	}
+	if req.CloseAt != nil {
+		t := req.CloseAt.AsTime()
+		closeAt = &t
+	}
+
+	form, err := s.formService.SetSchedule(ctx, formID, user.Merchant, openAt, closeAt, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// SetFormQuotas sets the maximum number of responses a form accepts
+func (s *GRPCFormServer) SetFormQuotas(ctx context.Context, req *pb.SetFormQuotasRequest) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.SetQuotas(ctx, formID, user.Merchant, int(req.MaxResponses), int(req.MaxResponsesPerUser), user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// SetFormRetention sets how long a form keeps its responses
+func (s *GRPCFormServer) SetFormRetention(ctx context.Context, req *pb.SetFormRetentionRequest) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.SetRetention(ctx, formID, user.Merchant, int(req.RetentionDays), models.RetentionAction(req.RetentionAction), user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// SetFormSpamProtection sets the defenses a form applies to submitted responses
+func (s *GRPCFormServer) SetFormSpamProtection(ctx context.Context, req *pb.SetFormSpamProtectionRequest) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.SetSpamProtection(ctx, formID, user.Merchant, &models.SpamProtection{
+		HoneypotField:   req.HoneypotField,
+		MinFillSeconds:  int(req.MinFillSeconds),
+		MaxPerIPPerHour: int(req.MaxPerIpPerHour),
+		CaptchaProvider: models.CaptchaProvider(req.CaptchaProvider),
+		CaptchaSiteKey:  req.CaptchaSiteKey,
+	}, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// SetFormSubmissionMode sets who may submit responses to a form
+func (s *GRPCFormServer) SetFormSubmissionMode(ctx context.Context, req *pb.SetFormSubmissionModeRequest) (*pb.Form, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.SetSubmissionMode(ctx, formID, user.Merchant, models.SubmissionMode(req.SubmissionMode), user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.convertFormToProto(form)
+}
+
+// CreateFormInvitations issues one-time invitation links for an invite-only form
+func (s *GRPCFormServer) CreateFormInvitations(ctx context.Context, req *pb.CreateFormInvitationsRequest) (*pb.CreateFormInvitationsResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	issued, err := s.invitationService.CreateInvitations(ctx, &models.CreateFormInvitationsInput{
+		FormID:     formID,
+		MerchantID: user.Merchant,
+		Count:      int(req.Count),
+		TTL:        time.Duration(req.TtlHours) * time.Hour,
+		CreatedBy:  user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*pb.FormInvitation, len(issued))
+	for i, inv := range issued {
+		invitations[i] = &pb.FormInvitation{
+			Id:        inv.Invitation.ID.Hex(),
+			FormId:    inv.Invitation.FormID.Hex(),
+			Token:     inv.Token,
+			Link:      inv.Link,
+			ExpiresAt: timestamppb.New(inv.Invitation.GetExpiresAt()),
+		}
+	}
+
+	return &pb.CreateFormInvitationsResponse{
+		Invitations: invitations,
+	}, nil
+}
+
+// CheckInAttendee checks in the respondent of a registration to an event session
+func (s *GRPCFormServer) CheckInAttendee(ctx context.Context, req *pb.CheckInAttendeeRequest) (*pb.CheckInAttendeeResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+	submissionID, err := primitive.ObjectIDFromHex(req.SubmissionId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
 	}
 
-	templates, totalCount, err := s.templateService.ListTemplates(ctx, options)
+	result, err := s.attendanceService.CheckIn(ctx, &models.CheckInInput{
+		FormID:       formID,
+		SubmissionID: submissionID,
+		SessionID:    req.SessionId,
+		MerchantID:   user.Merchant,
+		CheckedInBy:  user.ID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert templates to protobuf
-	pbTemplates := make([]*pb.FormTemplate, len(templates))
-	for i, template := range templates {
-		pbTemplate, err := s.convertFormTemplateToProto(template)
-		if err != nil {
-			log.Error("Failed to convert template to protobuf", log.Err(err))
-			return nil, err
-		}
-		pbTemplates[i] = pbTemplate
+	return &pb.CheckInAttendeeResponse{
+		Attendance:       convertAttendanceToProto(result.Attendance),
+		AlreadyCheckedIn: result.AlreadyCheckedIn,
+	}, nil
+}
+
+// IssueCheckInToken issues the signed check-in token of a registration for a session
+func (s *GRPCFormServer) IssueCheckInToken(ctx context.Context, req *pb.IssueCheckInTokenRequest) (*pb.CheckInToken, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate pagination
-	totalPages := (totalCount + int64(options.PageSize) - 1) / int64(options.PageSize)
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+	submissionID, err := primitive.ObjectIDFromHex(req.SubmissionId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
 
-	return &pb.ListFormTemplatesResponse{
-		Templates: pbTemplates,
-		Pagination: &common.Pagination{
-			Page:       helper.SafeInt32FromInt(options.Page),
-			PageSize:   helper.SafeInt32FromInt(options.PageSize),
-			TotalCount: helper.SafeInt32FromInt64(totalCount),
-			TotalPages: helper.SafeInt32FromInt64(totalPages),
-		},
+	token, err := s.attendanceService.IssueCheckInToken(ctx, &models.IssueCheckInTokenInput{
+		FormID:       formID,
+		SubmissionID: submissionID,
+		SessionID:    req.SessionId,
+		MerchantID:   user.Merchant,
+		TTL:          time.Duration(req.TtlHours) * time.Hour,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckInToken{
+		Token:        token.Token,
+		SubmissionId: token.SubmissionID.Hex(),
+		SessionId:    token.SessionID,
+		ExpiresAt:    timestamppb.New(token.ExpiresAt),
 	}, nil
 }
 
-// GetFormTemplate gets a form template by ID
-func (s *GRPCFormServer) GetFormTemplate(ctx context.Context, req *common.ID) (*pb.FormTemplate, error) {
-	templateID, err := primitive.ObjectIDFromHex(req.Id)
+// RedeemCheckInToken checks in the registration of a scanned check-in token
+func (s *GRPCFormServer) RedeemCheckInToken(ctx context.Context, req *pb.RedeemCheckInTokenRequest) (*pb.CheckInAttendeeResponse, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.attendanceService.RedeemCheckInToken(ctx, &models.RedeemCheckInTokenInput{
+		Token:       req.Token,
+		SessionID:   req.SessionId,
+		MerchantID:  user.Merchant,
+		CheckedInBy: user.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckInAttendeeResponse{
+		Attendance:       convertAttendanceToProto(result.Attendance),
+		AlreadyCheckedIn: result.AlreadyCheckedIn,
+	}, nil
+}
+
+// GetSessionAttendance counts the attendees checked in to a session against the responses of the form
+func (s *GRPCFormServer) GetSessionAttendance(ctx context.Context, req *pb.GetSessionAttendanceRequest) (*pb.SessionAttendance, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
 	if err != nil {
 		return nil, ErrInvalidObjectID
 	}
 
-	template, err := s.templateService.GetTemplate(ctx, templateID)
+	attendance, err := s.attendanceService.GetSessionAttendance(ctx, formID, req.SessionId, user.Merchant)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.convertFormTemplateToProto(template)
+	return &pb.SessionAttendance{
+		FormId:           attendance.FormID.Hex(),
+		SessionId:        attendance.SessionID,
+		CheckedIn:        attendance.CheckedIn,
+		FormResponses:    attendance.FormResponses,
+		FormMaxResponses: helper.SafeInt32FromInt(attendance.FormMaxResponses),
+	}, nil
 }
 
-// UpdateFormTemplate updates a form template
-func (s *GRPCFormServer) UpdateFormTemplate(ctx context.Context, req *pb.UpdateFormTemplateRequest) (*pb.FormTemplate, error) {
+func convertAttendanceToProto(attendance *models.Attendance) *pb.Attendance {
+	pbAttendance := &pb.Attendance{
+		Id:           attendance.ID.Hex(),
+		FormId:       attendance.FormID.Hex(),
+		SessionId:    attendance.SessionID,
+		SubmissionId: attendance.SubmissionID.Hex(),
+		CheckedInAt:  timestamppb.New(attendance.GetCheckedInAt()),
+		CheckedInBy:  attendance.CheckedInBy,
+	}
+	if attendance.EventID != nil {
+		pbAttendance.EventId = attendance.EventID.Hex()
+	}
+	return pbAttendance
+}
+
+// ShareForm grants another user the editor or viewer role on a form
+func (s *GRPCFormServer) ShareForm(ctx context.Context, req *pb.ShareFormRequest) (*pb.FormCollaborators, error) {
 	user, err := ezgrpc.GetUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	templateID, err := primitive.ObjectIDFromHex(req.Id)
+	formID, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
 		return nil, ErrInvalidObjectID
 	}
 
-	// Convert request to service input
-	input := &models.UpdateFormTemplateInput{
-		ID:        templateID,
-		Name:      req.Name,
-		UpdatedBy: user.ID,
+	collaborators, err := s.collaboratorService.ShareForm(ctx, &models.ShareFormInput{
+		FormID:     formID,
+		MerchantID: user.Merchant,
+		UserID:     req.UserId,
+		Role:       models.CollaboratorRole(req.Role),
+		SharedBy:   user.ID,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert schema if provided
-	if req.Schema != nil {
-		input.Schema = req.Schema.AsMap()
+	return s.convertCollaboratorsToProto(formID, collaborators), nil
+}
+
+// UnshareForm removes the role a form is shared with another user
+func (s *GRPCFormServer) UnshareForm(ctx context.Context, req *pb.UnshareFormRequest) (*pb.FormCollaborators, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert UI schema if provided
-	if req.Uischema != nil {
-		input.UISchema = req.Uischema.AsMap()
+	formID, err := primitive.ObjectIDFromHex(req.Id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
 	}
 
-	template, err := s.templateService.UpdateTemplate(ctx, input)
+	collaborators, err := s.collaboratorService.UnshareForm(ctx, &models.UnshareFormInput{
+		FormID:     formID,
+		MerchantID: user.Merchant,
+		UserID:     req.UserId,
+		UnsharedBy: user.ID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.convertFormTemplateToProto(template)
+	return s.convertCollaboratorsToProto(formID, collaborators), nil
 }
 
-// DeleteFormTemplate deletes a form template
-func (s *GRPCFormServer) DeleteFormTemplate(ctx context.Context, req *common.ID) (*emptypb.Empty, error) {
-	templateID, err := primitive.ObjectIDFromHex(req.Id)
+// ListFormCollaborators lists the users holding a role on a form
+func (s *GRPCFormServer) ListFormCollaborators(ctx context.Context, req *common.ID) (*pb.FormCollaborators, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formID, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
 		return nil, ErrInvalidObjectID
 	}
 
-	err = s.templateService.DeleteTemplate(ctx, templateID)
+	collaborators, err := s.collaboratorService.ListFormCollaborators(ctx, formID, user.Merchant)
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return s.convertCollaboratorsToProto(formID, collaborators), nil
 }
 
-// DuplicateFormTemplate duplicates a form template
-func (s *GRPCFormServer) DuplicateFormTemplate(ctx context.Context, req *pb.DuplicateFormTemplateRequest) (*pb.DuplicateFormTemplateResponse, error) {
+// CompareFormToTemplate compares a form's schema with the latest version of its source template
+func (s *GRPCFormServer) CompareFormToTemplate(ctx context.Context, req *common.ID) (*pb.FormTemplateComparison, error) {
 	user, err := ezgrpc.GetUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	sourceID, err := primitive.ObjectIDFromHex(req.Id)
+	formID, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
 		return nil, ErrInvalidObjectID
 	}
 
-	input := &models.DuplicateFormTemplateInput{
-		SourceID:   sourceID,
-		NameSuffix: " copy",
-		CreatedBy:  user.ID,
-		MerchantID: user.Merchant,
+	comparison, err := s.formService.CompareFormToTemplate(ctx, formID, user.Merchant)
+	if err != nil {
+		return nil, err
 	}
 
-	template, err := s.templateService.DuplicateTemplate(ctx, input)
+	changes, err := s.convertFieldChangesToProto(comparison.Changes)
 	if err != nil {
+		log.Error("Failed to convert schema changes to protobuf", log.Err(err))
 		return nil, err
 	}
 
-	pbTemplate, err := s.convertFormTemplateToProto(template)
+	return &pb.FormTemplateComparison{
+		FormId:              comparison.FormID.Hex(),
+		TemplateId:          comparison.TemplateID.Hex(),
+		FormTemplateVersion: helper.SafeInt32FromInt(comparison.FormVersion),
+		TemplateVersion:     helper.SafeInt32FromInt(comparison.TemplateVersion),
+		VersionsBehind:      helper.SafeInt32FromInt(comparison.VersionsBehind),
+		Changes:             changes,
+	}, nil
+}
+
+// CompareFormSchemas compares the schemas of two forms or templates of the caller's merchant
+func (s *GRPCFormServer) CompareFormSchemas(ctx context.Context, req *pb.CompareFormSchemasRequest) (*pb.SchemaComparison, error) {
+	user, err := ezgrpc.GetUser(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.DuplicateFormTemplateResponse{
-		Template: pbTemplate,
+	from, err := schemaSourceFromProto(req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := schemaSourceFromProto(req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison, err := s.formService.CompareSchemas(ctx, from, to, user.Merchant)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.convertFieldChangesToProto(comparison.Changes)
+	if err != nil {
+		log.Error("Failed to convert schema changes to protobuf", log.Err(err))
+		return nil, err
+	}
+
+	pbComparison := &pb.SchemaComparison{
+		From:    schemaSourceToProto(comparison.From),
+		To:      schemaSourceToProto(comparison.To),
+		Changes: changes,
+	}
+	for _, change := range comparison.Changes {
+		switch change.Type {
+		case schema.ChangeAdded:
+			pbComparison.Added++
+		case schema.ChangeRemoved:
+			pbComparison.Removed++
+		default:
+			pbComparison.Modified++
+		}
+	}
+	return pbComparison, nil
+}
+
+// GenerateUISchema generates a default UI Schema for a JSON Schema
+func (s *GRPCFormServer) GenerateUISchema(ctx context.Context, req *pb.GenerateUISchemaRequest) (*pb.GenerateUISchemaResponse, error) {
+	if req.Schema == nil {
+		return nil, ErrInvalidInput
+	}
+
+	uiSchema, err := s.templateService.GenerateUISchema(req.Schema.AsMap())
+	if err != nil {
+		return nil, err
+	}
+
+	pbUISchema, err := structpb.NewStruct(s.convertMongoDataToMap(uiSchema))
+	if err != nil {
+		log.Error("Failed to convert UI schema to protobuf", log.Err(err))
+		return nil, err
+	}
+
+	return &pb.GenerateUISchemaResponse{
+		Uischema: pbUISchema,
 	}, nil
 }
 
-// GetConfig returns configuration settings for the frontend
-func (s *GRPCFormServer) GetConfig(ctx context.Context, req *emptypb.Empty) (*pb.ConfigResponse, error) {
-	businessConfig, err := s.configService.GetBusinessConfig(ctx)
+// ValidateFormSchema checks a JSON Schema and its UI Schema and lints them for best practices
+func (s *GRPCFormServer) ValidateFormSchema(ctx context.Context, req *pb.ValidateFormSchemaRequest) (*pb.ValidateFormSchemaResponse, error) {
+	if req.Schema == nil {
+		return nil, ErrInvalidInput
+	}
+
+	var uiSchema interface{}
+	if req.Uischema != nil {
+		uiSchema = req.Uischema.AsMap()
+	}
+	result, err := s.templateService.ValidateSchema(req.Schema.AsMap(), uiSchema)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ConfigResponse{
-		MaxTemplatesPerMerchant: helper.SafeInt32FromInt(businessConfig.MaxTemplatesPerMerchant),
+	return &pb.ValidateFormSchemaResponse{
+		Valid:    result.Valid(),
+		Errors:   schemaIssuesToProto(result.Errors),
+		Warnings: schemaIssuesToProto(result.Warnings),
 	}, nil
 }
 
+func schemaIssuesToProto(issues []schema.Issue) []*pb.SchemaIssue {
+	pbIssues := make([]*pb.SchemaIssue, len(issues))
+	for i, issue := range issues {
+		pbIssues[i] = &pb.SchemaIssue{Field: issue.Field, Code: issue.Code, Message: issue.Message}
+	}
+	return pbIssues
+}
+
 /*
 // CreateForm creates a new form
 func (s *GRPCFormServer) CreateForm(ctx context.Context, req *pb.CreateFormRequest) (*pb.CreateFormResponse, error) {
@@ -332,6 +1874,7 @@ func (s *GRPCFormServer) ListForms(ctx context.Context, req *pb.ListFormsRequest
 			PageSize:   helper.SafeInt32FromInt(options.PageSize),
 			TotalCount: helper.SafeInt32FromInt64(totalCount),
 			TotalPages: helper.SafeInt32FromInt64(totalPages),
+			HasMore:    int64(options.Page)*int64(options.PageSize) < totalCount,
 		},
 	}, nil
 }
@@ -444,7 +1987,7 @@ func (s *GRPCFormServer) convertFormTemplateToProto(template *models.FormTemplat
 		}
 	}
 
-	return &pb.FormTemplate{
+	pbTemplate := &pb.FormTemplate{
 		Id:         template.ID.Hex(),
 		Name:       template.Name,
 		MerchantId: template.MerchantID,
@@ -454,10 +1997,246 @@ func (s *GRPCFormServer) convertFormTemplateToProto(template *models.FormTemplat
 		CreatedBy:  template.CreatedBy,
 		UpdatedAt:  timestamppb.New(template.GetUpdatedAt()),
 		UpdatedBy:  template.UpdatedBy,
+		Version:    helper.SafeInt32FromInt(template.CurrentVersion()),
+	}
+
+	if template.IsArchived() {
+		pbTemplate.ArchivedAt = timestamppb.New(*template.ArchivedAt)
+	}
+
+	return pbTemplate, nil
+}
+
+// convertFieldBlockToProto converts a field block model to protobuf
+func (s *GRPCFormServer) convertFieldBlockToProto(block *models.FieldBlock) (*pb.FieldBlock, error) {
+	var schemaStruct *structpb.Struct
+	if schemaMap := s.convertMongoDataToMap(block.Schema); schemaMap != nil {
+		var err error
+		schemaStruct, err = structpb.NewStruct(schemaMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.FieldBlock{
+		Id:          block.ID.Hex(),
+		MerchantId:  block.MerchantID,
+		Name:        block.Name,
+		Description: block.Description,
+		Schema:      schemaStruct,
+		CreatedAt:   timestamppb.New(block.GetCreatedAt()),
+		CreatedBy:   block.CreatedBy,
+		UpdatedAt:   timestamppb.New(block.GetUpdatedAt()),
+		UpdatedBy:   block.UpdatedBy,
 	}, nil
 }
 
-/*
+// convertConsistencyChecksToProto converts consistency checks to protobuf
+func (s *GRPCFormServer) convertConsistencyChecksToProto(checks []models.ConsistencyCheck) []*pb.ConsistencyCheck {
+	pbChecks := make([]*pb.ConsistencyCheck, len(checks))
+	for i, check := range checks {
+		pbChecks[i] = &pb.ConsistencyCheck{
+			Name:       check.Name,
+			Status:     check.Status,
+			ResourceId: check.ResourceID,
+			Message:    check.Message,
+		}
+	}
+	return pbChecks
+}
+
+// convertMerchantLimitsToProto converts a merchant's effective limits to protobuf
+func (s *GRPCFormServer) convertMerchantLimitsToProto(limits *models.EffectiveLimits) *pb.MerchantLimits {
+	pbLimits := &pb.MerchantLimits{
+		MerchantId:       limits.MerchantID,
+		MaxTemplates:     helper.SafeInt32FromInt(limits.MaxTemplates),
+		MaxFormsPerEvent: helper.SafeInt32FromInt(limits.MaxFormsPerEvent),
+	}
+	if o := limits.Overrides; o != nil {
+		pbLimits.MaxTemplatesOverride = helper.SafeInt32FromInt(o.MaxTemplates)
+		pbLimits.MaxFormsPerEventOverride = helper.SafeInt32FromInt(o.MaxFormsPerEvent)
+		pbLimits.UpdatedAt = timestamppb.New(o.GetUpdatedAt())
+		pbLimits.UpdatedBy = o.UpdatedBy
+	}
+	return pbLimits
+}
+
+// convertMerchantPurgeToProto converts a merchant purge to protobuf
+func (s *GRPCFormServer) convertMerchantPurgeToProto(purge *models.MerchantPurge) *pb.MerchantPurge {
+	pbPurge := &pb.MerchantPurge{
+		Id:          purge.ID.Hex(),
+		MerchantId:  purge.MerchantID,
+		Status:      string(purge.Status),
+		RequestedBy: purge.RequestedBy,
+		LastError:   purge.LastError,
+		CreatedAt:   timestamppb.New(purge.CreatedAt.Time()),
+		UpdatedAt:   timestamppb.New(purge.UpdatedAt.Time()),
+	}
+	for _, step := range purge.Steps {
+		pbPurge.Steps = append(pbPurge.Steps, &pb.MerchantPurgeStep{
+			Resource: step.Resource,
+			Deleted:  step.Deleted,
+			Done:     step.Done,
+		})
+	}
+	if purge.CompletedAt != nil {
+		pbPurge.CompletedAt = timestamppb.New(purge.CompletedAt.Time())
+	}
+	return pbPurge
+}
+
+// convertCollaboratorsToProto converts a form's collaborators to protobuf
+func (s *GRPCFormServer) convertCollaboratorsToProto(formID primitive.ObjectID, collaborators []models.Collaborator) *pb.FormCollaborators {
+	pbCollaborators := make([]*pb.FormCollaborator, len(collaborators))
+	for i, c := range collaborators {
+		pbCollaborators[i] = &pb.FormCollaborator{
+			UserId: c.UserID,
+			Role:   string(c.Role),
+		}
+	}
+	return &pb.FormCollaborators{
+		FormId:        formID.Hex(),
+		Collaborators: pbCollaborators,
+	}
+}
+
+// convertFormSubmissionToProto converts a FormSubmission model to protobuf
+func (s *GRPCFormServer) convertFormSubmissionToProto(submission *models.FormSubmission) (*pb.FormSubmission, error) {
+	pbSubmission := &pb.FormSubmission{
+		Id:            submission.ID.Hex(),
+		FormId:        submission.FormID.Hex(),
+		SchemaVersion: helper.SafeInt32FromInt(submission.SchemaVersion),
+		Source:        submission.Source,
+		ExternalId:    submission.ExternalID,
+		SubmittedBy:   submission.SubmittedBy,
+		SubmittedAt:   timestamppb.New(submission.GetSubmittedAt()),
+		CreatedAt:     timestamppb.New(submission.GetCreatedAt()),
+	}
+
+	if submission.Answers != nil {
+		answers, err := structpb.NewStruct(s.convertMongoDataToMap(submission.Answers))
+		if err != nil {
+			return nil, err
+		}
+		pbSubmission.Answers = answers
+	}
+
+	return pbSubmission, nil
+}
+
+// convertFormResponseStatsToProto converts form response statistics to protobuf
+func (s *GRPCFormServer) convertFormResponseStatsToProto(stats *models.FormResponseStats) *pb.FormResponseStats {
+	pbStats := &pb.FormResponseStats{
+		FormId:            stats.FormID.Hex(),
+		TotalResponses:    stats.TotalResponses,
+		CompleteResponses: stats.CompleteResponses,
+		CompletionRate:    stats.CompletionRate,
+		ChoiceFields:      make([]*pb.ChoiceFieldStats, len(stats.ChoiceFields)),
+		NumericFields:     make([]*pb.NumericFieldStats, len(stats.NumericFields)),
+		DailyCounts:       make([]*pb.DailyResponseCount, len(stats.DailyCounts)),
+	}
+
+	for i, field := range stats.ChoiceFields {
+		choices := make([]*pb.ChoiceCount, len(field.Choices))
+		for j, choice := range field.Choices {
+			choices[j] = &pb.ChoiceCount{
+				Value: choice.Value,
+				Count: choice.Count,
+			}
+		}
+		pbStats.ChoiceFields[i] = &pb.ChoiceFieldStats{
+			Field:   field.Field,
+			Choices: choices,
+		}
+	}
+
+	for i, field := range stats.NumericFields {
+		pbStats.NumericFields[i] = &pb.NumericFieldStats{
+			Field:   field.Field,
+			Count:   field.Count,
+			Average: field.Average,
+			Min:     field.Min,
+			Max:     field.Max,
+		}
+	}
+
+	for i, day := range stats.DailyCounts {
+		pbStats.DailyCounts[i] = &pb.DailyResponseCount{
+			Date:  day.Date,
+			Count: day.Count,
+		}
+	}
+
+	return pbStats
+}
+
+// schemaSourceFromProto converts a protobuf schema source to the model, parsing its IDs
+func schemaSourceFromProto(source *pb.SchemaSource) (models.SchemaSource, error) {
+	result := models.SchemaSource{Version: int(source.GetVersion())}
+	if id := source.GetFormId(); id != "" {
+		formID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return result, ErrInvalidObjectID
+		}
+		result.FormID = formID
+	}
+	if id := source.GetTemplateId(); id != "" {
+		templateID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return result, ErrInvalidObjectID
+		}
+		result.TemplateID = templateID
+	}
+	return result, nil
+}
+
+// schemaSourceToProto converts a schema source to protobuf
+func schemaSourceToProto(source models.SchemaSource) *pb.SchemaSource {
+	pbSource := &pb.SchemaSource{Version: helper.SafeInt32FromInt(source.Version)}
+	if source.IsForm() {
+		pbSource.FormId = source.FormID.Hex()
+	} else {
+		pbSource.TemplateId = source.TemplateID.Hex()
+	}
+	return pbSource
+}
+
+// convertFieldChangesToProto converts schema field changes to protobuf
+func (s *GRPCFormServer) convertFieldChangesToProto(changes []schema.FieldChange) ([]*pb.SchemaFieldChange, error) {
+	pbChanges := make([]*pb.SchemaFieldChange, len(changes))
+	for i, change := range changes {
+		pbChange := &pb.SchemaFieldChange{
+			Field:           change.Field,
+			ChangeType:      change.Type,
+			RequiredBefore:  change.RequiredBefore,
+			RequiredAfter:   change.RequiredAfter,
+			TypeBefore:      change.TypeBefore,
+			TypeAfter:       change.TypeAfter,
+			TypeChanged:     change.TypeChanged(),
+			RequiredChanged: change.RequiredChanged(),
+		}
+
+		if change.Before != nil {
+			before, err := structpb.NewValue(s.convertValue(change.Before))
+			if err != nil {
+				return nil, err
+			}
+			pbChange.Before = before
+		}
+
+		if change.After != nil {
+			after, err := structpb.NewValue(s.convertValue(change.After))
+			if err != nil {
+				return nil, err
+			}
+			pbChange.After = after
+		}
+
+		pbChanges[i] = pbChange
+	}
+	return pbChanges, nil
+}
+
 // convertFormToProto converts a form model to protobuf
 func (s *GRPCFormServer) convertFormToProto(form *models.Form) (*pb.Form, error) {
 	var schemaStruct *structpb.Struct
@@ -485,23 +2264,47 @@ func (s *GRPCFormServer) convertFormToProto(form *models.Form) (*pb.Form, error)
 	}
 
 	pbForm := &pb.Form{
-		Id:         form.ID.Hex(),
-		MerchantId: form.MerchantID,
-		Schema:     schemaStruct,
-		Uischema:   uiSchemaStruct,
-		CreatedAt:  timestamppb.New(form.GetCreatedAt()),
-		CreatedBy:  form.CreatedBy,
-		UpdatedAt:  timestamppb.New(form.GetUpdatedAt()),
-		UpdatedBy:  form.UpdatedBy,
+		Id:                  form.ID.Hex(),
+		MerchantId:          form.MerchantID,
+		Schema:              schemaStruct,
+		Uischema:            uiSchemaStruct,
+		CreatedAt:           timestamppb.New(form.GetCreatedAt()),
+		CreatedBy:           form.CreatedBy,
+		UpdatedAt:           timestamppb.New(form.GetUpdatedAt()),
+		UpdatedBy:           form.UpdatedBy,
+		Status:              string(form.CurrentStatus()),
+		SchemaVersion:       helper.SafeInt32FromInt(form.CurrentSchemaVersion()),
+		MaxResponses:        helper.SafeInt32FromInt(form.MaxResponses),
+		MaxResponsesPerUser: helper.SafeInt32FromInt(form.MaxPerUser),
+		SubmissionMode:      string(form.CurrentSubmissionMode()),
+		RetentionDays:       helper.SafeInt32FromInt(form.RetentionDays),
+		RetentionAction:     string(form.CurrentRetentionAction()),
 	}
 
 	if form.EventID != nil {
 		pbForm.EventId = form.EventID.Hex()
 	}
+	if form.HasTemplateID() {
+		pbForm.TemplateId = form.TemplateID.Hex()
+	}
+	if form.OpenAt != nil {
+		pbForm.OpenAt = timestamppb.New(*form.OpenAt)
+	}
+	if form.CloseAt != nil {
+		pbForm.CloseAt = timestamppb.New(*form.CloseAt)
+	}
+	if protection := form.SpamProtection; protection != nil {
+		pbForm.SpamProtection = &pb.SpamProtection{
+			HoneypotField:   protection.HoneypotField,
+			MinFillSeconds:  helper.SafeInt32FromInt(protection.MinFillSeconds),
+			MaxPerIpPerHour: helper.SafeInt32FromInt(protection.MaxPerIPPerHour),
+			CaptchaProvider: string(protection.CaptchaProvider),
+			CaptchaSiteKey:  protection.CaptchaSiteKey,
+		}
+	}
 
 	return pbForm, nil
 }
-*/
 
 // convertMongoDataToMap converts MongoDB primitive types to map[string]interface{}
 func (s *GRPCFormServer) convertMongoDataToMap(data interface{}) map[string]interface{} {
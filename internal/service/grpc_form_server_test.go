@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/arwoosa/form/gen/pb/form"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// merchantContext carries the caller identity the gateway forwards as gRPC metadata
+func merchantContext(userID, merchantID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", userID, "merchant-id", merchantID))
+}
+
+func TestGRPCFormServer_GetFormsByIDs(t *testing.T) {
+	formService, mockFormRepo, _, _ := setupFormService()
+	server := &GRPCFormServer{formService: formService}
+	form := createTestForm()
+	form.UISchema = map[string]interface{}{"ui:order": []interface{}{}} // As decoded from the database
+	missing := primitive.NewObjectID()
+
+	mockFormRepo.On("FindByIDs", mock.Anything, []primitive.ObjectID{form.ID, missing}).
+		Return([]*models.Form{form}, nil)
+
+	resp, err := server.GetFormsByIDs(merchantContext("user123", form.MerchantID), &pb.GetFormsByIDsRequest{
+		Ids: []string{form.ID.Hex(), missing.Hex()},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	assert.True(t, resp.Results[0].Found)
+	assert.Equal(t, form.ID.Hex(), resp.Results[0].Form.Id)
+	assert.False(t, resp.Results[1].Found)
+	assert.Equal(t, missing.Hex(), resp.Results[1].Id)
+	assert.Nil(t, resp.Results[1].Form)
+}
+
+func TestGRPCFormServer_GetFormsByIDs_Unauthenticated(t *testing.T) {
+	formService, mockFormRepo, _, _ := setupFormService()
+	server := &GRPCFormServer{formService: formService}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+	_, err := server.GetFormsByIDs(ctx, &pb.GetFormsByIDsRequest{Ids: []string{primitive.NewObjectID().Hex()}})
+	assert.Equal(t, ErrUnauthorized, err)
+
+	_, err = server.GetFormsByIDs(merchantContext("user123", ""), &pb.GetFormsByIDsRequest{Ids: []string{primitive.NewObjectID().Hex()}})
+	assert.Equal(t, ErrUnauthorized, err)
+
+	mockFormRepo.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+}
+
+func TestGRPCFormServer_GetFormsByIDs_InvalidID(t *testing.T) {
+	formService, _, _, _ := setupFormService()
+	server := &GRPCFormServer{formService: formService}
+
+	_, err := server.GetFormsByIDs(merchantContext("user123", "merchant123"), &pb.GetFormsByIDsRequest{Ids: []string{"not-an-id"}})
+	assert.Equal(t, ErrInvalidObjectID, err)
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	pb "github.com/arwoosa/form/gen/pb/form"
+	"github.com/arwoosa/form/internal/etag"
+)
+
+// GRPCPublicFormServer implements the PublicFormService gRPC interface for storefront users
+type GRPCPublicFormServer struct {
+	pb.UnimplementedPublicFormServiceServer
+	formService *FormService
+	spam        *SpamGuard
+}
+
+// NewGRPCPublicFormServer creates a new public form gRPC server. spam issues the submission
+// tokens of forms with a minimum fill time.
+func NewGRPCPublicFormServer(formService *FormService, spam *SpamGuard) *GRPCPublicFormServer {
+	return &GRPCPublicFormServer{
+		formService: formService,
+		spam:        spam,
+	}
+}
+
+// GetPublicForm gets the schema and UI schema of a published event form. The response is tagged
+// with an ETag, and an empty form is returned when it matches the request's If-None-Match, unless
+// the response carries a submission token: tokens are single use, so each load needs a new one.
+func (s *GRPCPublicFormServer) GetPublicForm(ctx context.Context, req *pb.GetPublicFormRequest) (*pb.PublicForm, error) {
+	formID, err := primitive.ObjectIDFromHex(req.FormId)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	form, err := s.formService.GetPublicForm(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accepting submissions changes with the access window, without the form being updated
+	accepting := form.AcceptsSubmissionsAt(time.Now())
+	submissionToken := s.spam.SubmissionToken(form)
+	if submissionToken == "" {
+		tag := etag.Compute(form.ID.Hex(), strconv.FormatInt(int64(form.UpdatedAt), 10), strconv.FormatBool(accepting))
+		if etag.Matches(ctx, tag) {
+			if err := etag.SetHeader(ctx, tag, true); err != nil {
+				log.Warn("Failed to set not modified header", log.Err(err))
+			}
+			return &pb.PublicForm{}, nil
+		}
+		if err := etag.SetHeader(ctx, tag, false); err != nil {
+			log.Warn("Failed to set ETag header", log.Err(err))
+		}
+	}
+
+	// Reuse the console conversion and copy over only the fields safe to expose
+	pbForm, err := (&GRPCFormServer{}).convertFormToProto(form)
+	if err != nil {
+		return nil, err
+	}
+
+	publicForm := &pb.PublicForm{
+		Id:                   pbForm.Id,
+		EventId:              pbForm.EventId,
+		Schema:               pbForm.Schema,
+		Uischema:             pbForm.Uischema,
+		SchemaVersion:        pbForm.SchemaVersion,
+		SubmissionMode:       pbForm.SubmissionMode,
+		OpenAt:               pbForm.OpenAt,
+		CloseAt:              pbForm.CloseAt,
+		AcceptingSubmissions: accepting,
+		SubmissionToken:      submissionToken,
+	}
+	// The limits of the spam protection stay private; respondents only need what to render
+	if protection := form.SpamProtection; protection != nil {
+		publicForm.HoneypotField = protection.HoneypotField
+		publicForm.CaptchaProvider = string(protection.CaptchaProvider)
+		publicForm.CaptchaSiteKey = protection.CaptchaSiteKey
+	}
+	return publicForm, nil
+}
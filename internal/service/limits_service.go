@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+	"github.com/arwoosa/vulpes/validate"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/cache"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// defaultLimitsCacheTTL is used when the configuration does not set how long overrides are cached
+const defaultLimitsCacheTTL = time.Minute
+
+// LimitsService resolves the business rule limits of a merchant from the platform defaults and
+// the per-merchant overrides managed by platform operators
+type LimitsService struct {
+	limitsRepo    repository.LimitsRepository
+	config        *conf.AppConfig
+	cache         *cache.TTL[*models.MerchantLimits] // nil entries record merchants without overrides
+	checkRelation relationCheckFunc
+}
+
+// NewLimitsService creates a new limits service
+func NewLimitsService(limitsRepo repository.LimitsRepository, config *conf.AppConfig) *LimitsService {
+	ttl := defaultLimitsCacheTTL
+	if config != nil && config.BusinessRulesConfig != nil && config.BusinessRulesConfig.LimitsCacheTTL > 0 {
+		ttl = config.BusinessRulesConfig.LimitsCacheTTL
+	}
+
+	return &LimitsService{
+		limitsRepo:    limitsRepo,
+		config:        config,
+		cache:         cache.NewTTL[*models.MerchantLimits](ttl),
+		checkRelation: relation.Check,
+	}
+}
+
+// GetLimits returns the limits applied to a merchant. Overrides are cached for the configured
+// TTL, so changes made through another instance take effect once the cached entry expires.
+func (s *LimitsService) GetLimits(ctx context.Context, merchantID string) (*models.EffectiveLimits, error) {
+	overrides, err := s.overrides(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+	return s.resolve(merchantID, overrides), nil
+}
+
+// GetMerchantLimits returns a merchant's effective limits and overrides (admin only)
+func (s *LimitsService) GetMerchantLimits(ctx context.Context, merchantID, userID string) (*models.EffectiveLimits, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "get_merchant_limits", merchantID); err != nil {
+		return nil, err
+	}
+
+	overrides, err := s.limitsRepo.FindByMerchantID(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to get merchant limits", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+	s.store(merchantID, overrides)
+
+	return s.resolve(merchantID, overrides), nil
+}
+
+// SetMerchantLimits creates or replaces a merchant's limit overrides (admin only)
+func (s *LimitsService) SetMerchantLimits(ctx context.Context, input *models.SetMerchantLimitsInput) (*models.EffectiveLimits, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, input.UpdatedBy, "set_merchant_limits", input.MerchantID); err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("SetMerchantLimits validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	overrides := &models.MerchantLimits{
+		MerchantID:       input.MerchantID,
+		MaxTemplates:     input.MaxTemplates,
+		MaxFormsPerEvent: input.MaxFormsPerEvent,
+		UpdatedBy:        input.UpdatedBy,
+	}
+	if err := s.limitsRepo.Upsert(ctx, overrides); err != nil {
+		log.Error("Failed to save merchant limits", log.Err(err), log.String("merchant_id", input.MerchantID))
+		return nil, ErrInternalError
+	}
+	s.store(input.MerchantID, overrides)
+
+	log.Info("Merchant limits updated",
+		log.String("merchant_id", input.MerchantID),
+		log.Int("max_templates", input.MaxTemplates),
+		log.Int("max_forms_per_event", input.MaxFormsPerEvent),
+		log.String("updated_by", input.UpdatedBy))
+
+	return s.resolve(input.MerchantID, overrides), nil
+}
+
+// DeleteMerchantLimits removes a merchant's overrides so the platform defaults apply again (admin only)
+func (s *LimitsService) DeleteMerchantLimits(ctx context.Context, merchantID, userID string) error {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "delete_merchant_limits", merchantID); err != nil {
+		return err
+	}
+
+	if err := s.limitsRepo.Delete(ctx, merchantID); err != nil {
+		log.Error("Failed to delete merchant limits", log.Err(err), log.String("merchant_id", merchantID))
+		return ErrInternalError
+	}
+	s.store(merchantID, nil)
+
+	log.Info("Merchant limits deleted",
+		log.String("merchant_id", merchantID),
+		log.String("deleted_by", userID))
+
+	return nil
+}
+
+// ListMerchantLimits lists the merchants with overrides and their effective limits (admin only)
+func (s *LimitsService) ListMerchantLimits(ctx context.Context, options *models.MerchantLimitsQueryOptions, userID string) ([]*models.EffectiveLimits, int64, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, userID, "list_merchant_limits", ""); err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination defaults
+	if options.Page <= 0 {
+		options.Page = 1
+	}
+	if options.PageSize <= 0 {
+		options.PageSize = s.config.PaginationConfig.DefaultPageSize
+	}
+	if options.PageSize > s.config.PaginationConfig.MaxPageSize {
+		options.PageSize = s.config.PaginationConfig.MaxPageSize
+	}
+
+	overrides, count, err := s.limitsRepo.List(ctx, options.Page, options.PageSize)
+	if err != nil {
+		log.Error("Failed to list merchant limits", log.Err(err))
+		return nil, 0, ErrInternalError
+	}
+
+	limits := make([]*models.EffectiveLimits, len(overrides))
+	for i, o := range overrides {
+		limits[i] = s.resolve(o.MerchantID, o)
+	}
+	return limits, count, nil
+}
+
+// overrides returns a merchant's overrides, from the cache when possible
+func (s *LimitsService) overrides(ctx context.Context, merchantID string) (*models.MerchantLimits, error) {
+	if overrides, ok := s.cache.Get(merchantID); ok {
+		return overrides, nil
+	}
+
+	overrides, err := s.limitsRepo.FindByMerchantID(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to get merchant limits", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+	s.store(merchantID, overrides)
+	return overrides, nil
+}
+
+// store caches a merchant's overrides
+func (s *LimitsService) store(merchantID string, overrides *models.MerchantLimits) {
+	s.cache.Set(merchantID, overrides)
+}
+
+// resolve applies a merchant's overrides on top of the platform defaults
+func (s *LimitsService) resolve(merchantID string, overrides *models.MerchantLimits) *models.EffectiveLimits {
+	limits := &models.EffectiveLimits{
+		MerchantID: merchantID,
+		Overrides:  overrides,
+	}
+	if rules := s.config.BusinessRulesConfig; rules != nil {
+		limits.MaxTemplates = rules.MaxTemplatesPerMerchant
+		limits.MaxFormsPerEvent = rules.MaxFormsPerEvent
+	}
+
+	if overrides != nil {
+		if overrides.MaxTemplates > 0 {
+			limits.MaxTemplates = overrides.MaxTemplates
+		}
+		if overrides.MaxFormsPerEvent > 0 {
+			limits.MaxFormsPerEvent = overrides.MaxFormsPerEvent
+		}
+	}
+	return limits
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Mock LimitsRepository
+type MockLimitsRepository struct {
+	mock.Mock
+}
+
+func (m *MockLimitsRepository) FindByMerchantID(ctx context.Context, merchantID string) (*models.MerchantLimits, error) {
+	args := m.Called(ctx, merchantID)
+	return args.Get(0).(*models.MerchantLimits), args.Error(1)
+}
+
+func (m *MockLimitsRepository) Upsert(ctx context.Context, limits *models.MerchantLimits) error {
+	args := m.Called(ctx, limits)
+	return args.Error(0)
+}
+
+func (m *MockLimitsRepository) Delete(ctx context.Context, merchantID string) error {
+	args := m.Called(ctx, merchantID)
+	return args.Error(0)
+}
+
+func (m *MockLimitsRepository) List(ctx context.Context, page, pageSize int) ([]*models.MerchantLimits, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]*models.MerchantLimits), args.Get(1).(int64), args.Error(2)
+}
+
+// Test setup helper for LimitsService
+func setupLimitsService() (*LimitsService, *MockLimitsRepository) {
+	mockLimitsRepo := &MockLimitsRepository{}
+	config := &conf.AppConfig{
+		PaginationConfig: &conf.PaginationConfig{
+			DefaultPageSize: 20,
+			MaxPageSize:     100,
+		},
+		BusinessRulesConfig: &conf.BusinessRulesConfig{
+			MaxTemplatesPerMerchant: 3,
+			MaxFormsPerEvent:        5,
+		},
+		AdminConfig: &conf.AdminConfig{
+			UserIDs: []string{"admin1"},
+		},
+	}
+	return NewLimitsService(mockLimitsRepo, config), mockLimitsRepo
+}
+
+// newDefaultLimitsService creates a limits service for merchants without overrides
+func newDefaultLimitsService(config *conf.AppConfig) *LimitsService {
+	mockLimitsRepo := &MockLimitsRepository{}
+	mockLimitsRepo.On("FindByMerchantID", mock.Anything, mock.Anything).Return((*models.MerchantLimits)(nil), nil).Maybe()
+	return NewLimitsService(mockLimitsRepo, config)
+}
+
+func TestLimitsService_GetLimits_Defaults(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	ctx := context.Background()
+
+	mockLimitsRepo.On("FindByMerchantID", ctx, "merchant123").Return((*models.MerchantLimits)(nil), nil).Once()
+
+	limits, err := service.GetLimits(ctx, "merchant123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, limits.MaxTemplates)
+	assert.Equal(t, 5, limits.MaxFormsPerEvent)
+	assert.Nil(t, limits.Overrides)
+	mockLimitsRepo.AssertExpectations(t)
+}
+
+func TestLimitsService_GetLimits_OverridesCached(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	ctx := context.Background()
+
+	overrides := &models.MerchantLimits{MerchantID: "merchant123", MaxTemplates: 50}
+	mockLimitsRepo.On("FindByMerchantID", ctx, "merchant123").Return(overrides, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		limits, err := service.GetLimits(ctx, "merchant123")
+
+		require.NoError(t, err)
+		assert.Equal(t, 50, limits.MaxTemplates)
+		assert.Equal(t, 5, limits.MaxFormsPerEvent, "unset overrides keep the platform default")
+	}
+	mockLimitsRepo.AssertExpectations(t)
+}
+
+func TestLimitsService_GetLimits_RepositoryError(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	ctx := context.Background()
+
+	mockLimitsRepo.On("FindByMerchantID", ctx, "merchant123").Return((*models.MerchantLimits)(nil), errors.New("database error"))
+
+	limits, err := service.GetLimits(ctx, "merchant123")
+
+	assert.Nil(t, limits)
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestLimitsService_SetMerchantLimits_Success(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	ctx := context.Background()
+
+	mockLimitsRepo.On("Upsert", ctx, mock.MatchedBy(func(l *models.MerchantLimits) bool {
+		return l.MerchantID == "merchant123" && l.MaxTemplates == 10 && l.MaxFormsPerEvent == 0 && l.UpdatedBy == "admin1"
+	})).Return(nil)
+
+	limits, err := service.SetMerchantLimits(ctx, &models.SetMerchantLimitsInput{
+		MerchantID:   "merchant123",
+		MaxTemplates: 10,
+		UpdatedBy:    "admin1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, limits.MaxTemplates)
+	assert.Equal(t, 5, limits.MaxFormsPerEvent)
+
+	// The new overrides are served from the cache without another lookup
+	cached, err := service.GetLimits(ctx, "merchant123")
+	require.NoError(t, err)
+	assert.Equal(t, 10, cached.MaxTemplates)
+	mockLimitsRepo.AssertExpectations(t)
+}
+
+func TestLimitsService_SetMerchantLimits_NotAdmin(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+
+	limits, err := service.SetMerchantLimits(context.Background(), &models.SetMerchantLimitsInput{
+		MerchantID:   "merchant123",
+		MaxTemplates: 10,
+		UpdatedBy:    "user123",
+	})
+
+	assert.Nil(t, limits)
+	assert.Equal(t, ErrPermissionDenied, err)
+	mockLimitsRepo.AssertNotCalled(t, "Upsert")
+}
+
+func TestLimitsService_SetMerchantLimits_ValidationError(t *testing.T) {
+	service, _ := setupLimitsService()
+
+	limits, err := service.SetMerchantLimits(context.Background(), &models.SetMerchantLimitsInput{
+		MerchantID:   "merchant123",
+		MaxTemplates: -1,
+		UpdatedBy:    "admin1",
+	})
+
+	assert.Nil(t, limits)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestLimitsService_DeleteMerchantLimits_Success(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	ctx := context.Background()
+
+	service.store("merchant123", &models.MerchantLimits{MerchantID: "merchant123", MaxTemplates: 10})
+	mockLimitsRepo.On("Delete", ctx, "merchant123").Return(nil)
+
+	err := service.DeleteMerchantLimits(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+
+	limits, err := service.GetLimits(ctx, "merchant123")
+	require.NoError(t, err)
+	assert.Equal(t, 3, limits.MaxTemplates)
+	mockLimitsRepo.AssertExpectations(t)
+}
+
+func TestLimitsService_ListMerchantLimits_Success(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	ctx := context.Background()
+
+	overrides := []*models.MerchantLimits{
+		{MerchantID: "merchant1", MaxFormsPerEvent: 20},
+		{MerchantID: "merchant2", MaxTemplates: 8},
+	}
+	mockLimitsRepo.On("List", ctx, 1, 20).Return(overrides, int64(2), nil)
+
+	options := &models.MerchantLimitsQueryOptions{}
+	limits, count, err := service.ListMerchantLimits(ctx, options, "admin1")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	require.Len(t, limits, 2)
+	assert.Equal(t, 20, limits[0].MaxFormsPerEvent)
+	assert.Equal(t, 3, limits[0].MaxTemplates)
+	assert.Equal(t, 8, limits[1].MaxTemplates)
+	assert.Equal(t, 20, options.PageSize)
+}
+
+func TestLimitsService_GetMerchantLimits_NotAdmin(t *testing.T) {
+	service, _ := setupLimitsService()
+
+	limits, err := service.GetMerchantLimits(context.Background(), "merchant123", "user123")
+
+	assert.Nil(t, limits)
+	assert.Equal(t, ErrPermissionDenied, err)
+}
+
+func TestLimitsService_DeleteMerchantLimits_KetoAdmin(t *testing.T) {
+	service, mockLimitsRepo := setupLimitsService()
+	service.config.AdminConfig.KetoNamespace = "Platform"
+	service.config.AdminConfig.KetoObject = "form"
+	service.config.AdminConfig.KetoRelation = "admin"
+	service.checkRelation = func(ctx context.Context, namespace, object, relation, subjectNamespace, subjectObject string) (bool, error) {
+		return namespace == "Platform" && object == "form" && relation == "admin" && subjectObject == "support1", nil
+	}
+	ctx := context.Background()
+
+	mockLimitsRepo.On("Delete", ctx, "merchant123").Return(nil).Once()
+
+	assert.NoError(t, service.DeleteMerchantLimits(ctx, "merchant123", "support1"))
+	assert.Equal(t, ErrPermissionDenied, service.DeleteMerchantLimits(ctx, "merchant123", "user123"))
+	mockLimitsRepo.AssertExpectations(t)
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/job"
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/storage"
+)
+
+// MerchantPurgeJob is the job type running merchant data purges
+const MerchantPurgeJob = "merchant_purge"
+
+const (
+	// defaultPurgeBatchSize is the number of documents deleted per batch when not configured
+	defaultPurgeBatchSize = 500
+	// defaultPurgeRunTime is the work done per job run when not configured
+	defaultPurgeRunTime = time.Minute
+)
+
+// jobEnqueuer queues background jobs
+type jobEnqueuer interface {
+	Enqueue(ctx context.Context, jobType string, payload map[string]interface{}, runAt time.Time) (*models.Job, error)
+}
+
+// objectDeleteFunc deletes all Keto relation tuples of an object
+type objectDeleteFunc func(ctx context.Context, namespace, objectID string) error
+
+// MerchantPurgeService deletes all data of a merchant when their contract ends or on a GDPR
+// erasure request. Purges run as queued jobs in batches and record their progress, so an
+// interrupted purge resumes where it stopped.
+type MerchantPurgeService struct {
+	purgeRepo     repository.MerchantPurgeRepository
+	formService   *FormService
+	storage       storage.Storage
+	queue         jobEnqueuer
+	config        *conf.AppConfig
+	checkRelation relationCheckFunc
+	deleteObject  objectDeleteFunc
+	now           func() time.Time
+}
+
+// NewMerchantPurgeService creates a new merchant purge service. storage may be nil when file
+// uploads are not configured.
+func NewMerchantPurgeService(purgeRepo repository.MerchantPurgeRepository, formService *FormService, store storage.Storage, queue jobEnqueuer, config *conf.AppConfig) *MerchantPurgeService {
+	return &MerchantPurgeService{
+		purgeRepo:     purgeRepo,
+		formService:   formService,
+		storage:       store,
+		queue:         queue,
+		config:        config,
+		checkRelation: relation.Check,
+		deleteObject:  relation.DeleteObjectId,
+		now:           time.Now,
+	}
+}
+
+// PurgeMerchantData starts deleting all forms, templates, responses, files and Keto tuples of a
+// merchant for a platform admin. A purge already in progress is returned instead of starting
+// another, and a failed purge is resumed from its progress.
+func (s *MerchantPurgeService) PurgeMerchantData(ctx context.Context, merchantID, adminID string) (*models.MerchantPurge, error) {
+	if merchantID == "" {
+		return nil, fmt.Errorf("%w: merchant_id is required", ErrInvalidInput)
+	}
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, adminID, "purge_merchant_data", merchantID); err != nil {
+		return nil, err
+	}
+
+	latest, err := s.purgeRepo.FindLatest(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to get merchant purge", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+	if latest != nil && latest.Active() {
+		return latest, nil
+	}
+
+	purge := latest
+	if purge != nil && purge.Status == models.PurgeStatusFailed {
+		purge.Status = models.PurgeStatusPending
+		purge.LastError = ""
+		err = s.purgeRepo.Update(ctx, purge)
+	} else {
+		purge = models.NewMerchantPurge(merchantID, adminID)
+		err = s.purgeRepo.Create(ctx, purge)
+	}
+	if err != nil {
+		log.Error("Failed to save merchant purge", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+
+	if err := s.enqueue(ctx, purge); err != nil {
+		purge.Status = models.PurgeStatusFailed
+		purge.LastError = err.Error()
+		if updateErr := s.purgeRepo.Update(ctx, purge); updateErr != nil {
+			log.Error("Failed to save merchant purge", log.Err(updateErr), log.String("purge_id", purge.ID.Hex()))
+		}
+		return nil, ErrInternalError
+	}
+
+	auditAdminAccess(adminID, "purge_merchant_data", merchantID, purge.ID.Hex())
+	return purge, nil
+}
+
+// GetMerchantPurge reports the progress of a purge to a platform admin
+func (s *MerchantPurgeService) GetMerchantPurge(ctx context.Context, purgeID primitive.ObjectID, adminID string) (*models.MerchantPurge, error) {
+	if err := authorizePlatformAdmin(ctx, s.config, s.checkRelation, adminID, "get_merchant_purge", ""); err != nil {
+		return nil, err
+	}
+
+	purge, err := s.purgeRepo.FindByID(ctx, purgeID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrPurgeNotFound
+	}
+	if err != nil {
+		log.Error("Failed to get merchant purge", log.Err(err), log.String("purge_id", purgeID.Hex()))
+		return nil, ErrInternalError
+	}
+	return purge, nil
+}
+
+// RunPurgeJob is the job handler of MerchantPurgeJob. It deletes batches until the purge is done
+// or the configured run time is used up, then queues a new job for the rest, so no run outlasts
+// its lease. Progress is saved after every batch.
+func (s *MerchantPurgeService) RunPurgeJob(ctx context.Context, j *models.Job) error {
+	purgeID, err := primitive.ObjectIDFromHex(fmt.Sprint(j.Payload["purge_id"]))
+	if err != nil {
+		return job.Permanent(fmt.Errorf("invalid purge_id: %w", err))
+	}
+
+	purge, err := s.purgeRepo.FindByID(ctx, purgeID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return job.Permanent(ErrPurgeNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	if !purge.Active() {
+		return nil
+	}
+
+	if err := s.run(ctx, purge); err != nil {
+		purge.LastError = err.Error()
+		if j.Attempts >= j.MaxAttempts {
+			purge.Status = models.PurgeStatusFailed
+		}
+		if updateErr := s.purgeRepo.Update(ctx, purge); updateErr != nil {
+			log.Error("Failed to save merchant purge", log.Err(updateErr), log.String("purge_id", purge.ID.Hex()))
+		}
+		log.Error("Merchant purge batch failed", log.Err(err),
+			log.String("purge_id", purge.ID.Hex()),
+			log.String("merchant_id", purge.MerchantID))
+		return err
+	}
+	return nil
+}
+
+// run deletes the remaining data of a purge until it is done or the run time is used up
+func (s *MerchantPurgeService) run(ctx context.Context, purge *models.MerchantPurge) error {
+	batchSize, runTime := defaultPurgeBatchSize, defaultPurgeRunTime
+	if s.config != nil && s.config.JobsConfig != nil {
+		cfg := s.config.JobsConfig.Purge
+		if cfg.BatchSize > 0 {
+			batchSize = cfg.BatchSize
+		}
+		if cfg.RunTime > 0 {
+			runTime = cfg.RunTime
+		}
+	}
+	deadline := s.now().Add(runTime)
+
+	purge.Status = models.PurgeStatusRunning
+	for i := range purge.Steps {
+		step := &purge.Steps[i]
+		for !step.Done {
+			if s.now().After(deadline) {
+				if err := s.purgeRepo.Update(ctx, purge); err != nil {
+					return err
+				}
+				return s.enqueue(ctx, purge)
+			}
+
+			deleted, done, err := s.purgeBatch(ctx, purge.MerchantID, step.Resource, batchSize)
+			if err != nil {
+				return fmt.Errorf("purge %s: %w", step.Resource, err)
+			}
+			step.Deleted += deleted
+			step.Done = done
+			if err := s.purgeRepo.Update(ctx, purge); err != nil {
+				return err
+			}
+		}
+	}
+
+	now := primitive.NewDateTimeFromTime(s.now())
+	purge.Status = models.PurgeStatusCompleted
+	purge.LastError = ""
+	purge.CompletedAt = &now
+	if err := s.purgeRepo.Update(ctx, purge); err != nil {
+		return err
+	}
+
+	log.Info("Audit: merchant data purged",
+		log.String("audit", "merchant_purge_completed"),
+		log.String("purge_id", purge.ID.Hex()),
+		log.String("merchant_id", purge.MerchantID),
+		log.String("requested_by", purge.RequestedBy))
+	return nil
+}
+
+// purgeBatch deletes the next batch of a merchant's documents in a collection, with the storage
+// objects of files and the Keto tuples of forms and templates. It reports whether the collection
+// holds no more documents of the merchant.
+func (s *MerchantPurgeService) purgeBatch(ctx context.Context, merchantID, collection string, limit int) (int64, bool, error) {
+	documents, err := s.purgeRepo.NextBatch(ctx, collection, merchantID, limit)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(documents) == 0 {
+		return 0, true, nil
+	}
+
+	ids := make([]primitive.ObjectID, len(documents))
+	for i, document := range documents {
+		ids[i] = document.ID
+	}
+
+	// External data goes first: if it fails, the documents are kept and the batch is retried
+	switch collection {
+	case models.FileReference{}.TableName():
+		for _, document := range documents {
+			if s.storage == nil || document.Key == "" {
+				continue
+			}
+			if err := s.storage.Delete(ctx, document.Key); err != nil {
+				return 0, false, err
+			}
+		}
+	case models.Form{}.TableName():
+		if err := s.deleteRelations(ctx, "Form", ids); err != nil {
+			return 0, false, err
+		}
+		if err := s.purgeRepo.DeleteResponseCounters(ctx, ids); err != nil {
+			return 0, false, err
+		}
+	case models.FormTemplate{}.TableName():
+		if err := s.deleteRelations(ctx, "FormTemplate", ids); err != nil {
+			return 0, false, err
+		}
+	}
+
+	deleted, err := s.purgeRepo.DeleteBatch(ctx, collection, merchantID, ids)
+	if err != nil {
+		return 0, false, err
+	}
+	if collection == (models.Form{}).TableName() && s.formService != nil {
+		for _, id := range ids {
+			s.formService.invalidatePublicForm(ctx, id)
+		}
+	}
+	return deleted, len(documents) < limit, nil
+}
+
+// deleteRelations deletes the Keto tuples of the objects. Without a Keto connection no tuples
+// were written, so there is nothing to delete.
+func (s *MerchantPurgeService) deleteRelations(ctx context.Context, namespace string, ids []primitive.ObjectID) error {
+	for _, id := range ids {
+		err := s.deleteObject(ctx, namespace, id.Hex())
+		if errors.Is(err, relation.ErrWriteConnectNotInitialed) {
+			return nil
+		}
+		if err != nil {
+			metrics.KetoWriteFailures.WithLabelValues(namespace, "delete_object").Inc()
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueue queues a job running the purge
+func (s *MerchantPurgeService) enqueue(ctx context.Context, purge *models.MerchantPurge) error {
+	if _, err := s.queue.Enqueue(ctx, MerchantPurgeJob, map[string]interface{}{"purge_id": purge.ID.Hex()}, time.Time{}); err != nil {
+		log.Error("Failed to queue merchant purge", log.Err(err), log.String("purge_id", purge.ID.Hex()))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/memory"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// fakeQueue records the jobs enqueued by a service
+type fakeQueue struct {
+	jobs []*models.Job
+	err  error
+}
+
+func (q *fakeQueue) Enqueue(_ context.Context, jobType string, payload map[string]interface{}, runAt time.Time) (*models.Job, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	job := &models.Job{ID: primitive.NewObjectID(), Type: jobType, Payload: payload, MaxAttempts: 3, RunAt: runAt, Attempts: 1}
+	q.jobs = append(q.jobs, job)
+	return job, nil
+}
+
+// purgeFixture holds a MerchantPurgeService on an in-memory database seeded with two merchants
+type purgeFixture struct {
+	service        *MerchantPurgeService
+	forms          repository.FormRepository
+	templates      repository.FormTemplateRepository
+	submissions    repository.FormSubmissionRepository
+	queue          *fakeQueue
+	storage        *fakeStorage
+	deletedObjects []string
+	formID         primitive.ObjectID
+	otherFormID    primitive.ObjectID
+}
+
+func setupMerchantPurgeService(t *testing.T) *purgeFixture {
+	ctx := context.Background()
+	store := memory.NewStore()
+	f := &purgeFixture{
+		forms:       memory.NewFormRepository(store),
+		templates:   memory.NewFormTemplateRepository(store),
+		submissions: memory.NewFormSubmissionRepository(store),
+		queue:       &fakeQueue{},
+		storage:     &fakeStorage{objects: map[string]int64{"merchant123/file.pdf": 10}},
+	}
+	config := &conf.AppConfig{
+		AdminConfig: &conf.AdminConfig{UserIDs: []string{"admin1"}},
+		JobsConfig:  &conf.JobsConfig{Purge: conf.PurgeJobConfig{BatchSize: 2}},
+	}
+	f.service = NewMerchantPurgeService(memory.NewMerchantPurgeRepository(store), nil, f.storage, f.queue, config)
+	f.service.deleteObject = func(ctx context.Context, namespace, objectID string) error {
+		f.deletedObjects = append(f.deletedObjects, namespace+":"+objectID)
+		return nil
+	}
+
+	template := &models.FormTemplate{Name: "Template", MerchantID: "merchant123"}
+	require.NoError(t, f.templates.Create(ctx, template))
+	for i := 0; i < 3; i++ {
+		form := &models.Form{MerchantID: "merchant123", CreatedBy: "user123"}
+		require.NoError(t, f.forms.Create(ctx, form))
+		f.formID = form.ID
+		require.NoError(t, f.submissions.Create(ctx, &models.FormSubmission{FormID: form.ID, MerchantID: "merchant123"}))
+	}
+	_, err := f.submissions.ReserveResponse(ctx, f.formID, "", 10)
+	require.NoError(t, err)
+	require.NoError(t, memory.NewFormFileRepository(store).Create(ctx, &models.FileReference{
+		FormID: f.formID, MerchantID: "merchant123", Key: "merchant123/file.pdf",
+	}))
+
+	other := &models.Form{MerchantID: "merchant456", CreatedBy: "user456"}
+	require.NoError(t, f.forms.Create(ctx, other))
+	f.otherFormID = other.ID
+	return f
+}
+
+// runJobs runs the queued purge jobs until none is left
+func (f *purgeFixture) runJobs(t *testing.T) {
+	for len(f.queue.jobs) > 0 {
+		job := f.queue.jobs[0]
+		f.queue.jobs = f.queue.jobs[1:]
+		require.NoError(t, f.service.RunPurgeJob(context.Background(), job))
+	}
+}
+
+// purgeStep returns the progress of a purge in a collection
+func purgeStep(t *testing.T, purge *models.MerchantPurge, resource string) models.PurgeStep {
+	for _, step := range purge.Steps {
+		if step.Resource == resource {
+			return step
+		}
+	}
+	require.Failf(t, "missing purge step", "no step for %s", resource)
+	return models.PurgeStep{}
+}
+
+func TestMerchantPurgeService_PurgeMerchantData_DeletesMerchantData(t *testing.T) {
+	ctx := context.Background()
+	f := setupMerchantPurgeService(t)
+
+	purge, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+	assert.Equal(t, models.PurgeStatusPending, purge.Status)
+	require.Len(t, f.queue.jobs, 1)
+	assert.Equal(t, MerchantPurgeJob, f.queue.jobs[0].Type)
+
+	f.runJobs(t)
+
+	purge, err = f.service.GetMerchantPurge(ctx, purge.ID, "admin1")
+	require.NoError(t, err)
+	assert.Equal(t, models.PurgeStatusCompleted, purge.Status)
+	assert.NotNil(t, purge.CompletedAt)
+	deleted := map[string]int64{}
+	for _, step := range purge.Steps {
+		assert.True(t, step.Done, step.Resource)
+		deleted[step.Resource] = step.Deleted
+	}
+	assert.Equal(t, int64(3), deleted["forms"])
+	assert.Equal(t, int64(3), deleted["form_submissions"])
+	assert.Equal(t, int64(1), deleted["form_templates"])
+	assert.Equal(t, int64(1), deleted["form_files"])
+
+	forms, total, err := f.forms.Find(ctx, &models.FormQueryOptions{MerchantID: "merchant123"})
+	require.NoError(t, err)
+	assert.Empty(t, forms)
+	assert.Zero(t, total)
+	_, err = f.forms.FindByID(ctx, f.otherFormID)
+	assert.NoError(t, err, "other merchants' data must be kept")
+
+	assert.Len(t, f.deletedObjects, 4)
+	assert.Contains(t, f.deletedObjects, "Form:"+f.formID.Hex())
+	assert.Empty(t, f.storage.objects)
+	// The response counter of the deleted form is gone, so a new reservation starts from zero
+	ok, err := f.submissions.ReserveResponse(ctx, f.formID, "", 1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestMerchantPurgeService_PurgeMerchantData_ResumesInLaterRuns(t *testing.T) {
+	ctx := context.Background()
+	f := setupMerchantPurgeService(t)
+	// Every batch uses up the run time, so each job run deletes a single batch
+	clock := time.Now()
+	f.service.now = func() time.Time {
+		clock = clock.Add(time.Minute)
+		return clock
+	}
+	f.service.config.JobsConfig.Purge.RunTime = time.Minute
+
+	purge, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+
+	// Run single jobs until the first batch of responses is deleted; collections purged
+	// before them hold nothing of the merchant
+	var submissions models.PurgeStep
+	for submissions.Deleted == 0 {
+		require.Len(t, f.queue.jobs, 1)
+		job := f.queue.jobs[0]
+		f.queue.jobs = nil
+		require.NoError(t, f.service.RunPurgeJob(ctx, job))
+
+		purge, err = f.service.GetMerchantPurge(ctx, purge.ID, "admin1")
+		require.NoError(t, err)
+		submissions = purgeStep(t, purge, models.FormSubmission{}.TableName())
+	}
+	assert.Equal(t, models.PurgeStatusRunning, purge.Status)
+	assert.Equal(t, int64(2), submissions.Deleted)
+	assert.False(t, submissions.Done)
+	require.Len(t, f.queue.jobs, 1, "the rest of the purge is queued")
+
+	f.runJobs(t)
+
+	purge, err = f.service.GetMerchantPurge(ctx, purge.ID, "admin1")
+	require.NoError(t, err)
+	assert.Equal(t, models.PurgeStatusCompleted, purge.Status)
+	assert.Equal(t, int64(3), purgeStep(t, purge, models.FormSubmission{}.TableName()).Deleted)
+}
+
+func TestMerchantPurgeService_PurgeMerchantData_ReturnsActivePurge(t *testing.T) {
+	ctx := context.Background()
+	f := setupMerchantPurgeService(t)
+
+	first, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+	second, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+
+	assert.Equal(t, first.ID, second.ID)
+	assert.Len(t, f.queue.jobs, 1)
+}
+
+func TestMerchantPurgeService_PurgeMerchantData_NotAdmin(t *testing.T) {
+	f := setupMerchantPurgeService(t)
+
+	_, err := f.service.PurgeMerchantData(context.Background(), "merchant123", "user123")
+
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Empty(t, f.queue.jobs)
+}
+
+func TestMerchantPurgeService_PurgeMerchantData_QueueError(t *testing.T) {
+	ctx := context.Background()
+	f := setupMerchantPurgeService(t)
+	f.queue.err = errors.New("database unavailable")
+
+	_, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	assert.Equal(t, ErrInternalError, err)
+
+	// The purge was recorded as failed, so the next request resumes it
+	f.queue.err = nil
+	purge, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+	assert.Equal(t, models.PurgeStatusPending, purge.Status)
+	assert.Empty(t, purge.LastError)
+}
+
+func TestMerchantPurgeService_RunPurgeJob_KetoFailure(t *testing.T) {
+	ctx := context.Background()
+	f := setupMerchantPurgeService(t)
+	f.service.deleteObject = func(ctx context.Context, namespace, objectID string) error {
+		return errors.New("keto unavailable")
+	}
+
+	purge, err := f.service.PurgeMerchantData(ctx, "merchant123", "admin1")
+	require.NoError(t, err)
+	job := f.queue.jobs[0]
+	f.queue.jobs = nil
+
+	// The forms are kept until their tuples are deleted; the last attempt fails the purge
+	assert.Error(t, f.service.RunPurgeJob(ctx, job))
+	_, err = f.forms.FindByID(ctx, f.formID)
+	assert.NoError(t, err)
+
+	job.Attempts = job.MaxAttempts
+	assert.Error(t, f.service.RunPurgeJob(ctx, job))
+	purge, err = f.service.GetMerchantPurge(ctx, purge.ID, "admin1")
+	require.NoError(t, err)
+	assert.Equal(t, models.PurgeStatusFailed, purge.Status)
+	assert.Contains(t, purge.LastError, "keto unavailable")
+	assert.True(t, purgeStep(t, purge, models.FormSubmission{}.TableName()).Done, "progress made before the failure is kept")
+}
+
+func TestMerchantPurgeService_GetMerchantPurge_NotFound(t *testing.T) {
+	f := setupMerchantPurgeService(t)
+
+	_, err := f.service.GetMerchantPurge(context.Background(), primitive.NewObjectID(), "admin1")
+
+	assert.Equal(t, ErrPurgeNotFound, err)
+}
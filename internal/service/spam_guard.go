@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/ratelimit"
+	"github.com/arwoosa/form/internal/schema"
+	"github.com/arwoosa/form/internal/spam"
+)
+
+// Limits of a form's spam protection settings
+const (
+	maxHoneypotFieldLength = 64
+	maxMinFillSeconds      = 3600
+)
+
+// defaultSubmissionTokenMaxAge is used when the configuration does not bound how long after
+// loading a form its submission token is accepted
+const defaultSubmissionTokenMaxAge = 24 * time.Hour
+
+// SpamGuard applies the spam protection configured on forms to submitted responses. A CAPTCHA
+// that cannot be verified rejects the response; the per-address limit is skipped with a warning
+// when its store is unavailable.
+type SpamGuard struct {
+	secret      []byte                                   // Signs submission tokens; nil disables minimum fill times
+	tokenMaxAge time.Duration                            // How long after loading the form a submission token is accepted
+	verifiers   map[models.CaptchaProvider]spam.Verifier // Configured CAPTCHA providers
+	limits      ratelimit.Store                          // Per-address submission counts and consumed submission tokens
+	now         func() time.Time
+}
+
+// NewSpamGuard creates a new spam guard
+func NewSpamGuard(secret []byte, tokenMaxAge time.Duration, verifiers map[models.CaptchaProvider]spam.Verifier, limits ratelimit.Store) *SpamGuard {
+	if tokenMaxAge <= 0 {
+		tokenMaxAge = defaultSubmissionTokenMaxAge
+	}
+	return &SpamGuard{
+		secret:      secret,
+		tokenMaxAge: tokenMaxAge,
+		verifiers:   verifiers,
+		limits:      limits,
+		now:         time.Now,
+	}
+}
+
+// SubmissionToken issues the token a respondent loading the form echoes back on submission, or
+// an empty token when the form has no minimum fill time
+func (g *SpamGuard) SubmissionToken(form *models.Form) string {
+	if g == nil || len(g.secret) == 0 || form.SpamProtection == nil || form.SpamProtection.MinFillSeconds <= 0 {
+		return ""
+	}
+	return spam.IssueToken(g.secret, form.ID, g.now())
+}
+
+// validate checks spam protection settings against the form's schema and the defenses the
+// deployment supports
+func (g *SpamGuard) validate(form *models.Form, protection *models.SpamProtection) error {
+	if protection.HoneypotField != "" {
+		if len(protection.HoneypotField) > maxHoneypotFieldLength {
+			return fmt.Errorf("%w: honeypot field name exceeds %d characters", ErrInvalidInput, maxHoneypotFieldLength)
+		}
+		root, _ := schema.Normalize(form.Schema).(map[string]interface{})
+		properties, _ := root["properties"].(map[string]interface{})
+		if _, ok := properties[protection.HoneypotField]; ok {
+			return fmt.Errorf("%w: honeypot field %q is a field of the form", ErrInvalidInput, protection.HoneypotField)
+		}
+	}
+	if protection.MinFillSeconds < 0 || protection.MinFillSeconds > maxMinFillSeconds {
+		return fmt.Errorf("%w: minimum fill time must be between 0 and %d seconds", ErrInvalidInput, maxMinFillSeconds)
+	}
+	if protection.MinFillSeconds > 0 && (g == nil || len(g.secret) == 0) {
+		return fmt.Errorf("%w: minimum fill times are not configured", ErrInvalidInput)
+	}
+	if protection.MaxPerIPPerHour < 0 {
+		return fmt.Errorf("%w: submissions per address cannot be negative", ErrInvalidInput)
+	}
+	if protection.CaptchaProvider != "" {
+		if !protection.CaptchaProvider.IsValid() {
+			return fmt.Errorf("%w: unknown captcha provider %q", ErrInvalidInput, protection.CaptchaProvider)
+		}
+		if g == nil || g.verifiers[protection.CaptchaProvider] == nil {
+			return fmt.Errorf("%w: captcha provider %q is not configured", ErrInvalidInput, protection.CaptchaProvider)
+		}
+		if protection.CaptchaSiteKey == "" {
+			return fmt.Errorf("%w: captcha site key is required", ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// check applies the form's spam protection to a submission. The honeypot field is removed from
+// the answers, so that it does not fail schema validation.
+func (g *SpamGuard) check(ctx context.Context, form *models.Form, input *models.SubmitFormResponseInput) error {
+	protection := form.SpamProtection
+	if !protection.Enabled() {
+		return nil
+	}
+
+	if protection.HoneypotField != "" {
+		value, filled := input.Answers[protection.HoneypotField]
+		delete(input.Answers, protection.HoneypotField)
+		if filled && value != nil && value != "" {
+			return g.reject(form, "honeypot", ErrSpamDetected)
+		}
+	}
+	if g == nil {
+		log.Warn("Spam protection not configured - only the honeypot is checked", log.String("form_id", form.ID.Hex()))
+		return nil
+	}
+
+	var token *spam.Claims
+	if protection.MinFillSeconds > 0 {
+		var err error
+		if token, err = g.checkFillTime(form, input.SubmissionToken); err != nil {
+			return err
+		}
+	}
+	if protection.MaxPerIPPerHour > 0 && input.ClientIP != "" && g.limits != nil {
+		rule := ratelimit.Rule{Rate: float64(protection.MaxPerIPPerHour) / 3600, Burst: protection.MaxPerIPPerHour}
+		allowed, err := g.limits.Allow(ctx, "submit:"+form.ID.Hex()+":ip:"+input.ClientIP, rule)
+		if err != nil {
+			log.Warn("Submission throttling store unavailable", log.Err(err), log.String("form_id", form.ID.Hex()))
+		} else if !allowed {
+			return g.reject(form, "throttled", ErrSubmissionThrottled)
+		}
+	}
+	if protection.CaptchaProvider != "" {
+		if err := g.checkCaptcha(ctx, form, input); err != nil {
+			return err
+		}
+	}
+	if token != nil {
+		// Consumed last, so a response rejected for another reason can be sent again
+		return g.consumeToken(ctx, form, token)
+	}
+	return nil
+}
+
+// checkFillTime rejects submissions without a valid token for the form, sooner than the minimum
+// fill time after the form was loaded, or later than the token's maximum age
+func (g *SpamGuard) checkFillTime(form *models.Form, token string) (*spam.Claims, error) {
+	if len(g.secret) == 0 {
+		log.Warn("Minimum fill time not checked - no submission token secret configured", log.String("form_id", form.ID.Hex()))
+		return nil, nil
+	}
+
+	claims, err := spam.ParseToken(g.secret, token)
+	if err != nil || claims.FormID != form.ID {
+		return nil, g.reject(form, "invalid_token", ErrSpamDetected)
+	}
+	elapsed := g.now().Sub(claims.LoadedAt)
+	if elapsed < time.Duration(form.SpamProtection.MinFillSeconds)*time.Second {
+		return nil, g.reject(form, "too_fast", ErrSpamDetected)
+	}
+	if elapsed > g.tokenMaxAge {
+		return nil, g.reject(form, "expired_token", ErrSpamDetected)
+	}
+	return claims, nil
+}
+
+// consumeToken rejects a submission token that was already used. Each token gets a bucket
+// holding a single use that refills only after the maximum age, when the token has expired.
+func (g *SpamGuard) consumeToken(ctx context.Context, form *models.Form, token *spam.Claims) error {
+	if g.limits == nil {
+		return nil
+	}
+	rule := ratelimit.Rule{Rate: 1 / g.tokenMaxAge.Seconds(), Burst: 1}
+	unused, err := g.limits.Allow(ctx, "submission_token:"+token.Nonce, rule)
+	if err != nil {
+		log.Warn("Submission token reuse not checked - store unavailable", log.Err(err), log.String("form_id", form.ID.Hex()))
+		return nil
+	}
+	if !unused {
+		return g.reject(form, "reused_token", ErrSpamDetected)
+	}
+	return nil
+}
+
+// checkCaptcha verifies the CAPTCHA token of a submission with the form's provider
+func (g *SpamGuard) checkCaptcha(ctx context.Context, form *models.Form, input *models.SubmitFormResponseInput) error {
+	provider := form.SpamProtection.CaptchaProvider
+	verifier := g.verifiers[provider]
+	if verifier == nil {
+		log.Error("Captcha not checked - provider not configured",
+			log.String("form_id", form.ID.Hex()),
+			log.String("provider", string(provider)))
+		return g.reject(form, "captcha_unavailable", ErrCaptchaUnavailable)
+	}
+
+	ok, err := verifier.Verify(ctx, input.CaptchaToken, input.ClientIP)
+	if err != nil {
+		log.Error("Captcha provider unavailable", log.Err(err),
+			log.String("form_id", form.ID.Hex()),
+			log.String("provider", string(provider)))
+		return g.reject(form, "captcha_unavailable", ErrCaptchaUnavailable)
+	}
+	if !ok {
+		return g.reject(form, "captcha", ErrCaptchaFailed)
+	}
+	return nil
+}
+
+func (g *SpamGuard) reject(form *models.Form, reason string, err error) error {
+	metrics.SpamRejections.WithLabelValues(reason).Inc()
+	log.Info("Submission rejected by spam protection",
+		log.String("form_id", form.ID.Hex()),
+		log.String("reason", reason))
+	return err
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/ratelimit"
+	"github.com/arwoosa/form/internal/spam"
+)
+
+// fakeVerifier accepts the captcha token "human"
+type fakeVerifier struct {
+	err error
+}
+
+func (v *fakeVerifier) Verify(_ context.Context, response, _ string) (bool, error) {
+	return response == "human", v.err
+}
+
+func setupSpamGuard() (*SpamGuard, *fakeVerifier, *time.Time) {
+	verifier := &fakeVerifier{}
+	guard := NewSpamGuard([]byte("secret"), time.Hour, map[models.CaptchaProvider]spam.Verifier{
+		models.CaptchaProviderTurnstile: verifier,
+	}, ratelimit.NewMemoryStore())
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	guard.now = func() time.Time { return now }
+	return guard, verifier, &now
+}
+
+func spamProtectedForm(protection *models.SpamProtection) *models.Form {
+	form := createTestForm()
+	form.SpamProtection = protection
+	return form
+}
+
+func TestSpamGuard_Honeypot(t *testing.T) {
+	guard, _, _ := setupSpamGuard()
+	ctx := context.Background()
+	form := spamProtectedForm(&models.SpamProtection{HoneypotField: "website"})
+
+	input := &models.SubmitFormResponseInput{Answers: map[string]interface{}{"name": "Alice", "website": ""}}
+	require.NoError(t, guard.check(ctx, form, input))
+	assert.Equal(t, map[string]interface{}{"name": "Alice"}, input.Answers)
+
+	input = &models.SubmitFormResponseInput{Answers: map[string]interface{}{"name": "Bot", "website": "http://spam.example"}}
+	assert.Equal(t, ErrSpamDetected, guard.check(ctx, form, input))
+}
+
+func TestSpamGuard_MinFillTime(t *testing.T) {
+	guard, _, now := setupSpamGuard()
+	ctx := context.Background()
+	form := spamProtectedForm(&models.SpamProtection{MinFillSeconds: 5})
+	token := guard.SubmissionToken(form)
+	require.NotEmpty(t, token)
+
+	*now = now.Add(2 * time.Second)
+	assert.Equal(t, ErrSpamDetected, guard.check(ctx, form, &models.SubmitFormResponseInput{SubmissionToken: token}))
+
+	*now = now.Add(3 * time.Second)
+	assert.NoError(t, guard.check(ctx, form, &models.SubmitFormResponseInput{SubmissionToken: token}))
+
+	// Tokens are used once
+	assert.Equal(t, ErrSpamDetected, guard.check(ctx, form, &models.SubmitFormResponseInput{SubmissionToken: token}))
+
+	// Tokens expire after their maximum age
+	expiring := guard.SubmissionToken(form)
+	*now = now.Add(time.Hour + time.Second)
+	assert.Equal(t, ErrSpamDetected, guard.check(ctx, form, &models.SubmitFormResponseInput{SubmissionToken: expiring}))
+
+	// Tokens are bound to their form and required
+	token = guard.SubmissionToken(form)
+	*now = now.Add(5 * time.Second)
+	other := spamProtectedForm(form.SpamProtection)
+	assert.Equal(t, ErrSpamDetected, guard.check(ctx, other, &models.SubmitFormResponseInput{SubmissionToken: token}))
+	assert.Equal(t, ErrSpamDetected, guard.check(ctx, form, &models.SubmitFormResponseInput{}))
+}
+
+func TestSpamGuard_ThrottlesPerAddress(t *testing.T) {
+	guard, _, _ := setupSpamGuard()
+	ctx := context.Background()
+	form := spamProtectedForm(&models.SpamProtection{MaxPerIPPerHour: 2})
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, guard.check(ctx, form, &models.SubmitFormResponseInput{ClientIP: "1.2.3.4"}))
+	}
+	assert.Equal(t, ErrSubmissionThrottled, guard.check(ctx, form, &models.SubmitFormResponseInput{ClientIP: "1.2.3.4"}))
+	assert.NoError(t, guard.check(ctx, form, &models.SubmitFormResponseInput{ClientIP: "5.6.7.8"}))
+
+	// The limit applies per form
+	other := spamProtectedForm(form.SpamProtection)
+	assert.NoError(t, guard.check(ctx, other, &models.SubmitFormResponseInput{ClientIP: "1.2.3.4"}))
+}
+
+func TestSpamGuard_Captcha(t *testing.T) {
+	guard, verifier, _ := setupSpamGuard()
+	ctx := context.Background()
+	form := spamProtectedForm(&models.SpamProtection{CaptchaProvider: models.CaptchaProviderTurnstile, CaptchaSiteKey: "site"})
+
+	assert.NoError(t, guard.check(ctx, form, &models.SubmitFormResponseInput{CaptchaToken: "human"}))
+	assert.Equal(t, ErrCaptchaFailed, guard.check(ctx, form, &models.SubmitFormResponseInput{CaptchaToken: "robot"}))
+
+	// An unavailable provider blocks responses rather than letting them through unverified
+	verifier.err = errors.New("timeout")
+	assert.Equal(t, ErrCaptchaUnavailable, guard.check(ctx, form, &models.SubmitFormResponseInput{CaptchaToken: "human"}))
+	assert.Equal(t, codes.Unavailable, status.Code(ToGRPCError(ErrCaptchaUnavailable)))
+
+	// So does a provider whose secret was removed after the form enabled it
+	verifier.err = nil
+	unconfigured := spamProtectedForm(&models.SpamProtection{CaptchaProvider: models.CaptchaProviderHCaptcha, CaptchaSiteKey: "site"})
+	assert.Equal(t, ErrCaptchaUnavailable, guard.check(ctx, unconfigured, &models.SubmitFormResponseInput{CaptchaToken: "human"}))
+}
+
+func TestSpamGuard_Validate(t *testing.T) {
+	guard, _, _ := setupSpamGuard()
+	form := createTestForm()
+	form.Schema = map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+	}
+
+	assert.NoError(t, guard.validate(form, &models.SpamProtection{
+		HoneypotField: "website", MinFillSeconds: 3, MaxPerIPPerHour: 10,
+		CaptchaProvider: models.CaptchaProviderTurnstile, CaptchaSiteKey: "site",
+	}))
+
+	invalid := []*models.SpamProtection{
+		{HoneypotField: "name"},
+		{MinFillSeconds: maxMinFillSeconds + 1},
+		{CaptchaProvider: models.CaptchaProviderHCaptcha, CaptchaSiteKey: "site"},
+		{CaptchaProvider: models.CaptchaProviderTurnstile},
+	}
+	for _, protection := range invalid {
+		assert.ErrorIs(t, guard.validate(form, protection), ErrInvalidInput)
+	}
+
+	// Minimum fill times need a token secret
+	assert.ErrorIs(t, NewSpamGuard(nil, 0, nil, nil).validate(form, &models.SpamProtection{MinFillSeconds: 3}), ErrInvalidInput)
+}
+
+func TestFormService_SetSpamProtection(t *testing.T) {
+	service, mockFormRepo, _, _ := setupFormService()
+	service.spam, _, _ = setupSpamGuard()
+	ctx := context.Background()
+	form := createTestForm()
+
+	mockFormRepo.On("FindByID", ctx, form.ID).Return(form, nil)
+	mockFormRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+	result, err := service.SetSpamProtection(ctx, form.ID, "merchant123", &models.SpamProtection{HoneypotField: "website"}, "user456")
+	require.NoError(t, err)
+	assert.Equal(t, "website", result.SpamProtection.HoneypotField)
+
+	// Turning every defense off clears the protection
+	result, err = service.SetSpamProtection(ctx, form.ID, "merchant123", &models.SpamProtection{}, "user456")
+	require.NoError(t, err)
+	assert.Nil(t, result.SpamProtection)
+
+	_, err = service.SetSpamProtection(ctx, form.ID, "merchant123", &models.SpamProtection{HoneypotField: "website", MinFillSeconds: -1}, "user456")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	_, err = service.SetSpamProtection(ctx, form.ID, "merchant456", nil, "user456")
+	assert.Equal(t, ErrFormNotFound, err)
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// generateUISchema derives a default UI Schema for a JSON Schema using the configured format widgets
+func generateUISchema(config *conf.AppConfig, s interface{}) map[string]interface{} {
+	var formatWidgets map[string]string
+	if config != nil && config.UISchemaConfig != nil {
+		formatWidgets = config.UISchemaConfig.FormatWidgets
+	}
+	return schema.GenerateUISchema(s, formatWidgets)
+}
+
+// defaultUISchema returns the given UI Schema, or a generated one when it is missing or empty
+func defaultUISchema(config *conf.AppConfig, s, uiSchema interface{}) interface{} {
+	if m, ok := schema.Normalize(uiSchema).(map[string]interface{}); ok && len(m) > 0 {
+		return uiSchema
+	}
+	return generateUISchema(config, s)
+}
+
+// checkSchemaExtensions checks the computed fields of a schema and the conditions of its UI Schema
+func checkSchemaExtensions(s, uiSchema interface{}) error {
+	if err := schema.CheckComputed(s); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if err := schema.CheckConditions(s, uiSchema); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+func TestDefaultUISchema(t *testing.T) {
+	config := &conf.AppConfig{
+		UISchemaConfig: &conf.UISchemaConfig{
+			FormatWidgets: map[string]string{"email": "text"},
+		},
+	}
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"email": map[string]interface{}{"type": "string", "format": "email"},
+		},
+	}
+
+	provided := map[string]interface{}{"ui:order": []interface{}{"email"}}
+	assert.Equal(t, provided, defaultUISchema(config, s, provided))
+
+	expected := map[string]interface{}{
+		schema.UIOrderKey: []interface{}{"email"},
+		"email":           map[string]interface{}{schema.UIWidgetKey: "text"},
+	}
+	assert.Equal(t, expected, defaultUISchema(config, s, nil))
+	assert.Equal(t, expected, defaultUISchema(config, s, map[string]interface{}{}))
+}
+
+func TestFormTemplateService_GenerateUISchema(t *testing.T) {
+	service, _, _ := setupFormTemplateService()
+
+	uiSchema, err := service.GenerateUISchema(map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"birthday": map[string]interface{}{"type": "string", "format": "date"},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"birthday"}, uiSchema[schema.UIOrderKey])
+	assert.Equal(t, map[string]interface{}{schema.UIWidgetKey: "date"}, uiSchema["birthday"])
+
+	uiSchema, err = service.GenerateUISchema(nil)
+
+	assert.Nil(t, uiSchema)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/cache"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/metrics"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// defaultUsageCacheTTL is used when the configuration does not set how long usage is cached
+const defaultUsageCacheTTL = time.Minute
+
+// UsageService reports per-merchant usage of templates, forms and responses
+type UsageService struct {
+	templateRepo   repository.FormTemplateRepository
+	formRepo       repository.FormRepository
+	submissionRepo repository.FormSubmissionRepository
+	limits         *LimitsService
+	cache          *cache.TTL[*models.MerchantUsage]
+}
+
+// NewUsageService creates a new usage service
+func NewUsageService(templateRepo repository.FormTemplateRepository, formRepo repository.FormRepository, submissionRepo repository.FormSubmissionRepository, limits *LimitsService, config *conf.AppConfig) *UsageService {
+	ttl := defaultUsageCacheTTL
+	if config != nil && config.UsageConfig != nil && config.UsageConfig.CacheTTL > 0 {
+		ttl = config.UsageConfig.CacheTTL
+	}
+
+	return &UsageService{
+		templateRepo:   templateRepo,
+		formRepo:       formRepo,
+		submissionRepo: submissionRepo,
+		limits:         limits,
+		cache:          cache.NewTTL[*models.MerchantUsage](ttl),
+	}
+}
+
+// GetMerchantUsage returns a merchant's usage together with its effective limits. Counts are
+// cached so that consoles polling for quota bars do not recount on every request.
+func (s *UsageService) GetMerchantUsage(ctx context.Context, merchantID string) (*models.MerchantUsage, error) {
+	if merchantID == "" {
+		return nil, ErrUnauthorized
+	}
+
+	usage, ok := s.cache.Get(merchantID)
+	metrics.ObserveCache("usage", ok)
+	if ok {
+		return usage, nil
+	}
+
+	templates, err := s.templateRepo.CountByMerchantID(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to count templates", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+
+	formsByStatus, err := s.formRepo.CountByStatus(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to count forms by status", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+
+	responses, err := s.submissionRepo.CountByMerchantID(ctx, merchantID)
+	if err != nil {
+		log.Error("Failed to count submissions", log.Err(err), log.String("merchant_id", merchantID))
+		return nil, ErrInternalError
+	}
+
+	limits, err := s.limits.GetLimits(ctx, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage = &models.MerchantUsage{
+		MerchantID:    merchantID,
+		Templates:     templates,
+		FormsByStatus: formsByStatus,
+		Responses:     responses,
+		Limits:        limits,
+		GeneratedAt:   time.Now(),
+	}
+	for _, count := range formsByStatus {
+		usage.Forms += count
+	}
+
+	s.cache.Set(merchantID, usage)
+	return usage, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/models"
+)
+
+// Test setup helper for UsageService
+func setupUsageService() (*UsageService, *MockFormTemplateRepository, *MockFormRepository, *MockFormSubmissionRepository) {
+	mockTemplateRepo := &MockFormTemplateRepository{}
+	mockFormRepo := &MockFormRepository{}
+	mockSubmissionRepo := &MockFormSubmissionRepository{}
+	config := &conf.AppConfig{
+		BusinessRulesConfig: &conf.BusinessRulesConfig{
+			MaxTemplatesPerMerchant: 10,
+		},
+	}
+	service := NewUsageService(mockTemplateRepo, mockFormRepo, mockSubmissionRepo, newDefaultLimitsService(config), config)
+	return service, mockTemplateRepo, mockFormRepo, mockSubmissionRepo
+}
+
+func TestUsageService_GetMerchantUsage_Success(t *testing.T) {
+	service, mockTemplateRepo, mockFormRepo, mockSubmissionRepo := setupUsageService()
+	ctx := context.Background()
+
+	mockTemplateRepo.On("CountByMerchantID", ctx, "merchant123").Return(int64(4), nil).Once()
+	mockFormRepo.On("CountByStatus", ctx, "merchant123").Return(map[models.FormStatus]int64{
+		models.FormStatusDraft:     2,
+		models.FormStatusPublished: 3,
+	}, nil).Once()
+	mockSubmissionRepo.On("CountByMerchantID", ctx, "merchant123").Return(int64(120), nil).Once()
+
+	usage, err := service.GetMerchantUsage(ctx, "merchant123")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), usage.Templates)
+	assert.Equal(t, int64(5), usage.Forms)
+	assert.Equal(t, int64(3), usage.FormsByStatus[models.FormStatusPublished])
+	assert.Equal(t, int64(120), usage.Responses)
+	assert.Equal(t, 10, usage.Limits.MaxTemplates)
+
+	// A second request is served from the cache
+	cached, err := service.GetMerchantUsage(ctx, "merchant123")
+	require.NoError(t, err)
+	assert.Same(t, usage, cached)
+
+	mockTemplateRepo.AssertExpectations(t)
+	mockFormRepo.AssertExpectations(t)
+	mockSubmissionRepo.AssertExpectations(t)
+}
+
+func TestUsageService_GetMerchantUsage_RepositoryError(t *testing.T) {
+	service, mockTemplateRepo, mockFormRepo, _ := setupUsageService()
+	ctx := context.Background()
+
+	mockTemplateRepo.On("CountByMerchantID", ctx, "merchant123").Return(int64(4), nil)
+	mockFormRepo.On("CountByStatus", ctx, "merchant123").Return(map[models.FormStatus]int64(nil), errors.New("database error"))
+
+	usage, err := service.GetMerchantUsage(ctx, "merchant123")
+
+	assert.Nil(t, usage)
+	assert.Equal(t, ErrInternalError, err)
+}
+
+func TestUsageService_GetMerchantUsage_NoMerchant(t *testing.T) {
+	service, _, _, _ := setupUsageService()
+
+	usage, err := service.GetMerchantUsage(context.Background(), "")
+
+	assert.Nil(t, usage)
+	assert.Equal(t, ErrUnauthorized, err)
+}
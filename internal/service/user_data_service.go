@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/log"
+	"github.com/arwoosa/vulpes/relation"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/storage"
+)
+
+// UserDataService answers GDPR data subject requests: it exports every document referring to a
+// user and erases the user by pseudonymizing those documents
+type UserDataService struct {
+	userDataRepo  repository.UserDataRepository
+	storage       storage.Storage
+	encryption    *AnswerEncryption
+	config        *conf.AppConfig
+	checkRelation relationCheckFunc
+	now           func() time.Time
+}
+
+// NewUserDataService creates a new user data service. storage may be nil when file uploads are
+// not configured.
+func NewUserDataService(userDataRepo repository.UserDataRepository, store storage.Storage, encryption *AnswerEncryption, config *conf.AppConfig) *UserDataService {
+	return &UserDataService{
+		userDataRepo:  userDataRepo,
+		storage:       store,
+		encryption:    encryption,
+		config:        config,
+		checkRelation: relation.Check,
+		now:           time.Now,
+	}
+}
+
+// ExportUserData collects every document in which the user is the creator, last editor or
+// respondent, with the PII answers of responses decrypted. Users may export their own data;
+// platform admins may export anyone's.
+func (s *UserDataService) ExportUserData(ctx context.Context, userID, requestedBy string) (*models.UserDataExport, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user_id is required", ErrInvalidInput)
+	}
+	if err := s.authorize(ctx, userID, requestedBy, "export_user_data"); err != nil {
+		return nil, err
+	}
+
+	export := &models.UserDataExport{
+		UserID:      userID,
+		ExportedAt:  s.now(),
+		Collections: make(map[string][]map[string]interface{}),
+	}
+	var total int
+	for _, c := range models.UserDataFields {
+		documents, err := s.userDataRepo.FindByUser(ctx, c.Collection, c.Fields, userID)
+		if err != nil {
+			log.Error("Failed to export user data", log.Err(err), log.String("collection", c.Collection))
+			return nil, ErrInternalError
+		}
+		if len(documents) == 0 {
+			continue
+		}
+
+		exported := make([]map[string]interface{}, len(documents))
+		for i, document := range documents {
+			if c.Collection == (models.FormSubmission{}).TableName() {
+				if err := s.openAnswers(ctx, document); err != nil {
+					return nil, err
+				}
+			}
+			if exported[i], err = toExtendedJSON(document); err != nil {
+				log.Error("Failed to encode exported document", log.Err(err), log.String("collection", c.Collection))
+				return nil, ErrInternalError
+			}
+		}
+		export.Collections[c.Collection] = exported
+		total += len(documents)
+	}
+
+	log.Info("Audit: user data exported",
+		log.String("audit", "user_data_export"),
+		log.String("user_id", userID),
+		log.String("requested_by", requestedBy),
+		log.Int("documents", total))
+	return export, nil
+}
+
+// EraseUserData replaces the user ID with a random pseudonym wherever it is stored. Responses
+// keep their answers, so statistics are unchanged, unless DeleteResponses is set, which also
+// deletes the files uploaded with them; answers to PII fields are removed, as the retention
+// anonymize policy does. The pseudonym is
+// the same for all documents of one erasure, so counts per respondent are preserved, but it
+// cannot be traced back to the user.
+func (s *UserDataService) EraseUserData(ctx context.Context, input *models.EraseUserDataInput) (*models.UserDataErasure, error) {
+	// Validate input
+	if err := validate.Struct(input); err != nil {
+		log.Error("EraseUserData validation failed", log.Err(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if err := s.authorize(ctx, input.UserID, input.RequestedBy, "erase_user_data"); err != nil {
+		return nil, err
+	}
+
+	erasure := &models.UserDataErasure{
+		UserID:    input.UserID,
+		Pseudonym: "erased-" + primitive.NewObjectID().Hex(),
+	}
+	var filesDeleted int64
+	if input.DeleteResponses {
+		deleted, err := s.deleteFiles(ctx, input.UserID)
+		if err != nil {
+			log.Error("Failed to delete user response files", log.Err(err))
+			return nil, ErrInternalError
+		}
+		filesDeleted = deleted
+	}
+	for _, c := range models.UserDataFields {
+		erased := models.ErasedCollection{Collection: c.Collection}
+		if c.Collection == (models.FileReference{}).TableName() {
+			erased.Deleted = filesDeleted
+		}
+
+		if input.DeleteResponses && c.Collection == (models.FormSubmission{}).TableName() {
+			deleted, err := s.userDataRepo.DeleteSubmissions(ctx, input.UserID)
+			if err != nil {
+				log.Error("Failed to delete user responses", log.Err(err))
+				return nil, ErrInternalError
+			}
+			erased.Deleted = deleted
+		} else if c.Collection == (models.FormSubmission{}).TableName() {
+			removed, err := s.userDataRepo.RemoveEncryptedAnswers(ctx, input.UserID)
+			if err != nil {
+				log.Error("Failed to remove user PII answers", log.Err(err))
+				return nil, ErrInternalError
+			}
+			log.Info("Removed PII answers of erased user", log.Int64("submissions", removed))
+		}
+
+		changed, err := s.userDataRepo.ReplaceUser(ctx, c.Collection, c.Fields, input.UserID, erasure.Pseudonym)
+		if err != nil {
+			log.Error("Failed to pseudonymize user data", log.Err(err), log.String("collection", c.Collection))
+			return nil, ErrInternalError
+		}
+		erased.Pseudonymized = changed
+
+		if erased.Deleted > 0 || erased.Pseudonymized > 0 {
+			erasure.Collections = append(erasure.Collections, erased)
+		}
+	}
+
+	log.Info("Audit: user data erased",
+		log.String("audit", "user_data_erasure"),
+		log.String("user_id", input.UserID),
+		log.String("requested_by", input.RequestedBy),
+		log.String("pseudonym", erasure.Pseudonym),
+		log.Bool("responses_deleted", input.DeleteResponses))
+	return erasure, nil
+}
+
+// deleteFiles deletes the files uploaded with the user's responses from storage and their
+// references, returning the number of files deleted
+func (s *UserDataService) deleteFiles(ctx context.Context, userID string) (int64, error) {
+	files, err := s.userDataRepo.FindSubmissionFiles(ctx, userID)
+	if err != nil || len(files) == 0 {
+		return 0, err
+	}
+
+	fileIDs := make([]primitive.ObjectID, len(files))
+	for i, file := range files {
+		if s.storage != nil && file.Key != "" {
+			if err := s.storage.Delete(ctx, file.Key); err != nil {
+				return 0, err
+			}
+		}
+		fileIDs[i] = file.ID
+	}
+	if err := s.userDataRepo.DeleteFiles(ctx, fileIDs); err != nil {
+		return 0, err
+	}
+	return int64(len(fileIDs)), nil
+}
+
+// authorize lets users act on their own data and platform admins on anyone's
+func (s *UserDataService) authorize(ctx context.Context, userID, requestedBy, action string) error {
+	if requestedBy != "" && requestedBy == userID {
+		return nil
+	}
+	return authorizePlatformAdmin(ctx, s.config, s.checkRelation, requestedBy, action, "")
+}
+
+// openAnswers decrypts the PII answers of an exported response document in place
+func (s *UserDataService) openAnswers(ctx context.Context, document bson.M) error {
+	if document["encrypted_answers"] == nil {
+		return nil
+	}
+
+	var submission models.FormSubmission
+	data, err := bson.Marshal(document)
+	if err == nil {
+		err = bson.Unmarshal(data, &submission)
+	}
+	if err != nil {
+		log.Error("Failed to decode exported response", log.Err(err))
+		return ErrInternalError
+	}
+	if err := s.encryption.open(ctx, &submission); err != nil {
+		return err
+	}
+
+	document["answers"] = submission.Answers
+	delete(document, "encrypted_answers")
+	delete(document, "key_id")
+	return nil
+}
+
+// toExtendedJSON converts a document to relaxed Extended JSON, so IDs and dates read naturally
+func toExtendedJSON(document bson.M) (map[string]interface{}, error) {
+	data, err := bson.MarshalExtJSON(document, false, false)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/conf"
+	"github.com/arwoosa/form/internal/dao/memory"
+	"github.com/arwoosa/form/internal/dao/repository"
+	"github.com/arwoosa/form/internal/kms"
+	"github.com/arwoosa/form/internal/models"
+	"github.com/arwoosa/form/internal/schema"
+)
+
+// userDataFixture holds a UserDataService on an in-memory database with data of two users
+type userDataFixture struct {
+	service     *UserDataService
+	encryption  *AnswerEncryption
+	forms       repository.FormRepository
+	submissions repository.FormSubmissionRepository
+	files       repository.FormFileRepository
+	storage     *fakeStorage
+	formID      primitive.ObjectID
+}
+
+func setupUserDataService(t *testing.T) *userDataFixture {
+	ctx := context.Background()
+	store := memory.NewStore()
+	f := &userDataFixture{
+		forms:       memory.NewFormRepository(store),
+		submissions: memory.NewFormSubmissionRepository(store),
+		files:       memory.NewFormFileRepository(store),
+		storage:     &fakeStorage{objects: map[string]int64{}},
+	}
+	config := &conf.AppConfig{
+		AdminConfig: &conf.AdminConfig{UserIDs: []string{"admin1"}},
+	}
+	keys, err := kms.NewLocal("master-1", bytes.Repeat([]byte{7}, kms.DataKeySize))
+	require.NoError(t, err)
+	f.encryption = NewAnswerEncryption(memory.NewMerchantKeyRepository(store), keys)
+	f.service = NewUserDataService(memory.NewUserDataRepository(store), f.storage, f.encryption, config)
+
+	form := &models.Form{MerchantID: "merchant123", CreatedBy: "owner1", UpdatedBy: "owner1"}
+	require.NoError(t, f.forms.Create(ctx, form))
+	f.formID = form.ID
+	for _, user := range []string{"user123", "user123", "user456"} {
+		require.NoError(t, f.submissions.Create(ctx, &models.FormSubmission{
+			FormID:      form.ID,
+			MerchantID:  "merchant123",
+			SubmittedBy: user,
+			CreatedBy:   user,
+			Answers:     map[string]interface{}{"rating": 5},
+		}))
+		_, err := f.submissions.ReserveResponse(ctx, form.ID, user, 10)
+		require.NoError(t, err)
+	}
+	return f
+}
+
+func (f *userDataFixture) submittedBy(t *testing.T) []string {
+	submissions, _, err := f.submissions.Find(context.Background(), &models.SubmissionQueryOptions{
+		FormID: f.formID, MerchantID: "merchant123", Page: 1, PageSize: 100,
+	})
+	require.NoError(t, err)
+	users := make([]string, len(submissions))
+	for i, submission := range submissions {
+		users[i] = submission.SubmittedBy
+	}
+	return users
+}
+
+func TestUserDataService_ExportUserData_OwnData(t *testing.T) {
+	f := setupUserDataService(t)
+
+	export, err := f.service.ExportUserData(context.Background(), "user123", "user123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "user123", export.UserID)
+	assert.Len(t, export.Collections["form_submissions"], 2)
+	assert.Len(t, export.Collections["form_response_counters"], 1)
+	assert.NotContains(t, export.Collections, "forms")
+	assert.Equal(t, "user123", export.Collections["form_submissions"][0]["submitted_by"])
+}
+
+func TestUserDataService_ExportUserData_OtherUser(t *testing.T) {
+	f := setupUserDataService(t)
+
+	_, err := f.service.ExportUserData(context.Background(), "user123", "user456")
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	export, err := f.service.ExportUserData(context.Background(), "owner1", "admin1")
+	require.NoError(t, err)
+	assert.Len(t, export.Collections["forms"], 1)
+}
+
+func TestUserDataService_EraseUserData_Pseudonymizes(t *testing.T) {
+	ctx := context.Background()
+	f := setupUserDataService(t)
+
+	erasure, err := f.service.EraseUserData(ctx, &models.EraseUserDataInput{UserID: "user123", RequestedBy: "user123"})
+
+	require.NoError(t, err)
+	assert.Contains(t, erasure.Pseudonym, "erased-")
+	assert.Contains(t, erasure.Collections, models.ErasedCollection{Collection: "form_submissions", Pseudonymized: 2})
+	assert.ElementsMatch(t, []string{erasure.Pseudonym, erasure.Pseudonym, "user456"}, f.submittedBy(t))
+
+	// Answers are kept, so statistics are unchanged
+	stats, err := f.submissions.AggregateStats(ctx, &models.FormResponseStatsQuery{FormID: f.formID, MerchantID: "merchant123"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.TotalResponses)
+
+	export, err := f.service.ExportUserData(ctx, "user123", "user123")
+	require.NoError(t, err)
+	assert.Empty(t, export.Collections)
+}
+
+func TestUserDataService_PIIAnswers(t *testing.T) {
+	ctx := context.Background()
+	f := setupUserDataService(t)
+	stored, err := f.encryption.seal(ctx, piiSchema, &models.FormSubmission{
+		ID:          primitive.NewObjectID(),
+		FormID:      f.formID,
+		MerchantID:  "merchant123",
+		SubmittedBy: "user789",
+		Answers:     map[string]interface{}{"email": "alice@example.com", "rating": 3},
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.submissions.Create(ctx, stored))
+
+	// The export holds the answers in clear text
+	export, err := f.service.ExportUserData(ctx, "user789", "user789")
+	require.NoError(t, err)
+	require.Len(t, export.Collections["form_submissions"], 1)
+	exported := export.Collections["form_submissions"][0]
+	assert.Equal(t, "alice@example.com", exported["answers"].(map[string]interface{})["email"])
+	assert.NotContains(t, exported, "encrypted_answers")
+
+	// Erasure drops the PII answers and keeps the others
+	_, err = f.service.EraseUserData(ctx, &models.EraseUserDataInput{UserID: "user789", RequestedBy: "user789"})
+	require.NoError(t, err)
+	erased, err := f.submissions.FindByID(ctx, stored.ID)
+	require.NoError(t, err)
+	assert.Empty(t, erased.EncryptedAnswers)
+	assert.True(t, erased.KeyID.IsZero())
+	answers := schema.Normalize(erased.Answers).(map[string]interface{})
+	assert.NotContains(t, answers, "email")
+	assert.EqualValues(t, 3, answers["rating"])
+}
+
+func TestUserDataService_EraseUserData_DeleteResponses(t *testing.T) {
+	f := setupUserDataService(t)
+
+	erasure, err := f.service.EraseUserData(context.Background(), &models.EraseUserDataInput{
+		UserID: "user123", RequestedBy: "admin1", DeleteResponses: true,
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, erasure.Collections, models.ErasedCollection{Collection: "form_submissions", Deleted: 2})
+	assert.Equal(t, []string{"user456"}, f.submittedBy(t))
+}
+
+func TestUserDataService_EraseUserData_DeleteResponses_DeletesFiles(t *testing.T) {
+	f := setupUserDataService(t)
+	ctx := context.Background()
+	submissions, _, err := f.submissions.Find(ctx, &models.SubmissionQueryOptions{
+		FormID: f.formID, MerchantID: "merchant123", Page: 1, PageSize: 100,
+	})
+	require.NoError(t, err)
+	for _, submission := range submissions {
+		key := "files/" + submission.SubmittedBy + "/" + submission.ID.Hex()
+		f.storage.objects[key] = 10
+		require.NoError(t, f.files.Create(ctx, &models.FileReference{
+			FormID:       f.formID,
+			MerchantID:   "merchant123",
+			Key:          key,
+			Status:       models.FileStatusAttached,
+			SubmissionID: &submission.ID,
+		}))
+	}
+
+	erasure, err := f.service.EraseUserData(ctx, &models.EraseUserDataInput{
+		UserID: "user123", RequestedBy: "admin1", DeleteResponses: true,
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, erasure.Collections, models.ErasedCollection{Collection: "form_files", Deleted: 2})
+	require.Len(t, f.storage.objects, 1)
+	for key := range f.storage.objects {
+		assert.Contains(t, key, "files/user456/")
+	}
+}
+
+func TestUserDataService_EraseUserData_NotAllowed(t *testing.T) {
+	f := setupUserDataService(t)
+
+	_, err := f.service.EraseUserData(context.Background(), &models.EraseUserDataInput{UserID: "user123", RequestedBy: "user456"})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	_, err = f.service.EraseUserData(context.Background(), &models.EraseUserDataInput{RequestedBy: "user456"})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Equal(t, []string{"user123", "user123", "user456"}, f.submittedBy(t))
+}
@@ -0,0 +1,66 @@
+package signedtoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	secret := []byte("secret")
+
+	fields, err := Verify(secret, Sign(secret, "a", "b", "c:d"), 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c:d"}, fields)
+}
+
+func TestVerify_Errors(t *testing.T) {
+	secret := []byte("secret")
+	token := Sign(secret, "a", "b")
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		token    string
+		fields   int
+		expected error
+	}{
+		{"Wrong secret", []byte("other"), token, 2, ErrInvalidSignature},
+		{"Tampered payload", secret, encode([]byte("x:y")) + token[len(token)-44:], 2, ErrInvalidSignature},
+		{"Missing signature", secret, "abc", 2, ErrMalformedToken},
+		{"Bad encoding", secret, "!!.!!", 2, ErrMalformedToken},
+		{"Missing field", secret, token, 3, ErrMalformedToken},
+		{"Empty last field", secret, Sign(secret, "a", ""), 2, ErrMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(tt.secret, tt.token, tt.fields)
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	now := time.Now()
+
+	parsed, err := ParseTime(FormatTime(now))
+	require.NoError(t, err)
+	assert.True(t, now.Truncate(time.Second).Equal(parsed))
+	_, err = ParseTime("soon")
+	assert.Equal(t, ErrMalformedToken, err)
+
+	id := primitive.NewObjectID()
+	parsedID, err := ParseObjectID(id.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, id, parsedID)
+	_, err = ParseObjectID("x")
+	assert.Equal(t, ErrMalformedToken, err)
+
+	assert.NoError(t, CheckExpiry(now.Add(time.Second), now))
+	assert.Equal(t, ErrTokenExpired, CheckExpiry(now, now))
+}
@@ -0,0 +1,97 @@
+package spam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Verification endpoints of the supported CAPTCHA providers
+const (
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+// Verifier checks the response tokens produced by a CAPTCHA widget
+type Verifier interface {
+	// Verify reports whether the provider accepts the response token. An error means the
+	// provider could not be asked, not that the token was rejected.
+	Verify(ctx context.Context, response, remoteIP string) (bool, error)
+}
+
+// SiteVerifier is a Verifier for providers implementing the siteverify protocol shared by
+// hCaptcha and Turnstile: the secret, response and remote IP are posted as a form and the
+// provider answers with a JSON object whose success field holds the verdict.
+type SiteVerifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewHCaptcha creates a verifier for hCaptcha with the secret of the account
+func NewHCaptcha(secret string, timeout time.Duration) (*SiteVerifier, error) {
+	return newSiteVerifier(HCaptchaVerifyURL, secret, timeout)
+}
+
+// NewTurnstile creates a verifier for Cloudflare Turnstile with the secret of the widget
+func NewTurnstile(secret string, timeout time.Duration) (*SiteVerifier, error) {
+	return newSiteVerifier(TurnstileVerifyURL, secret, timeout)
+}
+
+func newSiteVerifier(verifyURL, secret string, timeout time.Duration) (*SiteVerifier, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("spam: captcha secret is required")
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &SiteVerifier{
+		url:    verifyURL,
+		secret: secret,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify implements Verifier.Verify
+func (v *SiteVerifier) Verify(ctx context.Context, response, remoteIP string) (bool, error) {
+	if response == "" {
+		return false, nil
+	}
+
+	form := url.Values{"secret": {v.secret}, "response": {response}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("spam: captcha verify: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return false, fmt.Errorf("spam: captcha verify: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var result siteVerifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("spam: captcha verify: %w", err)
+	}
+	return result.Success, nil
+}
@@ -0,0 +1,105 @@
+package spam
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/signedtoken"
+)
+
+func TestIssueAndParseToken(t *testing.T) {
+	secret := []byte("secret")
+	formID := primitive.NewObjectID()
+	loadedAt := time.Now().Truncate(time.Second)
+
+	claims, err := ParseToken(secret, IssueToken(secret, formID, loadedAt))
+
+	require.NoError(t, err)
+	assert.Equal(t, formID, claims.FormID)
+	assert.True(t, loadedAt.Equal(claims.LoadedAt))
+	assert.NotEmpty(t, claims.Nonce)
+
+	// Tokens of the same form load differ by their nonce
+	other, err := ParseToken(secret, IssueToken(secret, formID, loadedAt))
+	require.NoError(t, err)
+	assert.NotEqual(t, claims.Nonce, other.Nonce)
+}
+
+func TestParseToken_Errors(t *testing.T) {
+	secret := []byte("secret")
+	token := IssueToken(secret, primitive.NewObjectID(), time.Now())
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		token    string
+		expected error
+	}{
+		{name: "no separator", secret: secret, token: "abc", expected: signedtoken.ErrMalformedToken},
+		{name: "other secret", secret: []byte("other"), token: token, expected: signedtoken.ErrInvalidSignature},
+		{name: "bad form ID", secret: secret, token: signedtoken.Sign(secret, "x", "1", "nonce"), expected: signedtoken.ErrMalformedToken},
+		{name: "no nonce", secret: secret, token: signedtoken.Sign(secret, primitive.NewObjectID().Hex(), "1"), expected: signedtoken.ErrMalformedToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseToken(tt.secret, tt.token)
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestSiteVerifier_Verify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "1.2.3.4", r.PostForm.Get("remoteip"))
+		if r.PostForm.Get("response") == "good" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier, err := newSiteVerifier(server.URL, "secret", time.Second)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ok, err := verifier.Verify(ctx, "good", "1.2.3.4")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = verifier.Verify(ctx, "bad", "1.2.3.4")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// A missing response is rejected without asking the provider
+	ok, err = verifier.Verify(ctx, "", "1.2.3.4")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSiteVerifier_ProviderUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	verifier, err := newSiteVerifier(server.URL, "secret", time.Second)
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(context.Background(), "good", "")
+	assert.Error(t, err)
+}
+
+func TestNewSiteVerifier_RequiresSecret(t *testing.T) {
+	_, err := NewHCaptcha("", 0)
+	assert.Error(t, err)
+}
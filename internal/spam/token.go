@@ -0,0 +1,48 @@
+// Package spam provides the building blocks of form spam protection: signed tokens recording
+// when a form was loaded, and CAPTCHA verification against hCaptcha or Cloudflare Turnstile.
+package spam
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/arwoosa/form/internal/signedtoken"
+)
+
+// nonceSize is the number of random bytes telling apart tokens issued for the same form and second
+const nonceSize = 16
+
+// Claims identifies the form load a submission token was issued for
+type Claims struct {
+	FormID   primitive.ObjectID
+	LoadedAt time.Time
+	Nonce    string // Random, so that a token can be consumed once
+}
+
+// IssueToken issues a signed submission token recording that the form was loaded at the given time
+func IssueToken(secret []byte, formID primitive.ObjectID, loadedAt time.Time) string {
+	nonce := make([]byte, nonceSize)
+	_, _ = rand.Read(nonce)
+	return signedtoken.Sign(secret, formID.Hex(), signedtoken.FormatTime(loadedAt), base64.RawURLEncoding.EncodeToString(nonce))
+}
+
+// ParseToken verifies the signature of a submission token and returns the claims it was issued
+// for. Errors are those of the signedtoken package.
+func ParseToken(secret []byte, token string) (*Claims, error) {
+	fields, err := signedtoken.Verify(secret, token, 3)
+	if err != nil {
+		return nil, err
+	}
+	formID, err := signedtoken.ParseObjectID(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	loadedAt, err := signedtoken.ParseTime(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	return &Claims{FormID: formID, LoadedAt: loadedAt, Nonce: fields[2]}, nil
+}
@@ -0,0 +1,243 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Config holds the settings of an S3 compatible bucket. Google Cloud Storage is supported
+// through its XML API with HMAC keys, using the storage.googleapis.com endpoint.
+type S3Config struct {
+	Endpoint        string // e.g. "https://s3.ap-northeast-1.amazonaws.com" or "https://storage.googleapis.com"
+	Region          string // "auto" for Google Cloud Storage
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	PathStyle       bool // Address the bucket as a path segment instead of a subdomain
+}
+
+// S3 is a Storage backed by an S3 compatible bucket, signing requests with AWS Signature Version 4
+type S3 struct {
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3 creates an S3 compatible storage
+func NewS3(config S3Config) (*S3, error) {
+	if config.Endpoint == "" || config.Bucket == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("storage: endpoint, bucket and credentials are required")
+	}
+	if _, err := url.Parse(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("storage: invalid endpoint: %w", err)
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	return &S3{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+	}, nil
+}
+
+// PresignPut implements Storage.PresignPut. Content type and length are part of the signature,
+// so the upload fails unless the client sends exactly the declared file.
+func (s *S3) PresignPut(_ context.Context, key, contentType string, size int64, expires time.Duration) (*PresignedRequest, error) {
+	headers := map[string]string{
+		"Content-Type":   contentType,
+		"Content-Length": strconv.FormatInt(size, 10),
+	}
+	now := s.now().UTC()
+
+	signedURL, err := s.presign(http.MethodPut, key, headers, expires, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PresignedRequest{
+		URL:       signedURL,
+		Method:    http.MethodPut,
+		Headers:   headers,
+		ExpiresAt: now.Add(expires),
+	}, nil
+}
+
+// Stat implements Storage.Stat
+func (s *S3) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	signedURL, err := s.presign(http.MethodHead, key, nil, time.Minute, s.now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, signedURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("storage: head object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrObjectNotFound
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("storage: head object: unexpected status %d", resp.StatusCode)
+	}
+
+	return &ObjectInfo{
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}, nil
+}
+
+// Delete implements Storage.Delete
+func (s *S3) Delete(ctx context.Context, key string) error {
+	signedURL, err := s.presign(http.MethodDelete, key, nil, time.Minute, s.now().UTC())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, signedURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("storage: delete object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// S3 answers 204 whether or not the object existed; other providers may answer 404
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("storage: delete object: unexpected status %d", resp.StatusCode)
+	}
+}
+
+// presign builds a query-string authenticated URL for the request
+func (s *S3) presign(method, key string, headers map[string]string, expires time.Duration, now time.Time) (string, error) {
+	endpoint, err := url.Parse(s.config.Endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	host := endpoint.Host
+	path := "/" + escapePath(key)
+	if s.config.PathStyle {
+		path = "/" + s.config.Bucket + path
+	} else {
+		host = s.config.Bucket + "." + host
+	}
+
+	amzDate := now.Format("20060102T150405Z")
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", now.Format("20060102"), s.config.Region)
+
+	// Canonical headers, lower-cased and sorted, always including the host
+	canonical := map[string]string{"host": host}
+	for name, value := range headers {
+		canonical[strings.ToLower(name)] = strings.TrimSpace(value)
+	}
+	names := make([]string, 0, len(canonical))
+	for name := range canonical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + canonical[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     "AWS4-HMAC-SHA256",
+		"X-Amz-Credential":    s.config.AccessKeyID + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       strconv.Itoa(int(expires.Seconds())),
+		"X-Amz-SignedHeaders": signedHeaders,
+	}
+	canonicalQuery := encodeQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		path,
+		canonicalQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), now.Format("20060102"))
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return fmt.Sprintf("%s://%s%s?%s&X-Amz-Signature=%s", endpoint.Scheme, host, path, canonicalQuery, signature), nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// encodeQuery encodes parameters sorted by name as required for canonical requests
+func encodeQuery(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = escape(name) + "=" + escape(params[name])
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath percent-encodes each segment of an object key, keeping the separators
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// escape percent-encodes everything except the RFC 3986 unreserved characters
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
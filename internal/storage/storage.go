@@ -0,0 +1,36 @@
+// Package storage issues pre-signed URLs so clients can upload files directly to object storage
+// without the file content passing through the form service.
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrObjectNotFound is returned by Stat when no object exists at the key
+var ErrObjectNotFound = errors.New("object not found")
+
+// Storage is an object store supporting pre-signed uploads
+type Storage interface {
+	// PresignPut returns a request that uploads exactly size bytes of contentType to key until it expires
+	PresignPut(ctx context.Context, key, contentType string, size int64, expires time.Duration) (*PresignedRequest, error)
+	// Stat returns the metadata of the object at key
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Delete removes the object at key; deleting a missing object succeeds
+	Delete(ctx context.Context, key string) error
+}
+
+// PresignedRequest is an HTTP request a client can perform without credentials
+type PresignedRequest struct {
+	URL       string
+	Method    string
+	Headers   map[string]string // Headers the client must send with the request
+	ExpiresAt time.Time
+}
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}